@@ -20,6 +20,10 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
 	"os"
 	"strings"
 	"time"
@@ -29,6 +33,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/service"
 
 	exportServer "kubevirt.io/kubevirt/pkg/storage/export/virt-exportserver"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 )
 
 const (
@@ -47,6 +52,7 @@ func main() {
 		ListenAddr: getListenAddr(),
 		TokenFile:  getTokenFile(),
 		Volumes:    getVolumeInfo(),
+		NBDDialer:  getNBDDialer(),
 	}
 	server := exportServer.NewExportServer(config)
 	service.Setup(server)
@@ -69,6 +75,18 @@ func getVolumeInfo() []exportServer.VolumeInfo {
 				SecretURI:  os.Getenv("EXPORT_SECRET_DEF_URI"),
 			}
 			result = append(result, vi)
+			continue
+		}
+		envPrefix = strings.TrimSuffix(kv[0], "_EXPORT_NBD_URI")
+		if envPrefix != kv[0] {
+			vi := exportServer.VolumeInfo{
+				NBDURI:      kv[1],
+				NBDExport:   os.Getenv(envPrefix + "_EXPORT_NBD_NAME"),
+				DirtyBitmap: os.Getenv(envPrefix + "_EXPORT_DIRTY_BITMAP"),
+				RawURI:      os.Getenv(envPrefix + "_EXPORT_RAW_URI"),
+				ExtentsURI:  os.Getenv(envPrefix + "_EXPORT_EXTENTS_URI"),
+			}
+			result = append(result, vi)
 		}
 	}
 	return result
@@ -91,6 +109,30 @@ func getCert() (certFile, keyFile string) {
 	return
 }
 
+// getNBDDialer returns the dialer of the virt-handler endpoints proxying the NBD
+// servers of pull mode backups, when the exporter serves such a backup
+func getNBDDialer() func(string) (net.Conn, error) {
+	certFile := os.Getenv("BACKUP_CLIENT_CERT_FILE")
+	keyFile := os.Getenv("BACKUP_CLIENT_KEY_FILE")
+	caFile := os.Getenv("BACKUP_CA_FILE")
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return nil
+	}
+	clientCert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid backup client certificate: %v", err))
+	}
+	caBundle, err := os.ReadFile(caFile)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid backup CA: %v", err))
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBundle) {
+		panic("Invalid backup CA")
+	}
+	return exportServer.NewVirtHandlerNBDDialer(kvtls.SetupTLSForVirtHandlerBackupClients(caPool, &clientCert))
+}
+
 func getListenAddr() string {
 	addr := os.Getenv("LISTEN_ADDR")
 	if addr != "" {
@@ -112,6 +112,9 @@ const (
 	// Default port that virt-handler listens to console requests
 	defaultConsoleServerPort = 8186
 
+	// Default port that virt-handler listens to export server requests for pull mode backups
+	defaultBackupServerPort = 8187
+
 	// Default period for resyncing virt-launcher domain cache
 	defaultDomainResyncPeriodSeconds = 300
 
@@ -121,6 +124,9 @@ const (
 	// Default ConfigMap name of CA
 	defaultCAConfigMapName = "kubevirt-ca"
 
+	// ConfigMap name of the CA issuing the export server certificates
+	exportCAConfigMapName = "kubevirt-export-ca"
+
 	// Default certificate and key paths
 	defaultClientCertFilePath = "/etc/virt-handler/clientcertificates/tls.crt"
 	defaultClientKeyFilePath  = "/etc/virt-handler/clientcertificates/tls.key"
@@ -165,12 +171,18 @@ type virtHandlerApp struct {
 	serverTLSConfig       *tls.Config
 	clientTLSConfig       *tls.Config
 	consoleServerPort     int
+	backupServerPort      int
 	clientcertmanager     certificate.Manager
 	servercertmanager     certificate.Manager
 	promTLSConfig         *tls.Config
 	clusterConfig         *virtconfig.ClusterConfig
 	reloadableRateLimiter *ratelimiter.ReloadableRateLimiter
 	caManager             kvtls.ClientCAManager
+
+	// TLS configurations of the cross cluster migration tunnels, which also trust the CAs of the peer clusters
+	crossClusterServerTLSConfig *tls.Config
+	crossClusterClientTLSConfig *tls.Config
+	backupServerTLSConfig       *tls.Config
 }
 
 var (
@@ -334,7 +346,7 @@ func (app *virtHandlerApp) Run() {
 
 	app.clusterConfig.SetConfigModifiedCallback(vsockConfigCallback)
 
-	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.clusterConfig)
+	migrationProxy := migrationproxy.NewMigrationProxyManager(app.serverTLSConfig, app.clientTLSConfig, app.crossClusterServerTLSConfig, app.crossClusterClientTLSConfig, app.clusterConfig)
 
 	stop := make(chan struct{})
 	defer close(stop)
@@ -448,6 +460,7 @@ func (app *virtHandlerApp) Run() {
 
 	errCh := make(chan error)
 	go app.runServer(errCh, consoleHandler, lifecycleHandler)
+	go app.runBackupServer(errCh, consoleHandler)
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
@@ -610,13 +623,17 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/freeze").To(lifecycleHandler.FreezeHandler).Reads(v1.FreezeUnfreezeTimeout{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/unfreeze").To(lifecycleHandler.UnfreezeHandler))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/softreboot").To(lifecycleHandler.SoftRebootHandler))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/backup").To(lifecycleHandler.BackupHandler).Reads(v1.VirtualMachineInstanceBackupOptions{}))
+	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/abortbackup").To(lifecycleHandler.AbortBackupHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/guestosinfo").To(lifecycleHandler.GetGuestInfo).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/userlist").To(lifecycleHandler.GetUsers).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestOSUserList{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/filesystemlist").To(lifecycleHandler.GetFilesystems).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/cpuload").To(lifecycleHandler.GetCPULoad).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceCPULoad{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/vsock").Param(restful.QueryParameter("port", "Target VSOCK port")).To(consoleHandler.VSOCKHandler))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/fetchcertchain").To(lifecycleHandler.SEVFetchCertChainHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVPlatformInfo{}))
 	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/querylaunchmeasurement").To(lifecycleHandler.SEVQueryLaunchMeasurementHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.SEVMeasurementInfo{}))
 	ws.Route(ws.PUT("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/sev/injectlaunchsecret").To(lifecycleHandler.SEVInjectLaunchSecretHandler))
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/attestationreport").Param(restful.QueryParameter("nonce", "Base64 encoded nonce to embed into the report")).To(lifecycleHandler.AttestationReportHandler).Produces(restful.MIME_JSON).Consumes(restful.MIME_JSON).Returns(http.StatusOK, "OK", v1.AttestationReport{}))
 	restful.DefaultContainer.Add(ws)
 	server := &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", app.ServiceListen.BindAddress, app.consoleServerPort),
@@ -628,6 +645,22 @@ func (app *virtHandlerApp) runServer(errCh chan error, consoleHandler *rest.Cons
 	errCh <- server.ListenAndServeTLS("", "")
 }
 
+// runBackupServer serves the disks of pull mode backups to the export servers,
+// which authenticate with certificates of the export CA
+func (app *virtHandlerApp) runBackupServer(errCh chan error, consoleHandler *rest.ConsoleHandler) {
+	ws := new(restful.WebService)
+	ws.Route(ws.GET("/v1/namespaces/{namespace}/virtualmachineinstances/{name}/backupnbd").To(consoleHandler.BackupNBDHandler))
+	container := restful.NewContainer()
+	container.Add(ws)
+	server := &http.Server{
+		Addr:        fmt.Sprintf("%s:%d", app.ServiceListen.BindAddress, app.backupServerPort),
+		Handler:     container,
+		TLSConfig:   app.backupServerTLSConfig,
+		IdleTimeout: 60 * time.Second,
+	}
+	errCh <- server.ListenAndServeTLS("", "")
+}
+
 func (app *virtHandlerApp) AddFlags() {
 	app.InitFlags()
 
@@ -690,6 +723,9 @@ func (app *virtHandlerApp) AddFlags() {
 	flag.IntVar(&app.consoleServerPort, "console-server-port", defaultConsoleServerPort,
 		"The port virt-handler listens on for console requests")
 
+	flag.IntVar(&app.backupServerPort, "backup-server-port", defaultBackupServerPort,
+		"The port virt-handler listens on for export server requests reading pull mode backups")
+
 	flag.IntVar(&app.domainResyncPeriodSeconds, "domain-resync-period-seconds", defaultDomainResyncPeriodSeconds,
 		"Recurring period for resyncing all known virt-launcher domains.")
 
@@ -709,6 +745,13 @@ func (app *virtHandlerApp) setupTLS(factory controller.KubeInformerFactory) erro
 	app.serverTLSConfig = kvtls.SetupTLSForVirtHandlerServer(app.caManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
 	app.clientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(app.caManager, app.clientcertmanager, app.externallyManaged)
 
+	crossClusterCAManager := kvtls.NewCrossClusterCAManager(app.caManager, app.clusterConfig)
+	app.crossClusterServerTLSConfig = kvtls.SetupTLSForVirtHandlerServer(crossClusterCAManager, app.servercertmanager, app.externallyManaged, app.clusterConfig)
+	app.crossClusterClientTLSConfig = kvtls.SetupTLSForVirtHandlerClients(crossClusterCAManager, app.clientcertmanager, app.externallyManaged)
+
+	exportCAManager := kvtls.NewCAManager(factory.KubeVirtExportCAConfigMap().GetStore(), app.namespace, exportCAConfigMapName)
+	app.backupServerTLSConfig = kvtls.SetupTLSForVirtHandlerBackupServer(exportCAManager, app.servercertmanager, app.clusterConfig)
+
 	return nil
 }
 
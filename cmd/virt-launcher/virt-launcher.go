@@ -395,6 +395,10 @@ func main() {
 	}
 
 	l.StartVirtquemud(stopChan)
+	// the TPM state encryption key is only mounted when the VMI asks for it
+	if _, err := os.Stat(config.TPMEncryptionKeyDir); err == nil {
+		util.StartVirtsecretd(stopChan)
+	}
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
 
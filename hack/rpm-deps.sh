@@ -89,6 +89,7 @@ sandboxroot_main="
 launcherbase_main="
   libvirt-client-${LIBVIRT_VERSION}
   libvirt-daemon-driver-qemu-${LIBVIRT_VERSION}
+  libvirt-daemon-driver-secret-${LIBVIRT_VERSION}
   passt-${PASST_VERSION}
   qemu-kvm-core-${QEMU_VERSION}
   swtpm-tools-${SWTPM_VERSION}
@@ -63,6 +63,10 @@ var (
 	SysprepSourceDir = filepath.Join(mountBaseDir, "sysprep")
 	// SecretSourceDir represents a location where Secrets is attached to the pod
 	SecretSourceDir = filepath.Join(mountBaseDir, "secret")
+	// TPMEncryptionKeyDir represents a location where the Secret holding the vTPM state encryption key is attached to the pod
+	TPMEncryptionKeyDir = filepath.Join(mountBaseDir, "vtpm-encryption")
+	// SecureBootKeysDir represents a location where the Secret holding the EFI Secure Boot keys to enroll is attached to the pod
+	SecureBootKeysDir = filepath.Join(mountBaseDir, "secure-boot-keys")
 	// DownwardAPISourceDir represents a location where downwardapi is attached to the pod
 	DownwardAPISourceDir = filepath.Join(mountBaseDir, "downwardapi")
 	// ServiceAccountSourceDir represents the location where the ServiceAccount token is attached to the pod
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net"
 	"sync"
 	"time"
 
@@ -67,6 +68,7 @@ import (
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -135,6 +137,15 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineBackup objects
+	VirtualMachineBackup() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
@@ -548,6 +559,19 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		macpool.MacAddressIndex: func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			var macs []string
+			for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+				if mac, err := net.ParseMAC(iface.MacAddress); err == nil {
+					macs = append(macs, mac.String())
+				}
+			}
+			return macs, nil
+		},
 	}
 }
 
@@ -588,6 +612,20 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 
 			return nil, nil
 		},
+		"vmbackup": func(obj interface{}) ([]string, error) {
+			export, ok := obj.(*exportv1.VirtualMachineExport)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if export.Spec.Source.APIGroup != nil &&
+				*export.Spec.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group &&
+				export.Spec.Source.Kind == "VirtualMachineBackup" {
+				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+			}
+
+			return nil, nil
+		},
 		"vm": func(obj interface{}) ([]string, error) {
 			export, ok := obj.(*exportv1.VirtualMachineExport)
 			if !ok {
@@ -626,6 +664,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if schedule, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, schedule)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -676,7 +726,11 @@ func GetVirtualMachineRestoreInformerIndexers() cache.Indexers {
 			if vmr.Spec.Target.APIGroup != nil &&
 				*vmr.Spec.Target.APIGroup == core.GroupName &&
 				vmr.Spec.Target.Kind == "VirtualMachine" {
-				return []string{fmt.Sprintf("%s/%s", vmr.Namespace, vmr.Spec.Target.Name)}, nil
+				namespace := vmr.Namespace
+				if vmr.Spec.TargetNamespace != nil && *vmr.Spec.TargetNamespace != "" {
+					namespace = *vmr.Spec.TargetNamespace
+				}
+				return []string{fmt.Sprintf("%s/%s", namespace, vmr.Spec.Target.Name)}, nil
 			}
 
 			return nil, nil
@@ -691,6 +745,46 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackup() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinebackups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineBackup{}, f.defaultResync, GetVirtualMachineBackupInformerIndexers())
+	})
+}
+
+func GetVirtualMachineBackupInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		"vm": func(obj interface{}) ([]string, error) {
+			backup, ok := obj.(*snapshotv1.VirtualMachineBackup)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if backup.Spec.Source.APIGroup != nil &&
+				*backup.Spec.Source.APIGroup == core.GroupName &&
+				backup.Spec.Source.Kind == "VirtualMachine" {
+				return []string{fmt.Sprintf("%s/%s", backup.Namespace, backup.Spec.Source.Name)}, nil
+			}
+
+			return nil, nil
+		},
+	}
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
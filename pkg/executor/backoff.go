@@ -73,6 +73,15 @@ func NewExponentialLimitedBackoffWithClock(limit time.Duration, clk clock.Clock)
 	return newLimitedBackoffWithClock(backoff, limit, clk)
 }
 
+// NewConstantLimitedBackoffWithClock creates a backoff which is ready once per interval until the limit is reached.
+func NewConstantLimitedBackoffWithClock(interval time.Duration, limit time.Duration, clk clock.Clock) LimitedBackoff {
+	// without Steps the underlying wait.Backoff keeps returning the initial duration
+	backoff := wait.Backoff{
+		Duration: interval,
+	}
+	return newLimitedBackoffWithClock(backoff, limit, clk)
+}
+
 func newLimitedBackoffWithClock(backoff wait.Backoff, limit time.Duration, clk clock.Clock) LimitedBackoff {
 	now := clk.Now()
 	return LimitedBackoff{
@@ -97,3 +106,10 @@ func NewExponentialLimitedBackoffCreator() LimitedBackoffCreator {
 		baseBackoff: NewExponentialLimitedBackoffWithClock(DefaultMaxStep, clock.RealClock{}),
 	}
 }
+
+// NewConstantBackoffCreator creates backoffs which are ready once per interval, with no limit.
+func NewConstantBackoffCreator(interval time.Duration) LimitedBackoffCreator {
+	return LimitedBackoffCreator{
+		baseBackoff: NewConstantLimitedBackoffWithClock(interval, math.MaxInt64, clock.RealClock{}),
+	}
+}
@@ -81,3 +81,39 @@ var _ = Describe("exponential limited backoff", func() {
 		Expect(backoff.Ready()).To(BeFalse())
 	})
 })
+
+var _ = Describe("constant limited backoff", func() {
+	const (
+		interval = 30 * time.Second
+		limit    = 5 * time.Minute
+	)
+
+	var testsClock *clock.FakeClock
+	var backoff executor.LimitedBackoff
+
+	BeforeEach(func() {
+		testsClock = clock.NewFakeClock(time.Time{})
+		backoff = executor.NewConstantLimitedBackoffWithClock(interval, limit, testsClock)
+
+		testsClock.Step(time.Nanosecond)
+	})
+
+	It("should be ready once per interval", func() {
+		for i := 0; i < 3; i++ {
+			Expect(backoff.Ready()).To(BeTrue())
+			backoff.Step()
+
+			Expect(backoff.Ready()).To(BeFalse())
+			testsClock.Step(interval)
+			Expect(backoff.Ready()).To(BeFalse())
+			testsClock.Step(time.Nanosecond)
+		}
+		Expect(backoff.Ready()).To(BeTrue())
+	})
+
+	It("should not be ready after max time is passed", func() {
+		testsClock.Step(limit + time.Nanosecond)
+
+		Expect(backoff.Ready()).To(BeFalse())
+	})
+})
@@ -40,6 +40,9 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	BackupRequest
+	AttestationReportRequest
+	AttestationReportResponse
 */
 package v1
 
@@ -393,8 +396,9 @@ type VirtualMachineOptions struct {
 	Topology              *Topology            `protobuf:"bytes,4,opt,name=topology" json:"topology,omitempty"`
 	DisksInfo             map[string]*DiskInfo `protobuf:"bytes,5,rep,name=DisksInfo" json:"DisksInfo,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Deprecated, use clusterConfig.ExpandDisksEnabled
-	ExpandDisksEnabled bool           `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
-	ClusterConfig      *ClusterConfig `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	ExpandDisksEnabled        bool              `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	ClusterConfig             *ClusterConfig    `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	InterfaceDomainAttachment map[string]string `protobuf:"bytes,8,rep,name=InterfaceDomainAttachment" json:"InterfaceDomainAttachment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *VirtualMachineOptions) Reset()                    { *m = VirtualMachineOptions{} }
@@ -451,6 +455,13 @@ func (m *VirtualMachineOptions) GetClusterConfig() *ClusterConfig {
 	return nil
 }
 
+func (m *VirtualMachineOptions) GetInterfaceDomainAttachment() map[string]string {
+	if m != nil {
+		return m.InterfaceDomainAttachment
+	}
+	return nil
+}
+
 type VMIRequest struct {
 	Vmi     *VMI                   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	Options *VirtualMachineOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
@@ -883,6 +894,78 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type BackupRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type AttestationReportRequest struct {
+	Vmi   *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Nonce string `protobuf:"bytes,2,opt,name=nonce" json:"nonce,omitempty"`
+}
+
+func (m *AttestationReportRequest) Reset()                    { *m = AttestationReportRequest{} }
+func (m *AttestationReportRequest) String() string            { return proto.CompactTextString(m) }
+func (*AttestationReportRequest) ProtoMessage()               {}
+func (*AttestationReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *AttestationReportRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *AttestationReportRequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+type AttestationReportResponse struct {
+	Response          *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	AttestationReport []byte    `protobuf:"bytes,2,opt,name=attestationReport,proto3" json:"attestationReport,omitempty"`
+}
+
+func (m *AttestationReportResponse) Reset()                    { *m = AttestationReportResponse{} }
+func (m *AttestationReportResponse) String() string            { return proto.CompactTextString(m) }
+func (*AttestationReportResponse) ProtoMessage()               {}
+func (*AttestationReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *AttestationReportResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *AttestationReportResponse) GetAttestationReport() []byte {
+	if m != nil {
+		return m.AttestationReport
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -914,6 +997,9 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
+	proto.RegisterType((*AttestationReportRequest)(nil), "kubevirt.cmd.v1.AttestationReportRequest")
+	proto.RegisterType((*AttestationReportResponse)(nil), "kubevirt.cmd.v1.AttestationReportResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -956,6 +1042,12 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	AbortVirtualMachineBackup(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	SyncVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	SyncVirtualMachineIOLimits(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	GetAttestationReport(ctx context.Context, in *AttestationReportRequest, opts ...grpc.CallOption) (*AttestationReportResponse, error)
+	SyncVirtualMachineInterfaceLinkStates(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1227,6 +1319,60 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) AbortVirtualMachineBackup(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SyncVirtualMachineMemory(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineMemory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SyncVirtualMachineIOLimits(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineIOLimits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GetAttestationReport(ctx context.Context, in *AttestationReportRequest, opts ...grpc.CallOption) (*AttestationReportResponse, error) {
+	out := new(AttestationReportResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetAttestationReport", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SyncVirtualMachineInterfaceLinkStates(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineInterfaceLinkStates", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1259,6 +1405,12 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	AbortVirtualMachineBackup(context.Context, *VMIRequest) (*Response, error)
+	SyncVirtualMachineMemory(context.Context, *VMIRequest) (*Response, error)
+	SyncVirtualMachineIOLimits(context.Context, *VMIRequest) (*Response, error)
+	GetAttestationReport(context.Context, *AttestationReportRequest) (*AttestationReportResponse, error)
+	SyncVirtualMachineInterfaceLinkStates(context.Context, *VMIRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1787,6 +1939,114 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_AbortVirtualMachineBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SyncVirtualMachineMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SyncVirtualMachineMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineMemory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SyncVirtualMachineMemory(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SyncVirtualMachineIOLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SyncVirtualMachineIOLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineIOLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SyncVirtualMachineIOLimits(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GetAttestationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetAttestationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetAttestationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetAttestationReport(ctx, req.(*AttestationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SyncVirtualMachineInterfaceLinkStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SyncVirtualMachineInterfaceLinkStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SyncVirtualMachineInterfaceLinkStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SyncVirtualMachineInterfaceLinkStates(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1907,6 +2167,30 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "BackupVirtualMachine",
+			Handler:    _Cmd_BackupVirtualMachine_Handler,
+		},
+		{
+			MethodName: "AbortVirtualMachineBackup",
+			Handler:    _Cmd_AbortVirtualMachineBackup_Handler,
+		},
+		{
+			MethodName: "SyncVirtualMachineMemory",
+			Handler:    _Cmd_SyncVirtualMachineMemory_Handler,
+		},
+		{
+			MethodName: "SyncVirtualMachineIOLimits",
+			Handler:    _Cmd_SyncVirtualMachineIOLimits_Handler,
+		},
+		{
+			MethodName: "GetAttestationReport",
+			Handler:    _Cmd_GetAttestationReport_Handler,
+		},
+		{
+			MethodName: "SyncVirtualMachineInterfaceLinkStates",
+			Handler:    _Cmd_SyncVirtualMachineInterfaceLinkStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1915,108 +2199,118 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6d, 0x6f, 0x1b, 0xc7,
-	0x11, 0x16, 0x45, 0x4a, 0x26, 0x47, 0x2f, 0xb1, 0x57, 0x2f, 0x3d, 0xab, 0xb5, 0xad, 0x2e, 0x0a,
-	0x43, 0x29, 0x12, 0xa9, 0x76, 0x9d, 0xa0, 0x08, 0x8a, 0x22, 0x35, 0x45, 0x2b, 0x4e, 0x42, 0x9b,
-	0x39, 0x4a, 0x32, 0x9a, 0x36, 0x08, 0x56, 0x77, 0xab, 0xd3, 0x56, 0x77, 0xbb, 0xd7, 0xdb, 0x3d,
-	0xd6, 0x0c, 0x50, 0xa0, 0x40, 0x8b, 0x7e, 0x28, 0xd0, 0x3f, 0xd1, 0x2f, 0xfd, 0x49, 0xfd, 0x3b,
-	0xc1, 0xee, 0xed, 0x51, 0x47, 0xde, 0xd1, 0xb4, 0x40, 0x7e, 0xe2, 0xcd, 0xee, 0xcc, 0x33, 0x73,
-	0xb3, 0x33, 0xb3, 0x0f, 0x0f, 0x3e, 0x8c, 0xaf, 0x83, 0xa3, 0x2b, 0xc2, 0xfd, 0x90, 0x26, 0x1f,
-	0x87, 0x24, 0xe5, 0xde, 0x15, 0x4d, 0x3e, 0xf6, 0x44, 0x74, 0xe4, 0x45, 0xfe, 0xd1, 0xe0, 0x89,
-	0xfe, 0x39, 0x8c, 0x13, 0xa1, 0x04, 0xfa, 0xe0, 0x3a, 0xbd, 0xa0, 0x03, 0x96, 0xa8, 0x43, 0xbd,
-	0x36, 0x78, 0x82, 0x2f, 0x61, 0xeb, 0x1b, 0x1a, 0xa5, 0xe7, 0x34, 0x91, 0x4c, 0x70, 0x97, 0xca,
-	0x58, 0x70, 0x49, 0xd1, 0x27, 0xd0, 0x4c, 0xec, 0xb3, 0x53, 0xdb, 0xaf, 0x1d, 0xac, 0x3d, 0xbd,
-	0x7f, 0x38, 0x61, 0x7a, 0x98, 0x2b, 0xbb, 0x23, 0x55, 0xe4, 0xc0, 0x9d, 0x41, 0x86, 0xe4, 0x2c,
-	0xef, 0xd7, 0x0e, 0x5a, 0x6e, 0x2e, 0xe2, 0x47, 0x50, 0x3f, 0xef, 0xbe, 0x34, 0x0a, 0x11, 0xfb,
-	0x52, 0x0a, 0x6e, 0x60, 0xd7, 0xdd, 0x5c, 0xc4, 0x4f, 0xa0, 0xde, 0xee, 0x9d, 0xa1, 0x4d, 0x58,
-	0x66, 0xbe, 0xd9, 0xdb, 0x70, 0x97, 0x99, 0x8f, 0xf6, 0xa0, 0x29, 0xd9, 0x45, 0xc8, 0x78, 0x20,
-	0x9d, 0xe5, 0xfd, 0xfa, 0xc1, 0x86, 0x3b, 0x92, 0xf1, 0x11, 0xdc, 0xe9, 0x67, 0xcf, 0x25, 0xb3,
-	0x6d, 0x58, 0x19, 0x90, 0x30, 0xa5, 0x26, 0x8c, 0x86, 0x9b, 0x09, 0xb8, 0x03, 0x2b, 0x3d, 0x12,
-	0x50, 0xa9, 0xb7, 0x3d, 0x91, 0x72, 0x65, 0x2c, 0x1a, 0x6e, 0x26, 0x20, 0x04, 0x8d, 0x94, 0x33,
-	0x65, 0x43, 0x37, 0xcf, 0x7a, 0x4d, 0xb2, 0x1f, 0xa8, 0x53, 0x37, 0xd0, 0xe6, 0x19, 0x3f, 0x83,
-	0xd5, 0x2e, 0x8d, 0x44, 0x32, 0x44, 0xbb, 0xb0, 0x4a, 0xa2, 0x02, 0x90, 0x95, 0xaa, 0x90, 0xf0,
-	0xff, 0x6b, 0xd0, 0x68, 0xd3, 0x30, 0x2c, 0xc5, 0x7a, 0x04, 0xab, 0x91, 0x81, 0x33, 0xea, 0x6b,
-	0x4f, 0x7f, 0x52, 0xca, 0x74, 0xe6, 0xcd, 0xb5, 0x6a, 0xe8, 0x23, 0x58, 0x89, 0xf5, 0x6b, 0x38,
-	0xf5, 0xfd, 0xfa, 0xc1, 0xda, 0xd3, 0xdd, 0x92, 0xbe, 0x79, 0x49, 0x37, 0x53, 0x42, 0x9f, 0x42,
-	0xcb, 0x67, 0x52, 0x11, 0xee, 0x51, 0xe9, 0x34, 0x8c, 0x85, 0x53, 0xb2, 0xb0, 0x79, 0x74, 0x6f,
-	0x54, 0xd1, 0x01, 0x34, 0xbc, 0x38, 0x95, 0xce, 0x8a, 0x31, 0xd9, 0x2e, 0x99, 0xb4, 0x7b, 0x67,
-	0xae, 0xd1, 0xc0, 0x9f, 0x43, 0xf3, 0x54, 0xc4, 0x22, 0x14, 0xc1, 0x10, 0x3d, 0x03, 0xe0, 0x69,
-	0x44, 0xbe, 0xf7, 0x68, 0x18, 0x4a, 0xa7, 0x66, 0x6c, 0x77, 0xca, 0xb6, 0x34, 0x0c, 0xdd, 0x96,
-	0x56, 0xd4, 0x4f, 0x12, 0xff, 0xbb, 0x06, 0xab, 0xfd, 0xee, 0x73, 0x26, 0x24, 0xc2, 0xb0, 0x1e,
-	0x11, 0x9e, 0x5e, 0x12, 0x4f, 0xa5, 0x09, 0x4d, 0x4c, 0x9e, 0x5a, 0xee, 0xd8, 0x9a, 0xae, 0xa2,
-	0x38, 0x11, 0x7e, 0xea, 0xe5, 0x19, 0xce, 0xc5, 0x62, 0x01, 0xd6, 0xc7, 0x0a, 0x10, 0xdd, 0x85,
-	0xba, 0xbc, 0x4e, 0x9d, 0x86, 0x59, 0xd5, 0x8f, 0xfa, 0xf0, 0x2e, 0x49, 0xc4, 0xc2, 0xa1, 0xb3,
-	0x62, 0x16, 0xad, 0x84, 0xff, 0x55, 0x83, 0xe6, 0x31, 0x93, 0xd7, 0x2f, 0xf9, 0xa5, 0x30, 0x4a,
-	0x22, 0x89, 0x88, 0xb2, 0x81, 0x58, 0x09, 0xed, 0xc3, 0xda, 0x05, 0xf1, 0xae, 0x19, 0x0f, 0x5e,
-	0xb0, 0x90, 0xda, 0x30, 0x8a, 0x4b, 0xe8, 0x21, 0x80, 0x8e, 0x97, 0x84, 0xfd, 0xbc, 0x7e, 0x1a,
-	0x6e, 0x61, 0x45, 0x23, 0xe8, 0x94, 0xe4, 0x0a, 0x0d, 0xa3, 0x50, 0x5c, 0xc2, 0x7f, 0x83, 0x8d,
-	0x76, 0x98, 0x4a, 0x45, 0x93, 0xb6, 0xe0, 0x97, 0x2c, 0x40, 0x87, 0x80, 0x3a, 0x6f, 0x63, 0xc2,
-	0x7d, 0x1d, 0x9e, 0xec, 0x70, 0x72, 0x11, 0xd2, 0xac, 0x92, 0x9a, 0x6e, 0xc5, 0x0e, 0xfa, 0x2d,
-	0xdc, 0x7f, 0x91, 0x50, 0xaa, 0xcb, 0xc1, 0xa5, 0xb1, 0x48, 0x14, 0xe3, 0xc1, 0x31, 0x93, 0x99,
-	0xd9, 0xb2, 0x31, 0x9b, 0xae, 0x80, 0xff, 0xd7, 0x80, 0x9d, 0xf3, 0x2c, 0x9c, 0x2e, 0xf1, 0xae,
-	0x18, 0xa7, 0xaf, 0x63, 0xc5, 0x04, 0x97, 0xe8, 0x2b, 0xd8, 0x1e, 0xdf, 0xc8, 0xce, 0xce, 0x4e,
-	0x8a, 0x72, 0xfd, 0x66, 0xdb, 0x6e, 0xa5, 0x11, 0x7a, 0x06, 0x3b, 0x5d, 0x1a, 0x3d, 0x27, 0x61,
-	0x28, 0x04, 0xef, 0x2b, 0xa2, 0x64, 0x8f, 0x26, 0x4c, 0x64, 0x01, 0x6e, 0xb8, 0xd5, 0x9b, 0xe8,
-	0x57, 0xb0, 0xd5, 0x4b, 0xa8, 0x5e, 0xf7, 0x88, 0xa2, 0xfe, 0xb9, 0x08, 0xd3, 0xc8, 0x76, 0x44,
-	0xcb, 0xad, 0xda, 0xd2, 0x23, 0x4d, 0xd9, 0x2a, 0x35, 0xc9, 0xae, 0x1a, 0x69, 0x79, 0x19, 0xbb,
-	0x23, 0x55, 0xd4, 0x87, 0x96, 0xc9, 0xa9, 0xae, 0x06, 0xdb, 0x0b, 0x9f, 0x94, 0xec, 0x2a, 0xd3,
-	0x74, 0x38, 0xb2, 0xeb, 0x70, 0x95, 0x0c, 0xdd, 0x1b, 0x9c, 0x29, 0x07, 0xb9, 0x3a, 0xf5, 0x20,
-	0x8f, 0x61, 0xc3, 0x2b, 0x56, 0x82, 0x73, 0xc7, 0xbc, 0xc0, 0xc3, 0x72, 0x63, 0x15, 0xb5, 0xdc,
-	0x71, 0xa3, 0xbd, 0x37, 0xb0, 0x39, 0x1e, 0x92, 0x6e, 0x8a, 0x6b, 0x3a, 0xb4, 0xa5, 0xad, 0x1f,
-	0xd1, 0x51, 0x71, 0x70, 0x56, 0xa5, 0x28, 0xef, 0x0c, 0x3b, 0x53, 0x3f, 0x5b, 0xfe, 0x4d, 0x0d,
-	0x0f, 0x00, 0xce, 0xbb, 0x2f, 0x5d, 0xfa, 0x97, 0x94, 0x4a, 0x85, 0x1e, 0x43, 0x7d, 0x10, 0x31,
-	0x5b, 0x0c, 0xe5, 0xb9, 0xa1, 0x35, 0xb5, 0x02, 0xfa, 0x1c, 0xee, 0x88, 0x2c, 0x53, 0xd6, 0xd9,
-	0xe3, 0xf7, 0xcb, 0xab, 0x9b, 0x9b, 0xe1, 0x53, 0xb8, 0xdb, 0x65, 0x41, 0x42, 0x94, 0xb9, 0xba,
-	0x6e, 0xe7, 0xdd, 0x19, 0xf7, 0xbe, 0x7e, 0x83, 0xfa, 0x8f, 0x1a, 0xac, 0x75, 0xde, 0x52, 0x2f,
-	0x47, 0x7c, 0x08, 0xe0, 0x8b, 0x88, 0x30, 0xfe, 0x8a, 0x44, 0xd4, 0xe6, 0xaa, 0xb0, 0xa2, 0x91,
-	0xda, 0x22, 0x8a, 0x08, 0xf7, 0xf3, 0x69, 0x64, 0x45, 0x7d, 0x0d, 0xfc, 0x3e, 0x09, 0xf2, 0xaa,
-	0x34, 0xcf, 0xe8, 0x31, 0x6c, 0x2a, 0x16, 0x51, 0x91, 0xaa, 0x3e, 0xf5, 0x04, 0xf7, 0xa5, 0x29,
-	0xc6, 0x15, 0x77, 0x62, 0x15, 0x6f, 0xc2, 0x7a, 0x27, 0x8a, 0xd5, 0xd0, 0x46, 0x81, 0x7f, 0x07,
-	0x4d, 0xb7, 0x70, 0xcd, 0xca, 0xd4, 0xf3, 0xa8, 0x94, 0xb6, 0xf9, 0x73, 0x51, 0xef, 0x44, 0x54,
-	0x4a, 0x12, 0xe4, 0x23, 0x29, 0x17, 0xf1, 0xf7, 0xb0, 0x79, 0x6c, 0x62, 0x9e, 0xf7, 0x8e, 0xdf,
-	0x85, 0xd5, 0xec, 0xe5, 0xad, 0x07, 0x2b, 0x61, 0x0e, 0x5b, 0x99, 0x03, 0xd3, 0xa6, 0xf3, 0x7a,
-	0xd9, 0x87, 0x35, 0xff, 0x06, 0x2d, 0x9f, 0xaf, 0x85, 0x25, 0xfc, 0x16, 0xee, 0x9d, 0xe8, 0xcc,
-	0x98, 0x62, 0x9c, 0xd3, 0xdb, 0x47, 0x70, 0x2f, 0x98, 0xc4, 0xb2, 0x3e, 0xcb, 0x1b, 0xf8, 0x9f,
-	0x35, 0xd8, 0x31, 0xae, 0xcf, 0x24, 0x4d, 0xbe, 0x66, 0x52, 0xcd, 0xeb, 0xfe, 0x19, 0xec, 0x04,
-	0x55, 0x78, 0x36, 0x84, 0xea, 0x4d, 0xfc, 0x9f, 0x1a, 0x38, 0x26, 0x0c, 0x7d, 0xdd, 0xc8, 0xa1,
-	0x54, 0x34, 0x9a, 0x3b, 0xed, 0x9f, 0x81, 0x13, 0x4c, 0x81, 0xb4, 0xc1, 0x4c, 0xdd, 0xc7, 0x43,
-	0x58, 0xcf, 0xda, 0x66, 0xbe, 0x10, 0xf6, 0xa0, 0x49, 0xdf, 0x32, 0xd5, 0x16, 0x7e, 0xe6, 0x72,
-	0xc5, 0x1d, 0xc9, 0xba, 0xf6, 0xa4, 0xf2, 0x5f, 0xa7, 0xca, 0xde, 0xee, 0x56, 0xc2, 0xdf, 0xc2,
-	0x5d, 0x93, 0x89, 0x9e, 0xe6, 0x30, 0xef, 0xd9, 0xb6, 0xe5, 0x46, 0x5c, 0xae, 0x6c, 0xc4, 0x2f,
-	0x6d, 0x9d, 0x65, 0xd8, 0x73, 0xbd, 0x1b, 0x16, 0xb0, 0xa1, 0xef, 0xdb, 0x1f, 0xe8, 0x6d, 0xa7,
-	0xd5, 0xa7, 0xb0, 0x9b, 0xf2, 0x4b, 0x63, 0x7a, 0x5a, 0x15, 0xf4, 0x94, 0x5d, 0xfc, 0x06, 0xee,
-	0x65, 0xe4, 0xf1, 0x38, 0x8d, 0xe2, 0xdb, 0x3a, 0xdd, 0x83, 0xa6, 0x9f, 0x46, 0x71, 0x8f, 0xa8,
-	0x2b, 0x7b, 0xf8, 0x23, 0x19, 0x5f, 0xc0, 0x07, 0xfd, 0xce, 0xf9, 0x22, 0x7a, 0x4f, 0x0f, 0x33,
-	0x3a, 0x30, 0xd7, 0xab, 0x1d, 0xc4, 0x56, 0xc4, 0x7f, 0xaf, 0xc1, 0xfd, 0xaf, 0xcd, 0xdf, 0x99,
-	0x2e, 0x25, 0x32, 0x4d, 0x68, 0x44, 0xb9, 0x5a, 0x40, 0xab, 0x87, 0x93, 0x98, 0xd6, 0x71, 0x79,
-	0x03, 0x7f, 0x07, 0xf7, 0x5f, 0xf2, 0x3f, 0x53, 0x4f, 0x65, 0x71, 0xf4, 0xa9, 0x97, 0x50, 0xb5,
-	0xb0, 0xab, 0xe6, 0xe9, 0x7f, 0xb7, 0xa0, 0xde, 0x8e, 0x7c, 0xf4, 0x0a, 0x50, 0x7f, 0xc8, 0xbd,
-	0xf1, 0xeb, 0x0e, 0xfd, 0xb4, 0x12, 0x32, 0x73, 0xbe, 0x37, 0xfd, 0x65, 0xf1, 0x12, 0x7a, 0x0d,
-	0x5b, 0x3d, 0x92, 0x4a, 0xba, 0x30, 0xc0, 0x6f, 0x60, 0xe7, 0x8c, 0xc7, 0x0b, 0x85, 0xec, 0xc3,
-	0x76, 0xd6, 0x0b, 0x13, 0x88, 0x65, 0x52, 0x33, 0xd6, 0x32, 0xef, 0x06, 0x75, 0x61, 0xf7, 0xcc,
-	0x76, 0xc2, 0xc2, 0x02, 0x3d, 0x05, 0xa7, 0x2f, 0x2e, 0x95, 0x4b, 0x2f, 0x84, 0x50, 0x0b, 0x43,
-	0x75, 0x61, 0xb7, 0x7f, 0x95, 0x2a, 0x5f, 0xfc, 0x95, 0x2f, 0x0c, 0xf3, 0x15, 0xa0, 0xaf, 0x58,
-	0x18, 0x2e, 0x0c, 0xaf, 0x07, 0xdb, 0xc7, 0x34, 0xa4, 0x6a, 0x71, 0xb9, 0x7c, 0x03, 0x3b, 0x19,
-	0x63, 0x9b, 0x84, 0xfc, 0x79, 0xf9, 0x4f, 0xef, 0x04, 0xb3, 0x9b, 0x59, 0xf1, 0xba, 0x83, 0x46,
-	0x46, 0xa7, 0x24, 0x09, 0xa8, 0x9a, 0x23, 0xd2, 0x3f, 0xc0, 0x83, 0xb6, 0xfe, 0x23, 0x3c, 0x91,
-	0xcd, 0x91, 0x83, 0x39, 0x8f, 0x9e, 0x05, 0x9c, 0x84, 0x59, 0x90, 0x3d, 0xe1, 0xb7, 0x43, 0x4a,
-	0x78, 0x1a, 0xcf, 0x81, 0xf9, 0x47, 0x78, 0xf4, 0x82, 0x71, 0x12, 0xb2, 0xc9, 0xc2, 0x5f, 0x44,
-	0xc0, 0xaf, 0x00, 0x7d, 0x21, 0x54, 0x1c, 0xa6, 0xc1, 0x17, 0x42, 0xaa, 0x63, 0x3a, 0x60, 0x1e,
-	0x95, 0x73, 0xe0, 0x75, 0xa1, 0x75, 0x42, 0x55, 0xc6, 0x16, 0xd1, 0x83, 0x92, 0x66, 0x91, 0xf7,
-	0xee, 0x3d, 0x2a, 0xff, 0x03, 0x19, 0xa3, 0xb1, 0xa6, 0xa8, 0x36, 0x47, 0x70, 0x86, 0x1b, 0xce,
-	0xc2, 0xfc, 0xc5, 0x14, 0xcc, 0x31, 0xe6, 0x6a, 0x46, 0xd4, 0xfa, 0x09, 0x55, 0x23, 0x96, 0x39,
-	0x0b, 0x16, 0x97, 0xb6, 0x4b, 0x04, 0xd5, 0x80, 0x36, 0x4f, 0xa8, 0x61, 0x73, 0x33, 0xe3, 0x7c,
-	0x5c, 0x0d, 0x58, 0x62, 0x82, 0x4b, 0xe8, 0x4f, 0x26, 0x05, 0x05, 0x56, 0x36, 0x0b, 0xfa, 0xc3,
-	0x6a, 0xe8, 0x2a, 0x5e, 0xb7, 0x84, 0x9e, 0x43, 0x43, 0xb3, 0x9f, 0x59, 0x98, 0xef, 0x3c, 0xf3,
-	0x0e, 0x34, 0x34, 0x3b, 0x44, 0x3f, 0x2b, 0x63, 0xdc, 0xfc, 0xd7, 0xda, 0x7b, 0x30, 0x65, 0xb7,
-	0x30, 0x8c, 0x5b, 0x23, 0x36, 0x56, 0x31, 0x34, 0x26, 0x59, 0xe0, 0xb4, 0x33, 0x29, 0x92, 0x39,
-	0xd3, 0x3d, 0xce, 0x44, 0xd7, 0x8c, 0x48, 0x13, 0xc2, 0x53, 0x3e, 0xc7, 0x15, 0x18, 0xd5, 0xac,
-	0x99, 0xa7, 0xcf, 0xa6, 0xf0, 0x95, 0xf5, 0xf6, 0xe5, 0x59, 0xf1, 0x89, 0xd6, 0xce, 0x91, 0x12,
-	0x6b, 0x68, 0xf7, 0xce, 0xe4, 0x5c, 0xcc, 0x01, 0x4e, 0xa8, 0xb2, 0xd4, 0x6e, 0x56, 0xa0, 0xfb,
-	0xe5, 0x2f, 0x3d, 0xe3, 0x9c, 0x10, 0x2f, 0x21, 0x02, 0xdb, 0x27, 0x54, 0x95, 0x68, 0xdc, 0xbb,
-	0x43, 0xfc, 0x65, 0x69, 0x73, 0x2a, 0x0f, 0xc4, 0x4b, 0xe8, 0x3b, 0x40, 0x65, 0x92, 0x86, 0xca,
-	0x18, 0x53, 0x99, 0xdc, 0x3b, 0x53, 0xf2, 0xbc, 0xf1, 0xed, 0xf2, 0xe0, 0xc9, 0xc5, 0xaa, 0xf9,
-	0x80, 0xfe, 0xeb, 0x1f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x62, 0x62, 0x8a, 0x89, 0x6d, 0x17, 0x00,
-	0x00,
+	// 1801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xff, 0x6f, 0x1b, 0xb7,
+	0x15, 0xb7, 0x2c, 0xd9, 0x91, 0x9f, 0xbf, 0x34, 0x61, 0x6c, 0xf7, 0xac, 0x2d, 0x89, 0x47, 0x6c,
+	0x81, 0x5b, 0xb4, 0xf6, 0x92, 0xa5, 0xc5, 0x50, 0x0c, 0x43, 0x63, 0xd9, 0x71, 0xdd, 0x5a, 0x89,
+	0x72, 0xb2, 0x9d, 0xad, 0x5b, 0x51, 0xd0, 0x77, 0x94, 0xcc, 0xf9, 0x8e, 0xd4, 0x8e, 0x3c, 0x2d,
+	0x0a, 0x30, 0x60, 0x40, 0x87, 0xfd, 0x30, 0x60, 0xff, 0xdb, 0x7e, 0xdb, 0x1f, 0xb3, 0x5f, 0x0a,
+	0xf2, 0x78, 0xf2, 0x49, 0x77, 0xb2, 0xe2, 0x4a, 0x3f, 0x99, 0x8f, 0xef, 0xbd, 0x0f, 0x1f, 0x1f,
+	0x1f, 0x1f, 0x3f, 0x3a, 0xc3, 0x47, 0xdd, 0xab, 0xce, 0xde, 0x25, 0xe1, 0x7e, 0x40, 0xa3, 0x4f,
+	0x03, 0x12, 0x73, 0xef, 0x92, 0x46, 0x9f, 0x7a, 0x22, 0xdc, 0xf3, 0x42, 0x7f, 0xaf, 0xf7, 0x44,
+	0xff, 0xd9, 0xed, 0x46, 0x42, 0x09, 0xf4, 0xc1, 0x55, 0x7c, 0x41, 0x7b, 0x2c, 0x52, 0xbb, 0x7a,
+	0xae, 0xf7, 0x04, 0xb7, 0xe1, 0xfe, 0x6b, 0x1a, 0xc6, 0xe7, 0x34, 0x92, 0x4c, 0x70, 0x97, 0xca,
+	0xae, 0xe0, 0x92, 0xa2, 0xcf, 0xa0, 0x1a, 0xd9, 0xb1, 0x53, 0xda, 0x2e, 0xed, 0x2c, 0x3f, 0xdd,
+	0xda, 0x1d, 0x71, 0xdd, 0x4d, 0x8d, 0xdd, 0x81, 0x29, 0x72, 0xe0, 0x4e, 0x2f, 0x41, 0x72, 0xe6,
+	0xb7, 0x4b, 0x3b, 0x4b, 0x6e, 0x2a, 0xe2, 0x47, 0x50, 0x3e, 0x6f, 0x1c, 0x1b, 0x83, 0x90, 0x7d,
+	0x2d, 0x05, 0x37, 0xb0, 0x2b, 0x6e, 0x2a, 0xe2, 0x27, 0x50, 0xae, 0x37, 0xcf, 0xd0, 0x1a, 0xcc,
+	0x33, 0xdf, 0xe8, 0x56, 0xdd, 0x79, 0xe6, 0xa3, 0x1a, 0x54, 0x25, 0xbb, 0x08, 0x18, 0xef, 0x48,
+	0x67, 0x7e, 0xbb, 0xbc, 0xb3, 0xea, 0x0e, 0x64, 0xbc, 0x07, 0x77, 0x5a, 0xc9, 0x38, 0xe7, 0xb6,
+	0x0e, 0x0b, 0x3d, 0x12, 0xc4, 0xd4, 0x84, 0x51, 0x71, 0x13, 0x01, 0x1f, 0xc2, 0x42, 0x93, 0x74,
+	0xa8, 0xd4, 0x6a, 0x4f, 0xc4, 0x5c, 0x19, 0x8f, 0x8a, 0x9b, 0x08, 0x08, 0x41, 0x25, 0xe6, 0x4c,
+	0xd9, 0xd0, 0xcd, 0x58, 0xcf, 0x49, 0xf6, 0x8e, 0x3a, 0x65, 0x03, 0x6d, 0xc6, 0xf8, 0x19, 0x2c,
+	0x36, 0x68, 0x28, 0xa2, 0x3e, 0xda, 0x84, 0x45, 0x12, 0x66, 0x80, 0xac, 0x54, 0x84, 0x84, 0xff,
+	0x57, 0x82, 0x4a, 0x9d, 0x06, 0x41, 0x2e, 0xd6, 0x3d, 0x58, 0x0c, 0x0d, 0x9c, 0x31, 0x5f, 0x7e,
+	0xfa, 0x61, 0x2e, 0xd3, 0xc9, 0x6a, 0xae, 0x35, 0x43, 0x9f, 0xc0, 0x42, 0x57, 0x6f, 0xc3, 0x29,
+	0x6f, 0x97, 0x77, 0x96, 0x9f, 0x6e, 0xe6, 0xec, 0xcd, 0x26, 0xdd, 0xc4, 0x08, 0x7d, 0x0e, 0x4b,
+	0x3e, 0x93, 0x8a, 0x70, 0x8f, 0x4a, 0xa7, 0x62, 0x3c, 0x9c, 0x9c, 0x87, 0xcd, 0xa3, 0x7b, 0x6d,
+	0x8a, 0x76, 0xa0, 0xe2, 0x75, 0x63, 0xe9, 0x2c, 0x18, 0x97, 0xf5, 0x9c, 0x4b, 0xbd, 0x79, 0xe6,
+	0x1a, 0x0b, 0xfc, 0x25, 0x54, 0x4f, 0x45, 0x57, 0x04, 0xa2, 0xd3, 0x47, 0xcf, 0x00, 0x78, 0x1c,
+	0x92, 0xef, 0x3d, 0x1a, 0x04, 0xd2, 0x29, 0x19, 0xdf, 0x8d, 0xbc, 0x2f, 0x0d, 0x02, 0x77, 0x49,
+	0x1b, 0xea, 0x91, 0xc4, 0xff, 0x2e, 0xc1, 0x62, 0xab, 0xb1, 0xcf, 0x84, 0x44, 0x18, 0x56, 0x42,
+	0xc2, 0xe3, 0x36, 0xf1, 0x54, 0x1c, 0xd1, 0xc8, 0xe4, 0x69, 0xc9, 0x1d, 0x9a, 0xd3, 0x55, 0xd4,
+	0x8d, 0x84, 0x1f, 0x7b, 0x69, 0x86, 0x53, 0x31, 0x5b, 0x80, 0xe5, 0xa1, 0x02, 0x44, 0x77, 0xa1,
+	0x2c, 0xaf, 0x62, 0xa7, 0x62, 0x66, 0xf5, 0x50, 0x1f, 0x5e, 0x9b, 0x84, 0x2c, 0xe8, 0x3b, 0x0b,
+	0x66, 0xd2, 0x4a, 0xf8, 0x5f, 0x25, 0xa8, 0x1e, 0x30, 0x79, 0x75, 0xcc, 0xdb, 0xc2, 0x18, 0x89,
+	0x28, 0x24, 0xca, 0x06, 0x62, 0x25, 0xb4, 0x0d, 0xcb, 0x17, 0xc4, 0xbb, 0x62, 0xbc, 0xf3, 0x82,
+	0x05, 0xd4, 0x86, 0x91, 0x9d, 0x42, 0x0f, 0x01, 0x74, 0xbc, 0x24, 0x68, 0xa5, 0xf5, 0x53, 0x71,
+	0x33, 0x33, 0x1a, 0x41, 0xa7, 0x24, 0x35, 0xa8, 0x18, 0x83, 0xec, 0x14, 0xfe, 0x3b, 0xac, 0xd6,
+	0x83, 0x58, 0x2a, 0x1a, 0xd5, 0x05, 0x6f, 0xb3, 0x0e, 0xda, 0x05, 0x74, 0xf8, 0xb6, 0x4b, 0xb8,
+	0xaf, 0xc3, 0x93, 0x87, 0x9c, 0x5c, 0x04, 0x34, 0xa9, 0xa4, 0xaa, 0x5b, 0xa0, 0x41, 0xbf, 0x83,
+	0xad, 0x17, 0x11, 0xa5, 0xba, 0x1c, 0x5c, 0xda, 0x15, 0x91, 0x62, 0xbc, 0x73, 0xc0, 0x64, 0xe2,
+	0x36, 0x6f, 0xdc, 0xc6, 0x1b, 0xe0, 0xff, 0x2f, 0xc0, 0xc6, 0x79, 0x12, 0x4e, 0x83, 0x78, 0x97,
+	0x8c, 0xd3, 0x57, 0x5d, 0xc5, 0x04, 0x97, 0xe8, 0x1b, 0x58, 0x1f, 0x56, 0x24, 0x67, 0xe7, 0x94,
+	0xc6, 0xd4, 0x6f, 0xa2, 0x76, 0x0b, 0x9d, 0xd0, 0x33, 0xd8, 0x68, 0xd0, 0x70, 0x9f, 0x04, 0x81,
+	0x10, 0xbc, 0xa5, 0x88, 0x92, 0x4d, 0x1a, 0x31, 0x91, 0x04, 0xb8, 0xea, 0x16, 0x2b, 0xd1, 0xaf,
+	0xe1, 0x7e, 0x33, 0xa2, 0x7a, 0xde, 0x23, 0x8a, 0xfa, 0xe7, 0x22, 0x88, 0x43, 0x7b, 0x23, 0x96,
+	0xdc, 0x22, 0x95, 0x6e, 0x69, 0xca, 0x56, 0xa9, 0x53, 0x19, 0xd3, 0xd2, 0xd2, 0x32, 0x76, 0x07,
+	0xa6, 0xa8, 0x05, 0x4b, 0x26, 0xa7, 0xba, 0x1a, 0xec, 0x5d, 0xf8, 0x2c, 0xe7, 0x57, 0x98, 0xa6,
+	0xdd, 0x81, 0xdf, 0x21, 0x57, 0x51, 0xdf, 0xbd, 0xc6, 0x19, 0x73, 0x90, 0x8b, 0x63, 0x0f, 0xf2,
+	0x00, 0x56, 0xbd, 0x6c, 0x25, 0x38, 0x77, 0xcc, 0x06, 0x1e, 0xe6, 0x2f, 0x56, 0xd6, 0xca, 0x1d,
+	0x76, 0x42, 0x3f, 0x94, 0x60, 0xeb, 0x98, 0x2b, 0x1a, 0xb5, 0x89, 0x47, 0x0f, 0x44, 0x48, 0x18,
+	0x7f, 0xae, 0x14, 0xf1, 0x2e, 0x43, 0xca, 0x95, 0x53, 0x35, 0x7b, 0x3b, 0x7c, 0xcf, 0xbd, 0x8d,
+	0xc5, 0x49, 0xf6, 0x3a, 0x7e, 0x9d, 0xda, 0x1b, 0x58, 0x1b, 0x4e, 0x8c, 0xbe, 0x9a, 0x57, 0xb4,
+	0x6f, 0x2f, 0x98, 0x1e, 0xa2, 0xbd, 0x6c, 0xfb, 0x2e, 0x3a, 0xa8, 0xf4, 0x7e, 0xda, 0xce, 0xfe,
+	0xc5, 0xfc, 0x6f, 0x4b, 0xb5, 0x13, 0x78, 0x78, 0x73, 0x54, 0x05, 0x0b, 0x0d, 0xbd, 0x13, 0x4b,
+	0x19, 0x34, 0xdc, 0x03, 0x38, 0x6f, 0x1c, 0xbb, 0xf4, 0xaf, 0x31, 0x95, 0x0a, 0x3d, 0x86, 0x72,
+	0x2f, 0x64, 0xb6, 0xc0, 0xf3, 0xbd, 0x50, 0x5b, 0x6a, 0x03, 0xf4, 0x25, 0xdc, 0x11, 0x49, 0x86,
+	0x6c, 0xe8, 0x8f, 0xdf, 0x2f, 0x9f, 0x6e, 0xea, 0x86, 0x4f, 0xe1, 0x6e, 0x83, 0x75, 0x22, 0xa2,
+	0xcc, 0x73, 0x7c, 0xbb, 0xd5, 0x9d, 0xe1, 0xd5, 0x57, 0xae, 0x51, 0x7f, 0x28, 0xc1, 0xf2, 0xe1,
+	0x5b, 0xea, 0xa5, 0x88, 0x0f, 0x01, 0x7c, 0x93, 0xa2, 0x97, 0x24, 0xa4, 0x36, 0x21, 0x99, 0x19,
+	0x8d, 0x54, 0x17, 0x61, 0x48, 0xb8, 0x9f, 0x76, 0x58, 0x2b, 0xea, 0xa7, 0xed, 0x79, 0xd4, 0x49,
+	0x6f, 0x9a, 0x19, 0xa3, 0xc7, 0xb0, 0xa6, 0x58, 0x48, 0x45, 0xac, 0x5a, 0xd4, 0x13, 0xdc, 0x97,
+	0xe6, 0x82, 0x2d, 0xb8, 0x23, 0xb3, 0x78, 0x0d, 0x56, 0x0e, 0xc3, 0xae, 0xea, 0xdb, 0x28, 0xf0,
+	0xef, 0xa1, 0xea, 0x66, 0xa8, 0x83, 0x8c, 0x3d, 0x8f, 0x4a, 0x69, 0x1b, 0x5a, 0x2a, 0x6a, 0x4d,
+	0x48, 0xa5, 0x24, 0x9d, 0xf4, 0x94, 0x52, 0x11, 0x7f, 0x0f, 0x6b, 0xc9, 0x41, 0x4f, 0xcb, 0x5b,
+	0x36, 0x61, 0x31, 0xd9, 0xbc, 0x5d, 0xc1, 0x4a, 0x98, 0xc3, 0xfd, 0x64, 0x01, 0xd3, 0x7a, 0xa6,
+	0x5d, 0x65, 0x1b, 0x96, 0xfd, 0x6b, 0xb4, 0xf4, 0xcd, 0xc8, 0x4c, 0xe1, 0xb7, 0x70, 0xef, 0x48,
+	0x67, 0xc6, 0x94, 0xf6, 0x94, 0xab, 0x7d, 0x02, 0xf7, 0x3a, 0xa3, 0x58, 0x76, 0xcd, 0xbc, 0x02,
+	0xff, 0xb3, 0x04, 0x1b, 0x66, 0xe9, 0x33, 0x49, 0xa3, 0x13, 0x26, 0xd5, 0xb4, 0xcb, 0x3f, 0x83,
+	0x8d, 0x4e, 0x11, 0x9e, 0x0d, 0xa1, 0x58, 0x89, 0xff, 0x53, 0x02, 0xc7, 0x84, 0xa1, 0x9f, 0x50,
+	0xd9, 0x97, 0x8a, 0x86, 0x53, 0xa7, 0xfd, 0x0b, 0x70, 0x3a, 0x63, 0x20, 0x6d, 0x30, 0x63, 0xf5,
+	0xb8, 0x0f, 0x2b, 0xc9, 0xb5, 0x99, 0x2e, 0x84, 0x1a, 0x54, 0xe9, 0x5b, 0xa6, 0xea, 0xc2, 0x4f,
+	0x96, 0x5c, 0x70, 0x07, 0xb2, 0xae, 0x3d, 0xa9, 0xfc, 0x57, 0xb1, 0xb2, 0x8c, 0xc5, 0x4a, 0xf8,
+	0x5b, 0xb8, 0x6b, 0x32, 0xd1, 0xd4, 0xbc, 0xec, 0x3d, 0xaf, 0x6d, 0xfe, 0x22, 0xce, 0x17, 0x5e,
+	0xc4, 0xaf, 0xe1, 0x5e, 0x06, 0x7b, 0xaa, 0xbd, 0x61, 0x01, 0xab, 0x9a, 0x43, 0xbc, 0xa3, 0xb7,
+	0xed, 0x56, 0x9f, 0xc3, 0x66, 0xcc, 0xdb, 0xc6, 0xf5, 0xb4, 0x28, 0xe8, 0x31, 0x5a, 0xfc, 0x06,
+	0xee, 0x25, 0x84, 0xf8, 0x20, 0x0e, 0xbb, 0xb7, 0x5d, 0xb4, 0x06, 0x55, 0x3f, 0x0e, 0xbb, 0x4d,
+	0xa2, 0x2e, 0xed, 0xe1, 0x0f, 0x64, 0x7c, 0x01, 0x1f, 0xb4, 0x0e, 0xcf, 0x67, 0x71, 0xf7, 0x74,
+	0x33, 0xa3, 0x3d, 0x43, 0x19, 0x6c, 0x23, 0xb6, 0x22, 0xfe, 0x47, 0x09, 0xb6, 0x4e, 0xcc, 0x4f,
+	0xb4, 0x06, 0x25, 0x32, 0x8e, 0xa8, 0x7e, 0x9d, 0x66, 0x70, 0xd5, 0x83, 0x51, 0x4c, 0xbb, 0x70,
+	0x5e, 0x81, 0xbf, 0xd3, 0x2c, 0xe0, 0x2f, 0xd4, 0x53, 0x49, 0x1c, 0x2d, 0xea, 0x45, 0x54, 0xcd,
+	0xee, 0xa9, 0x79, 0x0d, 0xab, 0xfb, 0xc4, 0xbb, 0x8a, 0xbb, 0xb3, 0x83, 0xfc, 0x03, 0x38, 0xcf,
+	0x95, 0xa2, 0x52, 0xd9, 0x57, 0x51, 0x33, 0xd5, 0xdb, 0xa2, 0xaf, 0xc3, 0x02, 0x17, 0xdc, 0x1b,
+	0xbc, 0xf4, 0x46, 0x30, 0xc7, 0x51, 0x00, 0x3d, 0xf5, 0x71, 0x90, 0x51, 0xcc, 0xf4, 0x38, 0x72,
+	0x8a, 0xa7, 0xff, 0xfd, 0x10, 0xca, 0xf5, 0xd0, 0x47, 0x2f, 0x01, 0xb5, 0xfa, 0xdc, 0x1b, 0xa6,
+	0x07, 0xe8, 0x67, 0x85, 0x3b, 0x4a, 0xf6, 0x5e, 0x1b, 0x1f, 0x0d, 0x9e, 0x43, 0xaf, 0xe0, 0x7e,
+	0x93, 0xc4, 0x92, 0xce, 0x0c, 0xf0, 0x35, 0x6c, 0x9c, 0xf1, 0xee, 0x4c, 0x21, 0x5b, 0xb0, 0x9e,
+	0xf4, 0x8e, 0x11, 0xc4, 0x3c, 0xb1, 0x1d, 0x6a, 0x31, 0x37, 0x83, 0xba, 0xb0, 0x79, 0xc6, 0xdb,
+	0x45, 0xb0, 0x3f, 0x3d, 0xd0, 0x53, 0x70, 0x5a, 0xa2, 0xad, 0x5c, 0x7a, 0x21, 0x84, 0x9a, 0x19,
+	0xaa, 0x0b, 0x9b, 0xad, 0xcb, 0x58, 0xf9, 0xe2, 0x6f, 0x7c, 0x66, 0x98, 0x2f, 0x01, 0x7d, 0xc3,
+	0x82, 0x60, 0x66, 0x78, 0x4d, 0x58, 0x3f, 0xa0, 0x01, 0x55, 0xb3, 0xcb, 0xe5, 0x1b, 0xd8, 0x48,
+	0x18, 0xee, 0x28, 0xe4, 0x2f, 0x72, 0x5e, 0xa3, 0x4c, 0x78, 0x62, 0xc5, 0xeb, 0x1b, 0x34, 0x70,
+	0x3a, 0x25, 0x51, 0x87, 0xaa, 0x29, 0x22, 0xfd, 0x23, 0x3c, 0xa8, 0xeb, 0x8f, 0x21, 0x23, 0xd9,
+	0x1c, 0x2c, 0x30, 0xe5, 0xd1, 0xb3, 0x0e, 0x27, 0x41, 0x12, 0x64, 0x53, 0xf8, 0xf5, 0x80, 0x12,
+	0x1e, 0x77, 0xa7, 0xc0, 0xfc, 0x13, 0x3c, 0x7a, 0xc1, 0x38, 0x09, 0xd8, 0x3b, 0x3a, 0xfb, 0x80,
+	0x5f, 0x02, 0xfa, 0x4a, 0xa8, 0x6e, 0x10, 0x77, 0xbe, 0x12, 0x52, 0x1d, 0xd0, 0x1e, 0xf3, 0xa8,
+	0x9c, 0x02, 0xaf, 0x01, 0x4b, 0x47, 0x54, 0x25, 0xec, 0x1a, 0x3d, 0xc8, 0x59, 0x66, 0x7f, 0x27,
+	0xd4, 0x1e, 0xe5, 0x7f, 0xff, 0x0d, 0xd1, 0x7e, 0x53, 0x54, 0x6b, 0x03, 0x38, 0xc3, 0xa5, 0x27,
+	0x61, 0xfe, 0x72, 0x0c, 0xe6, 0x10, 0xd3, 0x37, 0x2d, 0x6a, 0xe5, 0x88, 0xaa, 0x01, 0x2b, 0x9f,
+	0x04, 0x8b, 0x73, 0xea, 0x1c, 0xa1, 0x37, 0xa0, 0xd5, 0x23, 0x6a, 0xd8, 0xef, 0xc4, 0x38, 0x1f,
+	0x17, 0x03, 0xe6, 0x98, 0xf3, 0x1c, 0xfa, 0xb3, 0x49, 0x41, 0x86, 0xc5, 0x4e, 0x82, 0xfe, 0xa8,
+	0x18, 0xba, 0x88, 0x07, 0xcf, 0xa1, 0x7d, 0xa8, 0x68, 0xb6, 0x38, 0x09, 0xf3, 0xc6, 0x33, 0x3f,
+	0x84, 0x8a, 0x66, 0xd3, 0xe8, 0xe7, 0x79, 0x8c, 0xeb, 0xdf, 0xa6, 0xb5, 0x07, 0x63, 0xb4, 0x99,
+	0x66, 0xbc, 0x34, 0x60, 0xaf, 0x05, 0x4d, 0x63, 0x94, 0x35, 0xd7, 0xf0, 0x4d, 0x26, 0x99, 0xdb,
+	0xe3, 0x8c, 0xdc, 0x9a, 0x01, 0xc9, 0x44, 0x78, 0xcc, 0x27, 0xd9, 0x0c, 0x03, 0x9d, 0xd4, 0xf3,
+	0xf4, 0xd9, 0x64, 0xbe, 0xb4, 0xdf, 0xbe, 0x3c, 0x0b, 0x3e, 0xd3, 0xdb, 0x3e, 0x92, 0x63, 0x0d,
+	0xf5, 0xe6, 0x99, 0x9c, 0x8a, 0x39, 0xc0, 0x11, 0x55, 0x96, 0x0a, 0x4f, 0x0a, 0x74, 0x3b, 0xa7,
+	0x1e, 0xe1, 0xd0, 0x78, 0x0e, 0x11, 0x58, 0x3f, 0xa2, 0x2a, 0x47, 0x7b, 0x6f, 0x0e, 0xf1, 0xe3,
+	0x9c, 0x72, 0x2c, 0x6f, 0xc6, 0x73, 0xe8, 0x3b, 0x40, 0x79, 0x52, 0x8b, 0xf2, 0x18, 0x63, 0x99,
+	0xef, 0x44, 0xa2, 0x92, 0x90, 0xda, 0x89, 0x44, 0x65, 0x88, 0xfb, 0xde, 0x0c, 0x7a, 0x06, 0x5b,
+	0xcf, 0x2f, 0x44, 0x34, 0xc2, 0x27, 0x12, 0x80, 0x29, 0xb9, 0x4a, 0xae, 0x24, 0xec, 0x3f, 0x2c,
+	0x7e, 0x3a, 0xea, 0x39, 0xd4, 0xf2, 0xa8, 0xc7, 0xaf, 0x4e, 0x58, 0xc8, 0xd4, 0x34, 0xc5, 0x26,
+	0x4c, 0x6d, 0xe4, 0x38, 0x38, 0xca, 0x37, 0xa7, 0x71, 0x3f, 0x01, 0x6a, 0x1f, 0xbf, 0x8f, 0x69,
+	0xa6, 0x18, 0x7f, 0x55, 0xb0, 0x91, 0xf4, 0xc3, 0xe1, 0x09, 0xe3, 0x57, 0xfa, 0x09, 0x98, 0xe6,
+	0x6d, 0xdb, 0xaf, 0x7c, 0x3b, 0xdf, 0x7b, 0x72, 0xb1, 0x68, 0xfe, 0xe5, 0xf6, 0x9b, 0x1f, 0x07,
+	0x00, 0x13, 0xd9, 0x08, 0xf2, 0x9f, 0x1b, 0x00, 0x00,
 }
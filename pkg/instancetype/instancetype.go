@@ -998,6 +998,10 @@ func applyMemory(field *k8sfield.Path, instancetypeSpec *instancetypev1beta1.Vir
 		vmiSpec.Domain.Memory.Hugepages = instancetypeSpec.Memory.Hugepages.DeepCopy()
 	}
 
+	if instancetypeSpec.Memory.Balloon != nil {
+		vmiSpec.Domain.Memory.Balloon = instancetypeSpec.Memory.Balloon.DeepCopy()
+	}
+
 	return nil
 }
 
@@ -1130,6 +1130,19 @@ var _ = Describe("Instancetype and Preferences", func() {
 				Expect(memRequest.Value()).To(Equal(expectedOverhead))
 			})
 
+			It("should apply the memory balloon policy to VMI", func() {
+				instancetypeSpec.Memory.Hugepages = nil
+				minGuest := resource.MustParse("256M")
+				instancetypeSpec.Memory.Balloon = &v1.MemoryBalloon{
+					FreeMemoryPercent: pointer.Uint32(10),
+					MinGuest:          &minGuest,
+				}
+
+				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)
+				Expect(conflicts).To(BeEmpty())
+				Expect(vmi.Spec.Domain.Memory.Balloon).To(Equal(instancetypeSpec.Memory.Balloon))
+			})
+
 			It("should detect memory conflict", func() {
 				vmiMemGuest := resource.MustParse("512M")
 				vmi.Spec.Domain.Memory = &v1.Memory{
@@ -66,6 +66,24 @@ var (
 		nil,
 	)
 
+	vmiWatchdogFiredDesc = prometheus.NewDesc(
+		"kubevirt_vmi_watchdog_fired_total",
+		"Total number of times the guest watchdog of a VirtualMachineInstance fired.",
+		[]string{
+			"node", "namespace", "name", "action",
+		},
+		nil,
+	)
+
+	vmiNetworkFilterDroppedPacketsDesc = prometheus.NewDesc(
+		"kubevirt_vmi_network_filter_dropped_packets_total",
+		"Total number of packets dropped by the filter of a VirtualMachineInstance interface. Where `direction` is either `ingress` or `egress`.",
+		[]string{
+			"node", "namespace", "name", "interface", "direction",
+		},
+		nil,
+	)
+
 	instancetypeVendorLabel = "instancetype.kubevirt.io/vendor"
 
 	// vendors whose instance types are whitelisted for telemetry
@@ -287,5 +305,45 @@ func (co *VMICollector) updateVMIMetrics(vmis []*k6tv1.VirtualMachineInstance, c
 			continue
 		}
 		ch <- mv
+
+		if vmi.Status.WatchdogStatus != nil {
+			mv, err = prometheus.NewConstMetric(
+				vmiWatchdogFiredDesc, prometheus.CounterValue,
+				float64(vmi.Status.WatchdogStatus.FiredCount),
+				vmi.Status.NodeName, vmi.Namespace, vmi.Name, string(vmi.Status.WatchdogStatus.Action),
+			)
+			if err != nil {
+				continue
+			}
+			ch <- mv
+		}
+
+		co.updateVMINetworkFilterMetrics(vmi, ch)
+	}
+}
+
+func (co *VMICollector) updateVMINetworkFilterMetrics(vmi *k6tv1.VirtualMachineInstance, ch chan<- prometheus.Metric) {
+	for _, iface := range vmi.Status.Interfaces {
+		if iface.FilterDroppedPackets == nil {
+			continue
+		}
+		directions := []struct {
+			name    string
+			dropped int64
+		}{
+			{"ingress", iface.FilterDroppedPackets.Ingress},
+			{"egress", iface.FilterDroppedPackets.Egress},
+		}
+		for _, d := range directions {
+			mv, err := prometheus.NewConstMetric(
+				vmiNetworkFilterDroppedPacketsDesc, prometheus.CounterValue,
+				float64(d.dropped),
+				vmi.Status.NodeName, vmi.Namespace, vmi.Name, iface.Name, d.name,
+			)
+			if err != nil {
+				continue
+			}
+			ch <- mv
+		}
 	}
 }
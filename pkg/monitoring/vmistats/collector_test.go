@@ -72,6 +72,80 @@ var _ = Describe("VMI Stats Collector", func() {
 			Entry("VMI Eviction policy is not set and vm migratable status is not known", nil, k8sv1.ConditionUnknown, 0.0),
 		)
 	})
+
+	Context("VMI watchdog", func() {
+		It("should report how many times the watchdog fired", func() {
+			vmiInformer, _ := testutils.NewFakeInformerFor(&k6tv1.VirtualMachineInstance{})
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&k6tv1.KubeVirt{})
+			collector := &VMICollector{
+				vmiInformer:   vmiInformer,
+				clusterConfig: clusterConfig,
+			}
+
+			ch := make(chan prometheus.Metric, 2)
+			defer close(ch)
+
+			vmis := createVMISForEviction(nil, k8sv1.ConditionTrue)
+			vmis[0].Status.WatchdogStatus = &k6tv1.VirtualMachineInstanceWatchdogStatus{
+				FiredCount: 3,
+				Action:     k6tv1.WatchdogActionReset,
+			}
+			collector.updateVMIMetrics(vmis, ch)
+
+			<-ch
+			result := <-ch
+			dto := &io_prometheus_client.Metric{}
+			result.Write(dto)
+
+			Expect(result.Desc().String()).To(ContainSubstring("kubevirt_vmi_watchdog_fired_total"))
+			Expect(dto.Counter.GetValue()).To(BeEquivalentTo(3))
+			labels := map[string]string{}
+			for _, label := range dto.Label {
+				labels[label.GetName()] = label.GetValue()
+			}
+			Expect(labels).To(HaveKeyWithValue("action", "reset"))
+		})
+	})
+
+	Context("VMI network filter", func() {
+		It("should report the packets dropped by the interface filter", func() {
+			vmiInformer, _ := testutils.NewFakeInformerFor(&k6tv1.VirtualMachineInstance{})
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&k6tv1.KubeVirt{})
+			collector := &VMICollector{
+				vmiInformer:   vmiInformer,
+				clusterConfig: clusterConfig,
+			}
+
+			ch := make(chan prometheus.Metric, 3)
+			defer close(ch)
+
+			vmis := createVMISForEviction(nil, k8sv1.ConditionTrue)
+			vmis[0].Status.Interfaces = []k6tv1.VirtualMachineInstanceNetworkInterface{{
+				Name:                 "default",
+				FilterDroppedPackets: &k6tv1.InterfaceFilterDroppedPackets{Ingress: 5, Egress: 2},
+			}}
+			collector.updateVMIMetrics(vmis, ch)
+
+			<-ch
+			for _, expected := range []struct {
+				direction string
+				dropped   int
+			}{{"ingress", 5}, {"egress", 2}} {
+				result := <-ch
+				dto := &io_prometheus_client.Metric{}
+				result.Write(dto)
+
+				Expect(result.Desc().String()).To(ContainSubstring("kubevirt_vmi_network_filter_dropped_packets_total"))
+				Expect(dto.Counter.GetValue()).To(BeEquivalentTo(expected.dropped))
+				labels := map[string]string{}
+				for _, label := range dto.Label {
+					labels[label.GetName()] = label.GetValue()
+				}
+				Expect(labels).To(HaveKeyWithValue("interface", "default"))
+				Expect(labels).To(HaveKeyWithValue("direction", expected.direction))
+			}
+		})
+	})
 })
 
 func createVMISForEviction(evictionStrategy *k6tv1.EvictionStrategy, migratableCondStatus k8sv1.ConditionStatus) []*k6tv1.VirtualMachineInstance {
@@ -43,6 +43,8 @@ const (
 	errorSearchDomainNotValid = "Search domain is not valid"
 	errorSearchDomainTooLong  = "Search domains length exceeded allowable size"
 	errorNTPConfiguration     = "Could not parse NTP server as IPv4 address: %s"
+	errorRouteConfiguration   = "Could not parse static route %s via %s as IPv4 route"
+	ipxeUserClass             = "iPXE"
 )
 
 // simple domain validation regex. Put it here to avoid compiling each time.
@@ -78,8 +80,9 @@ func SingleClientDHCPServer(
 		clientIP:      clientIP,
 		clientMAC:     clientMAC,
 		serverIP:      serverIP.To4(),
-		leaseDuration: infiniteLease,
+		leaseDuration: leaseDuration(customDHCPOptions),
 		options:       options,
+		ipxeOptions:   prepareIPXEOptions(options, customDHCPOptions),
 	}
 
 	l, err := NewUDP4FilterListener(serverIface, ":67")
@@ -125,6 +128,10 @@ func prepareDHCPOptions(
 		dhcpOptions[dhcp.OptionRouter] = routerIP.To4()
 	}
 
+	routes, err := appendCustomRoutes(routes, routerIP, customDHCPOptions)
+	if err != nil {
+		return nil, err
+	}
 	netRoutes := formClasslessRoutes(routes)
 
 	if len(netRoutes) != 0 {
@@ -186,15 +193,84 @@ func prepareDHCPOptions(
 	return dhcpOptions, nil
 }
 
+// appendCustomRoutes returns the pod routes extended with the static routes of the DHCP options.
+// Clients ignore the router option when classless routes are offered (RFC 3442), hence a default
+// route via the router is added when the pod routes do not provide one.
+func appendCustomRoutes(routes *[]netlink.Route, routerIP net.IP, customDHCPOptions *v1.DHCPOptions) (*[]netlink.Route, error) {
+	if customDHCPOptions == nil || len(customDHCPOptions.Routes) == 0 {
+		return routes, nil
+	}
+
+	var allRoutes []netlink.Route
+	if routes != nil {
+		allRoutes = append(allRoutes, *routes...)
+	}
+
+	hasDefaultRoute := false
+	for _, route := range allRoutes {
+		if route.Dst == nil {
+			hasDefaultRoute = true
+		}
+	}
+	if !hasDefaultRoute && len(routerIP) != 0 {
+		allRoutes = append(allRoutes, netlink.Route{Gw: routerIP.To4()})
+	}
+
+	for _, route := range customDHCPOptions.Routes {
+		_, dst, err := net.ParseCIDR(route.Destination)
+		gateway := net.ParseIP(route.Gateway).To4()
+		if err != nil || dst.IP.To4() == nil || gateway == nil {
+			return nil, fmt.Errorf(errorRouteConfiguration, route.Destination, route.Gateway)
+		}
+		log.Log.Infof("Setting dhcp static route %s via %s", dst, gateway)
+		allRoutes = append(allRoutes, netlink.Route{Dst: dst, Gw: gateway})
+	}
+
+	return &allRoutes, nil
+}
+
+func leaseDuration(customDHCPOptions *v1.DHCPOptions) time.Duration {
+	if customDHCPOptions != nil && customDHCPOptions.LeaseTime != nil && customDHCPOptions.LeaseTime.Duration > 0 {
+		return customDHCPOptions.LeaseTime.Duration
+	}
+	return infiniteLease
+}
+
+// prepareIPXEOptions returns the options served to iPXE clients, or nil when
+// no dedicated boot file name is configured for them.
+func prepareIPXEOptions(options dhcp.Options, customDHCPOptions *v1.DHCPOptions) dhcp.Options {
+	if customDHCPOptions == nil || customDHCPOptions.IPXEBootFileName == "" {
+		return nil
+	}
+
+	log.Log.Infof("Setting dhcp option boot file name for iPXE clients to %s", customDHCPOptions.IPXEBootFileName)
+	ipxeOptions := dhcp.Options{}
+	for code, value := range options {
+		ipxeOptions[code] = value
+	}
+	ipxeOptions[dhcp.OptionBootFileName] = []byte(customDHCPOptions.IPXEBootFileName)
+	return ipxeOptions
+}
+
 type DHCPHandler struct {
 	serverIP      net.IP
 	clientIP      net.IP
 	clientMAC     net.HardwareAddr
 	leaseDuration time.Duration
 	options       dhcp.Options
+	ipxeOptions   dhcp.Options
+}
+
+// replyOptions returns the options to reply with, matching iPXE clients by their user class.
+func (h *DHCPHandler) replyOptions(requestOptions dhcp.Options) dhcp.Options {
+	if h.ipxeOptions != nil && bytes.Contains(requestOptions[dhcp.OptionUserClass], []byte(ipxeUserClass)) {
+		log.Log.V(4).Info("The request is from an iPXE client")
+		return h.ipxeOptions
+	}
+	return h.options
 }
 
-func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.Options) (d dhcp.Packet) {
+func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, requestOptions dhcp.Options) (d dhcp.Packet) {
 	log.Log.V(4).Info("Serving a new request")
 	if len(h.clientMAC) != 0 {
 		if mac := p.CHAddr(); !bytes.Equal(mac, h.clientMAC) {
@@ -208,12 +284,12 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, _ dhcp.
 	case dhcp.Discover:
 		log.Log.V(4).Info("The request has message type DISCOVER")
 		return dhcp.ReplyPacket(p, dhcp.Offer, h.serverIP, h.clientIP, h.leaseDuration,
-			h.options.SelectOrderOrAll(nil))
+			h.replyOptions(requestOptions).SelectOrderOrAll(nil))
 
 	case dhcp.Request:
 		log.Log.V(4).Info("The request has message type REQUEST")
 		return dhcp.ReplyPacket(p, dhcp.ACK, h.serverIP, h.clientIP, h.leaseDuration,
-			h.options.SelectOrderOrAll(nil))
+			h.replyOptions(requestOptions).SelectOrderOrAll(nil))
 
 	default:
 		log.Log.V(4).Info("The request has unhandled message type")
@@ -21,6 +21,7 @@ package server
 
 import (
 	"net"
+	"time"
 
 	"github.com/krolaw/dhcp4"
 	"github.com/vishvananda/netlink"
@@ -28,6 +29,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	v1 "kubevirt.io/api/core/v1"
 )
 
@@ -219,6 +222,44 @@ var _ = Describe("DHCP Server", func() {
 			Expect(options[dhcp4.OptionRouter]).To(Equal([]byte{192, 168, 2, 1}))
 		})
 
+		It("should append the static routes and a default route via the router", func() {
+			gw := net.ParseIP("10.0.2.1")
+			dhcpOptions := &v1.DHCPOptions{
+				Routes: []v1.DHCPRoute{{Destination: "10.10.0.0/16", Gateway: "10.0.2.254"}},
+			}
+			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", dhcpOptions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options[dhcp4.OptionClasslessRouteFormat]).To(Equal([]byte{
+				16, 10, 10, 10, 0, 2, 254,
+				0, 10, 0, 2, 1,
+			}))
+		})
+
+		It("should keep the default route of the pod routes", func() {
+			gw := net.ParseIP("192.168.2.1")
+			routes := []netlink.Route{{Gw: net.IPv4(192, 168, 2, 1)}}
+			dhcpOptions := &v1.DHCPOptions{
+				Routes: []v1.DHCPRoute{{Destination: "172.16.0.0/12", Gateway: "192.168.2.254"}},
+			}
+			options, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, &routes, nil, 1500, "myhost", dhcpOptions)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(options[dhcp4.OptionClasslessRouteFormat]).To(Equal([]byte{
+				12, 172, 16, 192, 168, 2, 254,
+				0, 192, 168, 2, 1,
+			}))
+		})
+
+		DescribeTable("should reject an invalid static route", func(route v1.DHCPRoute) {
+			gw := net.ParseIP("192.168.2.1")
+			dhcpOptions := &v1.DHCPOptions{Routes: []v1.DHCPRoute{route}}
+			_, err := prepareDHCPOptions(gw.DefaultMask(), gw, nil, nil, nil, 1500, "myhost", dhcpOptions)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("with an invalid destination", v1.DHCPRoute{Destination: "10.10.0.0", Gateway: "192.168.2.254"}),
+			Entry("with an IPv6 destination", v1.DHCPRoute{Destination: "fd10::/64", Gateway: "192.168.2.254"}),
+			Entry("with an invalid gateway", v1.DHCPRoute{Destination: "10.10.0.0/16", Gateway: "fd10::1"}),
+		)
+
 		Context("Options set to invalid value", func() {
 			var (
 				err           error
@@ -249,4 +290,41 @@ var _ = Describe("DHCP Server", func() {
 			})
 		})
 	})
+
+	Context("lease duration", func() {
+		It("should default to an infinite lease", func() {
+			Expect(leaseDuration(nil)).To(Equal(infiniteLease))
+			Expect(leaseDuration(&v1.DHCPOptions{})).To(Equal(infiniteLease))
+		})
+
+		It("should use the configured lease time", func() {
+			dhcpOptions := &v1.DHCPOptions{LeaseTime: &metav1.Duration{Duration: time.Hour}}
+			Expect(leaseDuration(dhcpOptions)).To(Equal(time.Hour))
+		})
+	})
+
+	Context("iPXE clients", func() {
+		var handler *DHCPHandler
+
+		BeforeEach(func() {
+			options := dhcp4.Options{dhcp4.OptionBootFileName: []byte("ipxe.efi")}
+			handler = &DHCPHandler{
+				options:     options,
+				ipxeOptions: prepareIPXEOptions(options, &v1.DHCPOptions{IPXEBootFileName: "http://boot.kubevirt.io/boot.ipxe"}),
+			}
+		})
+
+		It("should be served the iPXE boot file name", func() {
+			requestOptions := dhcp4.Options{dhcp4.OptionUserClass: []byte("iPXE")}
+			Expect(handler.replyOptions(requestOptions)[dhcp4.OptionBootFileName]).To(Equal([]byte("http://boot.kubevirt.io/boot.ipxe")))
+		})
+
+		It("should not affect other clients", func() {
+			Expect(handler.replyOptions(dhcp4.Options{})[dhcp4.OptionBootFileName]).To(Equal([]byte("ipxe.efi")))
+		})
+
+		It("should not be matched without an iPXE boot file name", func() {
+			Expect(prepareIPXEOptions(handler.options, &v1.DHCPOptions{BootFileName: "ipxe.efi"})).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,186 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package serverv6
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"golang.org/x/net/ipv6"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	routerAdvertisementInterval = 200 * time.Second
+	routerLifetime              = 1800 * time.Second
+	slaacPrefixLength           = 64
+	infiniteLifetime            = 0xffffffff
+
+	ndOptionSourceLinkLayerAddress = 1
+	ndOptionPrefixInformation      = 3
+	ndOptionMTU                    = 5
+	ndOptionRecursiveDNSServer     = 25
+
+	raFlagManaged      = 0x80
+	raFlagOtherConfig  = 0x40
+	prefixFlagOnLink   = 0x80
+	prefixFlagAutonomy = 0x40
+)
+
+var allNodesMulticast = net.ParseIP("ff02::1")
+var allRoutersMulticast = net.ParseIP("ff02::2")
+
+// SingleClientRouterAdvertiser periodically advertises the server interface as the
+// default router of the given prefix, and answers the router solicitations of the VM.
+func SingleClientRouterAdvertiser(prefix *net.IPNet, serverIfaceName string, mtu uint16, customDHCPOptions *v1.DHCPOptions) error {
+	log.Log.Info("Starting SingleClientRouterAdvertiser")
+
+	iface, err := net.InterfaceByName(serverIfaceName)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser, couldn't get the server interface: %v", err)
+	}
+
+	dnsServers, err := dnsServersFromOptions(customDHCPOptions)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser: %v", err)
+	}
+
+	conn, err := newRouterAdvertisementConn(iface)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertiser: %v", err)
+	}
+	defer conn.Close()
+
+	ra := buildRouterAdvertisement(prefix, iface.HardwareAddr, mtu, dnsServers)
+	destination := &net.IPAddr{IP: allNodesMulticast, Zone: iface.Name}
+	buf := make([]byte, iface.MTU)
+	for {
+		if _, err := conn.WriteTo(ra, nil, destination); err != nil {
+			return fmt.Errorf("failed sending a router advertisement: %v", err)
+		}
+
+		if err := waitForRouterSolicitation(conn, iface.Index, buf); err != nil {
+			return err
+		}
+	}
+}
+
+// waitForRouterSolicitation returns when a router solicitation arrived on the
+// interface or when the next periodic advertisement is due.
+func waitForRouterSolicitation(conn *ipv6.PacketConn, ifIndex int, buf []byte) error {
+	if err := conn.SetReadDeadline(time.Now().Add(routerAdvertisementInterval)); err != nil {
+		return err
+	}
+	for {
+		n, cm, _, err := conn.ReadFrom(buf)
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed reading router solicitations: %v", err)
+		}
+		if n > 0 && ipv6.ICMPType(buf[0]) == ipv6.ICMPTypeRouterSolicitation && (cm == nil || cm.IfIndex == ifIndex) {
+			log.Log.V(4).Info("Received a router solicitation")
+			return nil
+		}
+	}
+}
+
+func newRouterAdvertisementConn(iface *net.Interface) (*ipv6.PacketConn, error) {
+	c, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+
+	conn := ipv6.NewPacketConn(c)
+	if err := configureRouterAdvertisementConn(conn, iface); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func configureRouterAdvertisementConn(conn *ipv6.PacketConn, iface *net.Interface) error {
+	// Neighbor discovery messages must be sent with a hop limit of 255 (RFC 4861)
+	if err := conn.SetMulticastHopLimit(255); err != nil {
+		return err
+	}
+	if err := conn.SetMulticastInterface(iface); err != nil {
+		return err
+	}
+	if err := conn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+		return err
+	}
+	if err := conn.JoinGroup(iface, &net.IPAddr{IP: allRoutersMulticast}); err != nil {
+		return err
+	}
+
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+	return conn.SetICMPFilter(&filter)
+}
+
+// buildRouterAdvertisement returns the ICMPv6 router advertisement message (RFC 4861).
+// The checksum is left empty, it is computed by the kernel for ICMPv6 raw sockets.
+func buildRouterAdvertisement(prefix *net.IPNet, serverInterfaceMac net.HardwareAddr, mtu uint16, dnsServers []net.IP) []byte {
+	prefixLength, _ := prefix.Mask.Size()
+
+	// The address is assigned by DHCPv6, the prefix is autonomous only when SLAAC is possible
+	msg := []byte{byte(ipv6.ICMPTypeRouterAdvertisement), 0, 0, 0, 64, raFlagManaged | raFlagOtherConfig}
+	msg = binary.BigEndian.AppendUint16(msg, uint16(routerLifetime.Seconds()))
+	msg = binary.BigEndian.AppendUint32(msg, 0) // reachable time
+	msg = binary.BigEndian.AppendUint32(msg, 0) // retrans timer
+
+	if len(serverInterfaceMac) == 6 {
+		msg = append(msg, ndOptionSourceLinkLayerAddress, 1)
+		msg = append(msg, serverInterfaceMac...)
+	}
+
+	msg = append(msg, ndOptionMTU, 1, 0, 0)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(mtu))
+
+	prefixFlags := byte(prefixFlagOnLink)
+	if prefixLength == slaacPrefixLength {
+		prefixFlags |= prefixFlagAutonomy
+	}
+	msg = append(msg, ndOptionPrefixInformation, 4, byte(prefixLength), prefixFlags)
+	msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime) // valid lifetime
+	msg = binary.BigEndian.AppendUint32(msg, infiniteLifetime) // preferred lifetime
+	msg = binary.BigEndian.AppendUint32(msg, 0)                // reserved
+	msg = append(msg, prefix.IP.Mask(prefix.Mask).To16()...)
+
+	if len(dnsServers) > 0 {
+		// See RFC 8106, the lifetime should be at least three times the advertisement interval
+		msg = append(msg, ndOptionRecursiveDNSServer, byte(1+2*len(dnsServers)), 0, 0)
+		msg = binary.BigEndian.AppendUint32(msg, uint32(3*routerAdvertisementInterval.Seconds()))
+		for _, server := range dnsServers {
+			msg = append(msg, server.To16()...)
+		}
+	}
+
+	return msg
+}
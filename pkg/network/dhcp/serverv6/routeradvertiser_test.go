@@ -0,0 +1,73 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package serverv6
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router advertisement", func() {
+	var serverInterfaceMac net.HardwareAddr
+
+	BeforeEach(func() {
+		serverInterfaceMac, _ = net.ParseMAC("12:34:56:78:9A:BC")
+	})
+
+	It("should advertise an on-link prefix with the MTU and the link layer address", func() {
+		_, prefix, _ := net.ParseCIDR("fd10:0:2::/120")
+		ra := buildRouterAdvertisement(prefix, serverInterfaceMac, 1400, nil)
+
+		Expect(ra).To(HaveLen(16 + 8 + 8 + 32))
+		Expect(ra[:16]).To(Equal([]byte{134, 0, 0, 0, 64, 0xc0, 0x07, 0x08, 0, 0, 0, 0, 0, 0, 0, 0}))
+		Expect(ra[16:24]).To(Equal([]byte{1, 1, 0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc}))
+		Expect(ra[24:32]).To(Equal([]byte{5, 1, 0, 0, 0, 0, 0x05, 0x78}))
+		Expect(ra[32:36]).To(Equal([]byte{3, 4, 120, prefixFlagOnLink}))
+		Expect(net.IP(ra[48:64]).Equal(net.ParseIP("fd10:0:2::"))).To(BeTrue())
+	})
+
+	It("should enable SLAAC on a /64 prefix", func() {
+		_, prefix, _ := net.ParseCIDR("fd10:0:2::/64")
+		ra := buildRouterAdvertisement(prefix, serverInterfaceMac, 1500, nil)
+
+		Expect(ra[32:36]).To(Equal([]byte{3, 4, 64, prefixFlagOnLink | prefixFlagAutonomy}))
+	})
+
+	It("should advertise the prefix of the client address", func() {
+		prefix := &net.IPNet{IP: net.ParseIP("fd10:0:2::2"), Mask: net.CIDRMask(64, 128)}
+		ra := buildRouterAdvertisement(prefix, serverInterfaceMac, 1500, nil)
+
+		Expect(net.IP(ra[48:64]).Equal(net.ParseIP("fd10:0:2::"))).To(BeTrue())
+	})
+
+	It("should advertise the DNS servers", func() {
+		_, prefix, _ := net.ParseCIDR("fd10:0:2::/64")
+		dnsServers := []net.IP{net.ParseIP("fd10:0:2::53"), net.ParseIP("fd10:0:2::54")}
+		ra := buildRouterAdvertisement(prefix, serverInterfaceMac, 1500, dnsServers)
+
+		rdnss := ra[64:]
+		Expect(rdnss).To(HaveLen(8 + 32))
+		Expect(rdnss[:8]).To(Equal([]byte{25, 5, 0, 0, 0, 0, 0x02, 0x58}))
+		Expect(net.IP(rdnss[8:24]).Equal(dnsServers[0])).To(BeTrue())
+		Expect(net.IP(rdnss[24:40]).Equal(dnsServers[1])).To(BeTrue())
+	})
+})
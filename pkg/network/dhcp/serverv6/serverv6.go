@@ -28,6 +28,7 @@ import (
 	"github.com/insomniacslk/dhcp/dhcpv6/server6"
 	"github.com/insomniacslk/dhcp/iana"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 )
 
@@ -40,7 +41,7 @@ type DHCPv6Handler struct {
 	modifiers []dhcpv6.Modifier
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, searchDomains []string, customDHCPOptions *v1.DHCPOptions) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,7 +49,15 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	dnsServers, err := dnsServersFromOptions(customDHCPOptions)
+	if err != nil {
+		return fmt.Errorf("couldn't create DHCPv6 server: %v", err)
+	}
+	if customDHCPOptions != nil && customDHCPOptions.IPv6 != nil && len(customDHCPOptions.IPv6.SearchDomains) > 0 {
+		searchDomains = customDHCPOptions.IPv6.SearchDomains
+	}
+
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, leaseDuration(customDHCPOptions), dnsServers, searchDomains)
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
@@ -121,9 +130,45 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
-	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
+func prepareDHCPv6Modifiers(
+	clientIP net.IP,
+	serverInterfaceMac net.HardwareAddr,
+	leaseDuration time.Duration,
+	dnsServers []net.IP,
+	searchDomains []string) []dhcpv6.Modifier {
+
+	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: leaseDuration, ValidLifetime: leaseDuration}
 	duid := dhcpv6.Duid{Type: dhcpv6.DUID_LL, HwType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	if len(dnsServers) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDNS(dnsServers...))
+	}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+	return modifiers
+}
+
+func dnsServersFromOptions(customDHCPOptions *v1.DHCPOptions) ([]net.IP, error) {
+	if customDHCPOptions == nil || customDHCPOptions.IPv6 == nil {
+		return nil, nil
+	}
+
+	var dnsServers []net.IP
+	for _, server := range customDHCPOptions.IPv6.DNSServers {
+		ip := net.ParseIP(server)
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("could not parse DNS server as IPv6 address: %s", server)
+		}
+		dnsServers = append(dnsServers, ip)
+	}
+	return dnsServers, nil
+}
+
+func leaseDuration(customDHCPOptions *v1.DHCPOptions) time.Duration {
+	if customDHCPOptions != nil && customDHCPOptions.LeaseTime != nil && customDHCPOptions.LeaseTime.Duration > 0 {
+		return customDHCPOptions.LeaseTime.Duration
+	}
+	return infiniteLease
 }
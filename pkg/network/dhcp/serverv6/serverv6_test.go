@@ -21,12 +21,15 @@ package serverv6
 
 import (
 	"net"
+	"time"
 
 	"github.com/insomniacslk/dhcp/dhcpv6"
 	"github.com/insomniacslk/dhcp/iana"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("DHCPv6", func() {
@@ -34,7 +37,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, infiniteLease, nil, nil)
 			Expect(modifiers).To(HaveLen(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +56,43 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with DNS options", func() {
+		It("should contain the DNS servers and the domain search list", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			dnsServers := []net.IP{net.ParseIP("fd10:0:2::53")}
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, time.Hour, dnsServers, []string{"example.com"})
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeAdvertise,
+			}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			Expect(msg.Options.DNS()).To(Equal(dnsServers))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal([]string{"example.com"}))
+			Expect(msg.Options.OneIANA().Options.OneAddress().ValidLifetime).To(Equal(time.Hour))
+		})
+	})
+	Context("dnsServersFromOptions", func() {
+		It("should parse the IPv6 DNS servers", func() {
+			dhcpOptions := &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DNSServers: []string{"fd10:0:2::53"}}}
+			Expect(dnsServersFromOptions(dhcpOptions)).To(Equal([]net.IP{net.ParseIP("fd10:0:2::53")}))
+		})
+		It("should reject an IPv4 DNS server", func() {
+			dhcpOptions := &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{DNSServers: []string{"10.0.2.53"}}}
+			_, err := dnsServersFromOptions(dhcpOptions)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, infiniteLease, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
@@ -22,10 +22,12 @@
 package driver
 
 import (
+	"encoding/json"
 	"fmt"
 	"net"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/vishvananda/netlink"
 
@@ -88,6 +90,8 @@ type NetworkHandler interface {
 	NftablesNewChain(ipVersion IPVersion, table, chain string) error
 	NftablesNewTable(ipVersion IPVersion, name string) error
 	NftablesAppendRule(ipVersion IPVersion, table, chain string, rulespec ...string) error
+	NftablesLoad(ruleset string) error
+	NftablesListCounters(family, table string) (map[string]uint64, error)
 	CheckNftables() error
 	GetNFTIPString(ipVersion IPVersion) string
 	CreateTapDevice(tapName string, queueNumber uint32, launcherPID int, mtu int, tapOwner string) error
@@ -253,6 +257,45 @@ func (h *NetworkUtilsHandler) NftablesAppendRule(ipVersion IPVersion, table, cha
 	return nil
 }
 
+func (h *NetworkUtilsHandler) NftablesLoad(ruleset string) error {
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to load nftables ruleset: %s", string(output))
+	}
+
+	return nil
+}
+
+func (h *NetworkUtilsHandler) NftablesListCounters(family, table string) (map[string]uint64, error) {
+	// #nosec No risk for attacket injection. CMD variables are predefined strings
+	output, err := exec.Command("nft", "-j", "list", "counters", "table", family, table).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables counters of table %s %s: %v", family, table, err)
+	}
+
+	var listing struct {
+		Nftables []struct {
+			Counter *struct {
+				Name    string `json:"name"`
+				Packets uint64 `json:"packets"`
+			} `json:"counter,omitempty"`
+		} `json:"nftables"`
+	}
+	if err := json.Unmarshal(output, &listing); err != nil {
+		return nil, fmt.Errorf("failed to parse nftables counters: %v", err)
+	}
+
+	counters := map[string]uint64{}
+	for _, object := range listing.Nftables {
+		if object.Counter != nil {
+			counters[object.Counter.Name] = object.Counter.Packets
+		}
+	}
+	return counters, nil
+}
+
 func (h *NetworkUtilsHandler) GetNFTIPString(ipVersion IPVersion) string {
 	if ipVersion == IPv6 {
 		return "ip6"
@@ -343,11 +386,29 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *cache.DHCPConfig, bridgeInterfaceNa
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
 				bridgeInterfaceName,
+				searchDomains,
+				dhcpOptions,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6")
 				panic(err)
 			}
 		}()
+
+		if dhcpOptions != nil && dhcpOptions.IPv6 != nil &&
+			dhcpOptions.IPv6.RouterAdvertisements != nil && *dhcpOptions.IPv6.RouterAdvertisements {
+			// the router advertiser needs CAP_NET_RAW, which non-root launchers lack,
+			// the guest is then left with its DHCPv6 configuration only
+			go func() {
+				if err := RouterAdvertiser(
+					nic.IPv6.IPNet,
+					bridgeInterfaceName,
+					nic.Mtu,
+					dhcpOptions,
+				); err != nil {
+					log.Log.Reason(err).Error("failed to run the router advertiser")
+				}
+			}()
+		}
 	}
 
 	return nil
@@ -417,3 +478,4 @@ func (h *NetworkUtilsHandler) DisableTXOffloadChecksum(ifaceName string) error {
 // Allow mocking for tests
 var DHCPServer = dhcpserver.SingleClientDHCPServer
 var DHCPv6Server = dhcpserverv6.SingleClientDHCPv6Server
+var RouterAdvertiser = dhcpserverv6.SingleClientRouterAdvertiser
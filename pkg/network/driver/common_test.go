@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package driver_test
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/cache"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+)
+
+var _ = Describe("StartDHCP", func() {
+	var (
+		origDHCPv6Server     = netdriver.DHCPv6Server
+		origRouterAdvertiser = netdriver.RouterAdvertiser
+	)
+
+	AfterEach(func() {
+		netdriver.DHCPv6Server = origDHCPv6Server
+		netdriver.RouterAdvertiser = origRouterAdvertiser
+	})
+
+	It("should not crash when the router advertiser fails", func() {
+		netdriver.DHCPv6Server = func(net.IP, string, []string, *v1.DHCPOptions) error {
+			return nil
+		}
+		advertiserFailed := make(chan struct{})
+		netdriver.RouterAdvertiser = func(*net.IPNet, string, uint16, *v1.DHCPOptions) error {
+			defer close(advertiserFailed)
+			return fmt.Errorf("socket: operation not permitted")
+		}
+
+		ip, ipNet, err := net.ParseCIDR("fd10:0:2::2/120")
+		Expect(err).ToNot(HaveOccurred())
+		ipNet.IP = ip
+		nic := &cache.DHCPConfig{IPv6: netlink.Addr{IPNet: ipNet}, Mtu: 1500}
+		routerAdvertisements := true
+		dhcpOptions := &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{RouterAdvertisements: &routerAdvertisements}}
+
+		handler := &netdriver.NetworkUtilsHandler{}
+		Expect(handler.StartDHCP(nic, "k6t-eth0", dhcpOptions)).To(Succeed())
+		Eventually(advertiserFailed).Should(BeClosed())
+		// A panic in the router advertiser goroutine would abort the test binary
+		Consistently(func() bool { return true }, 100*time.Millisecond).Should(BeTrue())
+	})
+})
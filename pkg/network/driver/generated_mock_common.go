@@ -328,6 +328,27 @@ func (_mr *_MockNetworkHandlerRecorder) NftablesAppendRule(arg0, arg1, arg2 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesAppendRule", _s...)
 }
 
+func (_m *MockNetworkHandler) NftablesLoad(ruleset string) error {
+	ret := _m.ctrl.Call(_m, "NftablesLoad", ruleset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesLoad(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesLoad", arg0)
+}
+
+func (_m *MockNetworkHandler) NftablesListCounters(family string, table string) (map[string]uint64, error) {
+	ret := _m.ctrl.Call(_m, "NftablesListCounters", family, table)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesListCounters(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesListCounters", arg0, arg1)
+}
+
 func (_m *MockNetworkHandler) CheckNftables() error {
 	ret := _m.ctrl.Call(_m, "CheckNftables")
 	ret0, _ := ret[0].(error)
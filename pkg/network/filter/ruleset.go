@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package filter
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	Family = "bridge"
+	Table  = "kubevirt-filter"
+
+	forwardChain = "forward"
+)
+
+// Ruleset compiles the filter of a VMI interface into an nftables ruleset of the bridge family.
+// The ruleset is meant to be loaded with `nft -f` in the pod network namespace, where it
+// inspects the frames forwarded from and to the tap device backing the interface.
+// When ip is nil, the IP part of the anti-spoofing protection is not applied.
+func Ruleset(ifaceName string, filter *v1.InterfaceFilter, tapName string, mac net.HardwareAddr, ip net.IP) (string, error) {
+	ingressChain := tapName + "-ingress"
+	egressChain := tapName + "-egress"
+	ingressCounter := counterName(ifaceName, ingressDirection)
+	egressCounter := counterName(ifaceName, egressDirection)
+
+	rs := &ruleset{}
+	rs.add("add table %s %s", Family, Table)
+	rs.add("add chain %s %s %s { type filter hook forward priority 0; policy accept; }", Family, Table, forwardChain)
+	rs.add("add counter %s %s %q", Family, Table, ingressCounter)
+	rs.add("add counter %s %s %q", Family, Table, egressCounter)
+	rs.add("add chain %s %s %s", Family, Table, ingressChain)
+	rs.add("add chain %s %s %s", Family, Table, egressChain)
+	rs.add("add rule %s %s %s iifname %q jump %s", Family, Table, forwardChain, tapName, egressChain)
+	rs.add("add rule %s %s %s oifname %q jump %s", Family, Table, forwardChain, tapName, ingressChain)
+
+	dropEgress := fmt.Sprintf("counter name %q drop", egressCounter)
+	if filter.AntiSpoofing == nil || *filter.AntiSpoofing {
+		rs.addRule(egressChain, "ether saddr != %s %s", mac, dropEgress)
+		rs.addRule(egressChain, "arp saddr ether != %s %s", mac, dropEgress)
+		if ip != nil && ip.To4() != nil {
+			rs.addRule(egressChain, "arp saddr ip != %s %s", ip, dropEgress)
+			rs.addRule(egressChain, "ip saddr 0.0.0.0 udp sport 68 udp dport 67 accept")
+			rs.addRule(egressChain, "ip saddr != %s %s", ip, dropEgress)
+		} else if ip != nil {
+			rs.addRule(egressChain, "ip6 saddr != { %s, fe80::/10, :: } %s", ip, dropEgress)
+		}
+	}
+
+	if err := rs.addDirection(ingressChain, ingressDirection, filter.Ingress, ingressCounter); err != nil {
+		return "", err
+	}
+	if err := rs.addDirection(egressChain, egressDirection, filter.Egress, egressCounter); err != nil {
+		return "", err
+	}
+	return rs.String(), nil
+}
+
+// DroppedPackets extracts the dropped packets of a VMI interface from the counters of the filter table.
+func DroppedPackets(ifaceName string, counters map[string]uint64) *v1.InterfaceFilterDroppedPackets {
+	ingress, ingressExists := counters[counterName(ifaceName, ingressDirection)]
+	egress, egressExists := counters[counterName(ifaceName, egressDirection)]
+	if !ingressExists && !egressExists {
+		return nil
+	}
+	return &v1.InterfaceFilterDroppedPackets{Ingress: int64(ingress), Egress: int64(egress)}
+}
+
+type direction string
+
+const (
+	ingressDirection direction = "ingress"
+	egressDirection  direction = "egress"
+)
+
+func counterName(ifaceName string, dir direction) string {
+	return fmt.Sprintf("%s-%s-dropped", ifaceName, dir)
+}
+
+type ruleset struct {
+	lines []string
+}
+
+func (r *ruleset) add(format string, args ...interface{}) {
+	r.lines = append(r.lines, fmt.Sprintf(format, args...))
+}
+
+func (r *ruleset) addRule(chain, format string, args ...interface{}) {
+	r.add("add rule %s %s %s %s", Family, Table, chain, fmt.Sprintf(format, args...))
+}
+
+func (r *ruleset) String() string {
+	return strings.Join(r.lines, "\n") + "\n"
+}
+
+// addDirection fills the chain of one direction. An empty rule list allows all the traffic,
+// otherwise only the traffic matching one of the rules, the replies to allowed connections
+// and the traffic needed to keep the interface functional (ARP, DHCP, IPv6 ND) is accepted.
+func (r *ruleset) addDirection(chain string, dir direction, rules []v1.InterfaceFilterRule, counter string) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	r.addRule(chain, "ct state established,related accept")
+	r.addRule(chain, "ether type arp accept")
+	if dir == egressDirection {
+		r.addRule(chain, "udp sport 68 udp dport 67 accept")
+		r.addRule(chain, "icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit } accept")
+	} else {
+		r.addRule(chain, "udp sport 67 udp dport 68 accept")
+		r.addRule(chain, "icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-advert } accept")
+	}
+
+	for _, rule := range rules {
+		matches, err := ruleMatches(rule, dir)
+		if err != nil {
+			return err
+		}
+		r.addRule(chain, "%s accept", strings.Join(matches, " "))
+	}
+	r.addRule(chain, "counter name %q drop", counter)
+	return nil
+}
+
+func ruleMatches(rule v1.InterfaceFilterRule, dir direction) ([]string, error) {
+	var matches []string
+
+	if rule.CIDR != "" {
+		_, cidr, err := net.ParseCIDR(rule.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter CIDR %q: %v", rule.CIDR, err)
+		}
+		family := "ip"
+		if cidr.IP.To4() == nil {
+			family = "ip6"
+		}
+		// Ingress traffic is coming from the peer, egress traffic is going to it.
+		addr := "saddr"
+		if dir == egressDirection {
+			addr = "daddr"
+		}
+		matches = append(matches, fmt.Sprintf("%s %s %s", family, addr, cidr))
+	}
+
+	switch strings.ToUpper(rule.Protocol) {
+	case "":
+	case "TCP":
+		matches = append(matches, "meta l4proto tcp")
+	case "UDP":
+		matches = append(matches, "meta l4proto udp")
+	case "ICMP":
+		matches = append(matches, "meta l4proto { icmp, ipv6-icmp }")
+	default:
+		return nil, fmt.Errorf("unsupported filter protocol %q", rule.Protocol)
+	}
+
+	if len(rule.Ports) > 0 {
+		var ports []string
+		for _, port := range rule.Ports {
+			ports = append(ports, fmt.Sprintf("%d", port))
+		}
+		matches = append(matches, fmt.Sprintf("th dport { %s }", strings.Join(ports, ", ")))
+	}
+
+	if len(matches) == 0 {
+		matches = append(matches, "ether type { ip, ip6 }")
+	}
+	return matches, nil
+}
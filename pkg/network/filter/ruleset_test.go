@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package filter_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/filter"
+)
+
+var _ = Describe("Interface filter", func() {
+	const (
+		ifaceName = "default"
+		tapName   = "tap0"
+	)
+
+	mac, _ := net.ParseMAC("02:00:00:00:00:01")
+	ip := net.ParseIP("10.0.0.5")
+
+	header := `add table bridge kubevirt-filter
+add chain bridge kubevirt-filter forward { type filter hook forward priority 0; policy accept; }
+add counter bridge kubevirt-filter "default-ingress-dropped"
+add counter bridge kubevirt-filter "default-egress-dropped"
+add chain bridge kubevirt-filter tap0-ingress
+add chain bridge kubevirt-filter tap0-egress
+add rule bridge kubevirt-filter forward iifname "tap0" jump tap0-egress
+add rule bridge kubevirt-filter forward oifname "tap0" jump tap0-ingress
+`
+	antiSpoofing := `add rule bridge kubevirt-filter tap0-egress ether saddr != 02:00:00:00:00:01 counter name "default-egress-dropped" drop
+add rule bridge kubevirt-filter tap0-egress arp saddr ether != 02:00:00:00:00:01 counter name "default-egress-dropped" drop
+add rule bridge kubevirt-filter tap0-egress arp saddr ip != 10.0.0.5 counter name "default-egress-dropped" drop
+add rule bridge kubevirt-filter tap0-egress ip saddr 0.0.0.0 udp sport 68 udp dport 67 accept
+add rule bridge kubevirt-filter tap0-egress ip saddr != 10.0.0.5 counter name "default-egress-dropped" drop
+`
+
+	It("should only apply anti-spoofing protection when no rule is set", func() {
+		ruleset, err := filter.Ruleset(ifaceName, &v1.InterfaceFilter{}, tapName, mac, ip)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleset).To(Equal(header + antiSpoofing))
+	})
+
+	It("should only protect the MAC address when the IP address is unknown", func() {
+		ruleset, err := filter.Ruleset(ifaceName, &v1.InterfaceFilter{}, tapName, mac, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleset).To(Equal(header +
+			`add rule bridge kubevirt-filter tap0-egress ether saddr != 02:00:00:00:00:01 counter name "default-egress-dropped" drop
+add rule bridge kubevirt-filter tap0-egress arp saddr ether != 02:00:00:00:00:01 counter name "default-egress-dropped" drop
+`))
+	})
+
+	It("should not apply anti-spoofing protection when it is disabled", func() {
+		ruleset, err := filter.Ruleset(ifaceName, &v1.InterfaceFilter{AntiSpoofing: pointer.Bool(false)}, tapName, mac, ip)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleset).To(Equal(header))
+	})
+
+	It("should only allow the traffic matching the rules", func() {
+		interfaceFilter := &v1.InterfaceFilter{
+			AntiSpoofing: pointer.Bool(false),
+			Ingress: []v1.InterfaceFilterRule{
+				{CIDR: "192.168.0.0/16", Protocol: "TCP", Ports: []int32{22, 443}},
+				{Protocol: "ICMP"},
+			},
+			Egress: []v1.InterfaceFilterRule{
+				{CIDR: "fd10::/64", Protocol: "UDP", Ports: []int32{53}},
+				{},
+			},
+		}
+		ruleset, err := filter.Ruleset(ifaceName, interfaceFilter, tapName, mac, ip)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ruleset).To(Equal(header +
+			`add rule bridge kubevirt-filter tap0-ingress ct state established,related accept
+add rule bridge kubevirt-filter tap0-ingress ether type arp accept
+add rule bridge kubevirt-filter tap0-ingress udp sport 67 udp dport 68 accept
+add rule bridge kubevirt-filter tap0-ingress icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-advert } accept
+add rule bridge kubevirt-filter tap0-ingress ip saddr 192.168.0.0/16 meta l4proto tcp th dport { 22, 443 } accept
+add rule bridge kubevirt-filter tap0-ingress meta l4proto { icmp, ipv6-icmp } accept
+add rule bridge kubevirt-filter tap0-ingress counter name "default-ingress-dropped" drop
+add rule bridge kubevirt-filter tap0-egress ct state established,related accept
+add rule bridge kubevirt-filter tap0-egress ether type arp accept
+add rule bridge kubevirt-filter tap0-egress udp sport 68 udp dport 67 accept
+add rule bridge kubevirt-filter tap0-egress icmpv6 type { nd-neighbor-solicit, nd-neighbor-advert, nd-router-solicit } accept
+add rule bridge kubevirt-filter tap0-egress ip6 daddr fd10::/64 meta l4proto udp th dport { 53 } accept
+add rule bridge kubevirt-filter tap0-egress ether type { ip, ip6 } accept
+add rule bridge kubevirt-filter tap0-egress counter name "default-egress-dropped" drop
+`))
+	})
+
+	It("should fail on an invalid CIDR", func() {
+		interfaceFilter := &v1.InterfaceFilter{Ingress: []v1.InterfaceFilterRule{{CIDR: "10.0.0.0/33"}}}
+		_, err := filter.Ruleset(ifaceName, interfaceFilter, tapName, mac, ip)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report the dropped packets of the interface", func() {
+		counters := map[string]uint64{
+			"default-ingress-dropped": 3,
+			"default-egress-dropped":  7,
+			"other-ingress-dropped":   1,
+		}
+		Expect(filter.DroppedPackets(ifaceName, counters)).To(Equal(&v1.InterfaceFilterDroppedPackets{Ingress: 3, Egress: 7}))
+		Expect(filter.DroppedPackets("missing", counters)).To(BeNil())
+	})
+})
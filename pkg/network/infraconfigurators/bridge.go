@@ -12,6 +12,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/filter"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -153,9 +154,28 @@ func (b *BridgePodNetworkConfigurator) PreparePodNetworkInterface() error {
 		return err
 	}
 
+	if b.vmiSpecIface.Filter != nil {
+		if err := b.loadInterfaceFilter(); err != nil {
+			log.Log.Reason(err).Errorf("failed to load the filter of interface: %s", b.vmiSpecIface.Name)
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (b *BridgePodNetworkConfigurator) loadInterfaceFilter() error {
+	var vmIP net.IP
+	if b.ipamEnabled {
+		vmIP = b.podIfaceIP.IP
+	}
+	ruleset, err := filter.Ruleset(b.vmiSpecIface.Name, b.vmiSpecIface.Filter, b.tapDeviceName, *b.vmMac, vmIP)
+	if err != nil {
+		return err
+	}
+	return b.handler.NftablesLoad(ruleset)
+}
+
 func (b *BridgePodNetworkConfigurator) GenerateNonRecoverableDomainIfaceSpec() *api.Interface {
 	return &api.Interface{
 		MAC: &api.MAC{MAC: b.vmMac.String()},
@@ -35,6 +35,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/filter"
 )
 
 const (
@@ -431,6 +432,35 @@ var _ = Describe("Bridge infrastructure configurator", func() {
 				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(MatchError(errorString))
 			})
 
+			It("network preparation loads the interface filter", func() {
+				iface.Filter = &v1.InterfaceFilter{Ingress: []v1.InterfaceFilterRule{{Protocol: "TCP", Ports: []int32{22}}}}
+				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
+					vmi,
+					iface,
+					handler,
+					bridgeIfaceName,
+					launcherPID,
+					podLink,
+					podIP,
+					withOriginalPodLinkDown(podLink),
+					withPodPrimaryLinkSwapped(podLink, podLinkAfterNameChange, dummySwap, podIP),
+					withARPIgnore(),
+					withCreatedInPodBridge(inPodBridge, bridgeIPAddr),
+					withSwitchedPodLinkMac(podLinkAfterNameChange, inPodBridge),
+					withLinkAsBridgePort(inPodBridge, podLinkAfterNameChange),
+					withCreatedTapDevice(tapDeviceName, bridgeIfaceName, launcherPID, mtu, queueCount),
+					withDisabledTxOffloadChecksum(bridgeIfaceName),
+					withLinkLearningOff(podLinkAfterNameChange),
+					withLinkUp(podLinkAfterNameChange))
+				vmMac, _ := net.ParseMAC("02:00:00:00:00:01")
+				bridgeConfigurator.vmMac = &vmMac
+
+				expectedRuleset, err := filter.Ruleset(iface.Name, iface.Filter, tapDeviceName, vmMac, podIP.IP)
+				Expect(err).ToNot(HaveOccurred())
+				handler.EXPECT().NftablesLoad(expectedRuleset).Return(nil)
+				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(Succeed())
+			})
+
 			It("network preparation fails when setting the pod link learning off errors", func() {
 				const errorString = "failed to set link learning off"
 				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
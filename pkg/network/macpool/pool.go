@@ -0,0 +1,152 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package macpool
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// MacAddressIndex is the name of the VirtualMachine informer index which maps MAC addresses to
+// the VirtualMachines whose interfaces use them.
+const MacAddressIndex = "macAddress"
+
+// pendingTimeout bounds how long an allocated MAC address is held back, while the VirtualMachine
+// it was allocated to is not yet visible in the informer cache.
+const pendingTimeout = time.Minute
+
+// Allocator hands out MAC addresses from the cluster MAC address pool.
+// The MAC addresses in use are looked up in the VirtualMachine informer cache, so they are
+// released as soon as the VirtualMachine using them is deleted.
+// The pending allocations are only known to the Allocator itself, so a single Allocator, owned by
+// the virt-controller leader, must serve all the allocations of the cluster.
+type Allocator struct {
+	vmIndexer cache.Indexer
+	lock      sync.Mutex
+	pending   map[uint64]time.Time
+	now       func() time.Time
+}
+
+func NewAllocator(vmIndexer cache.Indexer) *Allocator {
+	return &Allocator{
+		vmIndexer: vmIndexer,
+		pending:   map[uint64]time.Time{},
+		now:       time.Now,
+	}
+}
+
+// Allocate returns the first unicast MAC address of the pool which is neither used by a
+// VirtualMachine nor recently allocated.
+func (a *Allocator) Allocate(pool *v1.MacAddressPool) (string, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.now()
+	for mac, allocated := range a.pending {
+		if now.Sub(allocated) > pendingTimeout {
+			delete(a.pending, mac)
+		}
+	}
+
+	for _, macRange := range pool.Ranges {
+		start, end, err := ParseRange(macRange)
+		if err != nil {
+			return "", err
+		}
+		for mac := start; mac <= end; mac++ {
+			if isMulticast(mac) {
+				// Skip the rest of the multicast block sharing the first octet
+				mac |= 1<<40 - 1
+				continue
+			}
+			if _, isPending := a.pending[mac]; isPending {
+				continue
+			}
+			used, err := a.vmIndexer.IndexKeys(MacAddressIndex, toString(mac))
+			if err != nil {
+				return "", err
+			}
+			if len(used) == 0 {
+				a.pending[mac] = now
+				return toString(mac), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("the MAC address pool is exhausted")
+}
+
+// UsedBy returns the keys of the VirtualMachines whose interfaces use the MAC address.
+func UsedBy(vmIndexer cache.Indexer, macAddress string) ([]string, error) {
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return nil, err
+	}
+	return vmIndexer.IndexKeys(MacAddressIndex, mac.String())
+}
+
+// ParseRange returns the numeric bounds of a MAC address range.
+func ParseRange(macRange v1.MacAddressRange) (uint64, uint64, error) {
+	start, err := parse(macRange.Start)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid MAC address range start %q: %v", macRange.Start, err)
+	}
+	end, err := parse(macRange.End)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid MAC address range end %q: %v", macRange.End, err)
+	}
+	if start > end {
+		return 0, 0, fmt.Errorf("MAC address range start %s is after its end %s", macRange.Start, macRange.End)
+	}
+	return start, end, nil
+}
+
+func parse(macAddress string) (uint64, error) {
+	mac, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return 0, err
+	}
+	if len(mac) != 6 {
+		return 0, fmt.Errorf("only 48-bit MAC addresses are supported")
+	}
+	var value uint64
+	for _, octet := range mac {
+		value = value<<8 | uint64(octet)
+	}
+	return value, nil
+}
+
+func toString(value uint64) string {
+	mac := make(net.HardwareAddr, 6)
+	for i := len(mac) - 1; i >= 0; i-- {
+		mac[i] = byte(value)
+		value >>= 8
+	}
+	return mac.String()
+}
+
+func isMulticast(value uint64) bool {
+	return (value>>40)&0x01 == 0x01
+}
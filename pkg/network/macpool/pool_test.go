@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package macpool_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+)
+
+var _ = Describe("MAC address pool", func() {
+	var vmIndexer cache.Indexer
+
+	newVM := func(name string, macAddresses ...string) *v1.VirtualMachine {
+		vm := &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		for _, mac := range macAddresses {
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = append(vm.Spec.Template.Spec.Domain.Devices.Interfaces,
+				v1.Interface{Name: name, MacAddress: mac})
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		vmIndexer = cache.NewIndexer(cache.MetaNamespaceKeyFunc, controller.GetVirtualMachineInformerIndexers())
+	})
+
+	It("should allocate the MAC addresses in order", func() {
+		allocator := macpool.NewAllocator(vmIndexer)
+		pool := &v1.MacAddressPool{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}}}
+
+		Expect(allocator.Allocate(pool)).To(Equal("02:00:00:00:00:00"))
+		Expect(allocator.Allocate(pool)).To(Equal("02:00:00:00:00:01"))
+	})
+
+	It("should skip the MAC addresses used by VirtualMachines", func() {
+		Expect(vmIndexer.Add(newVM("vm1", "02:00:00:00:00:00", "02:00:00:00:00:01"))).To(Succeed())
+		allocator := macpool.NewAllocator(vmIndexer)
+		pool := &v1.MacAddressPool{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:ff"}}}
+
+		Expect(allocator.Allocate(pool)).To(Equal("02:00:00:00:00:02"))
+	})
+
+	It("should continue with the next range and skip multicast MAC addresses", func() {
+		allocator := macpool.NewAllocator(vmIndexer)
+		pool := &v1.MacAddressPool{Ranges: []v1.MacAddressRange{
+			{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"},
+			{Start: "03:00:00:00:00:00", End: "04:00:00:00:00:00"},
+		}}
+
+		Expect(allocator.Allocate(pool)).To(Equal("02:00:00:00:00:00"))
+		Expect(allocator.Allocate(pool)).To(Equal("04:00:00:00:00:00"))
+	})
+
+	It("should fail when the pool is exhausted", func() {
+		Expect(vmIndexer.Add(newVM("vm1", "02:00:00:00:00:00"))).To(Succeed())
+		allocator := macpool.NewAllocator(vmIndexer)
+		pool := &v1.MacAddressPool{Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}}}
+
+		_, err := allocator.Allocate(pool)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should release the MAC addresses of deleted VirtualMachines", func() {
+		vm := newVM("vm1", "02:00:00:00:00:00")
+		Expect(vmIndexer.Add(vm)).To(Succeed())
+		Expect(macpool.UsedBy(vmIndexer, "02:00:00:00:00:00")).To(ConsistOf("default/vm1"))
+
+		Expect(vmIndexer.Delete(vm)).To(Succeed())
+		Expect(macpool.UsedBy(vmIndexer, "02:00:00:00:00:00")).To(BeEmpty())
+	})
+
+	It("should look up MAC addresses regardless of their format", func() {
+		Expect(vmIndexer.Add(newVM("vm1", "02-00-00-00-00-AA"))).To(Succeed())
+		Expect(macpool.UsedBy(vmIndexer, "02:00:00:00:00:aa")).To(ConsistOf("default/vm1"))
+	})
+
+	DescribeTable("should reject an invalid range", func(macRange v1.MacAddressRange) {
+		_, _, err := macpool.ParseRange(macRange)
+		Expect(err).To(HaveOccurred())
+	},
+		Entry("with an invalid start", v1.MacAddressRange{Start: "02:00:00", End: "02:00:00:00:00:ff"}),
+		Entry("with an invalid end", v1.MacAddressRange{Start: "02:00:00:00:00:00", End: "zz"}),
+		Entry("with a start after the end", v1.MacAddressRange{Start: "02:00:00:00:00:ff", End: "02:00:00:00:00:00"}),
+		Entry("with a 64-bit MAC address", v1.MacAddressRange{Start: "02:00:00:00:00:00:00:00", End: "02:00:00:00:00:00:00:ff"}),
+	)
+})
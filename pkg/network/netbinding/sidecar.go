@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package netbinding
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+)
+
+// NetBindingPluginSidecarList returns a hook sidecar for every network binding plugin used by
+// the VMI interfaces that ships a sidecar image. Each plugin gets a single sidecar, no matter
+// how many interfaces it binds.
+func NetBindingPluginSidecarList(vmi *v1.VirtualMachineInstance, bindingPlugins map[string]v1.InterfaceBindingPlugin) (hooks.HookSidecarList, error) {
+	var sidecars hooks.HookSidecarList
+	pluginsAdded := map[string]struct{}{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil {
+			continue
+		}
+		if _, added := pluginsAdded[iface.Binding.Name]; added {
+			continue
+		}
+		plugin, exists := bindingPlugins[iface.Binding.Name]
+		if !exists {
+			return nil, fmt.Errorf("network binding plugin %q used by interface %s is not registered", iface.Binding.Name, iface.Name)
+		}
+		pluginsAdded[iface.Binding.Name] = struct{}{}
+		if plugin.SidecarImage != "" {
+			sidecars = append(sidecars, hooks.HookSidecar{Image: plugin.SidecarImage})
+		}
+	}
+	return sidecars, nil
+}
+
+// DomainAttachmentByInterfaceName maps the interfaces bound by a plugin to the domain attachment
+// type the plugin asks for. Interfaces whose plugin has no domain attachment are left out.
+func DomainAttachmentByInterfaceName(interfaces []v1.Interface, bindingPlugins map[string]v1.InterfaceBindingPlugin) map[string]string {
+	domainAttachments := map[string]string{}
+	for _, iface := range interfaces {
+		if iface.Binding == nil {
+			continue
+		}
+		if plugin, exists := bindingPlugins[iface.Binding.Name]; exists && plugin.DomainAttachmentType != "" {
+			domainAttachments[iface.Name] = string(plugin.DomainAttachmentType)
+		}
+	}
+	return domainAttachments
+}
@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package netbinding_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/hooks"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
+)
+
+var _ = Describe("Network binding plugin sidecars", func() {
+	const sidecarImage = "registry:5000/plugin:devel"
+
+	bindingPlugins := map[string]v1.InterfaceBindingPlugin{
+		"withsidecar":    {SidecarImage: sidecarImage},
+		"withoutsidecar": {NetworkAttachmentDefinition: "plugin-nad"},
+	}
+
+	newVMI := func(interfaces ...v1.Interface) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Interfaces = interfaces
+		return vmi
+	}
+
+	It("should add a single sidecar per plugin", func() {
+		vmi := newVMI(
+			v1.Interface{Name: "net1", Binding: &v1.PluginBinding{Name: "withsidecar"}},
+			v1.Interface{Name: "net2", Binding: &v1.PluginBinding{Name: "withsidecar"}},
+			v1.Interface{Name: "net3", Binding: &v1.PluginBinding{Name: "withoutsidecar"}},
+			v1.Interface{Name: "net4", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		)
+
+		sidecars, err := netbinding.NetBindingPluginSidecarList(vmi, bindingPlugins)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sidecars).To(Equal(hooks.HookSidecarList{{Image: sidecarImage}}))
+	})
+
+	It("should fail when the plugin is not registered", func() {
+		vmi := newVMI(v1.Interface{Name: "net1", Binding: &v1.PluginBinding{Name: "unknown"}})
+
+		_, err := netbinding.NetBindingPluginSidecarList(vmi, bindingPlugins)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should map the interfaces to the domain attachment of their plugin", func() {
+		plugins := map[string]v1.InterfaceBindingPlugin{
+			"tapplugin":   {DomainAttachmentType: v1.Tap},
+			"otherplugin": {SidecarImage: sidecarImage},
+		}
+		interfaces := []v1.Interface{
+			{Name: "net1", Binding: &v1.PluginBinding{Name: "tapplugin"}},
+			{Name: "net2", Binding: &v1.PluginBinding{Name: "otherplugin"}},
+			{Name: "net3", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+
+		Expect(netbinding.DomainAttachmentByInterfaceName(interfaces, plugins)).To(Equal(map[string]string{"net1": "tap"}))
+	})
+})
@@ -32,6 +32,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/filter"
 	"kubevirt.io/kubevirt/pkg/network/netns"
 )
 
@@ -47,6 +49,7 @@ type ConfigStateExecutor interface {
 type NetConf struct {
 	cacheCreator     cacheCreator
 	nsFactory        nsFactory
+	handler          netdriver.NetworkHandler
 	configState      map[string]ConfigStateExecutor
 	configStateMutex *sync.RWMutex
 }
@@ -61,15 +64,16 @@ func NewNetConf() *NetConf {
 	var cacheFactory cache.CacheCreator
 	return NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
 		return netns.New(pid)
-	}, cacheFactory, map[string]ConfigStateExecutor{})
+	}, cacheFactory, map[string]ConfigStateExecutor{}, &netdriver.NetworkUtilsHandler{})
 }
 
-func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, configState map[string]ConfigStateExecutor) *NetConf {
+func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, configState map[string]ConfigStateExecutor, handler netdriver.NetworkHandler) *NetConf {
 	return &NetConf{
 		configState:      configState,
 		configStateMutex: &sync.RWMutex{},
 		cacheCreator:     cacheCreator,
 		nsFactory:        nsFactory,
+		handler:          handler,
 	}
 }
 
@@ -156,6 +160,34 @@ func (c *NetConf) Teardown(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// FilterDroppedPackets reports, per interface name, the packets dropped by the filter of the VMI interfaces.
+func (c *NetConf) FilterDroppedPackets(vmi *v1.VirtualMachineInstance, launcherPid int) (map[string]*v1.InterfaceFilterDroppedPackets, error) {
+	filteredIfaces := netvmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
+		return iface.Filter != nil
+	})
+	if len(filteredIfaces) == 0 {
+		return nil, nil
+	}
+
+	var counters map[string]uint64
+	err := c.nsFactory(launcherPid).Do(func() error {
+		var err error
+		counters, err = c.handler.NftablesListCounters(filter.Family, filter.Table)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	droppedPackets := map[string]*v1.InterfaceFilterDroppedPackets{}
+	for _, iface := range filteredIfaces {
+		if ifaceDroppedPackets := filter.DroppedPackets(iface.Name, counters); ifaceDroppedPackets != nil {
+			droppedPackets[iface.Name] = ifaceDroppedPackets
+		}
+	}
+	return droppedPackets, nil
+}
+
 func (c *NetConf) hotUnplugInterfaces(vmi *v1.VirtualMachineInstance, networks []v1.Network, configState ConfigStateExecutor, launcherPid int) error {
 	netConfigurator := NewVMNetworkConfigurator(vmi, c.cacheCreator, &launcherPid)
 	return netConfigurator.UnplugPodNetworksPhase1(vmi, networks, configState)
@@ -27,6 +27,7 @@ import (
 
 	kfs "kubevirt.io/kubevirt/pkg/os/fs"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+	"kubevirt.io/kubevirt/pkg/network/filter"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 )
 
@@ -42,13 +45,15 @@ var _ = Describe("netconf", func() {
 		netConf   *netsetup.NetConf
 		vmi       *v1.VirtualMachineInstance
 		configMap map[string]netsetup.ConfigStateExecutor
+		handler   *netdriver.MockNetworkHandler
 	)
 
 	const launcherPid = 0
 
 	BeforeEach(func() {
 		configMap = map[string]netsetup.ConfigStateExecutor{}
-		netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(nsNoopFactory, &tempCacheCreator{}, configMap)
+		handler = netdriver.NewMockNetworkHandler(gomock.NewController(GinkgoT()))
+		netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(nsNoopFactory, &tempCacheCreator{}, configMap, handler)
 		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{UID: "123", Name: "vmi1"}}
 	})
 
@@ -61,7 +66,7 @@ var _ = Describe("netconf", func() {
 	})
 
 	It("fails the setup run", func() {
-		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nsFailureFactory, &tempCacheCreator{}, configMap)
+		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nsFailureFactory, &tempCacheCreator{}, configMap, handler)
 		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
 			Name: "default",
 		}}
@@ -73,7 +78,7 @@ var _ = Describe("netconf", func() {
 	})
 
 	It("fails the teardown run", func() {
-		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nil, failingCacheCreator{}, configMap)
+		netConf := netsetup.NewNetConfWithCustomFactoryAndConfigState(nil, failingCacheCreator{}, configMap, handler)
 		Expect(netConf.Teardown(vmi)).NotTo(Succeed())
 	})
 
@@ -115,6 +120,44 @@ var _ = Describe("netconf", func() {
 			Expect(configState.RunWasExecuted).To(BeTrue())
 		})
 	})
+
+	Context("filter dropped packets", func() {
+		BeforeEach(func() {
+			netConf = netsetup.NewNetConfWithCustomFactoryAndConfigState(nsRunFactory, &tempCacheCreator{}, configMap, handler)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "filtered", Filter: &v1.InterfaceFilter{}},
+				{Name: "unfiltered"},
+			}
+		})
+
+		It("reports the dropped packets of the filtered interfaces", func() {
+			handler.EXPECT().NftablesListCounters(filter.Family, filter.Table).Return(map[string]uint64{
+				"filtered-ingress-dropped": 3,
+				"filtered-egress-dropped":  7,
+			}, nil)
+
+			droppedPackets, err := netConf.FilterDroppedPackets(vmi, launcherPid)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(droppedPackets).To(Equal(map[string]*v1.InterfaceFilterDroppedPackets{
+				"filtered": {Ingress: 3, Egress: 7},
+			}))
+		})
+
+		It("fails when the counters cannot be read", func() {
+			handler.EXPECT().NftablesListCounters(filter.Family, filter.Table).Return(nil, fmt.Errorf("nft failure"))
+
+			_, err := netConf.FilterDroppedPackets(vmi, launcherPid)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("does not read the counters without filtered interfaces", func() {
+			vmi.Spec.Domain.Devices.Interfaces = vmi.Spec.Domain.Devices.Interfaces[1:]
+
+			droppedPackets, err := netConf.FilterDroppedPackets(vmi, launcherPid)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(droppedPackets).To(BeEmpty())
+		})
+	})
 })
 
 type netnsStub struct {
@@ -130,6 +173,11 @@ func (n netnsStub) Do(func() error) error {
 func nsNoopFactory(_ int) netsetup.NSExecutor    { return netnsStub{} }
 func nsFailureFactory(_ int) netsetup.NSExecutor { return netnsStub{shouldFail: true} }
 
+type netnsRunner struct{}
+
+func (n netnsRunner) Do(f func() error) error { return f() }
+func nsRunFactory(_ int) netsetup.NSExecutor  { return netnsRunner{} }
+
 func netPreSetupDummyNoop() error { return nil }
 
 func netPreSetupFail() error { return fmt.Errorf("pre-setup failure") }
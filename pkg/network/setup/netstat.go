@@ -217,11 +217,20 @@ func ifacesStatusFromDomainInterfaces(domainSpecIfaces []api.Interface) []v1.Vir
 			MAC:        domainSpecIface.MAC.MAC,
 			InfoSource: netvmispec.InfoSourceDomain,
 			QueueCount: domainInterfaceQueues(domainSpecIface.Driver),
+			LinkState:  domainInterfaceLinkState(domainSpecIface.LinkState),
 		})
 	}
 	return vmiStatusIfaces
 }
 
+func domainInterfaceLinkState(linkState *api.LinkState) string {
+	if linkState != nil {
+		return linkState.State
+	}
+
+	return ""
+}
+
 func domainInterfaceQueues(driver *api.InterfaceDriver) int32 {
 	if driver != nil && driver.Queues != nil {
 		return int32(*driver.Queues)
@@ -366,6 +366,30 @@ var _ = Describe("netstat", func() {
 		}), "the SR-IOV interface should be reported in the status.")
 	})
 
+	It("should report the link state of the domain interface", func() {
+		const (
+			primaryNetworkName = "primary"
+			primaryPodIPv4     = "1.1.1.1"
+		)
+
+		domainIface := newDomainSpecIface(primaryNetworkName, "")
+		domainIface.LinkState = &api.LinkState{State: "down"}
+		Expect(
+			setup.addNetworkInterface(
+				newVMISpecIfaceWithBridgeBinding(primaryNetworkName),
+				newVMISpecPodNetwork(primaryNetworkName),
+				domainIface,
+				primaryPodIPv4,
+			),
+		).To(Succeed())
+
+		Expect(setup.NetStat.UpdateStatus(setup.Vmi, setup.Domain)).To(Succeed())
+
+		expectedIface := newVMIStatusIface(primaryNetworkName, []string{primaryPodIPv4}, "", "", netvmispec.InfoSourceDomain, netsetup.DefaultInterfaceQueueCount)
+		expectedIface.LinkState = "down"
+		Expect(setup.Vmi.Status.Interfaces).To(Equal([]v1.VirtualMachineInstanceNetworkInterface{expectedIface}))
+	})
+
 	It("should report SR-IOV interface when guest-agent is inactive and a regular interface exists", func() {
 		const (
 			networkName        = "sriov-network"
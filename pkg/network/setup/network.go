@@ -65,6 +65,11 @@ func (v VMNetworkConfigurator) getPhase1NICs(launcherPID *int, networks []v1.Net
 			continue
 		}
 
+		// Interfaces bound by a plugin are set up by the plugin CNI and sidecar
+		if iface.Binding != nil {
+			continue
+		}
+
 		nic, err := newPhase1PodNIC(v.vmi, &networks[i], iface, v.handler, v.cacheCreator, launcherPID)
 		if err != nil {
 			return nil, err
@@ -88,6 +93,11 @@ func (v VMNetworkConfigurator) getPhase2NICs(domain *api.Domain, networks []v1.N
 			continue
 		}
 
+		// Interfaces bound by a plugin are set up by the plugin CNI and sidecar
+		if iface.Binding != nil {
+			continue
+		}
+
 		nic, err := newPhase2PodNIC(v.vmi, &networks[i], iface, v.handler, v.cacheCreator, domain)
 		if err != nil {
 			return nil, err
@@ -22,7 +22,13 @@ package backendstorage
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -37,12 +43,29 @@ import (
 const (
 	PVCPrefix = "persistent-state-for-"
 	PVCSize   = "10Mi"
+
+	// AlternatePVCSuffix names the second PVC a VMI can keep its state in.
+	// Live migrations with a non-shared backend storage move the state back and forth between both PVCs.
+	AlternatePVCSuffix = "-alt"
 )
 
 func PVCForVMI(vmi *corev1.VirtualMachineInstance) string {
 	return PVCPrefix + vmi.Name
 }
 
+func AlternatePVCForVMI(vmi *corev1.VirtualMachineInstance) string {
+	return PVCForVMI(vmi) + AlternatePVCSuffix
+}
+
+func pvcsForVMI(vmi *corev1.VirtualMachineInstance) []string {
+	return []string{PVCForVMI(vmi), AlternatePVCForVMI(vmi)}
+}
+
+// PodVolumeName returns the name of the virt-launcher pod volume backed by the backend storage PVC
+func PodVolumeName(vmi *corev1.VirtualMachineInstance) string {
+	return vmi.Name + "-tpm"
+}
+
 func HasPersistentTPMDevice(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
 	if vmiSpec.Domain.Devices.TPM != nil &&
 		vmiSpec.Domain.Devices.TPM.Persistent != nil &&
@@ -53,64 +76,280 @@ func HasPersistentTPMDevice(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
 	return false
 }
 
-func isBackendStorageNeededForVMI(vmi *corev1.VirtualMachineInstance) bool {
-	return HasPersistentTPMDevice(&vmi.Spec)
+func HasEncryptedTPMState(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentTPMDevice(vmiSpec) && vmiSpec.Domain.Devices.TPM.EncryptionSecretRef != nil
+}
+
+func HasPersistentEFI(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return vmiSpec.Domain.Firmware != nil &&
+		vmiSpec.Domain.Firmware.Bootloader != nil &&
+		vmiSpec.Domain.Firmware.Bootloader.EFI != nil &&
+		vmiSpec.Domain.Firmware.Bootloader.EFI.Persistent != nil &&
+		*vmiSpec.Domain.Firmware.Bootloader.EFI.Persistent
+}
+
+// NVRAMDir returns the directory of the virt-launcher pod the persistent EFI NVRAM of the VMI is kept in.
+func NVRAMDir(vmi *corev1.VirtualMachineInstance) string {
+	if util.IsNonRootVMI(vmi) {
+		return filepath.Join(util.VirtPrivateDir, "libvirt", "qemu", "nvram")
+	}
+	return "/var/lib/libvirt/qemu/nvram"
+}
+
+func isStateRetained(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentTPMDevice(vmiSpec) &&
+		vmiSpec.Domain.Devices.TPM.StateRetentionPolicy == corev1.TPMStateRetentionPolicyRetain
+}
+
+func isBackendStorageNeeded(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentTPMDevice(vmiSpec) || HasPersistentEFI(vmiSpec)
+}
+
+func IsBackendStorageNeededForVMI(vmi *corev1.VirtualMachineInstance) bool {
+	return isBackendStorageNeeded(&vmi.Spec)
 }
 
 func IsBackendStorageNeededForVM(vm *corev1.VirtualMachine) bool {
 	if vm.Spec.Template == nil {
 		return false
 	}
-	return HasPersistentTPMDevice(&vm.Spec.Template.Spec)
+	return isBackendStorageNeeded(&vm.Spec.Template.Spec)
 }
 
-func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient) error {
-	if !isBackendStorageNeededForVMI(vmi) {
-		return nil
+func isShared(pvc *v1.PersistentVolumeClaim) bool {
+	for _, accessMode := range pvc.Spec.AccessModes {
+		if accessMode == v1.ReadWriteMany {
+			return true
+		}
 	}
+	return false
+}
 
-	_, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), PVCForVMI(vmi), metav1.GetOptions{})
-	if err == nil {
-		return nil
+func getPVCFromStore(pvcStore cache.Store, namespace, name string) (*v1.PersistentVolumeClaim, error) {
+	obj, exists, err := pvcStore.GetByKey(namespace + "/" + name)
+	if err != nil || !exists {
+		return nil, err
 	}
-	if !errors.IsNotFound(err) {
-		return err
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+// CurrentPVCName returns the name of the PVC holding the persistent state of the VMI.
+func CurrentPVCName(vmi *corev1.VirtualMachineInstance, pvcStore cache.Store) string {
+	if migrationState := vmi.Status.MigrationState; migrationState != nil && migrationState.SourcePersistentStatePVCName != "" {
+		if migrationState.Completed && !migrationState.Failed {
+			return migrationState.TargetPersistentStatePVCName
+		}
+		return migrationState.SourcePersistentStatePVCName
 	}
 
-	modeFile := v1.PersistentVolumeFilesystem
-	storageClass := clusterConfig.GetVMStateStorageClass()
-	if storageClass == "" {
-		return fmt.Errorf("backend VM storage requires a backend storage class defined in the custom resource")
+	// Without a migration to tell, the oldest PVC holds the state. A newer one can only be
+	// a leftover of a migration target which never got handed off.
+	var current *v1.PersistentVolumeClaim
+	for _, name := range pvcsForVMI(vmi) {
+		pvc, err := getPVCFromStore(pvcStore, vmi.Namespace, name)
+		if err != nil || pvc == nil || pvc.DeletionTimestamp != nil {
+			continue
+		}
+		if current == nil || pvc.CreationTimestamp.Before(&current.CreationTimestamp) {
+			current = pvc
+		}
 	}
-	ownerReferences := vmi.OwnerReferences
-	if len(vmi.OwnerReferences) == 0 {
-		// If the VMI has no owner, then it did not originate from a VM.
-		// In that case, we tie the PVC to the VMI, rendering it quite useless since it wont actually persist.
-		// The alternative is to remove this `if` block, allowing the PVC to persist after the VMI is deleted.
-		// However, that would pose security and littering concerns.
-		ownerReferences = []metav1.OwnerReference{
-			*metav1.NewControllerRef(vmi, corev1.VirtualMachineInstanceGroupVersionKind),
+	if current == nil {
+		return PVCForVMI(vmi)
+	}
+	return current.Name
+}
+
+// PVCNameFromPod returns the name of the backend storage PVC mounted by a virt-launcher pod.
+func PVCNameFromPod(vmi *corev1.VirtualMachineInstance, pod *v1.Pod) string {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == PodVolumeName(vmi) && volume.PersistentVolumeClaim != nil {
+			return volume.PersistentVolumeClaim.ClaimName
+		}
+	}
+	return ""
+}
+
+// SetPodPVC makes a virt-launcher pod mount the given backend storage PVC.
+func SetPodPVC(vmi *corev1.VirtualMachineInstance, pod *v1.Pod, pvcName string) {
+	for i, volume := range pod.Spec.Volumes {
+		if volume.Name == PodVolumeName(vmi) && volume.PersistentVolumeClaim != nil {
+			pod.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = pvcName
 		}
 	}
-	pvc := &v1.PersistentVolumeClaim{
+}
+
+func ownerReferencesForVMI(vmi *corev1.VirtualMachineInstance) []metav1.OwnerReference {
+	if len(vmi.OwnerReferences) > 0 {
+		return vmi.OwnerReferences
+	}
+	if isStateRetained(&vmi.Spec) {
+		// A retained state must survive the VMI, so it can't be owned by it.
+		return nil
+	}
+	// If the VMI has no owner, then it did not originate from a VM.
+	// In that case, we tie the PVC to the VMI, rendering it quite useless since it wont actually persist.
+	// The alternative is to remove this `if` block, allowing the PVC to persist after the VMI is deleted.
+	// However, that would pose security and littering concerns.
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(vmi, corev1.VirtualMachineInstanceGroupVersionKind),
+	}
+}
+
+func newPVC(name string, ownerReferences []metav1.OwnerReference, accessMode v1.PersistentVolumeAccessMode, storageClass *string) *v1.PersistentVolumeClaim {
+	modeFile := v1.PersistentVolumeFilesystem
+	return &v1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            PVCForVMI(vmi),
+			Name:            name,
 			OwnerReferences: ownerReferences,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
-			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			AccessModes: []v1.PersistentVolumeAccessMode{accessMode},
 			Resources: v1.ResourceRequirements{
 				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(PVCSize)},
 			},
-			StorageClassName: &storageClass,
+			StorageClassName: storageClass,
 			VolumeMode:       &modeFile,
 		},
 	}
+}
 
-	_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient) error {
+	if !IsBackendStorageNeededForVMI(vmi) {
+		return nil
+	}
+
+	for _, name := range pvcsForVMI(vmi) {
+		pvc, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err == nil && pvc.DeletionTimestamp == nil {
+			return nil
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	storageClass := clusterConfig.GetVMStateStorageClass()
+	if storageClass == "" {
+		return fmt.Errorf("backend VM storage requires a backend storage class defined in the custom resource")
+	}
+	pvc := newPVC(PVCForVMI(vmi), ownerReferencesForVMI(vmi), clusterConfig.GetVMStateStorageAccessMode(), &storageClass)
+
+	_, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if errors.IsAlreadyExists(err) {
 		return nil
 	}
 
 	return err
 }
+
+// CreateMigrationTargetIfNeeded returns the backend storage PVC the migration target pod of the VMI has to use.
+// When the PVC holding the state can not be shared between nodes, the alternate PVC of the VMI is created and
+// the state gets copied to it during the migration.
+func CreateMigrationTargetIfNeeded(vmi *corev1.VirtualMachineInstance, client kubecli.KubevirtClient, pvcStore cache.Store) (string, error) {
+	if !IsBackendStorageNeededForVMI(vmi) {
+		return "", nil
+	}
+
+	sourceName := CurrentPVCName(vmi, pvcStore)
+	source, err := getPVCFromStore(pvcStore, vmi.Namespace, sourceName)
+	if err != nil {
+		return "", err
+	}
+	if source == nil {
+		return "", fmt.Errorf("backend storage PVC %s/%s not found", vmi.Namespace, sourceName)
+	}
+	if isShared(source) {
+		return sourceName, nil
+	}
+
+	targetName := AlternatePVCForVMI(vmi)
+	if sourceName == targetName {
+		targetName = PVCForVMI(vmi)
+	}
+
+	target, err := getPVCFromStore(pvcStore, vmi.Namespace, targetName)
+	if err != nil {
+		return "", err
+	}
+	if target != nil {
+		if target.DeletionTimestamp != nil {
+			return "", fmt.Errorf("waiting for the backend storage PVC %s/%s of a previous migration to be deleted", vmi.Namespace, targetName)
+		}
+		return targetName, nil
+	}
+
+	pvc := newPVC(targetName, source.OwnerReferences, source.Spec.AccessModes[0], source.Spec.StorageClassName)
+	_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	return targetName, nil
+}
+
+// CleanupAfterMigration deletes the backend storage PVC the VMI stopped using after a live migration
+// which copied its state from a PVC to another one.
+func CleanupAfterMigration(vmi *corev1.VirtualMachineInstance, client kubecli.KubevirtClient, pvcStore cache.Store) error {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || migrationState.EndTimestamp == nil ||
+		migrationState.SourcePersistentStatePVCName == migrationState.TargetPersistentStatePVCName {
+		return nil
+	}
+
+	unused := migrationState.TargetPersistentStatePVCName
+	if migrationState.Completed && !migrationState.Failed {
+		unused = migrationState.SourcePersistentStatePVCName
+	}
+
+	pvc, err := getPVCFromStore(pvcStore, vmi.Namespace, unused)
+	if err != nil || pvc == nil || pvc.DeletionTimestamp != nil {
+		return err
+	}
+
+	err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Delete(context.Background(), unused, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// OrphanIfRetained releases the backend storage PVCs of a VM being deleted from its ownership when the
+// persistent state has to be retained, so that they are not garbage collected together with the VM.
+func OrphanIfRetained(vm *corev1.VirtualMachine, client kubecli.KubevirtClient, pvcStore cache.Store) error {
+	if !IsBackendStorageNeededForVM(vm) || !isStateRetained(&vm.Spec.Template.Spec) {
+		return nil
+	}
+
+	vmi := &corev1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: vm.Name, Namespace: vm.Namespace}}
+	for _, name := range pvcsForVMI(vmi) {
+		pvc, err := getPVCFromStore(pvcStore, vm.Namespace, name)
+		if err != nil {
+			return err
+		}
+		if pvc == nil || pvc.DeletionTimestamp != nil {
+			continue
+		}
+
+		var ownerReferences []metav1.OwnerReference
+		for _, ownerReference := range pvc.OwnerReferences {
+			if ownerReference.UID != vm.UID {
+				ownerReferences = append(ownerReferences, ownerReference)
+			}
+		}
+		if len(ownerReferences) == len(pvc.OwnerReferences) {
+			continue
+		}
+
+		patchBytes, err := patch.GenerateTestReplacePatch("/metadata/ownerReferences", pvc.OwnerReferences, ownerReferences)
+		if err != nil {
+			return err
+		}
+		_, err = client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package backendstorage
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Backend storage", func() {
+	const namespace = "default"
+
+	var (
+		k8sClient  *fake.Clientset
+		virtClient *kubecli.MockKubevirtClient
+		pvcStore   cache.Store
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	newStatePVC := func(name string, accessMode k8sv1.PersistentVolumeAccessMode, age time.Duration) *k8sv1.PersistentVolumeClaim {
+		pvc := newPVC(name, nil, accessMode, nil)
+		pvc.Namespace = namespace
+		pvc.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+		return pvc
+	}
+
+	addPVC := func(pvc *k8sv1.PersistentVolumeClaim) {
+		Expect(pvcStore.Add(pvc)).To(Succeed())
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	pvcExists := func(name string) bool {
+		_, err := k8sClient.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		pvcStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		persistent := true
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: namespace},
+		}
+		vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: &persistent}
+	})
+
+	Context("CurrentPVCName", func() {
+		It("should default to the primary PVC", func() {
+			Expect(CurrentPVCName(vmi, pvcStore)).To(Equal(PVCForVMI(vmi)))
+		})
+
+		It("should pick the oldest PVC", func() {
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Minute))
+			addPVC(newStatePVC(AlternatePVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour))
+			Expect(CurrentPVCName(vmi, pvcStore)).To(Equal(AlternatePVCForVMI(vmi)))
+		})
+
+		It("should ignore PVCs being deleted", func() {
+			pvc := newStatePVC(AlternatePVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour)
+			pvc.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			addPVC(pvc)
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Minute))
+			Expect(CurrentPVCName(vmi, pvcStore)).To(Equal(PVCForVMI(vmi)))
+		})
+
+		DescribeTable("should follow the migration state", func(completed, failed bool, expected string) {
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				Completed:                    completed,
+				Failed:                       failed,
+				SourcePersistentStatePVCName: "source",
+				TargetPersistentStatePVCName: "target",
+			}
+			Expect(CurrentPVCName(vmi, pvcStore)).To(Equal(expected))
+		},
+			Entry("while migrating", false, false, "source"),
+			Entry("after a successful migration", true, false, "target"),
+			Entry("after a failed migration", true, true, "source"),
+		)
+	})
+
+	Context("CreateMigrationTargetIfNeeded", func() {
+		It("should reuse a shared PVC", func() {
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteMany, time.Hour))
+			name, err := CreateMigrationTargetIfNeeded(vmi, virtClient, pvcStore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal(PVCForVMI(vmi)))
+			Expect(pvcExists(AlternatePVCForVMI(vmi))).To(BeFalse())
+		})
+
+		It("should create the alternate PVC for a non-shared PVC", func() {
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour))
+			name, err := CreateMigrationTargetIfNeeded(vmi, virtClient, pvcStore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal(AlternatePVCForVMI(vmi)))
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+		})
+
+		It("should move the state back to the primary PVC", func() {
+			addPVC(newStatePVC(AlternatePVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour))
+			name, err := CreateMigrationTargetIfNeeded(vmi, virtClient, pvcStore)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal(PVCForVMI(vmi)))
+			Expect(pvcExists(PVCForVMI(vmi))).To(BeTrue())
+		})
+
+		It("should wait for the PVC of a previous migration to be deleted", func() {
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour))
+			pvc := newStatePVC(AlternatePVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Minute)
+			pvc.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			addPVC(pvc)
+			_, err := CreateMigrationTargetIfNeeded(vmi, virtClient, pvcStore)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("CleanupAfterMigration", func() {
+		BeforeEach(func() {
+			addPVC(newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour))
+			addPVC(newStatePVC(AlternatePVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Minute))
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				SourcePersistentStatePVCName: PVCForVMI(vmi),
+				TargetPersistentStatePVCName: AlternatePVCForVMI(vmi),
+			}
+		})
+
+		It("should keep both PVCs while migrating", func() {
+			Expect(CleanupAfterMigration(vmi, virtClient, pvcStore)).To(Succeed())
+			Expect(pvcExists(PVCForVMI(vmi))).To(BeTrue())
+			Expect(pvcExists(AlternatePVCForVMI(vmi))).To(BeTrue())
+		})
+
+		It("should delete the source PVC after a successful migration", func() {
+			vmi.Status.MigrationState.EndTimestamp = pointerToNow()
+			vmi.Status.MigrationState.Completed = true
+			Expect(CleanupAfterMigration(vmi, virtClient, pvcStore)).To(Succeed())
+			Expect(pvcExists(PVCForVMI(vmi))).To(BeFalse())
+			Expect(pvcExists(AlternatePVCForVMI(vmi))).To(BeTrue())
+		})
+
+		It("should delete the target PVC after a failed migration", func() {
+			vmi.Status.MigrationState.EndTimestamp = pointerToNow()
+			vmi.Status.MigrationState.Completed = true
+			vmi.Status.MigrationState.Failed = true
+			Expect(CleanupAfterMigration(vmi, virtClient, pvcStore)).To(Succeed())
+			Expect(pvcExists(PVCForVMI(vmi))).To(BeTrue())
+			Expect(pvcExists(AlternatePVCForVMI(vmi))).To(BeFalse())
+		})
+	})
+
+	Context("OrphanIfRetained", func() {
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			vm = &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: namespace, UID: "vm-uid"},
+				Spec: v1.VirtualMachineSpec{
+					Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+				},
+			}
+			pvc := newStatePVC(PVCForVMI(vmi), k8sv1.ReadWriteOnce, time.Hour)
+			pvc.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+			addPVC(pvc)
+		})
+
+		getOwnerReferences := func() []metav1.OwnerReference {
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), PVCForVMI(vmi), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return pvc.OwnerReferences
+		}
+
+		It("should keep the owner of a deleted state", func() {
+			Expect(OrphanIfRetained(vm, virtClient, pvcStore)).To(Succeed())
+			Expect(getOwnerReferences()).To(HaveLen(1))
+		})
+
+		It("should orphan a retained state", func() {
+			vm.Spec.Template.Spec.Domain.Devices.TPM.StateRetentionPolicy = v1.TPMStateRetentionPolicyRetain
+			Expect(OrphanIfRetained(vm, virtClient, pvcStore)).To(Succeed())
+			Expect(getOwnerReferences()).To(BeEmpty())
+		})
+	})
+
+	It("should be needed by a persistent EFI NVRAM", func() {
+		persistent := true
+		vmi.Spec.Domain.Devices.TPM = nil
+		Expect(IsBackendStorageNeededForVMI(vmi)).To(BeFalse())
+		vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: &persistent}}}
+		Expect(IsBackendStorageNeededForVMI(vmi)).To(BeTrue())
+	})
+
+	It("should not own a retained state of a standalone VMI", func() {
+		vmi.Spec.Domain.Devices.TPM.StateRetentionPolicy = v1.TPMStateRetentionPolicyRetain
+		Expect(ownerReferencesForVMI(vmi)).To(BeEmpty())
+	})
+})
+
+func pointerToNow() *metav1.Time {
+	now := metav1.Now()
+	return &now
+}
@@ -163,6 +163,7 @@ func dirURI(pvc *corev1.PersistentVolumeClaim) string {
 
 type sourceVolumes struct {
 	volumes          []*corev1.PersistentVolumeClaim
+	nbdVolumes       []nbdVolume
 	inUse            bool
 	isPopulated      bool
 	availableMessage string
@@ -182,9 +183,11 @@ type VMExportController struct {
 	PVCInformer                 cache.SharedIndexInformer
 	VMSnapshotInformer          cache.SharedIndexInformer
 	VMSnapshotContentInformer   cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
 	PodInformer                 cache.SharedIndexInformer
 	DataVolumeInformer          cache.SharedIndexInformer
 	ConfigMapInformer           cache.SharedIndexInformer
+	KubeVirtCAConfigMapInformer cache.SharedIndexInformer
 	ServiceInformer             cache.SharedIndexInformer
 	VMInformer                  cache.SharedIndexInformer
 	VMIInformer                 cache.SharedIndexInformer
@@ -306,6 +309,16 @@ func (ctrl *VMExportController) Init() error {
 	if err != nil {
 		return err
 	}
+	_, err = ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+	if err != nil {
+		return err
+	}
 	_, err = ctrl.VMIInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVMI,
@@ -362,11 +375,13 @@ func (ctrl *VMExportController) Run(threadiness int, stopCh <-chan struct{}) err
 		ctrl.PodInformer.HasSynced,
 		ctrl.DataVolumeInformer.HasSynced,
 		ctrl.ConfigMapInformer.HasSynced,
+		ctrl.KubeVirtCAConfigMapInformer.HasSynced,
 		ctrl.ServiceInformer.HasSynced,
 		ctrl.RouteConfigMapInformer.HasSynced,
 		ctrl.SecretInformer.HasSynced,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMBackupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -513,6 +528,9 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 	if ctrl.isSourceVM(&vmExport.Spec) {
 		return ctrl.handleSource(vmExport, service, ctrl.getPVCFromSourceVM, ctrl.updateVMExportVMStatus)
 	}
+	if ctrl.isSourceVMBackup(&vmExport.Spec) {
+		return ctrl.handleSource(vmExport, service, ctrl.getVolumesFromSourceVMBackup, ctrl.updateVMExportVMBackupStatus)
+	}
 	return 0, nil
 }
 
@@ -541,8 +559,8 @@ func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMach
 	}
 	if !podExists {
 		if sourceVolumes.isSourceAvailable() {
-			if len(sourceVolumes.volumes) > 0 {
-				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes)
+			if len(sourceVolumes.volumes) > 0 || len(sourceVolumes.nbdVolumes) > 0 {
+				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes)
 				if err != nil {
 					return nil, err
 				}
@@ -670,6 +688,16 @@ func (ctrl *VMExportController) createCertSecretManifest(vmExport *exportv1.Virt
 		certParams.Duration,
 	)
 
+	data := map[string][]byte{
+		"tls.crt": cert.EncodeCertPEM(keyPair.Cert),
+		"tls.key": cert.EncodePrivateKeyPEM(keyPair.Key),
+	}
+	if usesBackupNBD(ownerPod) {
+		if err := ctrl.addBackupClientCertificate(data, caKeyPair, vmExport.Namespace, certParams.Duration); err != nil {
+			return nil, err
+		}
+	}
+
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      ctrl.getExportSecretName(ownerPod),
@@ -683,10 +711,7 @@ func (ctrl *VMExportController) createCertSecretManifest(vmExport *exportv1.Virt
 			},
 		},
 		Type: corev1.SecretTypeTLS,
-		Data: map[string][]byte{
-			"tls.crt": cert.EncodeCertPEM(keyPair.Cert),
-			"tls.key": cert.EncodePrivateKeyPEM(keyPair.Key),
-		},
+		Data: data,
 	}, nil
 }
 
@@ -827,14 +852,14 @@ func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachine
 	}
 }
 
-func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, sourceVolumes *sourceVolumes) (*corev1.Pod, error) {
 	log.Log.V(3).Infof("Checking if pod exists: %s/%s", vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	if obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(key); err != nil {
 		log.Log.Errorf("error %v", err)
 		return nil, err
 	} else if !exists {
-		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs)
+		manifest, err := ctrl.createExporterPodManifest(vmExport, service, sourceVolumes)
 		if err != nil {
 			return nil, err
 		}
@@ -852,7 +877,7 @@ func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMach
 	}
 }
 
-func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, sourceVolumes *sourceVolumes) (*corev1.Pod, error) {
 	certParams, err := ctrl.getCertParams()
 	if err != nil {
 		return nil, err
@@ -872,7 +897,7 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		FSGroup:        pointer.Int64Ptr(kvm),
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
-	for i, pvc := range pvcs {
+	for i, pvc := range sourceVolumes.volumes {
 		var mountPoint string
 		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
 			mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, pvc.Name)
@@ -898,6 +923,12 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		})
 		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
 	}
+	for i, volume := range sourceVolumes.nbdVolumes {
+		ctrl.addNBDVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], volume, len(sourceVolumes.volumes)+i)
+	}
+	if len(sourceVolumes.nbdVolumes) > 0 {
+		addBackupClientEnvironmentVariables(&podManifest.Spec.Containers[0])
+	}
 
 	// Add token and certs ENV variables
 	podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
@@ -1158,11 +1189,11 @@ func (ctrl *VMExportController) updateCommonVMExportStatusFields(vmExport, vmExp
 		if exporterPod.Status.Phase == corev1.PodRunning {
 			vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionTrue, podReadyReason, ""))
 			vmExportCopy.Status.Phase = exportv1.Ready
-			vmExportCopy.Status.Links.Internal, err = ctrl.getInteralLinks(sourceVolumes.volumes, exporterPod, service, getVolumeName, vmExport)
+			vmExportCopy.Status.Links.Internal, err = ctrl.getInteralLinks(sourceVolumes, exporterPod, service, getVolumeName, vmExport)
 			if err != nil {
 				return err
 			}
-			vmExportCopy.Status.Links.External, err = ctrl.getExternalLinks(sourceVolumes.volumes, exporterPod, getVolumeName, vmExport)
+			vmExportCopy.Status.Links.External, err = ctrl.getExternalLinks(sourceVolumes, exporterPod, getVolumeName, vmExport)
 			if err != nil {
 				return err
 			}
@@ -189,6 +189,7 @@ var _ = Describe("Export controller", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -234,6 +235,7 @@ var _ = Describe("Export controller", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
@@ -853,7 +855,7 @@ var _ = Describe("Export controller", func() {
 		})
 		service, err = controller.getOrCreateExportService(testVMExport)
 		Expect(err).ToNot(HaveOccurred())
-		pod, err := controller.createExporterPod(testVMExport, service, []*k8sv1.PersistentVolumeClaim{testPVC})
+		pod, err := controller.createExporterPod(testVMExport, service, &sourceVolumes{volumes: []*k8sv1.PersistentVolumeClaim{testPVC}})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(pod).ToNot(BeNil())
 		Expect(pod.Name).To(Equal(fmt.Sprintf("%s-%s", exportPrefix, testVMExport.Name)))
@@ -54,26 +54,26 @@ const (
 	external              = "external"
 )
 
-func (ctrl *VMExportController) getInteralLinks(pvcs []*corev1.PersistentVolumeClaim, exporterPod *corev1.Pod, service *corev1.Service, getVolumeName getExportVolumeName, export *exportv1.VirtualMachineExport) (*exportv1.VirtualMachineExportLink, error) {
+func (ctrl *VMExportController) getInteralLinks(sourceVolumes *sourceVolumes, exporterPod *corev1.Pod, service *corev1.Service, getVolumeName getExportVolumeName, export *exportv1.VirtualMachineExport) (*exportv1.VirtualMachineExportLink, error) {
 	internalCert, err := ctrl.internalExportCa()
 	if err != nil {
 		return nil, err
 	}
 	host := fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace)
-	return ctrl.getLinks(pvcs, exporterPod, export, host, internal, internalCert, getVolumeName)
+	return ctrl.getLinks(sourceVolumes, exporterPod, export, host, internal, internalCert, getVolumeName)
 }
 
-func (ctrl *VMExportController) getExternalLinks(pvcs []*corev1.PersistentVolumeClaim, exporterPod *corev1.Pod, getVolumeName getExportVolumeName, export *exportv1.VirtualMachineExport) (*exportv1.VirtualMachineExportLink, error) {
+func (ctrl *VMExportController) getExternalLinks(sourceVolumes *sourceVolumes, exporterPod *corev1.Pod, getVolumeName getExportVolumeName, export *exportv1.VirtualMachineExport) (*exportv1.VirtualMachineExportLink, error) {
 	urlPath := fmt.Sprintf(externalUrlLinkFormat, export.Namespace, export.Name)
 	externalLinkHost, cert := ctrl.getExternalLinkHostAndCert()
 	if externalLinkHost != "" {
 		hostAndBase := path.Join(externalLinkHost, urlPath)
-		return ctrl.getLinks(pvcs, exporterPod, export, hostAndBase, external, cert, getVolumeName)
+		return ctrl.getLinks(sourceVolumes, exporterPod, export, hostAndBase, external, cert, getVolumeName)
 	}
 	return nil, nil
 }
 
-func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, exporterPod *corev1.Pod, export *exportv1.VirtualMachineExport, hostAndBase, linkType, cert string, getVolumeName getExportVolumeName) (*exportv1.VirtualMachineExportLink, error) {
+func (ctrl *VMExportController) getLinks(sourceVolumes *sourceVolumes, exporterPod *corev1.Pod, export *exportv1.VirtualMachineExport, hostAndBase, linkType, cert string, getVolumeName getExportVolumeName) (*exportv1.VirtualMachineExportLink, error) {
 	const scheme = "https://"
 	exportLink := &exportv1.VirtualMachineExportLink{
 		Volumes: []exportv1.VirtualMachineExportVolume{},
@@ -89,7 +89,7 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			},
 		},
 	}
-	for _, pvc := range pvcs {
+	for _, pvc := range sourceVolumes.volumes {
 		if pvc != nil && exporterPod != nil && exporterPod.Status.Phase == corev1.PodRunning {
 
 			if ctrl.isKubevirtContentType(pvc) {
@@ -123,6 +123,23 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 			}
 		}
 	}
+	for _, volume := range sourceVolumes.nbdVolumes {
+		if exporterPod != nil && exporterPod.Status.Phase == corev1.PodRunning {
+			exportLink.Volumes = append(exportLink.Volumes, exportv1.VirtualMachineExportVolume{
+				Name: volume.name,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtRaw,
+						Url:    scheme + path.Join(hostAndBase, nbdRawURI(volume)),
+					},
+					{
+						Format: exportv1.Extents,
+						Url:    scheme + path.Join(hostAndBase, extentsURI(volume)),
+					},
+				},
+			})
+		}
+	}
 	return exportLink, nil
 }
 
@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package export
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/controller"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	noVolumeVMBackupReason = "VMBackupNoVolumes"
+
+	// virtHandlerBackupServerPort is the port virt-handler proxies the NBD servers of pull mode backups on
+	virtHandlerBackupServerPort = 8187
+	backupNBDTemplateURI        = "wss://%s/v1/namespaces/%s/virtualmachineinstances/%s/backupnbd"
+
+	backupClientCertKey = "backup-client.crt"
+	backupClientKeyKey  = "backup-client.key"
+	backupCAKey         = "backup-ca.crt"
+)
+
+// nbdVolume is a disk of a pull mode backup, served over NBD by the launcher pod
+// of the VM on a unix socket that virt-handler proxies
+type nbdVolume struct {
+	name string
+	// uri is the virt-handler endpoint proxying the NBD server
+	uri        string
+	exportName string
+	// dirtyBitmap is set when only the blocks changed since the previous backup have to be read
+	dirtyBitmap string
+}
+
+func nbdRawURI(volume nbdVolume) string {
+	return path.Join(urlBasePath, volume.name, "disk.img")
+}
+
+func extentsURI(volume nbdVolume) string {
+	return path.Join(urlBasePath, volume.name, "extents")
+}
+
+func (ctrl *VMExportController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackup, ok := obj.(*snapshotv1.VirtualMachineBackup); ok {
+		vmBackupKey, _ := cache.MetaNamespaceKeyFunc(vmBackup)
+		keys, err := ctrl.VMExportInformer.GetIndexer().IndexKeys("vmbackup", vmBackupKey)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		for _, key := range keys {
+			log.Log.V(3).Infof("Adding VMExport due to VMBackup %s", vmBackupKey)
+			ctrl.vmExportQueue.Add(key)
+		}
+	}
+}
+
+func (ctrl *VMExportController) getVolumesFromSourceVMBackup(vmExport *exportv1.VirtualMachineExport) (*sourceVolumes, error) {
+	vmBackup, exists, err := ctrl.getVmBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if !exists {
+		return &sourceVolumes{
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("VirtualMachineBackup %s/%s does not exist", vmExport.Namespace, vmExport.Spec.Source.Name)}, nil
+	}
+	if vmBackup.Spec.Mode != nil && *vmBackup.Spec.Mode != virtv1.BackupModePull {
+		return &sourceVolumes{
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("VirtualMachineBackup %s/%s is not a pull mode backup", vmExport.Namespace, vmExport.Spec.Source.Name)}, nil
+	}
+	if vmBackup.Status == nil || vmBackup.Status.ReadyToUse == nil || !*vmBackup.Status.ReadyToUse {
+		return &sourceVolumes{
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("VirtualMachineBackup %s/%s is not ready to use", vmExport.Namespace, vmExport.Spec.Source.Name)}, nil
+	}
+
+	handlerIP, err := ctrl.getVirtHandlerIP(vmBackup.Namespace, vmBackup.Spec.Source.Name)
+	if err != nil {
+		return &sourceVolumes{}, err
+	}
+	if handlerIP == "" {
+		return &sourceVolumes{
+			isPopulated:      false,
+			availableMessage: fmt.Sprintf("Waiting for the launcher pod of VirtualMachine %s/%s", vmBackup.Namespace, vmBackup.Spec.Source.Name)}, nil
+	}
+
+	var volumes []nbdVolume
+	host := net.JoinHostPort(handlerIP, strconv.Itoa(virtHandlerBackupServerPort))
+	uri := fmt.Sprintf(backupNBDTemplateURI, host, vmBackup.Namespace, vmBackup.Spec.Source.Name)
+	for _, volume := range vmBackup.Status.Volumes {
+		volumes = append(volumes, nbdVolume{
+			name:        volume.VolumeName,
+			uri:         uri,
+			exportName:  volume.ExportName,
+			dirtyBitmap: volume.DirtyBitmap,
+		})
+	}
+	return &sourceVolumes{
+		nbdVolumes:       volumes,
+		isPopulated:      true,
+		availableMessage: ""}, nil
+}
+
+// getVirtHandlerIP returns the IP of virt-handler on the node of the running
+// launcher pod of the VMI, which proxies the NBD server of a backup
+func (ctrl *VMExportController) getVirtHandlerIP(namespace, name string) (string, error) {
+	vmi, exists, err := ctrl.getVmi(namespace, name)
+	if err != nil || !exists {
+		return "", err
+	}
+	pod, err := controller.CurrentVMIPod(vmi, ctrl.PodInformer)
+	if err != nil || pod == nil || pod.Status.Phase != corev1.PodRunning {
+		return "", err
+	}
+	objs, err := ctrl.PodInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ctrl.KubevirtNamespace)
+	if err != nil {
+		return "", err
+	}
+	for _, obj := range objs {
+		handlerPod := obj.(*corev1.Pod)
+		if handlerPod.Labels[virtv1.AppLabel] == "virt-handler" && handlerPod.Spec.NodeName == pod.Spec.NodeName &&
+			handlerPod.Status.Phase == corev1.PodRunning {
+			return handlerPod.Status.PodIP, nil
+		}
+	}
+	return "", nil
+}
+
+func (ctrl *VMExportController) updateVMExportVMBackupStatus(vmExport *exportv1.VirtualMachineExport, exporterPod *corev1.Pod, service *corev1.Service, sourceVolumes *sourceVolumes) (time.Duration, error) {
+	var requeue time.Duration
+
+	vmExportCopy := vmExport.DeepCopy()
+	if vmBackup, exists, err := ctrl.getVmBackup(vmExport.Namespace, vmExport.Spec.Source.Name); err != nil {
+		return requeue, err
+	} else if exists {
+		vmExportCopy.Status.VirtualMachineName = pointer.StringPtr(vmBackup.Spec.Source.Name)
+	}
+
+	if err := ctrl.updateCommonVMExportStatusFields(vmExport, vmExportCopy, exporterPod, service, sourceVolumes, getVolumeName); err != nil {
+		return requeue, err
+	}
+	if sourceVolumes.isPopulated && len(sourceVolumes.nbdVolumes) == 0 {
+		vmExportCopy.Status.Conditions = updateCondition(vmExportCopy.Status.Conditions, newReadyCondition(corev1.ConditionFalse, noVolumeVMBackupReason, "VirtualMachineBackup does not contain any volumes"))
+		vmExportCopy.Status.Phase = exportv1.Skipped
+	}
+	if !sourceVolumes.isPopulated {
+		requeue = requeueTime
+	}
+	if err := ctrl.updateVMExportStatus(vmExport, vmExportCopy); err != nil {
+		return requeue, err
+	}
+	return requeue, nil
+}
+
+func (ctrl *VMExportController) addNBDVolumeEnvironmentVariables(exportContainer *corev1.Container, volume nbdVolume, index int) {
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_NBD_URI", index),
+		Value: volume.uri,
+	}, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_NBD_NAME", index),
+		Value: volume.exportName,
+	}, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_URI", index),
+		Value: nbdRawURI(volume),
+	}, corev1.EnvVar{
+		Name:  fmt.Sprintf("VOLUME%d_EXPORT_EXTENTS_URI", index),
+		Value: extentsURI(volume),
+	})
+	if volume.dirtyBitmap != "" {
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_DIRTY_BITMAP", index),
+			Value: volume.dirtyBitmap,
+		})
+	}
+}
+
+func addBackupClientEnvironmentVariables(exportContainer *corev1.Container) {
+	exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+		Name:  "BACKUP_CLIENT_CERT_FILE",
+		Value: "/cert/" + backupClientCertKey,
+	}, corev1.EnvVar{
+		Name:  "BACKUP_CLIENT_KEY_FILE",
+		Value: "/cert/" + backupClientKeyKey,
+	}, corev1.EnvVar{
+		Name:  "BACKUP_CA_FILE",
+		Value: "/cert/" + backupCAKey,
+	})
+}
+
+// usesBackupNBD tells whether the exporter pod reads the NBD servers of pull mode backups
+func usesBackupNBD(pod *corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		for _, env := range container.Env {
+			if strings.HasSuffix(env.Name, "_EXPORT_NBD_URI") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addBackupClientCertificate lets the exporter read the NBD servers of the pull
+// mode backups of its namespace through virt-handler
+func (ctrl *VMExportController) addBackupClientCertificate(data map[string][]byte, caKeyPair *triple.KeyPair, namespace string, duration time.Duration) error {
+	kubevirtCA, err := ctrl.kubevirtCA()
+	if err != nil {
+		return err
+	}
+	if kubevirtCA == "" {
+		return fmt.Errorf("KubeVirt CA bundle not found")
+	}
+	keyPair, err := triple.NewClientKeyPair(caKeyPair, kvtls.BackupClientCommonName, []string{namespace}, duration)
+	if err != nil {
+		return err
+	}
+	data[backupClientCertKey] = cert.EncodeCertPEM(keyPair.Cert)
+	data[backupClientKeyKey] = cert.EncodePrivateKeyPEM(keyPair.Key)
+	data[backupCAKey] = []byte(kubevirtCA)
+	return nil
+}
+
+// kubevirtCA returns the bundle of the CA issuing the virt-handler certificates
+func (ctrl *VMExportController) kubevirtCA() (string, error) {
+	key := controller.NamespacedKey(ctrl.KubevirtNamespace, components.KubeVirtCASecretName)
+	obj, exists, err := ctrl.KubeVirtCAConfigMapInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return "", err
+	}
+	return strings.TrimSpace(obj.(*corev1.ConfigMap).Data[caBundle]), nil
+}
+
+func (ctrl *VMExportController) isSourceVMBackup(source *exportv1.VirtualMachineExportSpec) bool {
+	return source != nil && source.Source.APIGroup != nil && *source.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group && source.Source.Kind == "VirtualMachineBackup"
+}
+
+func (ctrl *VMExportController) getVmBackup(namespace, name string) (*snapshotv1.VirtualMachineBackup, bool, error) {
+	key := controller.NamespacedKey(namespace, name)
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*snapshotv1.VirtualMachineBackup).DeepCopy(), true, nil
+}
@@ -0,0 +1,285 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+package export
+
+import (
+	"crypto/x509"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	testVMBackupName       = "test-vmbackup"
+	testVMBackupExportName = "test-vmbackup-export"
+	testHandlerIP          = "10.244.0.15"
+	testNodeName           = "node01"
+	testBackupNBDURI       = "wss://" + testHandlerIP + ":8187/v1/namespaces/" + testNamespace + "/virtualmachineinstances/" + testVmName + "/backupnbd"
+)
+
+var _ = Describe("VMBackup source", func() {
+	var (
+		controller       *VMExportController
+		podInformer      cache.SharedIndexInformer
+		caInformer       cache.SharedIndexInformer
+		vmiInformer      cache.SharedIndexInformer
+		vmBackupInformer cache.SharedIndexInformer
+		vmExportInformer cache.SharedIndexInformer
+	)
+
+	BeforeEach(func() {
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		caInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
+		vmExportInformer, _ = testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineExport{}, virtcontroller.GetVirtualMachineExportInformerIndexers())
+
+		controller = &VMExportController{
+			PodInformer:                 podInformer,
+			VMIInformer:                 vmiInformer,
+			VMBackupInformer:            vmBackupInformer,
+			VMExportInformer:            vmExportInformer,
+			KubeVirtCAConfigMapInformer: caInformer,
+			KubevirtNamespace:           "kubevirt",
+			vmExportQueue:               workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		}
+	})
+
+	createTestVMBackup := func(ready bool) *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVMBackupName,
+				Namespace: testNamespace,
+			},
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: pointer.String("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     testVmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineBackupStatus{
+				ReadyToUse: pointer.Bool(ready),
+				Volumes: []virtv1.VirtualMachineInstanceBackupVolume{
+					{
+						VolumeName: "rootdisk",
+						ExportName: "rootdisk",
+					},
+					{
+						VolumeName:  "datadisk",
+						ExportName:  "datadisk",
+						DirtyBitmap: "backup-datadisk",
+					},
+				},
+			},
+		}
+	}
+
+	createTestVMBackupExport := func() *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVMBackupExportName,
+				Namespace: testNamespace,
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: pointer.String(snapshotv1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachineBackup",
+					Name:     testVMBackupName,
+				},
+			},
+		}
+	}
+
+	addLauncherPod := func(phase k8sv1.PodPhase) {
+		vmi := &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVmName,
+				Namespace: testNamespace,
+				UID:       "vmi-uid",
+			},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		Expect(podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-launcher-" + testVmName,
+				Namespace: testNamespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind),
+				},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: testNodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: phase,
+				PodIP: "10.244.0.16",
+			},
+		})).To(Succeed())
+	}
+
+	addHandlerPod := func(nodeName string) {
+		Expect(podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-handler-" + nodeName,
+				Namespace: "kubevirt",
+				Labels:    map[string]string{virtv1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: testHandlerIP,
+			},
+		})).To(Succeed())
+	}
+
+	It("should recognize a VMBackup source", func() {
+		vmExport := createTestVMBackupExport()
+		Expect(controller.isSourceVMBackup(&vmExport.Spec)).To(BeTrue())
+		vmExport.Spec.Source.Kind = "VirtualMachineSnapshot"
+		Expect(controller.isSourceVMBackup(&vmExport.Spec)).To(BeFalse())
+	})
+
+	It("should queue the export when the VMBackup changes", func() {
+		Expect(vmExportInformer.GetStore().Add(createTestVMBackupExport())).To(Succeed())
+		controller.handleVMBackup(createTestVMBackup(true))
+		Expect(controller.vmExportQueue.Len()).To(Equal(1))
+	})
+
+	DescribeTable("should not populate the volumes", func(prepare func() *snapshotv1.VirtualMachineBackup, expectedMessage string) {
+		if vmBackup := prepare(); vmBackup != nil {
+			Expect(vmBackupInformer.GetStore().Add(vmBackup)).To(Succeed())
+		}
+		volumes, err := controller.getVolumesFromSourceVMBackup(createTestVMBackupExport())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumes.isPopulated).To(BeFalse())
+		Expect(volumes.availableMessage).To(ContainSubstring(expectedMessage))
+	},
+		Entry("when the VMBackup does not exist", func() *snapshotv1.VirtualMachineBackup {
+			return nil
+		}, "does not exist"),
+		Entry("when the VMBackup is a push mode backup", func() *snapshotv1.VirtualMachineBackup {
+			vmBackup := createTestVMBackup(true)
+			pushMode := virtv1.BackupModePush
+			vmBackup.Spec.Mode = &pushMode
+			return vmBackup
+		}, "is not a pull mode backup"),
+		Entry("when the VMBackup is not ready", func() *snapshotv1.VirtualMachineBackup {
+			return createTestVMBackup(false)
+		}, "is not ready to use"),
+		Entry("when the launcher pod is not running", func() *snapshotv1.VirtualMachineBackup {
+			addLauncherPod(k8sv1.PodPending)
+			addHandlerPod(testNodeName)
+			return createTestVMBackup(true)
+		}, "Waiting for the launcher pod"),
+		Entry("when virt-handler is not running on the node of the launcher pod", func() *snapshotv1.VirtualMachineBackup {
+			addLauncherPod(k8sv1.PodRunning)
+			addHandlerPod("node02")
+			return createTestVMBackup(true)
+		}, "Waiting for the launcher pod"),
+	)
+
+	It("should populate NBD volumes proxied by virt-handler", func() {
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(true))).To(Succeed())
+		addLauncherPod(k8sv1.PodRunning)
+		addHandlerPod(testNodeName)
+
+		volumes, err := controller.getVolumesFromSourceVMBackup(createTestVMBackupExport())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumes.isPopulated).To(BeTrue())
+		Expect(volumes.nbdVolumes).To(Equal([]nbdVolume{
+			{name: "rootdisk", uri: testBackupNBDURI, exportName: "rootdisk"},
+			{name: "datadisk", uri: testBackupNBDURI, exportName: "datadisk", dirtyBitmap: "backup-datadisk"},
+		}))
+	})
+
+	It("should pass the NBD volumes to the exporter container", func() {
+		container := &k8sv1.Container{}
+		controller.addNBDVolumeEnvironmentVariables(container, nbdVolume{
+			name:        "datadisk",
+			uri:         testBackupNBDURI,
+			exportName:  "datadisk",
+			dirtyBitmap: "backup-datadisk",
+		}, 0)
+		Expect(container.Env).To(ConsistOf(
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_NBD_URI", Value: testBackupNBDURI},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_NBD_NAME", Value: "datadisk"},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_RAW_URI", Value: "/volumes/datadisk/disk.img"},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_EXTENTS_URI", Value: "/volumes/datadisk/extents"},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_DIRTY_BITMAP", Value: "backup-datadisk"},
+		))
+	})
+
+	Context("exporter certificate", func() {
+		var caKeyPair *triple.KeyPair
+
+		BeforeEach(func() {
+			var err error
+			caKeyPair, err = triple.NewCA("export", time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should only be needed by exporters reading NBD volumes", func() {
+			pod := &k8sv1.Pod{Spec: k8sv1.PodSpec{Containers: []k8sv1.Container{{}}}}
+			Expect(usesBackupNBD(pod)).To(BeFalse())
+			controller.addNBDVolumeEnvironmentVariables(&pod.Spec.Containers[0], nbdVolume{name: "datadisk", uri: testBackupNBDURI, exportName: "datadisk"}, 0)
+			Expect(usesBackupNBD(pod)).To(BeTrue())
+		})
+
+		It("should issue a client certificate bound to the namespace of the export", func() {
+			Expect(caInformer.GetStore().Add(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "kubevirt-ca", Namespace: "kubevirt"},
+				Data:       map[string]string{"ca-bundle": "kubevirt-ca-bundle"},
+			})).To(Succeed())
+
+			data := map[string][]byte{}
+			Expect(controller.addBackupClientCertificate(data, caKeyPair, testNamespace, time.Hour)).To(Succeed())
+			Expect(string(data["backup-ca.crt"])).To(Equal("kubevirt-ca-bundle"))
+			Expect(data).To(HaveKey("backup-client.key"))
+			certs, err := cert.ParseCertsPEM(data["backup-client.crt"])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(certs).To(HaveLen(1))
+			Expect(certs[0].Subject.CommonName).To(Equal("kubevirt.io:system:client:virt-exportserver"))
+			Expect(certs[0].Subject.Organization).To(ConsistOf(testNamespace))
+			Expect(certs[0].ExtKeyUsage).To(ConsistOf(x509.ExtKeyUsageClientAuth))
+		})
+
+		It("should fail without the KubeVirt CA bundle", func() {
+			Expect(controller.addBackupClientCertificate(map[string][]byte{}, caKeyPair, testNamespace, time.Hour)).To(MatchError("KubeVirt CA bundle not found"))
+		})
+	})
+})
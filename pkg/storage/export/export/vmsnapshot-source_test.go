@@ -116,6 +116,7 @@ var _ = Describe("VMSnapshot source", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -161,6 +162,7 @@ var _ = Describe("VMSnapshot source", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
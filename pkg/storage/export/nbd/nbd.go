@@ -0,0 +1,435 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+// Package nbd implements the subset of the NBD protocol client needed to read
+// the disks exported by a pull mode backup of QEMU: fixed newstyle negotiation,
+// structured replies, reads and block status queries of a single meta context.
+package nbd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+const (
+	nbdMagic          = 0x4e42444d41474943 // "NBDMAGIC"
+	optMagic          = 0x49484156454f5054 // "IHAVEOPT"
+	optReplyMagic     = 0x3e889045565a9
+	requestMagic      = 0x25609513
+	simpleReplyMagic  = 0x67446698
+	structReplyMagic  = 0x668e33ef
+	flagFixedNewstyle = 1 << 0
+	flagNoZeroes      = 1 << 1
+
+	optGo              = 7
+	optStructuredReply = 8
+	optSetMetaContext  = 10
+
+	repAck         = 1
+	repInfo        = 3
+	repMetaContext = 4
+	repErrorBit    = 1 << 31
+
+	infoExport = 0
+
+	cmdRead        = 0
+	cmdDisc        = 2
+	cmdBlockStatus = 7
+
+	replyFlagDone       = 1 << 0
+	replyTypeNone       = 0
+	replyTypeOffsetData = 1
+	replyTypeOffsetHole = 2
+	replyTypeBlockStat  = 5
+	replyTypeErrorBit   = 1 << 15
+
+	// MetaContextAllocation is the meta context describing allocated and zero blocks
+	MetaContextAllocation = "base:allocation"
+	// MetaContextDirtyBitmapPrefix is prepended to the name of a dirty bitmap to form its meta context
+	MetaContextDirtyBitmapPrefix = "qemu:dirty-bitmap:"
+
+	// StateHole is set in an extent of the allocation context when the blocks are not allocated
+	StateHole uint32 = 1 << 0
+	// StateZero is set in an extent of the allocation context when the blocks read as zeroes
+	StateZero uint32 = 1 << 1
+	// StateDirty is set in an extent of a dirty bitmap context when the blocks changed
+	StateDirty uint32 = 1 << 0
+
+	// maxRequestLength bounds the length of a single read request
+	maxRequestLength = 32 * 1024 * 1024
+)
+
+// Extent is a range of blocks sharing the same state flags in the selected meta context
+type Extent struct {
+	Offset int64
+	Length int64
+	Flags  uint32
+}
+
+// Client is a connection to a single NBD export. Requests are serialized.
+type Client struct {
+	conn        net.Conn
+	lock        sync.Mutex
+	cookie      uint64
+	size        int64
+	metaContext uint32
+	hasContext  bool
+}
+
+// NewClient negotiates the export over an established connection. When
+// metaContext is not empty it is negotiated so that BlockStatus can be used.
+func NewClient(conn net.Conn, export, metaContext string) (*Client, error) {
+	c := &Client{conn: conn}
+	if err := c.handshake(export, metaContext); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Size returns the size of the export in bytes
+func (c *Client) Size() int64 {
+	return c.size
+}
+
+// Close ends the transmission and closes the connection
+func (c *Client) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_ = c.sendRequest(cmdDisc, 0, 0)
+	return c.conn.Close()
+}
+
+// ReadAt reads len(p) bytes of the export starting at off
+func (c *Client) ReadAt(p []byte, off int64) (int, error) {
+	if off >= c.size {
+		return 0, io.EOF
+	}
+
+	var err error
+	if remaining := c.size - off; int64(len(p)) > remaining {
+		p = p[:remaining]
+		err = io.EOF
+	}
+
+	for done := 0; done < len(p); {
+		length := len(p) - done
+		if length > maxRequestLength {
+			length = maxRequestLength
+		}
+		if readErr := c.read(p[done:done+length], off+int64(done)); readErr != nil {
+			return done, readErr
+		}
+		done += length
+	}
+	return len(p), err
+}
+
+// BlockStatus returns the extents of the negotiated meta context covering at
+// least the first block of the given range. The server may describe less than
+// the requested length.
+func (c *Client) BlockStatus(offset int64, length uint32) ([]Extent, error) {
+	if !c.hasContext {
+		return nil, fmt.Errorf("no meta context negotiated")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.sendRequest(cmdBlockStatus, uint64(offset), length); err != nil {
+		return nil, err
+	}
+
+	var extents []Extent
+	err := c.readReplies(func(replyType uint16, payload []byte) error {
+		if replyType != replyTypeBlockStat {
+			return fmt.Errorf("unexpected reply type %d", replyType)
+		}
+		if len(payload) < 4 || (len(payload)-4)%8 != 0 {
+			return fmt.Errorf("invalid block status payload")
+		}
+		if binary.BigEndian.Uint32(payload) != c.metaContext {
+			return nil
+		}
+		pos := offset
+		for i := 4; i < len(payload); i += 8 {
+			extent := Extent{
+				Offset: pos,
+				Length: int64(binary.BigEndian.Uint32(payload[i:])),
+				Flags:  binary.BigEndian.Uint32(payload[i+4:]),
+			}
+			extents = append(extents, extent)
+			pos += extent.Length
+		}
+		return nil
+	}, nil)
+	return extents, err
+}
+
+func (c *Client) read(p []byte, off int64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.sendRequest(cmdRead, uint64(off), uint32(len(p))); err != nil {
+		return err
+	}
+
+	return c.readReplies(func(replyType uint16, payload []byte) error {
+		if len(payload) < 8 {
+			return fmt.Errorf("invalid read payload")
+		}
+		chunkOffset := int64(binary.BigEndian.Uint64(payload)) - off
+		switch replyType {
+		case replyTypeOffsetData:
+			data := payload[8:]
+			if chunkOffset < 0 || chunkOffset+int64(len(data)) > int64(len(p)) {
+				return fmt.Errorf("data chunk out of range")
+			}
+			copy(p[chunkOffset:], data)
+		case replyTypeOffsetHole:
+			if len(payload) != 12 {
+				return fmt.Errorf("invalid hole payload")
+			}
+			holeLength := int64(binary.BigEndian.Uint32(payload[8:]))
+			if chunkOffset < 0 || chunkOffset+holeLength > int64(len(p)) {
+				return fmt.Errorf("hole chunk out of range")
+			}
+			for i := chunkOffset; i < chunkOffset+holeLength; i++ {
+				p[i] = 0
+			}
+		default:
+			return fmt.Errorf("unexpected reply type %d", replyType)
+		}
+		return nil
+	}, p)
+}
+
+func (c *Client) sendRequest(command uint16, offset uint64, length uint32) error {
+	c.cookie++
+	buf := make([]byte, 28)
+	binary.BigEndian.PutUint32(buf[0:], requestMagic)
+	binary.BigEndian.PutUint16(buf[4:], 0)
+	binary.BigEndian.PutUint16(buf[6:], command)
+	binary.BigEndian.PutUint64(buf[8:], c.cookie)
+	binary.BigEndian.PutUint64(buf[16:], offset)
+	binary.BigEndian.PutUint32(buf[24:], length)
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+// readReplies reads the replies of the last request until it is done. A simple
+// reply is only expected to a read, its data is read into simpleData.
+func (c *Client) readReplies(handleChunk func(replyType uint16, payload []byte) error, simpleData []byte) error {
+	for {
+		var magic uint32
+		if err := binary.Read(c.conn, binary.BigEndian, &magic); err != nil {
+			return err
+		}
+
+		switch magic {
+		case simpleReplyMagic:
+			var reply struct {
+				Error  uint32
+				Cookie uint64
+			}
+			if err := binary.Read(c.conn, binary.BigEndian, &reply); err != nil {
+				return err
+			}
+			if reply.Cookie != c.cookie {
+				return fmt.Errorf("unexpected cookie %d", reply.Cookie)
+			}
+			if reply.Error != 0 {
+				return fmt.Errorf("request failed with error %d", reply.Error)
+			}
+			if simpleData != nil {
+				_, err := io.ReadFull(c.conn, simpleData)
+				return err
+			}
+			return nil
+		case structReplyMagic:
+			var chunk struct {
+				Flags  uint16
+				Type   uint16
+				Cookie uint64
+				Length uint32
+			}
+			if err := binary.Read(c.conn, binary.BigEndian, &chunk); err != nil {
+				return err
+			}
+			if chunk.Cookie != c.cookie {
+				return fmt.Errorf("unexpected cookie %d", chunk.Cookie)
+			}
+			payload := make([]byte, chunk.Length)
+			if _, err := io.ReadFull(c.conn, payload); err != nil {
+				return err
+			}
+
+			switch {
+			case chunk.Type&replyTypeErrorBit != 0:
+				return chunkError(payload)
+			case chunk.Type != replyTypeNone:
+				if err := handleChunk(chunk.Type, payload); err != nil {
+					return err
+				}
+			}
+
+			if chunk.Flags&replyFlagDone != 0 {
+				return nil
+			}
+		default:
+			return fmt.Errorf("unexpected reply magic %x", magic)
+		}
+	}
+}
+
+func chunkError(payload []byte) error {
+	if len(payload) < 6 {
+		return fmt.Errorf("invalid error payload")
+	}
+	code := binary.BigEndian.Uint32(payload)
+	msgLength := int(binary.BigEndian.Uint16(payload[4:]))
+	if 6+msgLength > len(payload) {
+		return fmt.Errorf("request failed with error %d", code)
+	}
+	return fmt.Errorf("request failed with error %d: %s", code, string(payload[6:6+msgLength]))
+}
+
+func (c *Client) handshake(export, metaContext string) error {
+	var greeting struct {
+		Magic    uint64
+		OptMagic uint64
+		Flags    uint16
+	}
+	if err := binary.Read(c.conn, binary.BigEndian, &greeting); err != nil {
+		return err
+	}
+	if greeting.Magic != nbdMagic || greeting.OptMagic != optMagic {
+		return fmt.Errorf("server does not support the newstyle negotiation")
+	}
+	if greeting.Flags&flagFixedNewstyle == 0 {
+		return fmt.Errorf("server does not support the fixed newstyle negotiation")
+	}
+
+	clientFlags := uint32(flagFixedNewstyle)
+	if greeting.Flags&flagNoZeroes != 0 {
+		clientFlags |= flagNoZeroes
+	}
+	if err := binary.Write(c.conn, binary.BigEndian, clientFlags); err != nil {
+		return err
+	}
+
+	if err := c.sendOption(optStructuredReply, nil); err != nil {
+		return err
+	}
+	if err := c.readOptionReplies(optStructuredReply, nil); err != nil {
+		return err
+	}
+
+	if metaContext != "" {
+		if err := c.negotiateMetaContext(export, metaContext); err != nil {
+			return err
+		}
+	}
+
+	data := appendString32(nil, export)
+	data = binary.BigEndian.AppendUint16(data, 1)
+	data = binary.BigEndian.AppendUint16(data, infoExport)
+	if err := c.sendOption(optGo, data); err != nil {
+		return err
+	}
+	return c.readOptionReplies(optGo, func(replyType uint32, payload []byte) error {
+		if replyType == repInfo && len(payload) >= 12 && binary.BigEndian.Uint16(payload) == infoExport {
+			c.size = int64(binary.BigEndian.Uint64(payload[2:]))
+		}
+		return nil
+	})
+}
+
+func (c *Client) negotiateMetaContext(export, metaContext string) error {
+	data := appendString32(nil, export)
+	data = binary.BigEndian.AppendUint32(data, 1)
+	data = appendString32(data, metaContext)
+	if err := c.sendOption(optSetMetaContext, data); err != nil {
+		return err
+	}
+
+	err := c.readOptionReplies(optSetMetaContext, func(replyType uint32, payload []byte) error {
+		if replyType == repMetaContext && len(payload) >= 4 && string(payload[4:]) == metaContext {
+			c.metaContext = binary.BigEndian.Uint32(payload)
+			c.hasContext = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !c.hasContext {
+		return fmt.Errorf("meta context %s is not available", metaContext)
+	}
+	return nil
+}
+
+func (c *Client) sendOption(option uint32, data []byte) error {
+	buf := make([]byte, 16, 16+len(data))
+	binary.BigEndian.PutUint64(buf[0:], optMagic)
+	binary.BigEndian.PutUint32(buf[8:], option)
+	binary.BigEndian.PutUint32(buf[12:], uint32(len(data)))
+	_, err := c.conn.Write(append(buf, data...))
+	return err
+}
+
+func (c *Client) readOptionReplies(option uint32, handleReply func(replyType uint32, payload []byte) error) error {
+	for {
+		var reply struct {
+			Magic  uint64
+			Option uint32
+			Type   uint32
+			Length uint32
+		}
+		if err := binary.Read(c.conn, binary.BigEndian, &reply); err != nil {
+			return err
+		}
+		if reply.Magic != optReplyMagic || reply.Option != option {
+			return fmt.Errorf("unexpected reply to option %d", option)
+		}
+		payload := make([]byte, reply.Length)
+		if _, err := io.ReadFull(c.conn, payload); err != nil {
+			return err
+		}
+
+		switch {
+		case reply.Type&repErrorBit != 0:
+			return fmt.Errorf("option %d failed with error %d: %s", option, reply.Type&^repErrorBit, string(payload))
+		case reply.Type == repAck:
+			return nil
+		case handleReply != nil:
+			if err := handleReply(reply.Type, payload); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func appendString32(data []byte, s string) []byte {
+	data = binary.BigEndian.AppendUint32(data, uint32(len(s)))
+	return append(data, s...)
+}
@@ -0,0 +1,292 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nbd
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	testExport      = "disk0"
+	testContextID   = 3
+	testDirtyBitmap = MetaContextDirtyBitmapPrefix + "backup-disk0"
+)
+
+// fakeServer serves a single in memory export with a single meta context
+type fakeServer struct {
+	conn    net.Conn
+	data    []byte
+	extents []Extent
+	// holes are replied as hole chunks instead of data chunks
+	holes bool
+}
+
+func (s *fakeServer) serve() {
+	defer GinkgoRecover()
+	defer s.conn.Close()
+
+	s.write(uint64(nbdMagic), uint64(optMagic), uint16(flagFixedNewstyle|flagNoZeroes))
+	var clientFlags uint32
+	s.read(&clientFlags)
+
+	if s.negotiate() {
+		for s.command() {
+		}
+	}
+}
+
+func (s *fakeServer) write(values ...interface{}) {
+	for _, value := range values {
+		// an empty write blocks on a pipe until the other side reads
+		if data, ok := value.([]byte); ok && len(data) == 0 {
+			continue
+		}
+		Expect(binary.Write(s.conn, binary.BigEndian, value)).To(Succeed())
+	}
+}
+
+func (s *fakeServer) read(value interface{}) {
+	Expect(binary.Read(s.conn, binary.BigEndian, value)).To(Succeed())
+}
+
+// negotiate handles options and returns true once the transmission phase starts.
+// The client closes the connection when the negotiation fails.
+func (s *fakeServer) negotiate() bool {
+	for {
+		done, err := s.option()
+		if err != nil || done {
+			return err == nil
+		}
+	}
+}
+
+func (s *fakeServer) optionReply(option, replyType uint32, payload []byte) {
+	s.write(uint64(optReplyMagic), option, replyType, uint32(len(payload)), payload)
+}
+
+// option handles an option and returns true once the transmission phase starts
+func (s *fakeServer) option() (bool, error) {
+	var header struct {
+		Magic  uint64
+		Option uint32
+		Length uint32
+	}
+	if err := binary.Read(s.conn, binary.BigEndian, &header); err != nil {
+		return false, err
+	}
+	Expect(header.Magic).To(Equal(uint64(optMagic)))
+	data := make([]byte, header.Length)
+	_, err := io.ReadFull(s.conn, data)
+	Expect(err).ToNot(HaveOccurred())
+
+	switch header.Option {
+	case optStructuredReply:
+		s.optionReply(header.Option, repAck, nil)
+	case optSetMetaContext:
+		exportLength := binary.BigEndian.Uint32(data)
+		Expect(string(data[4 : 4+exportLength])).To(Equal(testExport))
+		query := string(data[4+exportLength+4+4:])
+		if query == testDirtyBitmap {
+			payload := binary.BigEndian.AppendUint32(nil, testContextID)
+			s.optionReply(header.Option, repMetaContext, append(payload, query...))
+		}
+		s.optionReply(header.Option, repAck, nil)
+	case optGo:
+		exportLength := binary.BigEndian.Uint32(data)
+		if string(data[4:4+exportLength]) != testExport {
+			s.optionReply(header.Option, repErrorBit|6, []byte("unknown export"))
+			return false, nil
+		}
+		payload := binary.BigEndian.AppendUint16(nil, infoExport)
+		payload = binary.BigEndian.AppendUint64(payload, uint64(len(s.data)))
+		payload = binary.BigEndian.AppendUint16(payload, 0)
+		s.optionReply(header.Option, repInfo, payload)
+		s.optionReply(header.Option, repAck, nil)
+		return true, nil
+	default:
+		s.optionReply(header.Option, repErrorBit|1, nil)
+	}
+	return false, nil
+}
+
+func (s *fakeServer) chunk(flags, replyType uint16, cookie uint64, payload []byte) {
+	s.write(uint32(structReplyMagic), flags, replyType, cookie, uint32(len(payload)), payload)
+}
+
+// command handles a request and returns false once the client disconnects
+func (s *fakeServer) command() bool {
+	var request struct {
+		Magic  uint32
+		Flags  uint16
+		Type   uint16
+		Cookie uint64
+		Offset uint64
+		Length uint32
+	}
+	s.read(&request)
+	Expect(request.Magic).To(Equal(uint32(requestMagic)))
+
+	switch request.Type {
+	case cmdRead:
+		end := request.Offset + uint64(request.Length)
+		mid := request.Offset + uint64(request.Length)/2
+		first := binary.BigEndian.AppendUint64(nil, request.Offset)
+		if s.holes {
+			first = binary.BigEndian.AppendUint32(first, uint32(mid-request.Offset))
+			s.chunk(0, replyTypeOffsetHole, request.Cookie, first)
+		} else {
+			s.chunk(0, replyTypeOffsetData, request.Cookie, append(first, s.data[request.Offset:mid]...))
+		}
+		second := binary.BigEndian.AppendUint64(nil, mid)
+		s.chunk(replyFlagDone, replyTypeOffsetData, request.Cookie, append(second, s.data[mid:end]...))
+	case cmdBlockStatus:
+		payload := binary.BigEndian.AppendUint32(nil, testContextID)
+		for _, extent := range s.extents {
+			if extent.Offset >= int64(request.Offset) {
+				payload = binary.BigEndian.AppendUint32(payload, uint32(extent.Length))
+				payload = binary.BigEndian.AppendUint32(payload, extent.Flags)
+			}
+		}
+		s.chunk(replyFlagDone, replyTypeBlockStat, request.Cookie, payload)
+	case cmdDisc:
+		return false
+	default:
+		message := "unsupported"
+		payload := binary.BigEndian.AppendUint32(nil, 22)
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(message)))
+		s.chunk(replyFlagDone, replyTypeErrorBit|1, request.Cookie, append(payload, message...))
+	}
+	return true
+}
+
+var _ = Describe("NBD client", func() {
+	var (
+		server *fakeServer
+		conn   net.Conn
+	)
+
+	BeforeEach(func() {
+		var serverConn net.Conn
+		conn, serverConn = net.Pipe()
+		data := make([]byte, 4096)
+		for i := range data {
+			data[i] = byte(i % 251)
+		}
+		server = &fakeServer{
+			conn: serverConn,
+			data: data,
+			extents: []Extent{
+				{Offset: 0, Length: 1024, Flags: StateDirty},
+				{Offset: 1024, Length: 3072, Flags: 0},
+			},
+		}
+	})
+
+	It("should negotiate the size of the export", func() {
+		go server.serve()
+		client, err := NewClient(conn, testExport, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.Size()).To(Equal(int64(4096)))
+		Expect(client.Close()).To(Succeed())
+	})
+
+	It("should fail for an unknown export", func() {
+		go server.serve()
+		_, err := NewClient(conn, "unknown", "")
+		Expect(err).To(MatchError(ContainSubstring("unknown export")))
+		conn.Close()
+	})
+
+	It("should fail for an unknown meta context", func() {
+		go server.serve()
+		_, err := NewClient(conn, testExport, MetaContextDirtyBitmapPrefix+"unknown")
+		Expect(err).To(MatchError(ContainSubstring("is not available")))
+		conn.Close()
+	})
+
+	It("should read the export from structured replies", func() {
+		go server.serve()
+		client, err := NewClient(conn, testExport, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		buf := make([]byte, 512)
+		n, err := client.ReadAt(buf, 1000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(512))
+		Expect(buf).To(Equal(server.data[1000:1512]))
+		Expect(client.Close()).To(Succeed())
+	})
+
+	It("should read holes as zeroes", func() {
+		server.holes = true
+		go server.serve()
+		client, err := NewClient(conn, testExport, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		buf := make([]byte, 512)
+		for i := range buf {
+			buf[i] = 0xff
+		}
+		_, err = client.ReadAt(buf, 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf[:256]).To(Equal(make([]byte, 256)))
+		Expect(buf[256:]).To(Equal(server.data[256:512]))
+		Expect(client.Close()).To(Succeed())
+	})
+
+	It("should stop reading at the end of the export", func() {
+		go server.serve()
+		client, err := NewClient(conn, testExport, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		buf := make([]byte, 512)
+		n, err := client.ReadAt(buf, 3840)
+		Expect(err).To(Equal(io.EOF))
+		Expect(n).To(Equal(256))
+		Expect(buf[:n]).To(Equal(server.data[3840:]))
+		Expect(client.Close()).To(Succeed())
+	})
+
+	It("should return the extents of the dirty bitmap", func() {
+		go server.serve()
+		client, err := NewClient(conn, testExport, testDirtyBitmap)
+		Expect(err).ToNot(HaveOccurred())
+
+		extents, err := client.BlockStatus(0, 4096)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal(server.extents))
+		Expect(client.Close()).To(Succeed())
+	})
+
+	It("should refuse block status without meta context", func() {
+		go server.serve()
+		client, err := NewClient(conn, testExport, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.BlockStatus(0, 4096)
+		Expect(err).To(HaveOccurred())
+		Expect(client.Close()).To(Succeed())
+	})
+})
@@ -23,11 +23,13 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	goflag "flag"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
@@ -39,6 +41,7 @@ import (
 	flag "github.com/spf13/pflag"
 	"sigs.k8s.io/yaml"
 
+	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
 	corev1 "k8s.io/api/core/v1"
@@ -49,6 +52,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/service"
+	"kubevirt.io/kubevirt/pkg/storage/export/nbd"
 )
 
 const (
@@ -75,7 +79,18 @@ type VolumeInfo struct {
 	RawGzURI   string
 	VMURI      string
 	SecretURI  string
+	// NBDURI is set instead of Path for the disks of a pull mode backup, it is
+	// the virt-handler endpoint proxying the NBD server of the launcher
+	NBDURI      string
+	NBDExport   string
+	DirtyBitmap string
+	ExtentsURI  string
 }
+
+// NBDConnector opens a client to the NBD export of a disk of a pull mode backup,
+// negotiating the meta context when it is not empty
+type NBDConnector func(metaContext string) (*nbd.Client, error)
+
 type ExportServerConfig struct {
 	Deadline time.Time
 
@@ -87,11 +102,16 @@ type ExportServerConfig struct {
 
 	Volumes []VolumeInfo
 
+	// NBDDialer connects to the virt-handler endpoints proxying NBD servers
+	NBDDialer func(uri string) (net.Conn, error)
+
 	// unit testing helpers
 	ArchiveHandler     func(string) http.Handler
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	NBDHandler         func(NBDConnector) http.Handler
+	ExtentsHandler     func(NBDConnector, string) http.Handler
 	VmHandler          func(string, []VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
@@ -158,6 +178,10 @@ func getExternalCAConfigMap() (*corev1.ConfigMap, error) {
 }
 
 func (s *exportServer) getHandlerMap(vi VolumeInfo) map[string]http.Handler {
+	if vi.NBDURI != "" {
+		return s.getNBDHandlerMap(vi)
+	}
+
 	fi, err := os.Stat(vi.Path)
 	if err != nil {
 		log.Log.Reason(err).Errorf("error statting %s", vi.Path)
@@ -190,6 +214,55 @@ func (s *exportServer) getHandlerMap(vi VolumeInfo) map[string]http.Handler {
 	return result
 }
 
+func (s *exportServer) getNBDHandlerMap(vi VolumeInfo) map[string]http.Handler {
+	var result = make(map[string]http.Handler)
+
+	connect := s.nbdConnector(vi)
+	if vi.RawURI != "" {
+		result[vi.RawURI] = s.NBDHandler(connect)
+	}
+
+	if vi.ExtentsURI != "" {
+		metaContext := nbd.MetaContextAllocation
+		if vi.DirtyBitmap != "" {
+			metaContext = nbd.MetaContextDirtyBitmapPrefix + vi.DirtyBitmap
+		}
+		result[vi.ExtentsURI] = s.ExtentsHandler(connect, metaContext)
+	}
+
+	return result
+}
+
+func (s *exportServer) nbdConnector(vi VolumeInfo) NBDConnector {
+	return func(metaContext string) (*nbd.Client, error) {
+		if s.NBDDialer == nil {
+			return nil, fmt.Errorf("no NBD dialer configured")
+		}
+		conn, err := s.NBDDialer(vi.NBDURI)
+		if err != nil {
+			return nil, err
+		}
+		client, err := nbd.NewClient(conn, vi.NBDExport, metaContext)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return client, nil
+	}
+}
+
+// NewVirtHandlerNBDDialer connects to the virt-handler endpoints proxying NBD
+// servers, the NBD protocol is carried over a websocket
+func NewVirtHandlerNBDDialer(tlsConfig *tls.Config) func(string) (net.Conn, error) {
+	return func(uri string) (net.Conn, error) {
+		conn, _, err := kubecli.Dial(uri, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		return kubecli.NewWebsocketStreamer(conn, make(chan struct{})).AsConn(), nil
+	}
+}
+
 func (s *exportServer) Run() {
 	s.initHandler()
 
@@ -243,6 +316,14 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.NBDHandler == nil {
+		es.NBDHandler = nbdHandler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = extentsHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -653,6 +734,117 @@ func fileHandler(file string) http.Handler {
 	})
 }
 
+// nbdHandler serves the disk exported over NBD, range requests allow to only
+// read the extents returned by the extents handler
+func nbdHandler(connect NBDConnector) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		client, err := connect("")
+		if err != nil {
+			log.Log.Reason(err).Error("error connecting to the NBD server")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer client.Close()
+		http.ServeContent(w, r, "disk.img", time.Time{}, io.NewSectionReader(client, 0, client.Size()))
+	})
+}
+
+// extent is a range of a disk, its type is either data or zero for full backups,
+// and dirty or clean for incremental backups
+type extent struct {
+	Start  int64  `json:"start"`
+	Length int64  `json:"length"`
+	Type   string `json:"type"`
+}
+
+func extentType(metaContext string, flags uint32) string {
+	if strings.HasPrefix(metaContext, nbd.MetaContextDirtyBitmapPrefix) {
+		if flags&nbd.StateDirty != 0 {
+			return "dirty"
+		}
+		return "clean"
+	}
+	if flags&nbd.StateZero != 0 {
+		return "zero"
+	}
+	return "data"
+}
+
+// maxBlockStatusLength bounds the range described by a single block status request
+const maxBlockStatusLength = 1 << 30
+
+type blockStatusReader interface {
+	Size() int64
+	BlockStatus(offset int64, length uint32) ([]nbd.Extent, error)
+}
+
+func getExtents(client blockStatusReader, metaContext string) ([]extent, error) {
+	result := []extent{}
+	for offset := int64(0); offset < client.Size(); {
+		length := client.Size() - offset
+		if length > maxBlockStatusLength {
+			length = maxBlockStatusLength
+		}
+		extents, err := client.BlockStatus(offset, uint32(length))
+		if err != nil {
+			return nil, err
+		}
+		if len(extents) == 0 {
+			return nil, fmt.Errorf("no extents returned for offset %d", offset)
+		}
+		for _, e := range extents {
+			if e.Length <= 0 {
+				return nil, fmt.Errorf("invalid extent length %d at offset %d", e.Length, offset)
+			}
+			if offset+e.Length > client.Size() {
+				e.Length = client.Size() - offset
+			}
+			t := extentType(metaContext, e.Flags)
+			if last := len(result) - 1; last >= 0 && result[last].Type == t {
+				result[last].Length += e.Length
+			} else {
+				result = append(result, extent{Start: offset, Length: e.Length, Type: t})
+			}
+			offset += e.Length
+		}
+	}
+	return result, nil
+}
+
+func extentsHandler(connect NBDConnector, metaContext string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		client, err := connect(metaContext)
+		if err != nil {
+			log.Log.Reason(err).Error("error connecting to the NBD server")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer client.Close()
+		extents, err := getExtents(client, metaContext)
+		if err != nil {
+			log.Log.Reason(err).Error("error reading extents")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		data, err := json.Marshal(extents)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		n, err := w.Write(data)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing extents")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
 func getToken(tokenFile string) (string, error) {
 	content, err := os.ReadFile(tokenFile)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -37,10 +38,13 @@ import (
 	"kubevirt.io/client-go/log"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/nbd"
 )
 
 const (
 	testNamespace = "default"
+	testNBDURI    = "wss://10.0.0.1:8187/v1/namespaces/default/virtualmachineinstances/testvmi/backupnbd"
 )
 
 func successHandler(w http.ResponseWriter, req *http.Request) {
@@ -61,6 +65,12 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		NBDHandler: func(NBDConnector) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(NBDConnector, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func(string, []VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -113,6 +123,14 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("NBD raw URI",
+			VolumeInfo{NBDURI: testNBDURI, NBDExport: "v1", RawURI: "/volume/v1/disk.img"},
+			"/volume/v1/disk.img",
+		),
+		Entry("NBD extents URI",
+			VolumeInfo{NBDURI: testNBDURI, NBDExport: "v1", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/internal/manifest",
@@ -730,3 +748,119 @@ var _ = Describe("exportserver", func() {
 		})
 	})
 })
+
+type fakeBlockStatusReader struct {
+	size    int64
+	extents []nbd.Extent
+	err     error
+}
+
+func (r *fakeBlockStatusReader) Size() int64 {
+	return r.size
+}
+
+func (r *fakeBlockStatusReader) BlockStatus(offset int64, length uint32) ([]nbd.Extent, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var result []nbd.Extent
+	for _, e := range r.extents {
+		if e.Offset >= offset && e.Offset < offset+int64(length) {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+var _ = Describe("extents", func() {
+	const bitmapContext = nbd.MetaContextDirtyBitmapPrefix + "backup-disk0"
+
+	DescribeTable("should describe the extent type", func(metaContext string, flags uint32, expected string) {
+		Expect(extentType(metaContext, flags)).To(Equal(expected))
+	},
+		Entry("allocated data", nbd.MetaContextAllocation, uint32(0), "data"),
+		Entry("zeroes", nbd.MetaContextAllocation, nbd.StateZero, "zero"),
+		Entry("holes reading as zeroes", nbd.MetaContextAllocation, nbd.StateHole|nbd.StateZero, "zero"),
+		Entry("dirty blocks", bitmapContext, nbd.StateDirty, "dirty"),
+		Entry("clean blocks", bitmapContext, uint32(0), "clean"),
+	)
+
+	It("should merge adjacent extents of the same type", func() {
+		reader := &fakeBlockStatusReader{
+			size: 4096,
+			extents: []nbd.Extent{
+				{Offset: 0, Length: 1024, Flags: nbd.StateDirty},
+				{Offset: 1024, Length: 1024, Flags: nbd.StateDirty},
+				{Offset: 2048, Length: 2048},
+			},
+		}
+		extents, err := getExtents(reader, bitmapContext)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal([]extent{
+			{Start: 0, Length: 2048, Type: "dirty"},
+			{Start: 2048, Length: 2048, Type: "clean"},
+		}))
+	})
+
+	It("should clamp the last extent to the size of the export", func() {
+		reader := &fakeBlockStatusReader{
+			size: 4096,
+			extents: []nbd.Extent{
+				{Offset: 0, Length: 1024, Flags: nbd.StateZero},
+				{Offset: 1024, Length: 8192},
+			},
+		}
+		extents, err := getExtents(reader, nbd.MetaContextAllocation)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal([]extent{
+			{Start: 0, Length: 1024, Type: "zero"},
+			{Start: 1024, Length: 3072, Type: "data"},
+		}))
+	})
+
+	It("should fail when no extents are returned", func() {
+		reader := &fakeBlockStatusReader{size: 4096}
+		_, err := getExtents(reader, nbd.MetaContextAllocation)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail when the block status request fails", func() {
+		reader := &fakeBlockStatusReader{size: 4096, err: fmt.Errorf("connection reset")}
+		_, err := getExtents(reader, nbd.MetaContextAllocation)
+		Expect(err).To(MatchError("connection reset"))
+	})
+})
+
+var _ = Describe("NBD connector", func() {
+	vi := VolumeInfo{NBDURI: testNBDURI, NBDExport: "v1"}
+
+	It("should fail without a dialer", func() {
+		es := newTestServer("foo")
+		_, err := es.nbdConnector(vi)("")
+		Expect(err).To(MatchError("no NBD dialer configured"))
+	})
+
+	It("should negotiate the export over the virt-handler endpoint of the volume", func() {
+		var dialedURI string
+		es := newTestServer("foo")
+		es.NBDDialer = func(uri string) (net.Conn, error) {
+			dialedURI = uri
+			clientConn, serverConn := net.Pipe()
+			// a server going away fails the negotiation
+			serverConn.Close()
+			return clientConn, nil
+		}
+		_, err := es.nbdConnector(vi)(nbd.MetaContextAllocation)
+		Expect(err).To(HaveOccurred())
+		Expect(dialedURI).To(Equal(testNBDURI))
+	})
+
+	It("should fail when virt-handler can not be reached", func() {
+		es := newTestServer("foo")
+		es.NBDDialer = func(string) (net.Conn, error) {
+			return nil, fmt.Errorf("connection refused")
+		}
+		_, err := es.nbdConnector(vi)("")
+		Expect(err).To(MatchError("connection refused"))
+	})
+})
@@ -0,0 +1,264 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	vmBackupFinalizer = "snapshot.kubevirt.io/vmbackup-protection"
+
+	vmBackupStartedEvent = "VirtualMachineBackupStarted"
+
+	vmBackupCompleteEvent = "VirtualMachineBackupComplete"
+
+	vmBackupErrorEvent = "VirtualMachineBackupError"
+)
+
+func getVMBackupMode(vmBackup *snapshotv1.VirtualMachineBackup) kubevirtv1.VirtualMachineInstanceBackupMode {
+	if vmBackup.Spec.Mode != nil {
+		return *vmBackup.Spec.Mode
+	}
+
+	return kubevirtv1.BackupModePull
+}
+
+func vmBackupProgressing(vmBackup *snapshotv1.VirtualMachineBackup) bool {
+	return vmBackup.Status == nil ||
+		(vmBackup.Status.Phase != snapshotv1.Succeeded && vmBackup.Status.Phase != snapshotv1.Failed)
+}
+
+// vmiBackupStatus returns the status of the backup job of the VMI, if it belongs to the VirtualMachineBackup
+func vmiBackupStatus(vmBackup *snapshotv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) *kubevirtv1.VirtualMachineInstanceBackupStatus {
+	if vmi == nil || vmi.Status.BackupStatus == nil || vmi.Status.BackupStatus.Name != vmBackup.Name {
+		return nil
+	}
+
+	return vmi.Status.BackupStatus
+}
+
+// vmiBackupActive returns true if the backup job of the VirtualMachineBackup still runs in the VMI
+func vmiBackupActive(vmBackup *snapshotv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) bool {
+	backupStatus := vmiBackupStatus(vmBackup, vmi)
+	return backupStatus != nil && !backupStatus.Completed
+}
+
+func (ctrl *VMBackupController) updateVMBackup(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineBackup %s/%s", vmBackup.Namespace, vmBackup.Name)
+
+	vmi, err := ctrl.getBackupVMI(vmBackup)
+	if err != nil {
+		return 0, err
+	}
+
+	vmBackupOut := vmBackup.DeepCopy()
+
+	if vmBackup.DeletionTimestamp != nil {
+		if vmiBackupActive(vmBackup, vmi) {
+			// a pull mode backup job only ends when aborted
+			err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).AbortBackup(context.Background(), vmi.Name)
+			if err != nil && !errors.IsNotFound(err) {
+				return 0, err
+			}
+		}
+
+		controller.RemoveFinalizer(vmBackupOut, vmBackupFinalizer)
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	if vmBackupOut.Status == nil {
+		controller.AddFinalizer(vmBackupOut, vmBackupFinalizer)
+		vmBackupOut.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.Bool(false),
+		}
+		updateVMBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineBackup"))
+		updateVMBackupCondition(vmBackupOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineBackup"))
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	if vmBackupOut.Status.Phase == snapshotv1.Failed {
+		return 0, nil
+	}
+
+	if !vmBackupProgressing(vmBackupOut) {
+		if getVMBackupMode(vmBackupOut) == kubevirtv1.BackupModePull {
+			// the disks are only exported as long as the backup job runs
+			readyToUse := vmiBackupActive(vmBackupOut, vmi)
+			vmBackupOut.Status.ReadyToUse = &readyToUse
+			if !readyToUse {
+				updateVMBackupCondition(vmBackupOut, newReadyCondition(corev1.ConditionFalse, "Backup job is no longer running"))
+			}
+		}
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	if vmi == nil || vmi.Status.Phase != kubevirtv1.Running {
+		ctrl.setVMBackupFailure(vmBackupOut, fmt.Sprintf("VirtualMachine %s is not running", vmBackupOut.Spec.Source.Name))
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	backupStatus := vmiBackupStatus(vmBackupOut, vmi)
+	if backupStatus == nil {
+		if vmBackupOut.Status.StartTime != nil {
+			ctrl.setVMBackupFailure(vmBackupOut, "Backup job is gone, the VirtualMachine may have been restarted or migrated")
+			return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+		}
+
+		if vmi.Status.BackupStatus != nil && !vmi.Status.BackupStatus.Completed {
+			updateVMBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionTrue,
+				fmt.Sprintf("Waiting for backup %s to complete", vmi.Status.BackupStatus.Name)))
+			return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+		}
+
+		if err := ctrl.startVMIBackup(vmBackupOut, vmi); err != nil {
+			return 0, err
+		}
+
+		vmBackupOut.Status.StartTime = currentTime()
+		updateVMBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionTrue, "Backup job started"))
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	if backupStatus.Failed {
+		ctrl.setVMBackupFailure(vmBackupOut, backupStatus.FailureReason)
+		return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+	}
+
+	vmBackupOut.Status.Volumes = backupStatus.Volumes
+	if backupStatus.Checkpoint != "" {
+		vmBackupOut.Status.CheckpointName = pointer.String(backupStatus.Checkpoint)
+	}
+
+	switch getVMBackupMode(vmBackupOut) {
+	case kubevirtv1.BackupModePush:
+		if !backupStatus.Completed {
+			updateVMBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionTrue, "Writing the backup to the target volume"))
+			return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+		}
+		vmBackupOut.Status.CompletionTime = backupStatus.EndTimestamp
+	case kubevirtv1.BackupModePull:
+		if backupStatus.Completed {
+			ctrl.setVMBackupFailure(vmBackupOut, "Backup job ended before the disks could be exported")
+			return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+		}
+		vmBackupOut.Status.CompletionTime = backupStatus.StartTimestamp
+	}
+
+	ctrl.Recorder.Eventf(
+		vmBackupOut,
+		corev1.EventTypeNormal,
+		vmBackupCompleteEvent,
+		"Successfully completed VirtualMachineBackup %s",
+		vmBackupOut.Name,
+	)
+
+	vmBackupOut.Status.Phase = snapshotv1.Succeeded
+	vmBackupOut.Status.ReadyToUse = pointer.Bool(true)
+	updateVMBackupCondition(vmBackupOut, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateVMBackupCondition(vmBackupOut, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+
+	return 0, ctrl.doUpdateVMBackup(vmBackup, vmBackupOut)
+}
+
+func (ctrl *VMBackupController) getBackupVMI(vmBackup *snapshotv1.VirtualMachineBackup) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vmBackup.Namespace, vmBackup.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance), nil
+}
+
+func (ctrl *VMBackupController) startVMIBackup(vmBackup *snapshotv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) error {
+	options := &kubevirtv1.VirtualMachineInstanceBackupOptions{
+		Name:            vmBackup.Name,
+		Mode:            getVMBackupMode(vmBackup),
+		Checkpoint:      vmBackup.Name,
+		IncrementalFrom: vmBackup.Spec.IncrementalFrom,
+	}
+	if vmBackup.Spec.Target != nil {
+		options.Target = *vmBackup.Spec.Target
+	}
+
+	log.Log.Object(vmBackup).V(3).Infof("Starting backup job of VMI %s/%s", vmi.Namespace, vmi.Name)
+	if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, options); err != nil {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(
+		vmBackup,
+		corev1.EventTypeNormal,
+		vmBackupStartedEvent,
+		"Started backup job of VirtualMachine %s",
+		vmi.Name,
+	)
+
+	return nil
+}
+
+func (ctrl *VMBackupController) setVMBackupFailure(vmBackup *snapshotv1.VirtualMachineBackup, message string) {
+	ctrl.Recorder.Eventf(
+		vmBackup,
+		corev1.EventTypeWarning,
+		vmBackupErrorEvent,
+		"VirtualMachineBackup encountered error %s",
+		message,
+	)
+
+	vmBackup.Status.Phase = snapshotv1.Failed
+	vmBackup.Status.ReadyToUse = pointer.Bool(false)
+	vmBackup.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+	updateVMBackupCondition(vmBackup, newProgressingCondition(corev1.ConditionFalse, message))
+	updateVMBackupCondition(vmBackup, newReadyCondition(corev1.ConditionFalse, message))
+	updateVMBackupCondition(vmBackup, newFailureCondition(corev1.ConditionTrue, message))
+}
+
+func updateVMBackupCondition(vmBackup *snapshotv1.VirtualMachineBackup, c snapshotv1.Condition) {
+	vmBackup.Status.Conditions = updateCondition(vmBackup.Status.Conditions, c, true)
+}
+
+func (ctrl *VMBackupController) doUpdateVMBackup(original, updated *snapshotv1.VirtualMachineBackup) error {
+	if !equality.Semantic.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineBackup(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
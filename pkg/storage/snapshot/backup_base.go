@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMBackupController is responsible for backing up the disks of running VMs,
+// it drives the backup jobs of the VMIs and reports their progress
+type VMBackupController struct {
+	Client kubecli.KubevirtClient
+
+	VMBackupInformer cache.SharedIndexInformer
+	VMIInformer      cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmBackupQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the backup controller
+func (ctrl *VMBackupController) Init() error {
+	ctrl.vmBackupQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-snapshot-vmbackup")
+
+	_, err := ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+
+	return err
+}
+
+// Run the controller
+func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmBackupQueue.ShutDown()
+
+	log.Log.Info("Starting backup controller.")
+	defer log.Log.Info("Shutting down backup controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMBackupInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmBackupWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupController) vmBackupWorker() {
+	for ctrl.processVMBackupWorkItem() {
+	}
+}
+
+func (ctrl *VMBackupController) processVMBackupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmBackupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmBackup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		vmBackup, ok := storeObj.(*snapshotv1.VirtualMachineBackup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMBackup(vmBackup.DeepCopy())
+	})
+}
+
+func (ctrl *VMBackupController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackup, ok := obj.(*snapshotv1.VirtualMachineBackup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmBackup)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, vmBackup)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmBackupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMBackupController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		keys, err := ctrl.VMBackupInformer.GetIndexer().IndexKeys("vm", cacheKeyFunc(vmi.Namespace, vmi.Name))
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		for _, key := range keys {
+			log.Log.V(3).Infof("Handling VMI %s/%s, VirtualMachineBackup %s", vmi.Namespace, vmi.Name, key)
+			ctrl.vmBackupQueue.Add(key)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Backup controller", func() {
+	const (
+		backupName = "backup"
+		vmName     = "testvm"
+	)
+
+	var (
+		now      = metav1.NewTime(time.Date(2023, time.May, 10, 2, 30, 0, 0, time.UTC))
+		pushMode = v1.BackupModePush
+
+		vmiInformer  cache.SharedIndexInformer
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		recorder     *record.FakeRecorder
+		client       *kubevirtfake.Clientset
+		controller   *VMBackupController
+	)
+
+	createBackup := func() *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backupName,
+				Namespace: testNamespace,
+				UID:       "backup-uid",
+			},
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.String(core.GroupName),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+		}
+	}
+
+	createBackupInProgress := func() *snapshotv1.VirtualMachineBackup {
+		vmBackup := createBackup()
+		vmBackup.Finalizers = []string{vmBackupFinalizer}
+		vmBackup.Status = &snapshotv1.VirtualMachineBackupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.Bool(false),
+		}
+		return vmBackup
+	}
+
+	createBackupStarted := func() *snapshotv1.VirtualMachineBackup {
+		vmBackup := createBackupInProgress()
+		vmBackup.Status.StartTime = &now
+		return vmBackup
+	}
+
+	createRunningVMI := func(backupStatus *v1.VirtualMachineInstanceBackupStatus) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:        v1.Running,
+				BackupStatus: backupStatus,
+			},
+		}
+	}
+
+	backupVolumes := []v1.VirtualMachineInstanceBackupVolume{
+		{
+			VolumeName:  "disk0",
+			DiskTarget:  "vda",
+			ExportName:  "disk0",
+			DirtyBitmap: "backup-disk0",
+		},
+	}
+
+	getBackup := func() *snapshotv1.VirtualMachineBackup {
+		vmBackup, err := client.SnapshotV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmBackup
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmBackupInformer, _ := testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineBackup{}, virtcontroller.GetVirtualMachineBackupInformerIndexers())
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMBackupController{
+			Client:           virtClient,
+			VMBackupInformer: vmBackupInformer,
+			VMIInformer:      vmiInformer,
+			Recorder:         recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(client.SnapshotV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+
+		currentTime = func() *metav1.Time {
+			t := now
+			return &t
+		}
+	})
+
+	reconcile := func(vmBackup *snapshotv1.VirtualMachineBackup) (time.Duration, error) {
+		_, err := client.SnapshotV1alpha1().VirtualMachineBackups(testNamespace).Create(context.Background(), vmBackup, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		client.Fake.ClearActions()
+
+		return controller.updateVMBackup(vmBackup)
+	}
+
+	It("should initialize the status and add the finalizer", func() {
+		_, err := reconcile(createBackup())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Finalizers).To(ContainElement(vmBackupFinalizer))
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(*updated.Status.ReadyToUse).To(BeFalse())
+	})
+
+	It("should fail when the VirtualMachine is not running", func() {
+		_, err := reconcile(createBackupInProgress())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*updated.Status.Error.Message).To(Equal(fmt.Sprintf("VirtualMachine %s is not running", vmName)))
+		testutils.ExpectEvent(recorder, vmBackupErrorEvent)
+	})
+
+	DescribeTable("should start the backup job", func(mode *v1.VirtualMachineInstanceBackupMode, expectedMode v1.VirtualMachineInstanceBackupMode) {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(nil))).To(Succeed())
+		vmBackup := createBackupInProgress()
+		vmBackup.Spec.Mode = mode
+		vmBackup.Spec.IncrementalFrom = pointer.String("previous")
+
+		vmiInterface.EXPECT().Backup(context.Background(), vmName, &v1.VirtualMachineInstanceBackupOptions{
+			Name:            backupName,
+			Mode:            expectedMode,
+			Checkpoint:      backupName,
+			IncrementalFrom: pointer.String("previous"),
+		}).Return(nil)
+
+		_, err := reconcile(vmBackup)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(updated.Status.StartTime).To(Equal(&now))
+		testutils.ExpectEvent(recorder, vmBackupStartedEvent)
+	},
+		Entry("in pull mode by default", nil, v1.BackupModePull),
+		Entry("in push mode", &pushMode, v1.BackupModePush),
+	)
+
+	It("should wait for another backup job of the VMI to complete", func() {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(&v1.VirtualMachineInstanceBackupStatus{Name: "other"}))).To(Succeed())
+
+		_, err := reconcile(createBackupInProgress())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(updated.Status.StartTime).To(BeNil())
+	})
+
+	It("should be ready to use once a pull mode backup job runs", func() {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(&v1.VirtualMachineInstanceBackupStatus{
+			Name:           backupName,
+			Mode:           v1.BackupModePull,
+			Checkpoint:     backupName,
+			StartTimestamp: &now,
+			Volumes:        backupVolumes,
+		}))).To(Succeed())
+
+		_, err := reconcile(createBackupStarted())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Succeeded))
+		Expect(*updated.Status.ReadyToUse).To(BeTrue())
+		Expect(*updated.Status.CheckpointName).To(Equal(backupName))
+		Expect(updated.Status.Volumes).To(Equal(backupVolumes))
+		testutils.ExpectEvent(recorder, vmBackupCompleteEvent)
+	})
+
+	It("should wait for a push mode backup job to complete", func() {
+		vmBackup := createBackupStarted()
+		vmBackup.Spec.Mode = &pushMode
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(&v1.VirtualMachineInstanceBackupStatus{
+			Name:           backupName,
+			Mode:           v1.BackupModePush,
+			StartTimestamp: &now,
+		}))).To(Succeed())
+
+		_, err := reconcile(vmBackup)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(updated.Status.CheckpointName).To(BeNil())
+	})
+
+	It("should fail when the backup job fails", func() {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(&v1.VirtualMachineInstanceBackupStatus{
+			Name:          backupName,
+			Completed:     true,
+			Failed:        true,
+			FailureReason: "no space left",
+		}))).To(Succeed())
+
+		_, err := reconcile(createBackupStarted())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*updated.Status.Error.Message).To(Equal("no space left"))
+	})
+
+	It("should fail when the backup job is gone", func() {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(nil))).To(Succeed())
+
+		_, err := reconcile(createBackupStarted())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+	})
+
+	It("should abort a running backup job and remove the finalizer on deletion", func() {
+		Expect(vmiInformer.GetStore().Add(createRunningVMI(&v1.VirtualMachineInstanceBackupStatus{
+			Name: backupName,
+			Mode: v1.BackupModePull,
+		}))).To(Succeed())
+		vmBackup := createBackupStarted()
+		vmBackup.DeletionTimestamp = &now
+
+		vmiInterface.EXPECT().AbortBackup(context.Background(), vmName).Return(nil)
+
+		_, err := reconcile(vmBackup)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getBackup()
+		Expect(updated.Finalizers).ToNot(ContainElement(vmBackupFinalizer))
+	})
+})
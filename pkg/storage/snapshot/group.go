@@ -0,0 +1,440 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// snapshotGroupFrozenAnnotation is set on the snapshots of a group once all its members are frozen,
+	// the VolumeSnapshots of a member are not taken before
+	snapshotGroupFrozenAnnotation = "snapshot.kubevirt.io/group-frozen"
+
+	vmSnapshotGroupCompleteEvent = "VirtualMachineSnapshotGroupComplete"
+
+	vmSnapshotGroupErrorEvent = "VirtualMachineSnapshotGroupError"
+)
+
+func vmSnapshotGroupMember(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	_, ok := vmSnapshot.Labels[snapshotv1.SnapshotGroupLabel]
+	return ok
+}
+
+// waitingForGroupFreeze returns true if the snapshot belongs to a group whose members are not all frozen yet
+func waitingForGroupFreeze(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshotGroupMember(vmSnapshot) && vmSnapshot.Annotations[snapshotGroupFrozenAnnotation] != "true"
+}
+
+func vmSnapshotGroupProgressing(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	return group.Status.Phase != snapshotv1.Succeeded && group.Status.Phase != snapshotv1.Failed
+}
+
+func getGroupFailureDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	if group.Spec.FailureDeadline != nil {
+		return group.Spec.FailureDeadline.Duration
+	}
+
+	return snapshotv1.DefaultFailureDeadline
+}
+
+func timeUntilGroupDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := getGroupFailureDeadline(group)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return 0
+	}
+
+	return group.CreationTimestamp.Add(failureDeadline).Sub(currentTime().Time)
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotGroup %s/%s", group.Namespace, group.Name)
+
+	if group.DeletionTimestamp != nil {
+		// the snapshots of the group are garbage collected through their owner reference
+		return 0, nil
+	}
+
+	groupOut := group.DeepCopy()
+	if groupOut.Status == nil {
+		groupOut.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.Bool(false),
+		}
+		updateGroupCondition(groupOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineSnapshotGroup"))
+		updateGroupCondition(groupOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineSnapshotGroup"))
+		return 0, ctrl.doUpdateGroup(group, groupOut)
+	}
+
+	if groupOut.Status.Phase == snapshotv1.Failed {
+		return 0, nil
+	}
+
+	if len(groupOut.Status.Members) == 0 {
+		members, err := ctrl.selectGroupMembers(groupOut)
+		if err != nil {
+			return 0, err
+		}
+
+		if len(members) == 0 {
+			ctrl.setGroupFailure(groupOut, "no VirtualMachine matches the selector")
+		}
+
+		groupOut.Status.Members = members
+		return 0, ctrl.doUpdateGroup(group, groupOut)
+	}
+
+	vmSnapshots, err := ctrl.ensureGroupSnapshots(groupOut)
+	if err != nil {
+		return 0, err
+	}
+
+	if !vmSnapshotGroupProgressing(groupOut) {
+		groupOut.Status.ReadyToUse = pointer.Bool(groupSnapshotsReady(vmSnapshots))
+		return 0, ctrl.doUpdateGroup(group, groupOut)
+	}
+
+	if failure := groupSnapshotsFailure(groupOut, vmSnapshots); failure != "" {
+		return 0, ctrl.rollbackGroup(group, groupOut, failure)
+	}
+
+	if timeUntilGroupDeadline(groupOut) < 0 {
+		return 0, ctrl.rollbackGroup(group, groupOut, fmt.Sprintf("Failed to create group snapshot within failure deadline %s", getGroupFailureDeadline(groupOut)))
+	}
+
+	if groupOut.Status.FreezeTime == nil {
+		// every source has to be locked before freezing so none of them can change while the group is frozen
+		for _, vmSnapshot := range vmSnapshots {
+			if vmSnapshot == nil || vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+				updateGroupCondition(groupOut, newProgressingCondition(corev1.ConditionTrue, "Waiting for the VirtualMachines to be locked"))
+				return timeUntilGroupDeadline(groupOut), ctrl.doUpdateGroup(group, groupOut)
+			}
+		}
+
+		if err := ctrl.freezeGroup(groupOut); err != nil {
+			return 0, ctrl.rollbackGroup(group, groupOut, err.Error())
+		}
+
+		groupOut.Status.FreezeTime = currentTime()
+		updateGroupCondition(groupOut, newProgressingCondition(corev1.ConditionTrue, "Snapshotting the frozen VirtualMachines"))
+		if err := ctrl.doUpdateGroup(group, groupOut); err != nil {
+			return 0, err
+		}
+
+		// let the snapshot controller take the VolumeSnapshots of all the members
+		for _, vmSnapshot := range vmSnapshots {
+			if err := ctrl.releaseGroupSnapshot(vmSnapshot); err != nil {
+				return 0, err
+			}
+		}
+
+		return timeUntilGroupDeadline(groupOut), nil
+	}
+
+	for _, vmSnapshot := range vmSnapshots {
+		if !vmSnapshotSucceeded(vmSnapshot) {
+			return timeUntilGroupDeadline(groupOut), ctrl.doUpdateGroup(group, groupOut)
+		}
+	}
+
+	ctrl.Recorder.Eventf(
+		groupOut,
+		corev1.EventTypeNormal,
+		vmSnapshotGroupCompleteEvent,
+		"Successfully completed VirtualMachineSnapshotGroup %s",
+		groupOut.Name,
+	)
+
+	groupOut.Status.Phase = snapshotv1.Succeeded
+	groupOut.Status.CreationTime = groupOut.Status.FreezeTime
+	groupOut.Status.ReadyToUse = pointer.Bool(groupSnapshotsReady(vmSnapshots))
+	updateGroupCondition(groupOut, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateGroupCondition(groupOut, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+
+	return 0, ctrl.doUpdateGroup(group, groupOut)
+}
+
+func (ctrl *VMSnapshotGroupController) selectGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) ([]snapshotv1.VirtualMachineSnapshotGroupMember, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %v", err)
+	}
+
+	var members []snapshotv1.VirtualMachineSnapshotGroupMember
+	err = cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), group.Namespace, selector, func(obj interface{}) {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if vm.DeletionTimestamp != nil {
+			return
+		}
+
+		members = append(members, snapshotv1.VirtualMachineSnapshotGroupMember{
+			VirtualMachineName:         vm.Name,
+			VirtualMachineSnapshotName: fmt.Sprintf("%s-%s", group.Name, vm.Name),
+		})
+	})
+
+	return members, err
+}
+
+// ensureGroupSnapshots creates the missing snapshots of the group, the returned
+// slice follows the order of the members and holds nil for the ones just created
+func (ctrl *VMSnapshotGroupController) ensureGroupSnapshots(group *snapshotv1.VirtualMachineSnapshotGroup) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	vmSnapshots := make([]*snapshotv1.VirtualMachineSnapshot, len(group.Status.Members))
+	for i, member := range group.Status.Members {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			vmSnapshots[i] = obj.(*snapshotv1.VirtualMachineSnapshot)
+			continue
+		}
+
+		if !vmSnapshotGroupProgressing(group) || group.Status.FreezeTime != nil {
+			// a snapshot that disappears later on is reported as a failure of the group
+			continue
+		}
+
+		vmSnapshot := newGroupSnapshot(group, member)
+		_, err = ctrl.Client.VirtualMachineSnapshot(group.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return nil, err
+		}
+
+		ctrl.Recorder.Eventf(
+			group,
+			corev1.EventTypeNormal,
+			vmSnapshotCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	return vmSnapshots, nil
+}
+
+func newGroupSnapshot(group *snapshotv1.VirtualMachineSnapshotGroup, member snapshotv1.VirtualMachineSnapshotGroupMember) *snapshotv1.VirtualMachineSnapshot {
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      member.VirtualMachineSnapshotName,
+			Namespace: group.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotGroupLabel: group.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         snapshotv1.SchemeGroupVersion.String(),
+					Kind:               "VirtualMachineSnapshotGroup",
+					Name:               group.Name,
+					UID:                group.UID,
+					Controller:         pointer.Bool(true),
+					BlockOwnerDeletion: pointer.Bool(true),
+				},
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.String(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			DeletionPolicy:  group.Spec.DeletionPolicy,
+			FailureDeadline: group.Spec.FailureDeadline,
+		},
+	}
+}
+
+// groupSnapshotsFailure returns why the group can not complete, if any of its snapshots failed or is gone
+func groupSnapshotsFailure(group *snapshotv1.VirtualMachineSnapshotGroup, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) string {
+	for i, vmSnapshot := range vmSnapshots {
+		member := group.Status.Members[i]
+		switch {
+		case vmSnapshot == nil && group.Status.FreezeTime != nil:
+			return fmt.Sprintf("VirtualMachineSnapshot %s of VirtualMachine %s no longer exists", member.VirtualMachineSnapshotName, member.VirtualMachineName)
+		case vmSnapshot == nil:
+			continue
+		case !metav1.IsControlledBy(vmSnapshot, group):
+			return fmt.Sprintf("VirtualMachineSnapshot %s of VirtualMachine %s is not owned by the group", member.VirtualMachineSnapshotName, member.VirtualMachineName)
+		case vmSnapshotFailed(vmSnapshot), vmSnapshotDeleting(vmSnapshot):
+			return fmt.Sprintf("VirtualMachineSnapshot %s of VirtualMachine %s failed", member.VirtualMachineSnapshotName, member.VirtualMachineName)
+		}
+	}
+
+	return ""
+}
+
+func groupSnapshotsReady(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot == nil || !VmSnapshotReady(vmSnapshot) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ctrl *VMSnapshotGroupController) getGroupVMI(namespace, name string) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance), nil
+}
+
+// freezableGroupMembers returns the members of the group running with a guest agent,
+// the others are snapshotted crash consistent like a single VirtualMachineSnapshot would be
+func (ctrl *VMSnapshotGroupController) freezableGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup) ([]string, error) {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+
+	var names []string
+	for _, member := range group.Status.Members {
+		vmi, err := ctrl.getGroupVMI(group.Namespace, member.VirtualMachineName)
+		if err != nil {
+			return nil, err
+		}
+
+		if vmi != nil && condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+			names = append(names, member.VirtualMachineName)
+		}
+	}
+
+	return names, nil
+}
+
+// freezeGroup freezes all the members of the group, if any of them fails the ones
+// already frozen are thawed again and the error names the failing VirtualMachine
+func (ctrl *VMSnapshotGroupController) freezeGroup(group *snapshotv1.VirtualMachineSnapshotGroup) error {
+	names, err := ctrl.freezableGroupMembers(group)
+	if err != nil {
+		return err
+	}
+
+	for i, name := range names {
+		log.Log.Object(group).V(3).Infof("Freezing vm %s file system before taking the group snapshot", name)
+
+		err := ctrl.Client.VirtualMachineInstance(group.Namespace).Freeze(context.Background(), name, getGroupFailureDeadline(group))
+		if err != nil {
+			ctrl.unfreezeGroupMembers(group, names[:i])
+			return fmt.Errorf("failed to freeze VirtualMachine %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) unfreezeGroupMembers(group *snapshotv1.VirtualMachineSnapshotGroup, names []string) {
+	for _, name := range names {
+		if err := ctrl.Client.VirtualMachineInstance(group.Namespace).Unfreeze(context.Background(), name); err != nil {
+			log.Log.Object(group).Reason(err).Errorf("Failed to unfreeze VirtualMachine %s", name)
+		}
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) releaseGroupSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	if vmSnapshot.Annotations[snapshotGroupFrozenAnnotation] == "true" {
+		return nil
+	}
+
+	vmSnapshotCopy := vmSnapshot.DeepCopy()
+	if vmSnapshotCopy.Annotations == nil {
+		vmSnapshotCopy.Annotations = make(map[string]string)
+	}
+	vmSnapshotCopy.Annotations[snapshotGroupFrozenAnnotation] = "true"
+
+	_, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshotCopy.Namespace).Update(context.Background(), vmSnapshotCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// rollbackGroup thaws the members of the group and deletes all its snapshots before marking it as failed
+func (ctrl *VMSnapshotGroupController) rollbackGroup(group, groupOut *snapshotv1.VirtualMachineSnapshotGroup, reason string) error {
+	log.Log.Object(group).Infof("Rolling back VirtualMachineSnapshotGroup: %s", reason)
+
+	if groupOut.Status.FreezeTime != nil {
+		names, err := ctrl.freezableGroupMembers(groupOut)
+		if err != nil {
+			return err
+		}
+		ctrl.unfreezeGroupMembers(groupOut, names)
+	}
+
+	for _, member := range groupOut.Status.Members {
+		err := ctrl.Client.VirtualMachineSnapshot(groupOut.Namespace).Delete(context.Background(), member.VirtualMachineSnapshotName, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	ctrl.setGroupFailure(groupOut, reason)
+
+	return ctrl.doUpdateGroup(group, groupOut)
+}
+
+func (ctrl *VMSnapshotGroupController) setGroupFailure(group *snapshotv1.VirtualMachineSnapshotGroup, message string) {
+	ctrl.Recorder.Eventf(
+		group,
+		corev1.EventTypeWarning,
+		vmSnapshotGroupErrorEvent,
+		"VirtualMachineSnapshotGroup encountered error %s",
+		message,
+	)
+
+	group.Status.Phase = snapshotv1.Failed
+	group.Status.ReadyToUse = pointer.Bool(false)
+	group.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+	updateGroupCondition(group, newProgressingCondition(corev1.ConditionFalse, message))
+	updateGroupCondition(group, newReadyCondition(corev1.ConditionFalse, message))
+	updateGroupCondition(group, newFailureCondition(corev1.ConditionTrue, message))
+}
+
+func updateGroupCondition(group *snapshotv1.VirtualMachineSnapshotGroup, c snapshotv1.Condition) {
+	group.Status.Conditions = updateCondition(group.Status.Conditions, c, true)
+}
+
+func (ctrl *VMSnapshotGroupController) doUpdateGroup(original, updated *snapshotv1.VirtualMachineSnapshotGroup) error {
+	if !equality.Semantic.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineSnapshotGroup(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"fmt"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+// VMSnapshotGroupController is responsible for snapshotting several VMs at the same instant,
+// it freezes all the members of a group before any of their volumes is snapshotted
+type VMSnapshotGroupController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotGroupInformer cache.SharedIndexInformer
+	VMSnapshotInformer      cache.SharedIndexInformer
+	VMInformer              cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotGroupQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the snapshot group controller
+func (ctrl *VMSnapshotGroupController) Init() error {
+	ctrl.vmSnapshotGroupQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-snapshot-vmsnapshotgroup")
+
+	_, err := ctrl.VMSnapshotGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+
+	return err
+}
+
+// Run the controller
+func (ctrl *VMSnapshotGroupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotGroupQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot group controller.")
+	defer log.Log.Info("Shutting down snapshot group controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotGroupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		groupName, ok := vmSnapshot.Labels[snapshotv1.SnapshotGroupLabel]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vmSnapshot.Namespace, groupName)
+
+		log.Log.V(3).Infof("Handling VirtualMachineSnapshot %s/%s, Group %s", vmSnapshot.Namespace, vmSnapshot.Name, objName)
+		ctrl.vmSnapshotGroupQueue.Add(objName)
+	}
+}
@@ -0,0 +1,327 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot group controller", func() {
+	const groupName = "database"
+
+	var (
+		now = metav1.NewTime(time.Date(2023, time.May, 10, 2, 30, 0, 0, time.UTC))
+
+		vmSnapshotInformer cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		vmiInformer        cache.SharedIndexInformer
+		vmiInterface       *kubecli.MockVirtualMachineInstanceInterface
+		recorder           *record.FakeRecorder
+		client             *kubevirtfake.Clientset
+		controller         *VMSnapshotGroupController
+	)
+
+	createGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupName,
+				Namespace:         testNamespace,
+				UID:               "group-uid",
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Minute)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": groupName},
+				},
+			},
+		}
+	}
+
+	createGroupInProgress := func(vmNames ...string) *snapshotv1.VirtualMachineSnapshotGroup {
+		group := createGroup()
+		group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.Bool(false),
+		}
+		for _, vmName := range vmNames {
+			group.Status.Members = append(group.Status.Members, snapshotv1.VirtualMachineSnapshotGroupMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: fmt.Sprintf("%s-%s", groupName, vmName),
+			})
+		}
+		return group
+	}
+
+	createSelectedVM := func(name string) *v1.VirtualMachine {
+		vm := createVirtualMachine(testNamespace, name)
+		vm.Labels["app"] = groupName
+		return vm
+	}
+
+	createAgentVMI := func(name string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Conditions: []v1.VirtualMachineInstanceCondition{
+					{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+
+	createMemberSnapshot := func(group *snapshotv1.VirtualMachineSnapshotGroup, vmName string) *snapshotv1.VirtualMachineSnapshot {
+		s := newGroupSnapshot(group, snapshotv1.VirtualMachineSnapshotGroupMember{
+			VirtualMachineName:         vmName,
+			VirtualMachineSnapshotName: fmt.Sprintf("%s-%s", groupName, vmName),
+		})
+		s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:                             snapshotv1.InProgress,
+			VirtualMachineSnapshotContentName: pointer.String("vmsnapshot-content-" + vmName),
+		}
+		return s
+	}
+
+	actionsFor := func(verb, resource string) []testing.Action {
+		var actions []testing.Action
+		for _, action := range client.Fake.Actions() {
+			if action.GetVerb() == verb && action.GetResource().Resource == resource {
+				actions = append(actions, action)
+			}
+		}
+		return actions
+	}
+
+	getGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		group, err := client.SnapshotV1alpha1().VirtualMachineSnapshotGroups(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return group
+	}
+
+	addMemberSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+		Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+		_, err := client.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		groupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotGroupController{
+			Client:                  virtClient,
+			VMSnapshotGroupInformer: groupInformer,
+			VMSnapshotInformer:      vmSnapshotInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(client.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+			Return(client.SnapshotV1alpha1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+
+		currentTime = func() *metav1.Time {
+			t := now
+			return &t
+		}
+	})
+
+	reconcile := func(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+		_, err := client.SnapshotV1alpha1().VirtualMachineSnapshotGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		client.Fake.ClearActions()
+
+		return controller.updateVMSnapshotGroup(group)
+	}
+
+	It("should initialize the status", func() {
+		_, err := reconcile(createGroup())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(*updated.Status.ReadyToUse).To(BeFalse())
+	})
+
+	It("should record the selected VirtualMachines as members", func() {
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm1"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm2"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVirtualMachine(testNamespace, "other"))).To(Succeed())
+
+		_, err := reconcile(createGroupInProgress())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Members).To(ConsistOf(
+			snapshotv1.VirtualMachineSnapshotGroupMember{VirtualMachineName: "vm1", VirtualMachineSnapshotName: groupName + "-vm1"},
+			snapshotv1.VirtualMachineSnapshotGroupMember{VirtualMachineName: "vm2", VirtualMachineSnapshotName: groupName + "-vm2"},
+		))
+	})
+
+	It("should fail when no VirtualMachine is selected", func() {
+		_, err := reconcile(createGroupInProgress())
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*updated.Status.Error.Message).To(ContainSubstring("no VirtualMachine matches the selector"))
+		testutils.ExpectEvent(recorder, vmSnapshotGroupErrorEvent)
+	})
+
+	It("should create a snapshot for every member", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		retain := snapshotv1.VirtualMachineSnapshotContentRetain
+		group.Spec.DeletionPolicy = &retain
+
+		_, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+
+		creates := actionsFor("create", "virtualmachinesnapshots")
+		Expect(creates).To(HaveLen(2))
+		for _, action := range creates {
+			vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			Expect(vmSnapshot.Name).To(Equal(fmt.Sprintf("%s-%s", groupName, vmSnapshot.Spec.Source.Name)))
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotGroupLabel, groupName))
+			Expect(metav1.IsControlledBy(vmSnapshot, group)).To(BeTrue())
+			Expect(*vmSnapshot.Spec.DeletionPolicy).To(Equal(snapshotv1.VirtualMachineSnapshotContentRetain))
+		}
+		testutils.ExpectEvent(recorder, vmSnapshotCreateEvent)
+		testutils.ExpectEvent(recorder, vmSnapshotCreateEvent)
+	})
+
+	It("should wait for every member to be locked before freezing", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		addMemberSnapshot(createMemberSnapshot(group, "vm1"))
+		vm2Snapshot := createMemberSnapshot(group, "vm2")
+		vm2Snapshot.Status.VirtualMachineSnapshotContentName = nil
+		addMemberSnapshot(vm2Snapshot)
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm1"))).To(Succeed())
+
+		retry, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeNumerically(">", 0))
+		Expect(getGroup().Status.FreezeTime).To(BeNil())
+		Expect(actionsFor("update", "virtualmachinesnapshots")).To(BeEmpty())
+	})
+
+	It("should freeze all members and release their snapshots", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		addMemberSnapshot(createMemberSnapshot(group, "vm1"))
+		addMemberSnapshot(createMemberSnapshot(group, "vm2"))
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm1"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm2"))).To(Succeed())
+
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm1", snapshotv1.DefaultFailureDeadline).Return(nil)
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm2", snapshotv1.DefaultFailureDeadline).Return(nil)
+
+		_, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(getGroup().Status.FreezeTime.Time).To(BeTemporally("==", now.Time))
+		updates := actionsFor("update", "virtualmachinesnapshots")
+		Expect(updates).To(HaveLen(2))
+		for _, action := range updates {
+			vmSnapshot := action.(testing.UpdateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			Expect(vmSnapshot.Annotations).To(HaveKeyWithValue(snapshotGroupFrozenAnnotation, "true"))
+		}
+	})
+
+	It("should roll back the group when a member cannot be frozen", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		addMemberSnapshot(createMemberSnapshot(group, "vm1"))
+		addMemberSnapshot(createMemberSnapshot(group, "vm2"))
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm1"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm2"))).To(Succeed())
+
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm1", snapshotv1.DefaultFailureDeadline).Return(nil)
+		vmiInterface.EXPECT().Freeze(context.Background(), "vm2", snapshotv1.DefaultFailureDeadline).Return(fmt.Errorf("agent not responding"))
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm1").Return(nil)
+
+		_, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*updated.Status.Error.Message).To(ContainSubstring("failed to freeze VirtualMachine vm2"))
+		Expect(actionsFor("delete", "virtualmachinesnapshots")).To(HaveLen(2))
+		testutils.ExpectEvent(recorder, vmSnapshotGroupErrorEvent)
+	})
+
+	It("should roll back the group when a member snapshot failed", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		group.Status.FreezeTime = &metav1.Time{Time: now.Add(-time.Second)}
+		addMemberSnapshot(createMemberSnapshot(group, "vm1"))
+		failed := createMemberSnapshot(group, "vm2")
+		failed.Status.Phase = snapshotv1.Failed
+		addMemberSnapshot(failed)
+		Expect(vmiInformer.GetStore().Add(createAgentVMI("vm1"))).To(Succeed())
+
+		vmiInterface.EXPECT().Unfreeze(context.Background(), "vm1").Return(nil)
+
+		_, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*updated.Status.Error.Message).To(ContainSubstring("VirtualMachine vm2 failed"))
+		Expect(actionsFor("delete", "virtualmachinesnapshots")).To(HaveLen(2))
+	})
+
+	It("should succeed once every member snapshot succeeded", func() {
+		group := createGroupInProgress("vm1", "vm2")
+		freezeTime := metav1.NewTime(now.Add(-time.Second))
+		group.Status.FreezeTime = &freezeTime
+		for _, vmName := range []string{"vm1", "vm2"} {
+			vmSnapshot := createMemberSnapshot(group, vmName)
+			vmSnapshot.Status.Phase = snapshotv1.Succeeded
+			vmSnapshot.Status.ReadyToUse = pointer.Bool(true)
+			addMemberSnapshot(vmSnapshot)
+		}
+
+		_, err := reconcile(group)
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := getGroup()
+		Expect(updated.Status.Phase).To(Equal(snapshotv1.Succeeded))
+		Expect(*updated.Status.ReadyToUse).To(BeTrue())
+		Expect(updated.Status.CreationTime.Time).To(BeTemporally("==", freezeTime.Time))
+		testutils.ExpectEvent(recorder, vmSnapshotGroupCompleteEvent)
+	})
+})
@@ -36,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -50,6 +51,8 @@ import (
 const (
 	restoreNameAnnotation = "restore.kubevirt.io/name"
 
+	restoreNamespaceAnnotation = "restore.kubevirt.io/namespace"
+
 	populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
 
 	lastRestoreAnnotation = "restore.kubevirt.io/lastRestoreUID"
@@ -98,6 +101,35 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+// restoreTargetNamespace returns the namespace the target of the restore lives in
+func restoreTargetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace != nil && *vmRestore.Spec.TargetNamespace != "" {
+		return *vmRestore.Spec.TargetNamespace
+	}
+	return vmRestore.Namespace
+}
+
+// setRestoreNameAnnotations points obj back to the restore, including its namespace when obj lives elsewhere
+func setRestoreNameAnnotations(vmRestore *snapshotv1.VirtualMachineRestore, obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[restoreNameAnnotation] = vmRestore.Name
+	if restoreTargetNamespace(vmRestore) != vmRestore.Namespace {
+		annotations[restoreNamespaceAnnotation] = vmRestore.Namespace
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// restoreNamespace returns the namespace of the restore an object was created for
+func restoreNamespace(obj metav1.Object) string {
+	if namespace, ok := obj.GetAnnotations()[restoreNamespaceAnnotation]; ok {
+		return namespace
+	}
+	return obj.GetNamespace()
+}
+
 func VmRestoreProgressing(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status == nil || vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete
 }
@@ -287,7 +319,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 	createdPVC := false
 	waitingPVC := false
 	for _, restore := range restores {
-		pvc, err := ctrl.getPVC(vmRestore.Namespace, restore.PersistentVolumeClaimName)
+		pvc, err := ctrl.getPVC(restoreTargetNamespace(vmRestore), restore.PersistentVolumeClaimName)
 		if err != nil {
 			return false, err
 		}
@@ -446,13 +478,13 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 					continue
 				}
 
-				pvc, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+				pvc, err := t.controller.getPVC(restoreTargetNamespace(t.vmRestore), vr.PersistentVolumeClaimName)
 				if err != nil {
 					return false, err
 				}
 
 				if pvc == nil {
-					return false, fmt.Errorf("pvc %s/%s does not exist and should", t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+					return false, fmt.Errorf("pvc %s/%s does not exist and should", restoreTargetNamespace(t.vmRestore), vr.PersistentVolumeClaimName)
 				}
 
 				if nv.DataVolume != nil {
@@ -539,7 +571,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 		newVM = &kubevirtv1.VirtualMachine{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        t.vmRestore.Spec.Target.Name,
-				Namespace:   t.vmRestore.Namespace,
+				Namespace:   restoreTargetNamespace(t.vmRestore),
 				Labels:      snapshotVM.Labels,
 				Annotations: snapshotVM.Annotations,
 			},
@@ -547,6 +579,9 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 			Status: kubevirtv1.VirtualMachineStatus{},
 		}
 
+		if newVM.Name != snapshotVM.Name || newVM.Namespace != snapshotVM.Namespace {
+			resetVMIdentity(newVM, t.vmRestore.Spec.NewMacAddresses, t.vmRestore.Spec.NewSMBiosSerial)
+		}
 	} else {
 		newVM = t.vm.DeepCopy()
 		newVM.Spec = *snapshotVM.Spec.DeepCopy()
@@ -574,7 +609,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	}
 
 	if !t.doesTargetVMExist() {
-		newVM, err = t.controller.Client.VirtualMachine(t.vmRestore.Namespace).Create(context.Background(), newVM)
+		newVM, err = t.controller.Client.VirtualMachine(newVM.Namespace).Create(context.Background(), newVM)
 	} else {
 		newVM, err = t.controller.Client.VirtualMachine(newVM.Namespace).Update(context.Background(), newVM)
 	}
@@ -639,7 +674,7 @@ func (t *vmRestoreTarget) getVirtualMachineSnapshot(namespace, name string) (*sn
 }
 
 func (t *vmRestoreTarget) restoreInstancetypeControllerRevision(vmSnapshotRevisionName, vmSnapshotName string, vm *kubevirtv1.VirtualMachine, isPreference bool) (*appsv1.ControllerRevision, error) {
-	snapshotCR, err := t.getControllerRevision(vm.Namespace, vmSnapshotRevisionName)
+	snapshotCR, err := t.getControllerRevision(t.vmRestore.Namespace, vmSnapshotRevisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -755,7 +790,7 @@ func (t *vmRestoreTarget) createDataVolume(dvt kubevirtv1.DataVolumeTemplateSpec
 	if newDataVolume.Annotations == nil {
 		newDataVolume.Annotations = make(map[string]string)
 	}
-	newDataVolume.Annotations[restoreNameAnnotation] = t.vmRestore.Name
+	setRestoreNameAnnotations(t.vmRestore, newDataVolume)
 
 	if _, err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(t.vm.Namespace).Create(context.Background(), newDataVolume, v1.CreateOptions{}); err != nil {
 		t.controller.Recorder.Eventf(t.vm, corev1.EventTypeWarning, restoreDataVolumeCreateErrorEvent, "Error creating restore DataVolume %s: %v", newDataVolume.Name, err)
@@ -779,7 +814,8 @@ func (t *vmRestoreTarget) createDataVolume(dvt kubevirtv1.DataVolumeTemplateSpec
 }
 
 func (t *vmRestoreTarget) Own(obj metav1.Object) {
-	if !t.doesTargetVMExist() {
+	// owner references can not cross namespaces
+	if !t.doesTargetVMExist() || t.vm.Namespace != obj.GetNamespace() {
 		return
 	}
 
@@ -797,15 +833,16 @@ func (t *vmRestoreTarget) Own(obj metav1.Object) {
 }
 
 func (t *vmRestoreTarget) Cleanup() error {
+	namespace := restoreTargetNamespace(t.vmRestore)
 	for _, dvName := range t.vmRestore.Status.DeletedDataVolumes {
-		objKey := cacheKeyFunc(t.vmRestore.Namespace, dvName)
+		objKey := cacheKeyFunc(namespace, dvName)
 		_, exists, err := t.controller.DataVolumeInformer.GetStore().GetByKey(objKey)
 		if err != nil {
 			return err
 		}
 
 		if exists {
-			err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(t.vmRestore.Namespace).
+			err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(namespace).
 				Delete(context.Background(), dvName, metav1.DeleteOptions{})
 			if err != nil {
 				return err
@@ -903,6 +940,24 @@ func patchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.Virtu
 	return vm, nil
 }
 
+// resetVMIdentity gives a VM restored next to its source its own MAC addresses, SMBIOS serial and firmware UUID
+func resetVMIdentity(vm *kubevirtv1.VirtualMachine, newMacAddresses map[string]string, newSMBiosSerial *string) {
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	for i := range interfaces {
+		// An empty mac address is assigned when none is specified, leaving its allocation to the cluster
+		interfaces[i].MacAddress = newMacAddresses[interfaces[i].Name]
+	}
+
+	if firmware := vm.Spec.Template.Spec.Domain.Firmware; firmware != nil {
+		if newSMBiosSerial != nil {
+			firmware.Serial = *newSMBiosSerial
+		} else if firmware.Serial != "" {
+			firmware.Serial = string(uuid.NewUUID())
+		}
+		firmware.UUID = ""
+	}
+}
+
 func (ctrl *VMRestoreController) getDV(namespace, name string) (*v1beta1.DataVolume, error) {
 	objKey := cacheKeyFunc(namespace, name)
 	obj, exists, err := ctrl.DataVolumeInformer.GetStore().GetByKey(objKey)
@@ -935,7 +990,7 @@ func (ctrl *VMRestoreController) getTarget(vmRestore *snapshotv1.VirtualMachineR
 	vmRestore.Spec.Target.DeepCopy()
 	switch vmRestore.Spec.Target.Kind {
 	case "VirtualMachine":
-		vm, err := ctrl.getVM(vmRestore.Namespace, vmRestore.Spec.Target.Name)
+		vm, err := ctrl.getVM(restoreTargetNamespace(vmRestore), vmRestore.Spec.Target.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -974,9 +1029,16 @@ func (ctrl *VMRestoreController) createRestorePVC(
 		return fmt.Errorf("missing volumeRestore")
 	}
 	pvc := CreateRestorePVCDefFromVMRestore(vmRestore.Name, volumeRestore.PersistentVolumeClaimName, volumeSnapshot, volumeBackup, sourceVmName, sourceVmNamespace)
+	pvc.Namespace = restoreTargetNamespace(vmRestore)
+	if pvc.Namespace != vmRestore.Namespace {
+		// the VolumeSnapshot stays in the namespace of the restore, only dataSourceRef can point across namespaces
+		pvc.Spec.DataSource = nil
+		pvc.Spec.DataSourceRef.Namespace = &vmRestore.Namespace
+		setRestoreNameAnnotations(vmRestore, pvc)
+	}
 	target.Own(pvc)
 
-	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
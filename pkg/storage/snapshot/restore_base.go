@@ -194,7 +194,7 @@ func (ctrl *VMRestoreController) handleDataVolume(obj interface{}) {
 			return
 		}
 
-		objName := cacheKeyFunc(dv.Namespace, restoreName)
+		objName := cacheKeyFunc(restoreNamespace(dv), restoreName)
 
 		log.Log.V(3).Infof("Handling DV %s/%s, Restore %s", dv.Namespace, dv.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
@@ -212,7 +212,7 @@ func (ctrl *VMRestoreController) handlePVC(obj interface{}) {
 			return
 		}
 
-		objName := cacheKeyFunc(pvc.Namespace, restoreName)
+		objName := cacheKeyFunc(restoreNamespace(pvc), restoreName)
 
 		log.Log.V(3).Infof("Handling PVC %s/%s, Restore %s", pvc.Namespace, pvc.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
@@ -9,6 +9,7 @@ import (
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -963,6 +964,99 @@ var _ = Describe("Restore controller", func() {
 						Expect(err).ShouldNot(HaveOccurred())
 					})
 
+					Context("with a firmware set on the source VM", func() {
+						BeforeEach(func() {
+							sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress = "00:00:5e:00:53:ff"
+							sc.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+								Serial: "original-serial",
+								UUID:   "original-uuid",
+							}
+						})
+
+						expectCreatedIdentity := func(macAddress string, serial gomegatypes.GomegaMatcher) {
+							vmInterface.EXPECT().Create(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, newVM *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+								Expect(newVM.Name).To(Equal(newVmName))
+								Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal(macAddress))
+								Expect(newVM.Spec.Template.Spec.Domain.Firmware.Serial).To(serial)
+								Expect(newVM.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEmpty())
+								return newVM, nil
+							}).Times(1)
+						}
+
+						It("should clear the MAC addresses and firmware UUID and generate a new SMBIOS serial", func() {
+							expectCreatedIdentity("", And(Not(BeEmpty()), Not(Equal("original-serial"))))
+
+							targetVM, err := controller.getTarget(r)
+							Expect(err).ShouldNot(HaveOccurred())
+							success, err := targetVM.Reconcile()
+							Expect(success).To(BeTrue())
+							Expect(err).ShouldNot(HaveOccurred())
+						})
+
+						It("should use the MAC addresses and SMBIOS serial from the restore spec", func() {
+							r.Spec.NewMacAddresses = map[string]string{"fake-interface": newMacAddress}
+							r.Spec.NewSMBiosSerial = pointer.String("new-serial")
+							expectCreatedIdentity(newMacAddress, Equal("new-serial"))
+
+							targetVM, err := controller.getTarget(r)
+							Expect(err).ShouldNot(HaveOccurred())
+							success, err := targetVM.Reconcile()
+							Expect(success).To(BeTrue())
+							Expect(err).ShouldNot(HaveOccurred())
+						})
+					})
+
+					Context("in another namespace", func() {
+						const targetNamespace = "target-namespace"
+
+						var targetVMInterface *kubecli.MockVirtualMachineInterface
+
+						BeforeEach(func() {
+							r.Spec.TargetNamespace = pointer.String(targetNamespace)
+							targetVMInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+							virtClient.EXPECT().VirtualMachine(targetNamespace).Return(targetVMInterface).AnyTimes()
+						})
+
+						It("should create the restore PVCs in the target namespace referencing the snapshots across namespaces", func() {
+							addVolumeRestores(r)
+							vs := createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, resource.MustParse("2Gi"))
+							fakeVolumeSnapshotProvider.Add(vs)
+
+							k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+								create, ok := action.(testing.CreateAction)
+								Expect(ok).To(BeTrue())
+								Expect(create.GetNamespace()).To(Equal(targetNamespace))
+
+								pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+								Expect(pvc.OwnerReferences).To(BeEmpty())
+								Expect(pvc.Spec.DataSource).To(BeNil())
+								Expect(pvc.Spec.DataSourceRef.Namespace).To(HaveValue(Equal(testNamespace)))
+								Expect(pvc.Annotations).To(HaveKeyWithValue(restoreNameAnnotation, r.Name))
+								Expect(pvc.Annotations).To(HaveKeyWithValue(restoreNamespaceAnnotation, testNamespace))
+								return true, pvc, nil
+							})
+
+							targetVM, err := controller.getTarget(r)
+							Expect(err).ShouldNot(HaveOccurred())
+							updated, err := controller.reconcileVolumeRestores(r, targetVM)
+							Expect(err).ShouldNot(HaveOccurred())
+							Expect(updated).To(BeTrue())
+						})
+
+						It("should create the new VM in the target namespace", func() {
+							targetVMInterface.EXPECT().Create(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, newVM *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+								Expect(newVM.Name).To(Equal(newVmName))
+								Expect(newVM.Namespace).To(Equal(targetNamespace))
+								return newVM, nil
+							}).Times(1)
+
+							targetVM, err := controller.getTarget(r)
+							Expect(err).ShouldNot(HaveOccurred())
+							success, err := targetVM.Reconcile()
+							Expect(success).To(BeTrue())
+							Expect(err).ShouldNot(HaveOccurred())
+						})
+					})
 				})
 
 			})
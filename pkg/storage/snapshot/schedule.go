@@ -0,0 +1,334 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	vmSnapshotCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	vmSnapshotDeleteEvent = "SuccessfulVirtualMachineSnapshotDelete"
+
+	vmSnapshotScheduleErrorEvent = "VirtualMachineSnapshotScheduleError"
+)
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		// snapshots taken by the schedule outlive it
+		return 0, nil
+	}
+
+	scheduleOut := schedule.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		scheduleOut.Status.NextScheduleTime = nil
+		ctrl.setScheduleFailure(scheduleOut, fmt.Sprintf("invalid schedule %q: %v", schedule.Spec.Schedule, err))
+		// nothing to retry until the schedule is fixed
+		return 0, ctrl.doUpdateSchedule(schedule, scheduleOut)
+	}
+
+	now := currentTime()
+
+	if dueTime := lastMissedScheduleTime(schedule, cronSchedule, now.Time); dueTime != nil && !scheduleDisabled(schedule) {
+		if err := ctrl.createScheduledSnapshots(schedule, *dueTime); err != nil {
+			ctrl.setScheduleFailure(scheduleOut, err.Error())
+			if err2 := ctrl.doUpdateSchedule(schedule, scheduleOut); err2 != nil {
+				return 0, err2
+			}
+			return 0, err
+		}
+
+		scheduleOut.Status.LastScheduleTime = &metav1.Time{Time: *dueTime}
+		scheduleOut.Status.LastSuccessTime = now
+		updateScheduleCondition(scheduleOut, newReadyCondition(corev1.ConditionTrue, "Snapshots created"))
+		updateScheduleCondition(scheduleOut, newFailureCondition(corev1.ConditionFalse, ""))
+	}
+
+	nextExpiration, err := ctrl.enforceRetention(schedule, now.Time)
+	if err != nil {
+		return 0, err
+	}
+
+	next := cronSchedule.Next(now.Time)
+	scheduleOut.Status.NextScheduleTime = &metav1.Time{Time: next}
+	if err := ctrl.doUpdateSchedule(schedule, scheduleOut); err != nil {
+		return 0, err
+	}
+
+	retry := next.Sub(now.Time)
+	if nextExpiration != nil && nextExpiration.Sub(now.Time) < retry {
+		retry = nextExpiration.Sub(now.Time)
+	}
+
+	return retry, nil
+}
+
+func scheduleDisabled(schedule *snapshotv1.VirtualMachineSnapshotSchedule) bool {
+	return schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+}
+
+// maxMissedSchedules bounds the number of missed schedule times walked on every sync,
+// like the CronJob controller does, so that a long outage does not make the walk unbounded
+const maxMissedSchedules = 100
+
+// lastMissedScheduleTime returns the most recent time the schedule was due at
+// and has not been acted upon, or nil if there is none
+func lastMissedScheduleTime(schedule *snapshotv1.VirtualMachineSnapshotSchedule, cronSchedule cron.Schedule, now time.Time) *time.Time {
+	earliest := schedule.CreationTimestamp.Time
+	if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+		earliest = schedule.Status.LastScheduleTime.Time
+	}
+
+	// Skip over the older missed schedule times, estimating their interval from the first two
+	first := cronSchedule.Next(earliest)
+	if second := cronSchedule.Next(first); !first.IsZero() && !second.IsZero() {
+		if window := maxMissedSchedules * second.Sub(first); now.Sub(earliest) > window {
+			earliest = now.Add(-window)
+		}
+	}
+
+	var missed *time.Time
+	for t := cronSchedule.Next(earliest); !t.IsZero() && !t.After(now); t = cronSchedule.Next(t) {
+		due := t
+		missed = &due
+	}
+
+	return missed
+}
+
+func (ctrl *VMSnapshotScheduleController) createScheduledSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, dueTime time.Time) error {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector: %v", err)
+	}
+
+	var vms []*kubevirtv1.VirtualMachine
+	err = cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), schedule.Namespace, selector, func(obj interface{}) {
+		vms = append(vms, obj.(*kubevirtv1.VirtualMachine))
+	})
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+
+		vmSnapshot := newScheduledSnapshot(schedule, vm, dueTime)
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			log.Log.Object(schedule).Reason(err).Errorf("Failed to create VirtualMachineSnapshot for VM %s", vm.Name)
+			failed = append(failed, vm.Name)
+			continue
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			vmSnapshotCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to create snapshots for VMs: %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func newScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, dueTime time.Time) *snapshotv1.VirtualMachineSnapshot {
+	deletionPolicy := snapshotv1.VirtualMachineSnapshotContentDelete
+
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			// the name is derived from the due time so retries never create duplicates
+			Name:      fmt.Sprintf("%s-%s-%d", schedule.Name, vm.Name, dueTime.Unix()),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.String(core.GroupName),
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			DeletionPolicy:  &deletionPolicy,
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+}
+
+// enforceRetention deletes the snapshots of the schedule that are past their retention,
+// the contents are garbage collected by the snapshot controller since the snapshots are
+// created with the Delete deletion policy.
+// Only ready snapshots count towards MaxCount, so that a run of failures never rotates out
+// the last good snapshots. Failed snapshots are deleted once a newer snapshot of the VM is
+// ready, and are otherwise limited to MaxCount on their own.
+// It returns the time at which the next snapshot expires, if any.
+func (ctrl *VMSnapshotScheduleController) enforceRetention(schedule *snapshotv1.VirtualMachineSnapshotSchedule, now time.Time) (*time.Time, error) {
+	retention := schedule.Spec.Retention
+	if retention == nil || (retention.MaxCount == nil && retention.Expires == nil) {
+		return nil, nil
+	}
+
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsPerVM := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, obj := range objs {
+		vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if vmSnapshot.DeletionTimestamp != nil {
+			continue
+		}
+		vmName := vmSnapshot.Spec.Source.Name
+		snapshotsPerVM[vmName] = append(snapshotsPerVM[vmName], vmSnapshot)
+	}
+
+	var nextExpiration *time.Time
+	for _, vmSnapshots := range snapshotsPerVM {
+		// newest first
+		sort.Slice(vmSnapshots, func(i, j int) bool {
+			ti, tj := vmSnapshots[i].CreationTimestamp, vmSnapshots[j].CreationTimestamp
+			if ti.Equal(&tj) {
+				return vmSnapshots[i].Name > vmSnapshots[j].Name
+			}
+			return tj.Before(&ti)
+		})
+
+		var ready, failed int32
+		for _, vmSnapshot := range vmSnapshots {
+			expired := false
+			switch {
+			case VmSnapshotReady(vmSnapshot):
+				expired = retention.MaxCount != nil && ready >= *retention.MaxCount
+				ready++
+			case vmSnapshotFailed(vmSnapshot):
+				// snapshots are sorted newest first, a ready one was already seen if it supersedes this one
+				expired = ready > 0 || (retention.MaxCount != nil && failed >= *retention.MaxCount)
+				failed++
+			}
+			if !expired && retention.Expires != nil {
+				expiration := vmSnapshot.CreationTimestamp.Add(retention.Expires.Duration)
+				if !expiration.After(now) {
+					expired = true
+				} else if nextExpiration == nil || expiration.Before(*nextExpiration) {
+					nextExpiration = &expiration
+				}
+			}
+
+			if expired {
+				if err := ctrl.deleteScheduledSnapshot(schedule, vmSnapshot); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return nextExpiration, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) deleteScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshot *snapshotv1.VirtualMachineSnapshot) error {
+	log.Log.Object(schedule).V(2).Infof("Deleting expired VirtualMachineSnapshot %s", vmSnapshot.Name)
+
+	err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(
+		schedule,
+		corev1.EventTypeNormal,
+		vmSnapshotDeleteEvent,
+		"Successfully deleted expired VirtualMachineSnapshot %s",
+		vmSnapshot.Name,
+	)
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) setScheduleFailure(schedule *snapshotv1.VirtualMachineSnapshotSchedule, message string) {
+	ctrl.Recorder.Eventf(
+		schedule,
+		corev1.EventTypeWarning,
+		vmSnapshotScheduleErrorEvent,
+		"VirtualMachineSnapshotSchedule encountered error %s",
+		message,
+	)
+
+	lastFailure := schedule.Status.LastFailure
+	if lastFailure == nil || lastFailure.Message == nil || *lastFailure.Message != message {
+		schedule.Status.LastFailure = &snapshotv1.Error{
+			Time:    currentTime(),
+			Message: &message,
+		}
+	}
+	updateScheduleCondition(schedule, newReadyCondition(corev1.ConditionFalse, message))
+	updateScheduleCondition(schedule, newFailureCondition(corev1.ConditionTrue, message))
+}
+
+func updateScheduleCondition(schedule *snapshotv1.VirtualMachineSnapshotSchedule, c snapshotv1.Condition) {
+	schedule.Status.Conditions = updateCondition(schedule.Status.Conditions, c, true)
+}
+
+func (ctrl *VMSnapshotScheduleController) doUpdateSchedule(original, updated *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if !equality.Semantic.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineSnapshotSchedule(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
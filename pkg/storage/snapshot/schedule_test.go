@@ -0,0 +1,341 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/robfig/cron/v3"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const scheduleName = "nightly"
+
+	var (
+		now = metav1.NewTime(time.Date(2023, time.May, 10, 2, 30, 0, 0, time.UTC))
+
+		vmSnapshotInformer cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		recorder           *record.FakeRecorder
+		client             *kubevirtfake.Clientset
+		controller         *VMSnapshotScheduleController
+	)
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-24 * time.Hour)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: now.Add(-24*time.Hour - 30*time.Minute)},
+			},
+		}
+	}
+
+	createSelectedVM := func(name string) *v1.VirtualMachine {
+		vm := createVirtualMachine(testNamespace, name)
+		vm.Labels["backup"] = "nightly"
+		return vm
+	}
+
+	createScheduledSnapshot := func(vmName string, age time.Duration) *snapshotv1.VirtualMachineSnapshot {
+		s := createVirtualMachineSnapshot(testNamespace, fmt.Sprintf("%s-%s-%d", scheduleName, vmName, now.Add(-age).Unix()), vmName)
+		s.Labels = map[string]string{snapshotv1.SnapshotScheduleLabel: scheduleName}
+		s.CreationTimestamp = metav1.NewTime(now.Add(-age))
+		s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:      snapshotv1.Succeeded,
+			ReadyToUse: pointer.Bool(true),
+		}
+		return s
+	}
+
+	createFailedScheduledSnapshot := func(vmName string, age time.Duration) *snapshotv1.VirtualMachineSnapshot {
+		s := createScheduledSnapshot(vmName, age)
+		s.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:      snapshotv1.Failed,
+			ReadyToUse: pointer.Bool(false),
+		}
+		return s
+	}
+
+	actionsFor := func(verb, resource string) []testing.Action {
+		var actions []testing.Action
+		for _, action := range client.Fake.Actions() {
+			if action.GetVerb() == verb && action.GetResource().Resource == resource {
+				actions = append(actions, action)
+			}
+		}
+		return actions
+	}
+
+	getSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		schedule, err := client.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		scheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+
+		recorder = record.NewFakeRecorder(100)
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+
+		client = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(client.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(client.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+
+		currentTime = func() *metav1.Time {
+			t := now
+			return &t
+		}
+	})
+
+	reconcile := func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+		_, err := client.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		client.Fake.ClearActions()
+
+		return controller.updateVMSnapshotSchedule(schedule)
+	}
+
+	It("should report an invalid cron expression", func() {
+		schedule := createSchedule()
+		schedule.Spec.Schedule = "every night"
+
+		retry, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeZero())
+		Expect(actionsFor("create", "virtualmachinesnapshots")).To(BeEmpty())
+
+		updated := getSchedule()
+		Expect(updated.Status.LastFailure).ToNot(BeNil())
+		Expect(*updated.Status.LastFailure.Message).To(ContainSubstring("invalid schedule"))
+		Expect(updated.Status.Conditions).To(ContainElement(newFailureCondition(corev1.ConditionTrue, *updated.Status.LastFailure.Message)))
+		testutils.ExpectEvent(recorder, vmSnapshotScheduleErrorEvent)
+	})
+
+	It("should bound the missed schedule times walked after a long outage", func() {
+		cronSchedule, err := cron.ParseStandard("* * * * *")
+		Expect(err).ToNot(HaveOccurred())
+		counting := &countingSchedule{Schedule: cronSchedule}
+		schedule := createSchedule()
+		schedule.CreationTimestamp = metav1.NewTime(now.AddDate(-10, 0, 0))
+		schedule.Status = nil
+
+		dueTime := lastMissedScheduleTime(schedule, counting, now.Time)
+		Expect(dueTime).ToNot(BeNil())
+		Expect(*dueTime).To(BeTemporally("==", now.Time))
+		Expect(counting.calls).To(BeNumerically("<=", maxMissedSchedules+3))
+	})
+
+	It("should not snapshot before the schedule is due", func() {
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm1"))).To(Succeed())
+		schedule := createSchedule()
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+
+		retry, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(23*time.Hour + 30*time.Minute))
+		Expect(actionsFor("create", "virtualmachinesnapshots")).To(BeEmpty())
+
+		updated := getSchedule()
+		Expect(updated.Status.NextScheduleTime.Time).To(BeTemporally("==", now.Add(23*time.Hour+30*time.Minute)))
+	})
+
+	It("should snapshot every selected VM when the schedule is due", func() {
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm1"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm2"))).To(Succeed())
+		Expect(vmInformer.GetStore().Add(createVirtualMachine(testNamespace, "other"))).To(Succeed())
+
+		retry, err := reconcile(createSchedule())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(23*time.Hour + 30*time.Minute))
+
+		dueTime := time.Date(2023, time.May, 10, 2, 0, 0, 0, time.UTC)
+		creates := actionsFor("create", "virtualmachinesnapshots")
+		Expect(creates).To(HaveLen(2))
+		for _, action := range creates {
+			vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			Expect(vmSnapshot.Name).To(Equal(fmt.Sprintf("%s-%s-%d", scheduleName, vmSnapshot.Spec.Source.Name, dueTime.Unix())))
+			Expect(vmSnapshot.Spec.Source.Name).To(BeElementOf("vm1", "vm2"))
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+			Expect(*vmSnapshot.Spec.DeletionPolicy).To(Equal(snapshotv1.VirtualMachineSnapshotContentDelete))
+		}
+
+		updated := getSchedule()
+		Expect(updated.Status.LastScheduleTime.Time).To(BeTemporally("==", dueTime))
+		Expect(updated.Status.LastSuccessTime.Time).To(BeTemporally("==", now.Time))
+		Expect(updated.Status.LastFailure).To(BeNil())
+		testutils.ExpectEvent(recorder, vmSnapshotCreateEvent)
+		testutils.ExpectEvent(recorder, vmSnapshotCreateEvent)
+	})
+
+	It("should not snapshot when disabled", func() {
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm1"))).To(Succeed())
+		schedule := createSchedule()
+		schedule.Spec.Disabled = pointer.Bool(true)
+
+		_, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actionsFor("create", "virtualmachinesnapshots")).To(BeEmpty())
+	})
+
+	It("should report a failure when a snapshot cannot be created", func() {
+		Expect(vmInformer.GetStore().Add(createSelectedVM("vm1"))).To(Succeed())
+		schedule := createSchedule()
+		client.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("quota exceeded")
+		})
+
+		_, err := reconcile(schedule)
+		Expect(err).To(HaveOccurred())
+
+		updated := getSchedule()
+		Expect(updated.Status.LastScheduleTime).To(Equal(schedule.Status.LastScheduleTime))
+		Expect(updated.Status.LastSuccessTime).To(BeNil())
+		Expect(*updated.Status.LastFailure.Message).To(ContainSubstring("vm1"))
+		testutils.ExpectEvent(recorder, vmSnapshotScheduleErrorEvent)
+	})
+
+	It("should keep only the newest snapshots of every VM", func() {
+		schedule := createSchedule()
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+		schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+			MaxCount: pointer.Int32(2),
+		}
+		for _, vmName := range []string{"vm1", "vm2"} {
+			for day := 0; day < 3; day++ {
+				Expect(vmSnapshotInformer.GetStore().Add(createScheduledSnapshot(vmName, time.Duration(day)*24*time.Hour))).To(Succeed())
+			}
+		}
+
+		_, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		deletes := actionsFor("delete", "virtualmachinesnapshots")
+		Expect(deletes).To(HaveLen(2))
+		var deleted []string
+		for _, action := range deletes {
+			deleted = append(deleted, action.(testing.DeleteAction).GetName())
+		}
+		Expect(deleted).To(ConsistOf(
+			createScheduledSnapshot("vm1", 48*time.Hour).Name,
+			createScheduledSnapshot("vm2", 48*time.Hour).Name,
+		))
+	})
+
+	It("should not rotate out ready snapshots because of failed ones", func() {
+		schedule := createSchedule()
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+		schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+			MaxCount: pointer.Int32(2),
+		}
+		for day := 0; day < 3; day++ {
+			Expect(vmSnapshotInformer.GetStore().Add(createFailedScheduledSnapshot("vm1", time.Duration(day)*24*time.Hour))).To(Succeed())
+		}
+		for day := 3; day < 5; day++ {
+			Expect(vmSnapshotInformer.GetStore().Add(createScheduledSnapshot("vm1", time.Duration(day)*24*time.Hour))).To(Succeed())
+		}
+		inProgress := createScheduledSnapshot("vm1", time.Hour)
+		inProgress.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: snapshotv1.InProgress}
+		Expect(vmSnapshotInformer.GetStore().Add(inProgress)).To(Succeed())
+
+		_, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		deletes := actionsFor("delete", "virtualmachinesnapshots")
+		Expect(deletes).To(HaveLen(1))
+		Expect(deletes[0].(testing.DeleteAction).GetName()).To(Equal(createFailedScheduledSnapshot("vm1", 48*time.Hour).Name))
+	})
+
+	It("should delete failed snapshots once a newer snapshot is ready", func() {
+		schedule := createSchedule()
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+		schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+			MaxCount: pointer.Int32(2),
+		}
+		Expect(vmSnapshotInformer.GetStore().Add(createScheduledSnapshot("vm1", 0))).To(Succeed())
+		failed := createFailedScheduledSnapshot("vm1", 24*time.Hour)
+		Expect(vmSnapshotInformer.GetStore().Add(failed)).To(Succeed())
+		Expect(vmSnapshotInformer.GetStore().Add(createScheduledSnapshot("vm1", 48*time.Hour))).To(Succeed())
+
+		_, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+
+		deletes := actionsFor("delete", "virtualmachinesnapshots")
+		Expect(deletes).To(HaveLen(1))
+		Expect(deletes[0].(testing.DeleteAction).GetName()).To(Equal(failed.Name))
+	})
+
+	It("should delete expired snapshots and requeue for the next expiration", func() {
+		schedule := createSchedule()
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+		schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+			Expires: &metav1.Duration{Duration: 36 * time.Hour},
+		}
+		expired := createScheduledSnapshot("vm1", 48*time.Hour)
+		Expect(vmSnapshotInformer.GetStore().Add(expired)).To(Succeed())
+		Expect(vmSnapshotInformer.GetStore().Add(createScheduledSnapshot("vm1", 24*time.Hour))).To(Succeed())
+
+		retry, err := reconcile(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(12 * time.Hour))
+
+		deletes := actionsFor("delete", "virtualmachinesnapshots")
+		Expect(deletes).To(HaveLen(1))
+		Expect(deletes[0].(testing.DeleteAction).GetName()).To(Equal(expired.Name))
+		testutils.ExpectEvent(recorder, vmSnapshotDeleteEvent)
+	})
+})
+
+type countingSchedule struct {
+	cron.Schedule
+	calls int
+}
+
+func (s *countingSchedule) Next(t time.Time) time.Time {
+	s.calls++
+	return s.Schedule.Next(t)
+}
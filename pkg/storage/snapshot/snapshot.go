@@ -295,6 +295,12 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	currentlyCreated := vmSnapshotContentCreated(content)
 	currentlyError := (content.Status != nil && content.Status.Error != nil) || vmSnapshotError(vmSnapshot) != nil
 
+	if !currentlyCreated && vmSnapshot != nil && !vmSnapshotDeleting(vmSnapshot) && waitingForGroupFreeze(vmSnapshot) {
+		// the group controller freezes all its members and releases them at once
+		log.Log.V(3).Infof("Content %s/%s waiting for the members of its group to be frozen", content.Namespace, content.Name)
+		return 0, nil
+	}
+
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
 			continue
@@ -334,7 +340,8 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
-			if !didFreeze {
+			// members of a group are frozen by the group controller
+			if !didFreeze && !vmSnapshotGroupMember(vmSnapshot) {
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
 					return 0, err
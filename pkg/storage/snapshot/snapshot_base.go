@@ -406,6 +406,11 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+
+		// the content of a group member waits for the snapshot to be released by the group
+		if vmSnapshotGroupMember(vmSnapshot) && vmSnapshot.Status != nil && vmSnapshot.Status.VirtualMachineSnapshotContentName != nil {
+			ctrl.vmSnapshotContentQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName))
+		}
 	}
 }
 
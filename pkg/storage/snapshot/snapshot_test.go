@@ -1260,6 +1260,22 @@ var _ = Describe("Snapshot controlleer", func() {
 				testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
 			})
 
+			It("should not create VolumeSnapshots before the snapshot group is frozen", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Labels = map[string]string{snapshotv1.SnapshotGroupLabel: "group"}
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vm := createLockedVM()
+				vmSource.Add(vm)
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVolumeSnapshotClass(createVolumeSnapshotClasses()[0])
+
+				// no VolumeSnapshot is created and the source is not frozen
+				controller.processVMSnapshotContentWorkItem()
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
 			DescribeTable("should update VirtualMachineSnapshotContent", func(readyToUse bool) {
 				vmSnapshot := createVMSnapshotInProgress()
 				vmSnapshotContent := createVMSnapshotContent()
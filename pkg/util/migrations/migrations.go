@@ -62,6 +62,35 @@ func MigrationFailed(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// IsCrossClusterMigrationTarget returns true if a given VMI waits for a domain migrated from another cluster.
+func IsCrossClusterMigrationTarget(vmi *v1.VirtualMachineInstance) bool {
+	_, isTarget := vmi.Annotations[v1.CrossClusterMigrationTargetAnnotation]
+	return isTarget && !vmi.IsRunning() && !vmi.IsFinal()
+}
+
+// IsCrossClusterMigrationSource returns true if a given VMI is being migrated to another cluster.
+func IsCrossClusterMigrationSource(vmi *v1.VirtualMachineInstance) bool {
+	state := vmi.Status.MigrationState
+	return state != nil && state.CrossCluster != nil && state.SourceNode != ""
+}
+
+// MigratedToAnotherCluster returns true if a given VMI completed a migration to another cluster
+// and therefore no longer runs in this one.
+func MigratedToAnotherCluster(vmi *v1.VirtualMachineInstance) bool {
+	return IsCrossClusterMigrationSource(vmi) &&
+		vmi.Status.MigrationState.Completed &&
+		!vmi.Status.MigrationState.Failed
+}
+
+// CrossClusterVolumeMigration returns how the persistent volumes of a VMI are transferred
+// to another cluster, or an empty string if the VMI is not being migrated to another cluster.
+func CrossClusterVolumeMigration(vmi *v1.VirtualMachineInstance) v1.CrossClusterVolumeMigration {
+	if !IsCrossClusterMigrationSource(vmi) {
+		return ""
+	}
+	return vmi.Status.MigrationState.CrossCluster.VolumeMigration
+}
+
 func VMIEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) *v1.EvictionStrategy {
 	if vmi != nil && vmi.Spec.EvictionStrategy != nil {
 		return vmi.Spec.EvictionStrategy
@@ -32,6 +32,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
@@ -126,3 +127,55 @@ func (m *manager) GetCurrent() (*x509.CertPool, error) {
 
 	return pool, nil
 }
+
+type crossClusterCAManager struct {
+	caManager     ClientCAManager
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+// NewCrossClusterCAManager returns a manager trusting the CA of the given manager and the
+// CA bundle of the peer clusters configured for cross cluster migrations.
+func NewCrossClusterCAManager(caManager ClientCAManager, clusterConfig *virtconfig.ClusterConfig) ClientCAManager {
+	return &crossClusterCAManager{
+		caManager:     caManager,
+		clusterConfig: clusterConfig,
+	}
+}
+
+func (m *crossClusterCAManager) peerCABundle() string {
+	return m.clusterConfig.GetMigrationConfiguration().CrossClusterPeerCABundle
+}
+
+func (m *crossClusterCAManager) GetCurrentRaw() ([]byte, error) {
+	raw, err := m.caManager.GetCurrentRaw()
+	if err != nil {
+		return nil, err
+	}
+	peerCABundle := m.peerCABundle()
+	if peerCABundle == "" {
+		return raw, nil
+	}
+	return append(append(append([]byte{}, raw...), '\n'), peerCABundle...), nil
+}
+
+func (m *crossClusterCAManager) GetCurrent() (*x509.CertPool, error) {
+	pool, err := m.caManager.GetCurrent()
+	if err != nil || pool == nil {
+		return pool, err
+	}
+	peerCABundle := m.peerCABundle()
+	if peerCABundle == "" {
+		return pool, nil
+	}
+
+	certs, err := cert.ParseCertsPEM([]byte(peerCABundle))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the cross cluster peer CA bundle: %v", err)
+	}
+	// the pool of the wrapped manager is cached and shared, never extend it in place
+	pool = pool.Clone()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool, nil
+}
@@ -9,8 +9,11 @@ import (
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
+	virtv1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/certificates/triple"
 	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -92,4 +95,41 @@ var _ = Describe("CaManager", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(cert.Subjects()[0]).To(ContainSubstring("first"))
 	})
+
+	Context("for cross cluster migrations", func() {
+		newCrossClusterCAManager := func(peerCABundle string) ClientCAManager {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+				MigrationConfiguration: &virtv1.MigrationConfiguration{CrossClusterPeerCABundle: peerCABundle},
+			})
+			return NewCrossClusterCAManager(manager, clusterConfig)
+		}
+
+		It("should trust only the own CA without a peer CA bundle", func() {
+			pool, err := newCrossClusterCAManager("").GetCurrent()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pool.Subjects()).To(HaveLen(1))
+			Expect(pool.Subjects()[0]).To(ContainSubstring("first"))
+		})
+
+		It("should trust the peer CA bundle in addition to the own CA", func() {
+			peerCA, err := triple.NewCA("peer", time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			crossClusterManager := newCrossClusterCAManager(string(cert.EncodeCertPEM(peerCA.Cert)))
+
+			pool, err := crossClusterManager.GetCurrent()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pool.Subjects()).To(HaveLen(2))
+			Expect(pool.Subjects()[1]).To(ContainSubstring("peer"))
+
+			By("leaving the pool of the own CA untouched")
+			ownPool, err := manager.GetCurrent()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ownPool.Subjects()).To(HaveLen(1))
+		})
+
+		It("should fail on an invalid peer CA bundle", func() {
+			_, err := newCrossClusterCAManager("garbage").GetCurrent()
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -16,7 +16,13 @@ import (
 	"kubevirt.io/client-go/log"
 )
 
-const noSrvCertMessage = "No server certificate, server is not yet ready to receive traffic"
+const (
+	noSrvCertMessage = "No server certificate, server is not yet ready to receive traffic"
+
+	// BackupClientCommonName is the common name of the certificates export servers
+	// present to virt-handler to read the disks of a pull mode backup
+	BackupClientCommonName = "kubevirt.io:system:client:virt-exportserver"
+)
 
 var (
 	cipherSuites         = tls.CipherSuites()
@@ -133,6 +139,20 @@ func SetupTLSWithCertManager(caManager ClientCAManager, certManager certificate.
 }
 
 func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certificate.Manager, externallyManaged bool, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
+	return setupTLSForVirtHandlerServer(caManager, certManager, clusterConfig, func(rawCerts [][]byte, certPool *x509.CertPool) error {
+		return verifyPeerCert(rawCerts, externallyManaged, certPool, x509.ExtKeyUsageClientAuth, "client")
+	})
+}
+
+// SetupTLSForVirtHandlerBackupServer only accepts the client certificates the
+// export controller issues to export servers from the export CA
+func SetupTLSForVirtHandlerBackupServer(exportCAManager ClientCAManager, certManager certificate.Manager, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
+	return setupTLSForVirtHandlerServer(exportCAManager, certManager, clusterConfig, func(rawCerts [][]byte, certPool *x509.CertPool) error {
+		return verifyPeerCertCommonName(rawCerts, false, certPool, x509.ExtKeyUsageClientAuth, BackupClientCommonName)
+	})
+}
+
+func setupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certificate.Manager, clusterConfig *virtconfig.ClusterConfig, verifyPeer func([][]byte, *x509.CertPool) error) *tls.Config {
 	// #nosec cause: InsecureSkipVerify: true
 	// resolution: Neither the client nor the server should validate anything itself, `VerifyPeerCertificate` is still executed
 	return &tls.Config{
@@ -174,7 +194,7 @@ func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certifi
 				InsecureSkipVerify: true,
 				// XXX: We need to verify the cert ourselves because we don't have DNS or IP on the certs at the moment
 				VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
-					return verifyPeerCert(rawCerts, externallyManaged, certPool, x509.ExtKeyUsageClientAuth, "client")
+					return verifyPeer(rawCerts, certPool)
 				},
 				ClientAuth: tls.RequireAndVerifyClientCert,
 			}
@@ -215,6 +235,25 @@ func SetupTLSForVirtHandlerClients(caManager ClientCAManager, certManager certif
 	}
 }
 
+// SetupTLSForVirtHandlerBackupClients is used by export servers to read the
+// disks of a pull mode backup through virt-handler. The common name of the
+// virt-handler certificate is not checked, since it is unknown when the
+// certificates are externally managed.
+func SetupTLSForVirtHandlerBackupClients(caPool *x509.CertPool, clientCert *tls.Certificate) *tls.Config {
+	// #nosec cause: InsecureSkipVerify: true
+	// resolution: Neither the client nor the server should validate anything itself, `VerifyPeerCertificate` is still executed
+	return &tls.Config{
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS12,
+		GetClientCertificate: func(info *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return clientCert, nil
+		},
+		VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			return verifyPeerCertCommonName(rawCerts, true, caPool, x509.ExtKeyUsageServerAuth, "")
+		},
+	}
+}
+
 func getTLSConfiguration(kubevirt *v1.KubeVirt) *v1.TLSConfiguration {
 	tlsConfiguration := &v1.TLSConfiguration{
 		MinTLSVersion: v1.VersionTLS12,
@@ -283,6 +322,15 @@ func TLSVersionName(versionId uint16) string {
 }
 
 func verifyPeerCert(rawCerts [][]byte, externallyManaged bool, certPool *x509.CertPool, usage x509.ExtKeyUsage, commonName string) error {
+	fullCommonName := ""
+	if !externallyManaged {
+		fullCommonName = fmt.Sprintf("kubevirt.io:system:%s:virt-handler", commonName)
+	}
+	return verifyPeerCertCommonName(rawCerts, externallyManaged, certPool, usage, fullCommonName)
+}
+
+// verifyPeerCertCommonName verifies the peer certificate and, unless empty, its common name
+func verifyPeerCertCommonName(rawCerts [][]byte, externallyManaged bool, certPool *x509.CertPool, usage x509.ExtKeyUsage, commonName string) error {
 	// impossible with RequireAnyClientCert
 	if len(rawCerts) == 0 {
 		return fmt.Errorf("no client certificate provided.")
@@ -305,9 +353,8 @@ func verifyPeerCert(rawCerts [][]byte, externallyManaged bool, certPool *x509.Ce
 		return fmt.Errorf("could not verify peer certificate: %v", err)
 	}
 
-	fullCommonName := fmt.Sprintf("kubevirt.io:system:%s:virt-handler", commonName)
-	if !externallyManaged && c.Subject.CommonName != fullCommonName {
-		return fmt.Errorf("common name is invalid, expected %s, but got %s", fullCommonName, c.Subject.CommonName)
+	if commonName != "" && c.Subject.CommonName != commonName {
+		return fmt.Errorf("common name is invalid, expected %s, but got %s", commonName, c.Subject.CommonName)
 	}
 
 	return nil
@@ -25,6 +25,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/certificate"
 
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
 	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
@@ -197,6 +198,49 @@ var _ = Describe("TLS", func() {
 		),
 	)
 
+	DescribeTable("on the virt-handler backup server should", func(commonName string, signedByExportCA bool, errStr string) {
+		exportCA, err := triple.NewCA("export", time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		exportCAManager := &mockCAManager{caBundle: cert.EncodeCertPEM(exportCA.Cert)}
+
+		var clientCert *tls.Certificate
+		if signedByExportCA {
+			keyPair, err := triple.NewClientKeyPair(exportCA, commonName, []string{"namespace"}, time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			crt, err := tls.X509KeyPair(cert.EncodeCertPEM(keyPair.Cert), cert.EncodePrivateKeyPEM(keyPair.Key))
+			Expect(err).ToNot(HaveOccurred())
+			clientCert = &crt
+		} else {
+			clientCert = certmanagers[components.VirtHandlerCertSecretName].Current()
+		}
+		kubevirtCAPool, err := caManager.GetCurrent()
+		Expect(err).ToNot(HaveOccurred())
+
+		serverTLSConfig := kvtls.SetupTLSForVirtHandlerBackupServer(exportCAManager, certmanagers[components.VirtHandlerServerCertSecretName], clusterConfig)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerBackupClients(kubevirtCAPool, clientCert)
+		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintln(w, "hello")
+		}))
+		srv.TLS = serverTLSConfig
+		srv.StartTLS()
+		defer srv.Close()
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+		resp, err := client.Get(srv.URL)
+		if errStr != "" {
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(errStr))
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.TrimSpace(string(body))).To(Equal("hello"))
+	},
+		Entry("connect with an export server certificate", kvtls.BackupClientCommonName, true, ""),
+		Entry("fail with another common name signed by the export CA", "kubevirt.io:system:client:other", true, "remote error: tls: bad certificate"),
+		Entry("fail with a certificate signed by the KubeVirt CA", "", false, "remote error: tls: unknown certificate authority"),
+	)
+
 	DescribeTable("should allow anonymous TLS connections", func(setupTLS func() *tls.Config) {
 		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintln(w, "hello")
@@ -118,6 +118,21 @@ func IsSEVAttestationRequested(vmi *v1.VirtualMachineInstance) bool {
 	return IsSEVVMI(vmi) && vmi.Spec.Domain.LaunchSecurity.SEV.Attestation != nil
 }
 
+// Check if a VMI spec requests AMD SEV-SNP
+func IsSEVSNPVMI(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.SNP != nil
+}
+
+// Check if a VMI spec requests Intel TDX
+func IsTDXVMI(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.TDX != nil
+}
+
+// Check if a VMI spec requests an encrypted guest, with any launch security technology
+func IsConfidentialVMI(vmi *v1.VirtualMachineInstance) bool {
+	return IsSEVVMI(vmi) || IsSEVSNPVMI(vmi) || IsTDXVMI(vmi)
+}
+
 func IsAMD64VMI(vmi *v1.VirtualMachineInstance) bool {
 	if vmi.Spec.Architecture == "amd64" {
 		return true
@@ -297,6 +297,23 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("backup")).
+			To(subresourceApp.BackupVMIRequestHandler).
+			Reads(v1.VirtualMachineInstanceBackupOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"Backup").
+			Doc("Start a backup job in a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("abortbackup")).
+			To(subresourceApp.AbortBackupVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"AbortBackup").
+			Doc("Abort the backup job of a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("softreboot")).
 			To(subresourceApp.SoftRebootVMIRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -451,6 +468,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("cpuload")).
+			To(subresourceApp.CPULoad).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Cpuload").
+			Doc("Get the vCPU utilization of a running VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceCPULoad{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceCPULoad{}))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Reads(v1.AddVolumeOptions{}).
@@ -561,6 +588,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("attestationreport")).
+			To(subresourceApp.AttestationReportHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.NonceParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"AttestationReport").
+			Doc("Fetch the attestation report of a confidential Virtual Machine").
+			Writes(v1.AttestationReport{}).
+			Returns(http.StatusOK, "OK", v1.AttestationReport{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -607,6 +645,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/unfreeze",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/backup",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/abortbackup",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/softreboot",
 						Namespaced: true,
@@ -651,6 +697,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/cpuload",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -675,6 +725,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/sev/injectlaunchsecret",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/attestationreport",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -894,6 +948,15 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroups(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMBackupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackups(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -1065,6 +1128,7 @@ func (app *virtAPIApp) Run() {
 	crdInformer := kubeInformerFactory.CRD()
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
+	vmInformer := kubeInformerFactory.VirtualMachine()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1102,6 +1166,7 @@ func (app *virtAPIApp) Run() {
 		VMIPresetInformer:  vmiPresetInformer,
 		VMRestoreInformer:  vmRestoreInformer,
 		DataSourceInformer: dataSourceInformer,
+		VMInformer:         vmInformer,
 	}
 
 	// Build webhook subresources
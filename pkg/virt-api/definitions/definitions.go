@@ -125,6 +125,9 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmsgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotgroups")
+	vmbGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinebackups")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +149,21 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmsgGVR, &snapshotv1.VirtualMachineSnapshotGroup{}, "VirtualMachineSnapshotGroup", &snapshotv1.VirtualMachineSnapshotGroupList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmbGVR, &snapshotv1.VirtualMachineBackup{}, "VirtualMachineBackup", &snapshotv1.VirtualMachineBackupList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -568,6 +586,7 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	NonceParamName      = "nonce"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +601,10 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func NonceParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(NonceParamName, "Base64 encoded nonce to embed into the attestation report").Required(true)
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
@@ -56,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -69,6 +70,8 @@ const (
 	vmiNotPaused                 = "VMI is not paused"
 	vmiGuestAgentErr             = "VMI does not have guest agent connected"
 	vmiNoAttestationErr          = "Attestation not requested for VMI"
+	vmiNotConfidentialErr        = "VMI is not a confidential guest"
+	vmiGuestAttestationErr       = "SEV-SNP and TDX attestation reports can only be requested from within the guest"
 	prepConnectionErrFmt         = "Cannot prepare connection %s"
 	getRequestErrFmt             = "Cannot GET request %s"
 	pvcVolumeModeErr             = "pvc should be filesystem pvc"
@@ -178,6 +181,10 @@ func (app *SubresourceAPIApp) httpGetRequestHandler(request *restful.Request, re
 		return
 	}
 
+	getAndWriteEntity(response, url, conn, v)
+}
+
+func getAndWriteEntity(response *restful.Response, url string, conn kubecli.VirtHandlerConn, v interface{}) {
 	resp, conErr := conn.Get(url)
 	if conErr != nil {
 		log.Log.Errorf(getRequestErrFmt, conErr.Error())
@@ -814,6 +821,45 @@ func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request
 
 }
 
+func (app *SubresourceAPIApp) BackupVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.IncrementalBackupEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.IncrementalBackupGate)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body: backup options are required"), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.BackupURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
+func (app *SubresourceAPIApp) AbortBackupVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.AbortBackupURI(vmi)
+	}
+	app.putRequestHandler(request, response, validate, getURL, false)
+}
+
 func (app *SubresourceAPIApp) SoftRebootVMIRequestHandler(request *restful.Request, response *restful.Response) {
 
 	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
@@ -962,6 +1008,21 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// CPULoad handles the subresource for providing the vCPU utilization of a VMI
+func (app *SubresourceAPIApp) CPULoad(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.CPULoadURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceCPULoad{})
+}
+
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) (string, error) {
 	vmCopy := vm.DeepCopy()
 
@@ -1636,6 +1697,42 @@ func (app *SubresourceAPIApp) SEVQueryLaunchMeasurementHandler(request *restful.
 	app.httpGetRequestHandler(request, response, validateVMIForSEVAttestation, getURL, v1.SEVMeasurementInfo{})
 }
 
+func (app *SubresourceAPIApp) AttestationReportHandler(request *restful.Request, response *restful.Response) {
+	nonce := request.QueryParameter(definitions.NonceParamName)
+	if nonce == "" {
+		writeError(errors.NewBadRequest("nonce is required to request an attestation report"), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		// The host has no access to the reports of SEV-SNP and TDX guests, they are requested from within the guest
+		if kutil.IsSEVSNPVMI(vmi) || kutil.IsTDXVMI(vmi) {
+			return errors.NewBadRequest(vmiGuestAttestationErr)
+		}
+		if !app.clusterConfig.WorkloadEncryptionSEVEnabled() {
+			return errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, virtconfig.WorkloadEncryptionSEV))
+		}
+		if !vmi.IsRunning() {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !kutil.IsConfidentialVMI(vmi) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotConfidentialErr))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.AttestationReportURI(vmi, nonce)
+	}
+
+	_, url, conn, statusErr := app.prepareConnection(request, validate, getURL)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	getAndWriteEntity(response, url, conn, v1.AttestationReport{})
+}
+
 func (app *SubresourceAPIApp) SEVSetupSessionHandler(request *restful.Request, response *restful.Response) {
 	if !app.ensureSEVEnabled(response) {
 		return
@@ -29,6 +29,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -2033,6 +2034,76 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Backup", func() {
+		withBackupOptions := func() {
+			body, err := json.Marshal(&v1.VirtualMachineInstanceBackupOptions{
+				Name:       "backup",
+				Mode:       v1.BackupModePull,
+				Checkpoint: "backup",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+		}
+
+		It("Should fail to back up a VMI when the feature gate is disabled", func() {
+			withBackupOptions()
+
+			app.BackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		Context("with the IncrementalBackup feature gate", func() {
+			BeforeEach(func() {
+				enableFeatureGate(virtconfig.IncrementalBackupGate)
+			})
+
+			It("Should back up a running VMI", func() {
+				backend.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/backup"),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+				withBackupOptions()
+				expectVMI(Running, UnPaused)
+
+				app.BackupVMIRequestHandler(request, response)
+
+				Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			})
+
+			It("Should fail backing up a not running VMI", func() {
+				withBackupOptions()
+				expectVMI(NotRunning, UnPaused)
+
+				app.BackupVMIRequestHandler(request, response)
+
+				ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			})
+
+			It("Should fail backing up without options", func() {
+				app.BackupVMIRequestHandler(request, response)
+
+				ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			})
+		})
+
+		It("Should abort the backup of a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/abortbackup"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMI(Running, UnPaused)
+
+			app.AbortBackupVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+	})
+
 	Context("SoftReboot", func() {
 		It("Should soft reboot a running VMI", func() {
 			backend.AppendHandlers(
@@ -2344,6 +2415,63 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Subresource api - attestation report", func() {
+		const nonce = "AAECAwQFBgcICQoLDA0ODw=="
+
+		withLaunchSecurity := func(launchSecurity *v1.LaunchSecurity) func(vmi *v1.VirtualMachineInstance) {
+			return func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.LaunchSecurity = launchSecurity
+			}
+		}
+
+		BeforeEach(func() {
+			request.Request.URL = &url.URL{RawQuery: url.Values{"nonce": []string{nonce}}.Encode()}
+		})
+
+		It("Should allow to fetch the attestation report of a running SEV VMI", func() {
+			enableFeatureGate(virtconfig.WorkloadEncryptionSEV)
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/attestationreport", "nonce="+url.QueryEscape(nonce)),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.AttestationReport{}),
+				),
+			)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			expectVMI(Running, UnPaused, withLaunchSecurity(&v1.LaunchSecurity{SEV: &v1.SEV{}}))
+			app.AttestationReportHandler(request, response)
+			Expect(response.Error()).ToNot(HaveOccurred())
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		DescribeTable("Should reject fetching the attestation report from the host", func(featureGate string, launchSecurity *v1.LaunchSecurity) {
+			enableFeatureGate(featureGate)
+			expectVMI(Running, UnPaused, withLaunchSecurity(launchSecurity))
+			app.AttestationReportHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		},
+			Entry("with SEV-SNP", virtconfig.WorkloadEncryptionSEV, &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}),
+			Entry("with TDX", virtconfig.WorkloadEncryptionTDX, &v1.LaunchSecurity{TDX: &v1.TDX{}}),
+		)
+
+		DescribeTable("Should fail to fetch the attestation report", func(featureGate string, running bool, launchSecurity *v1.LaunchSecurity, expectedStatusCode int) {
+			enableFeatureGate(featureGate)
+			expectVMI(running, UnPaused, withLaunchSecurity(launchSecurity))
+			app.AttestationReportHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(expectedStatusCode))
+		},
+			Entry("when VMI is not running", virtconfig.WorkloadEncryptionSEV, NotRunning, &v1.LaunchSecurity{SEV: &v1.SEV{}}, http.StatusConflict),
+			Entry("when VMI is not a confidential guest", virtconfig.WorkloadEncryptionSEV, Running, nil, http.StatusConflict),
+			Entry("when the SEV feature gate is disabled", virtconfig.WorkloadEncryptionTDX, Running, &v1.LaunchSecurity{SEV: &v1.SEV{}}, http.StatusBadRequest),
+		)
+
+		It("Should fail to fetch the attestation report without a nonce", func() {
+			request.Request.URL = &url.URL{}
+			app.AttestationReportHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+	})
+
 	AfterEach(func() {
 		backend.Close()
 		disableFeatureGates()
@@ -1080,6 +1080,14 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 			map[string]string{},
 			map[string]string{v1.SEVLabel: ""},
 			&v1.LaunchSecurity{SEV: &v1.SEV{}}),
+		Entry("It should add SEV-SNP node label selector with SEV-SNP workload",
+			map[string]string{},
+			map[string]string{v1.SEVSNPLabel: ""},
+			&v1.LaunchSecurity{SNP: &v1.SEVSNP{}}),
+		Entry("It should add TDX node label selector with TDX workload",
+			map[string]string{},
+			map[string]string{v1.TDXLabel: ""},
+			&v1.LaunchSecurity{TDX: &v1.TDX{}}),
 		Entry("It should not add SEV node label selector when no SEV workload",
 			map[string]string{v1.NodeSchedulable: "true"},
 			map[string]string{v1.NodeSchedulable: "true"},
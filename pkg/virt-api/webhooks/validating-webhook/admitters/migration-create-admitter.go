@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
@@ -44,10 +45,14 @@ type MigrationCreateAdmitter struct {
 	VirtClient    kubecli.KubevirtClient
 }
 
-func isMigratable(vmi *v1.VirtualMachineInstance) error {
+func isMigratable(vmi *v1.VirtualMachineInstance, migration *v1.VirtualMachineInstanceMigration) error {
 	for _, c := range vmi.Status.Conditions {
 		if c.Type == v1.VirtualMachineInstanceIsMigratable &&
 			c.Status == k8sv1.ConditionFalse {
+			// Volumes of a VMI migrated to another cluster are either pre-synced or block migrated
+			if migration.IsCrossClusterSource() && c.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable {
+				continue
+			}
 			return fmt.Errorf("Cannot migrate VMI, Reason: %s, Message: %s", c.Reason, c.Message)
 		}
 	}
@@ -92,6 +97,11 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = validateCrossClusterMigration(k8sfield.NewPath("spec"), &migration.Spec, admitter.ClusterConfig)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	vmi, err := admitter.VirtClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -105,9 +115,13 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrate VMI in finalized state."))
 	}
 
-	// Reject migration jobs for non-migratable VMIs
-	err = isMigratable(vmi)
-	if err != nil {
+	if migration.IsCrossClusterTarget() {
+		// The receiving VMI does not run yet, it only has to wait for the migrated domain
+		if vmi.Annotations[v1.CrossClusterMigrationTargetAnnotation] != migration.Spec.Receive.MigrationID {
+			return webhookutils.ToAdmissionResponseError(fmt.Errorf("the VMI \"%s/%s\" is not annotated with %s=%s", migration.Namespace, migration.Spec.VMIName, v1.CrossClusterMigrationTargetAnnotation, migration.Spec.Receive.MigrationID))
+		}
+	} else if err = isMigratable(vmi, migration); err != nil {
+		// Reject migration jobs for non-migratable VMIs
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
@@ -163,3 +177,64 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 
 	return causes
 }
+
+func validateCrossClusterMigration(field *k8sfield.Path, spec *v1.VirtualMachineInstanceMigrationSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.SendTo == nil && spec.Receive == nil {
+		return causes
+	}
+
+	if !config.CrossClusterLiveMigrationEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("cross cluster migrations require the %s feature gate", virtconfig.CrossClusterLiveMigrationGate),
+			Field:   field.String(),
+		})
+	}
+
+	if spec.SendTo != nil && spec.Receive != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "sendTo and receive are mutually exclusive",
+			Field:   field.String(),
+		})
+	}
+
+	if spec.Receive != nil && spec.Receive.MigrationID == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "migrationID is missing",
+			Field:   field.Child("receive", "migrationID").String(),
+		})
+	}
+
+	if spec.SendTo != nil {
+		sendToField := field.Child("sendTo")
+		if spec.SendTo.MigrationID == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "migrationID is missing",
+				Field:   sendToField.Child("migrationID").String(),
+			})
+		}
+		if _, _, err := net.SplitHostPort(spec.SendTo.ConnectURL); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("connectURL must be in host:port form: %v", err),
+				Field:   sendToField.Child("connectURL").String(),
+			})
+		}
+		switch spec.SendTo.VolumeMigration {
+		case "", v1.CrossClusterVolumeMigrationPreSynced, v1.CrossClusterVolumeMigrationBlock:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("volumeMigration must be one of %s, %s", v1.CrossClusterVolumeMigrationPreSynced, v1.CrossClusterVolumeMigrationBlock),
+				Field:   sendToField.Child("volumeMigration").String(),
+			})
+		}
+	}
+
+	return causes
+}
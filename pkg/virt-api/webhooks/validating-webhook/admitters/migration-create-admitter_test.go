@@ -301,6 +301,109 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		Context("cross cluster migrations", func() {
+			admit := func(migration *v1.VirtualMachineInstanceMigration) *admissionv1.AdmissionResponse {
+				migrationBytes, _ := json.Marshal(migration)
+				ar := &admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+					},
+				}
+				return migrationCreateAdmitter.Admit(ar)
+			}
+
+			newReceivingMigration := func(vmiName, migrationID string) *v1.VirtualMachineInstanceMigration {
+				return &v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmiName,
+						Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: migrationID},
+					},
+				}
+			}
+
+			It("should reject cross cluster migrations when the feature gate is disabled", func() {
+				resp := admit(newReceivingMigration("testvmi", "migration-id"))
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(virtconfig.CrossClusterLiveMigrationGate))
+			})
+
+			DescribeTable("should reject invalid cross cluster migration specs", func(spec v1.VirtualMachineInstanceMigrationSpec, expectedField string) {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+
+				spec.VMIName = "testvmi"
+				resp := admit(&v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec:       spec,
+				})
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("with sendTo and receive", v1.VirtualMachineInstanceMigrationSpec{
+					SendTo:  &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", ConnectURL: "10.0.0.1:1234"},
+					Receive: &v1.VirtualMachineInstanceMigrationReceive{MigrationID: "id"},
+				}, "spec"),
+				Entry("without a receiving migration ID", v1.VirtualMachineInstanceMigrationSpec{
+					Receive: &v1.VirtualMachineInstanceMigrationReceive{},
+				}, "spec.receive.migrationID"),
+				Entry("without a sending migration ID", v1.VirtualMachineInstanceMigrationSpec{
+					SendTo: &v1.VirtualMachineInstanceMigrationSendTo{ConnectURL: "10.0.0.1:1234"},
+				}, "spec.sendTo.migrationID"),
+				Entry("with a connectURL without port", v1.VirtualMachineInstanceMigrationSpec{
+					SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", ConnectURL: "10.0.0.1"},
+				}, "spec.sendTo.connectURL"),
+				Entry("with an unknown volume migration", v1.VirtualMachineInstanceMigrationSpec{
+					SendTo: &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", ConnectURL: "10.0.0.1:1234", VolumeMigration: "Copy"},
+				}, "spec.sendTo.volumeMigration"),
+			)
+
+			DescribeTable("should check the cross cluster annotation of the receiving VMI", func(annotation string, allowed bool) {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+
+				vmi := api.NewMinimalVMI("testvmi")
+				if annotation != "" {
+					vmi.Annotations = map[string]string{v1.CrossClusterMigrationTargetAnnotation: annotation}
+				}
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+
+				resp := admit(newReceivingMigration(vmi.Name, "migration-id"))
+				Expect(resp.Allowed).To(Equal(allowed))
+			},
+				Entry("with a matching migration ID", "migration-id", true),
+				Entry("with another migration ID", "other-id", false),
+				Entry("without annotation", "", false),
+			)
+
+			It("should accept sending VMIs whose disks are not live migratable within the cluster", func() {
+				enableFeatureGate(virtconfig.CrossClusterLiveMigrationGate)
+
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Status.Phase = v1.Running
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+					{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionFalse,
+						Reason: v1.VirtualMachineInstanceReasonDisksNotMigratable,
+					},
+				}
+				mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+
+				resp := admit(&v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: vmi.Name,
+						SendTo:  &v1.VirtualMachineInstanceMigrationSendTo{MigrationID: "id", ConnectURL: "10.0.0.1:1234"},
+					},
+				})
+				Expect(resp.Allowed).To(BeTrue())
+			})
+		})
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -29,6 +29,7 @@ import (
 
 	"kubevirt.io/api/migrations"
 
+	v1 "kubevirt.io/api/core/v1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -95,6 +96,26 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		}
 	}
 
+	if spec.ProgressTimeout != nil && *spec.ProgressTimeout < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be negative",
+			Field:   sourceField.Child("progressTimeout").String(),
+		})
+	}
+
+	if spec.ParallelMigrationConnections != nil && *spec.ParallelMigrationConnections == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("parallelMigrationConnections").String(),
+		})
+	}
+
+	if spec.Compression != nil {
+		causes = append(causes, validateMigrationCompression(sourceField, spec)...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -104,3 +125,26 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	}
 	return &reviewResponse
 }
+
+func validateMigrationCompression(sourceField *k8sfield.Path, spec migrationsv1.MigrationPolicySpec) []metav1.StatusCause {
+	switch *spec.Compression {
+	case v1.MigrationCompressionXBZRLE:
+		// QEMU does not support xbzrle compression over multifd channels
+		if spec.ParallelMigrationConnections != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s compression can not be used with parallel migration connections", v1.MigrationCompressionXBZRLE),
+				Field:   sourceField.Child("compression").String(),
+			}}
+		}
+	case v1.MigrationCompressionZstd:
+	default:
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("compression %q is not supported, supported methods are %s and %s",
+				*spec.Compression, v1.MigrationCompressionXBZRLE, v1.MigrationCompressionZstd),
+			Field: sourceField.Child("compression").String(),
+		}}
+	}
+	return nil
+}
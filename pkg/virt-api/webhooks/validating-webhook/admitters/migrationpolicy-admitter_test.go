@@ -78,6 +78,25 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.Int64Ptr(-1)},
 		),
+
+		Entry("negative ProgressTimeout",
+			migrationsv1.MigrationPolicySpec{ProgressTimeout: pointer.Int64(-1)},
+		),
+
+		Entry("zero ParallelMigrationConnections",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationConnections: pointer.Uint32(0)},
+		),
+
+		Entry("unknown Compression",
+			migrationsv1.MigrationPolicySpec{Compression: migrationCompressionPtr("gzip")},
+		),
+
+		Entry("xbzrle Compression with ParallelMigrationConnections",
+			migrationsv1.MigrationPolicySpec{
+				Compression:                  migrationCompressionPtr(v1.MigrationCompressionXBZRLE),
+				ParallelMigrationConnections: pointer.Uint32(4),
+			},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -104,12 +123,35 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("zero ProgressTimeout",
+			migrationsv1.MigrationPolicySpec{ProgressTimeout: pointer.Int64(0)},
+		),
+
+		Entry("negative Priority",
+			migrationsv1.MigrationPolicySpec{Priority: pointer.Int32(-10)},
+		),
+
+		Entry("xbzrle Compression",
+			migrationsv1.MigrationPolicySpec{Compression: migrationCompressionPtr(v1.MigrationCompressionXBZRLE)},
+		),
+
+		Entry("zstd Compression with ParallelMigrationConnections",
+			migrationsv1.MigrationPolicySpec{
+				Compression:                  migrationCompressionPtr(v1.MigrationCompressionZstd),
+				ParallelMigrationConnections: pointer.Uint32(4),
+			},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
 	)
 })
 
+func migrationCompressionPtr(compression v1.MigrationCompression) *v1.MigrationCompression {
+	return &compression
+}
+
 func createPolicyAdmissionReview(policy *migrationsv1.MigrationPolicy, namespace string) *admissionv1.AdmissionReview {
 	policyBytes, _ := json.Marshal(policy)
 
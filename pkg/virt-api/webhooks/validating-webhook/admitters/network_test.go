@@ -44,6 +44,8 @@ var _ = Describe("Validating VMI network spec", func() {
 	},
 		Entry("is empty", v1.InterfaceState("")),
 		Entry("is absent when bridge binding is used", v1.InterfaceStateAbsent),
+		Entry("is up", v1.InterfaceStateLinkUp),
+		Entry("is down", v1.InterfaceStateLinkDown),
 	)
 
 	It("network interface state value is invalid", func() {
@@ -87,4 +89,19 @@ var _ = Describe("Validating VMI network spec", func() {
 				Field:   "fake.domain.devices.interfaces[0].state",
 			}))
 	})
+
+	It("network interface link state is not supported when SR-IOV binding is used", func() {
+		vm := api.NewMinimalVMI("testvm")
+		vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+			Name:                   "foo",
+			State:                  v1.InterfaceStateLinkDown,
+			InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}},
+		}}
+		Expect(validateInterfaceStateValue(k8sfield.NewPath("fake"), &vm.Spec)).To(
+			ConsistOf(metav1.StatusCause{
+				Type:    "FieldValueInvalid",
+				Message: "\"foo\" interface's state \"down\" is not supported for SR-IOV binding",
+				Field:   "fake.domain.devices.interfaces[0].state",
+			}))
+	})
 })
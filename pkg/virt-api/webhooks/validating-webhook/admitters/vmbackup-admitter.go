@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"kubevirt.io/api/core"
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMBackupAdmitter validates VirtualMachineBackups
+type VMBackupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupAdmitter creates a VMBackupAdmitter
+func NewVMBackupAdmitter(config *virtconfig.ClusterConfig) *VMBackupAdmitter {
+	return &VMBackupAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMBackupAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("incremental backup feature gate not enabled"))
+	}
+
+	vmBackup := &snapshotv1.VirtualMachineBackup{}
+	err := json.Unmarshal(ar.Request.Object.Raw, vmBackup)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes = validateVMBackupSpec(k8sfield.NewPath("spec"), &vmBackup.Spec)
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineBackup{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, vmBackup.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateVMBackupSpec(field *k8sfield.Path, spec *snapshotv1.VirtualMachineBackupSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	sourceField := field.Child("source")
+	if spec.Source.APIGroup == nil || *spec.Source.APIGroup != core.GroupName {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "missing or invalid apiGroup",
+			Field:   sourceField.Child("apiGroup").String(),
+		})
+	}
+
+	if spec.Source.Kind != "VirtualMachine" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid kind %s", spec.Source.Kind),
+			Field:   sourceField.Child("kind").String(),
+		})
+	}
+
+	if spec.Source.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "missing name",
+			Field:   sourceField.Child("name").String(),
+		})
+	}
+
+	mode := v1.BackupModePull
+	if spec.Mode != nil {
+		mode = *spec.Mode
+	}
+
+	switch mode {
+	case v1.BackupModePull:
+		if spec.Target != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: "target is only supported in push mode",
+				Field:   field.Child("target").String(),
+			})
+		}
+	case v1.BackupModePush:
+		if spec.Target == nil || *spec.Target == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "target is required in push mode",
+				Field:   field.Child("target").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("invalid mode %s", mode),
+			Field:   field.Child("mode").String(),
+		})
+	}
+
+	if spec.IncrementalFrom != nil && *spec.IncrementalFrom == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "incrementalFrom must not be empty",
+			Field:   field.Child("incrementalFrom").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,196 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineBackup Admitter", func() {
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	pushMode := v1.BackupModePush
+	invalidMode := v1.VirtualMachineInstanceBackupMode("Sideways")
+
+	newVMBackup := func() *snapshotv1.VirtualMachineBackup {
+		return &snapshotv1.VirtualMachineBackup{
+			Spec: snapshotv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.String("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     "vm",
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createBackupAdmissionReview(newVMBackup())
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("incremental backup feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"IncrementalBackup"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		DescribeTable("should accept", func(mutate func(*snapshotv1.VirtualMachineBackup)) {
+			vmBackup := newVMBackup()
+			mutate(vmBackup)
+
+			ar := createBackupAdmissionReview(vmBackup)
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			Entry("a full pull mode backup", func(b *snapshotv1.VirtualMachineBackup) {}),
+			Entry("an incremental backup", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.IncrementalFrom = pointer.String("previous")
+			}),
+			Entry("a push mode backup with a target", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Mode = &pushMode
+				b.Spec.Target = pointer.String("backup-target")
+			}),
+		)
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineBackup), field string) {
+			vmBackup := newVMBackup()
+			mutate(vmBackup)
+
+			ar := createBackupAdmissionReview(vmBackup)
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an invalid source apiGroup", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Source.APIGroup = pointer.String("foo.io")
+			}, "spec.source.apiGroup"),
+			Entry("an invalid source kind", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Source.Kind = "VirtualMachineInstance"
+			}, "spec.source.kind"),
+			Entry("a missing source name", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Source.Name = ""
+			}, "spec.source.name"),
+			Entry("an invalid mode", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Mode = &invalidMode
+			}, "spec.mode"),
+			Entry("a push mode backup without target", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Mode = &pushMode
+			}, "spec.target"),
+			Entry("a pull mode backup with target", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.Target = pointer.String("backup-target")
+			}, "spec.target"),
+			Entry("an empty incrementalFrom", func(b *snapshotv1.VirtualMachineBackup) {
+				b.Spec.IncrementalFrom = pointer.String("")
+			}, "spec.incrementalFrom"),
+		)
+
+		It("should reject spec update", func() {
+			oldVMBackup := newVMBackup()
+			vmBackup := newVMBackup()
+			vmBackup.Spec.IncrementalFrom = pointer.String("previous")
+
+			oldBytes, _ := json.Marshal(oldVMBackup)
+			ar := createBackupAdmissionReview(vmBackup)
+			ar.Request.Operation = admissionv1.Update
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldVMBackup := newVMBackup()
+			vmBackup := newVMBackup()
+			vmBackup.Finalizers = []string{"finalizer"}
+
+			oldBytes, _ := json.Marshal(oldVMBackup)
+			ar := createBackupAdmissionReview(vmBackup)
+			ar.Request.Operation = admissionv1.Update
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMBackupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createBackupAdmissionReview(vmBackup *snapshotv1.VirtualMachineBackup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(vmBackup)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinebackups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
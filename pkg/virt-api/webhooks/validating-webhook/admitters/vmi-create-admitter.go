@@ -146,6 +146,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMemoryRequestsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateMemoryLimitsNegativeOrNull(field, spec)...)
 	causes = append(causes, validateHugepagesMemoryRequests(field, spec)...)
+	causes = append(causes, validateKSMOvercommit(field, spec)...)
 	causes = append(causes, validateGuestMemoryLimit(field, spec)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareSerial(field, spec)...)
@@ -157,6 +158,8 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
+	causes = append(causes, validateMemoryHotplug(field, spec)...)
+	causes = append(causes, validateMemoryBalloon(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec, !root)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
@@ -222,6 +225,8 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validatePanicDevices(field, spec)...)
+	causes = append(causes, validateWatchdog(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
@@ -276,6 +281,7 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		networkData, networkExists := networkNameMap[iface.Name]
 
 		causes = append(causes, validateInterfaceNetworkBasics(field, networkExists, idx, iface, networkData, config, numOfInterfaces)...)
+		causes = append(causes, validateInterfaceBindingPlugin(field, iface, idx, config)...)
 
 		causes = append(causes, validateInterfaceNameUnique(field, networkInterfaceMap, iface, idx)...)
 		causes = append(causes, validateInterfaceNameFormat(field, iface, idx)...)
@@ -287,6 +293,8 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field, iface, idx)...)
+		causes = append(causes, validateInterfaceFilter(field, iface, idx)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -296,6 +304,9 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		}
 
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPRoutes(field, iface, idx)...)
+		causes = append(causes, validateDHCPLeaseTime(field, iface, idx)...)
+		causes = append(causes, validateDHCPv6Options(field, iface, idx, config)...)
 	}
 	return networkInterfaceMap, causes, done
 }
@@ -327,6 +338,35 @@ func validateInterfaceNetworkBasics(field *k8sfield.Path, networkExists bool, id
 	return causes
 }
 
+func validateInterfaceBindingPlugin(field *k8sfield.Path, iface v1.Interface, idx int, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	if iface.Binding == nil {
+		return nil
+	}
+	bindingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("binding")
+	if !config.NetworkBindingPluginsEnabled() {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", virtconfig.NetworkBindingPluginsGate),
+			Field:   bindingField.String(),
+		}}
+	}
+	if iface.InterfaceBindingMethod != (v1.InterfaceBindingMethod{}) {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("logical %s interface cannot have both a binding plugin and a binding method", iface.Name),
+			Field:   bindingField.String(),
+		}}
+	}
+	if _, exists := config.GetNetworkBindings()[iface.Binding.Name]; !exists {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("network binding plugin %q is not registered in the KubeVirt configuration", iface.Binding.Name),
+			Field:   bindingField.Child("name").String(),
+		}}
+	}
+	return nil
+}
+
 func validateDHCPExtraOptions(field *k8sfield.Path, iface v1.Interface) (causes []metav1.StatusCause, done bool) {
 	done = false
 	if iface.DHCPOptions != nil {
@@ -358,6 +398,73 @@ func validateDHCPNTPServersAreValidIPv4Addresses(field *k8sfield.Path, iface v1.
 	return causes
 }
 
+func validateDHCPRoutes(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.DHCPOptions == nil {
+		return nil
+	}
+	for index, route := range iface.DHCPOptions.Routes {
+		routeField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "routes").Index(index)
+		if _, dst, err := net.ParseCIDR(route.Destination); err != nil || dst.IP.To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route destination must be a valid IPv4 CIDR.",
+				Field:   routeField.Child("destination").String(),
+			})
+		}
+		if net.ParseIP(route.Gateway).To4() == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCP route gateway must be a valid IPv4 address.",
+				Field:   routeField.Child("gateway").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateDHCPLeaseTime(field *k8sfield.Path, iface v1.Interface, idx int) []metav1.StatusCause {
+	if iface.DHCPOptions == nil || iface.DHCPOptions.LeaseTime == nil || iface.DHCPOptions.LeaseTime.Duration > 0 {
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "DHCP lease time must be positive.",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "leaseTime").String(),
+	}}
+}
+
+func validateDHCPv6Options(field *k8sfield.Path, iface v1.Interface, idx int, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if iface.DHCPOptions == nil || iface.DHCPOptions.IPv6 == nil {
+		return nil
+	}
+	ipv6Field := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions", "ipv6")
+	for index, ip := range iface.DHCPOptions.IPv6.DNSServers {
+		if parsedIP := net.ParseIP(ip); parsedIP == nil || parsedIP.To4() != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DHCPv6 DNS servers must be a list of valid IPv6 addresses.",
+				Field:   ipv6Field.Child("dnsServers").Index(index).String(),
+			})
+		}
+	}
+	routerAdvertisements := iface.DHCPOptions.IPv6.RouterAdvertisements
+	if routerAdvertisements != nil && *routerAdvertisements && iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "router advertisements are supported only on masquerade interfaces.",
+			Field:   ipv6Field.Child("routerAdvertisements").String(),
+		})
+	}
+	if routerAdvertisements != nil && *routerAdvertisements && !config.RootEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("router advertisements require the %s feature gate.", virtconfig.Root),
+			Field:   ipv6Field.Child("routerAdvertisements").String(),
+		})
+	}
+	return causes
+}
+
 func validateDHCPPrivateOptionsWithinRange(field *k8sfield.Path, DHCPPrivateOption v1.DHCPPrivateOptions) (causes []metav1.StatusCause) {
 	if !(DHCPPrivateOption.Option >= 224 && DHCPPrivateOption.Option <= 254) {
 		causes = append(causes, metav1.StatusCause{
@@ -392,6 +499,102 @@ func validateInterfacePciAddress(field *k8sfield.Path, iface v1.Interface, idx i
 	return causes
 }
 
+func validateInterfaceBandwidth(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Bandwidth == nil {
+		return causes
+	}
+	bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+	if iface.SRIOV != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("interface %s uses SR-IOV which does not support bandwidth limits.", field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String()),
+			Field:   bandwidthField.String(),
+		})
+	}
+	limits := []struct {
+		name  string
+		limit *v1.BandwidthLimit
+	}{
+		{"inbound", iface.Bandwidth.Inbound},
+		{"outbound", iface.Bandwidth.Outbound},
+	}
+	for _, l := range limits {
+		if l.limit != nil && l.limit.Average == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have an average > 0", bandwidthField.Child(l.name).String()),
+				Field:   bandwidthField.Child(l.name, "average").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateInterfaceFilter(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Filter == nil {
+		return causes
+	}
+	filterField := field.Child("domain", "devices", "interfaces").Index(idx).Child("filter")
+	if iface.Bridge == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is only supported with the bridge binding method", filterField.String()),
+			Field:   filterField.String(),
+		})
+	}
+	directions := []struct {
+		name  string
+		rules []v1.InterfaceFilterRule
+	}{
+		{"ingress", iface.Filter.Ingress},
+		{"egress", iface.Filter.Egress},
+	}
+	for _, d := range directions {
+		for ruleIdx, rule := range d.rules {
+			causes = append(causes, validateInterfaceFilterRule(filterField.Child(d.name).Index(ruleIdx), rule)...)
+		}
+	}
+	return causes
+}
+
+func validateInterfaceFilterRule(ruleField *k8sfield.Path, rule v1.InterfaceFilterRule) (causes []metav1.StatusCause) {
+	if rule.CIDR != "" {
+		if _, _, err := net.ParseCIDR(rule.CIDR); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a valid CIDR", ruleField.Child("cidr").String()),
+				Field:   ruleField.Child("cidr").String(),
+			})
+		}
+	}
+	switch rule.Protocol {
+	case "", "TCP", "UDP", "ICMP":
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of TCP, UDP or ICMP", ruleField.Child("protocol").String()),
+			Field:   ruleField.Child("protocol").String(),
+		})
+	}
+	if len(rule.Ports) > 0 && rule.Protocol != "TCP" && rule.Protocol != "UDP" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can only be set with the TCP or UDP protocol", ruleField.Child("ports").String()),
+			Field:   ruleField.Child("ports").String(),
+		})
+	}
+	for portIdx, port := range rule.Ports {
+		if port < 1 || port > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be between 1 and 65535", ruleField.Child("ports").Index(portIdx).String()),
+				Field:   ruleField.Child("ports").Index(portIdx).String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateInterfaceBootOrder(field *k8sfield.Path, iface v1.Interface, idx int, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	if iface.BootOrder != nil {
 		order := *iface.BootOrder
@@ -880,47 +1083,177 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
-func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
-	launchSecurity := spec.Domain.LaunchSecurity
-	if launchSecurity != nil && !config.WorkloadEncryptionSEVEnabled() {
+func validateWatchdog(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	watchdog := spec.Domain.Devices.Watchdog
+	if watchdog == nil {
+		return causes
+	}
+	watchdogField := field.Child("domain", "devices", "watchdog")
+
+	var actions []v1.WatchdogAction
+	if watchdog.I6300ESB != nil {
+		actions = append(actions, watchdog.I6300ESB.Action)
+	}
+	if watchdog.Diag288 != nil {
+		actions = append(actions, watchdog.Diag288.Action)
+	}
+	if watchdog.ITCO != nil {
+		actions = append(actions, watchdog.ITCO.Action)
+	}
+	if len(actions) != 1 {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.WorkloadEncryptionSEV),
-			Field:   field.Child("launchSecurity").String(),
+			Message: fmt.Sprintf("%s must have exactly one watchdog type set", watchdogField.String()),
+			Field:   watchdogField.String(),
 		})
-	} else if launchSecurity != nil && launchSecurity.SEV != nil {
-		firmware := spec.Domain.Firmware
-		if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil {
+		return causes
+	}
+
+	switch actions[0] {
+	case "", v1.WatchdogActionPoweroff, v1.WatchdogActionReset, v1.WatchdogActionShutdown:
+	case v1.WatchdogActionDump:
+		if watchdog.MemoryDumpClaimName == "" {
 			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "SEV requires OVMF (UEFI)",
-				Field:   field.Child("launchSecurity").String(),
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s is required by the %s watchdog action", watchdogField.Child("memoryDumpClaimName").String(), v1.WatchdogActionDump),
+				Field:   watchdogField.Child("memoryDumpClaimName").String(),
 			})
-		} else if firmware.Bootloader.EFI.SecureBoot == nil || *firmware.Bootloader.EFI.SecureBoot {
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("watchdog action %q is not supported. Options: 'poweroff', 'reset', 'shutdown' or 'dump'", actions[0]),
+			Field:   watchdogField.String(),
+		})
+	}
+	return causes
+}
+
+func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	for idx, panicDevice := range spec.Domain.Devices.PanicDevices {
+		if panicDevice.Model == nil {
+			continue
+		}
+		switch *panicDevice.Model {
+		case v1.PanicDeviceModelHyperv, v1.PanicDeviceModelIsa, v1.PanicDeviceModelPvpanic:
+		default:
 			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "SEV does not work along with SecureBoot",
-				Field:   field.Child("launchSecurity").String(),
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("panic device model %q is not supported. Options: 'hyperv', 'isa' or 'pvpanic'", *panicDevice.Model),
+				Field:   field.Child("domain", "devices", "panicDevices").Index(idx).Child("model").String(),
 			})
 		}
+	}
 
-		startStrategy := spec.StartStrategy
-		if launchSecurity.SEV.Attestation != nil && (startStrategy == nil || *startStrategy != v1.StartStrategyPaused) {
+	onCrash := spec.Domain.OnCrash
+	if onCrash == nil {
+		return causes
+	}
+	onCrashField := field.Child("domain", "onCrash")
+	if len(spec.Domain.Devices.PanicDevices) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires a panic device", onCrashField.String()),
+			Field:   onCrashField.String(),
+		})
+	}
+	switch onCrash.Action {
+	case "", v1.CrashActionRestart, v1.CrashActionPause:
+	case v1.CrashActionMemoryDump:
+		if onCrash.MemoryDumpClaimName == "" {
 			causes = append(causes, metav1.StatusCause{
-				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: fmt.Sprintf("SEV attestation requires VMI StartStrategy '%s'", v1.StartStrategyPaused),
-				Field:   field.Child("launchSecurity").String(),
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s is required by the %s crash action", onCrashField.Child("memoryDumpClaimName").String(), v1.CrashActionMemoryDump),
+				Field:   onCrashField.Child("memoryDumpClaimName").String(),
 			})
 		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("crash action %q is not supported. Options: 'Restart', 'Pause' or 'MemoryDump'", onCrash.Action),
+			Field:   onCrashField.Child("action").String(),
+		})
+	}
+	return causes
+}
 
-		for _, iface := range spec.Domain.Devices.Interfaces {
-			if iface.BootOrder != nil {
-				causes = append(causes, metav1.StatusCause{
-					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: fmt.Sprintf("SEV does not work with bootable NICs: %s", iface.Name),
-					Field:   field.Child("launchSecurity").String(),
-				})
-			}
+func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	launchSecurity := spec.Domain.LaunchSecurity
+	if launchSecurity == nil {
+		return causes
+	}
+
+	technologies := 0
+	for _, set := range []bool{launchSecurity.SEV != nil, launchSecurity.SNP != nil, launchSecurity.TDX != nil} {
+		if set {
+			technologies++
+		}
+	}
+	if technologies > 1 {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "only one of SEV, SEV-SNP and TDX can be requested",
+			Field:   field.Child("launchSecurity").String(),
+		})
+	}
+
+	if launchSecurity.TDX != nil && !config.WorkloadEncryptionTDXEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.WorkloadEncryptionTDX),
+			Field:   field.Child("launchSecurity").String(),
+		})
+	} else if launchSecurity.TDX == nil && !config.WorkloadEncryptionSEVEnabled() {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.WorkloadEncryptionSEV),
+			Field:   field.Child("launchSecurity").String(),
+		})
+	}
+
+	var technology string
+	switch {
+	case launchSecurity.SEV != nil:
+		technology = "SEV"
+	case launchSecurity.SNP != nil:
+		technology = "SEV-SNP"
+	case launchSecurity.TDX != nil:
+		technology = "TDX"
+	default:
+		return causes
+	}
+
+	firmware := spec.Domain.Firmware
+	if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires OVMF (UEFI)", technology),
+			Field:   field.Child("launchSecurity").String(),
+		})
+	} else if firmware.Bootloader.EFI.SecureBoot == nil || *firmware.Bootloader.EFI.SecureBoot {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s does not work along with SecureBoot", technology),
+			Field:   field.Child("launchSecurity").String(),
+		})
+	}
+
+	startStrategy := spec.StartStrategy
+	if launchSecurity.SEV != nil && launchSecurity.SEV.Attestation != nil && (startStrategy == nil || *startStrategy != v1.StartStrategyPaused) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("SEV attestation requires VMI StartStrategy '%s'", v1.StartStrategyPaused),
+			Field:   field.Child("launchSecurity").String(),
+		})
+	}
+
+	for _, iface := range spec.Domain.Devices.Interfaces {
+		if iface.BootOrder != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s does not work with bootable NICs: %s", technology, iface.Name),
+				Field:   field.Child("launchSecurity").String(),
+			})
 		}
 	}
 	return causes
@@ -1472,6 +1805,20 @@ func validateHugepagesMemoryRequests(field *k8sfield.Path, spec *v1.VirtualMachi
 	return causes
 }
 
+func validateKSMOvercommit(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.Resources.OvercommitWithKSM && spec.Domain.Memory != nil && spec.Domain.Memory.Hugepages != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not supported together with %s, hugepages cannot be merged by KSM",
+				field.Child("domain", "resources", "overcommitWithKSM").String(),
+				field.Child("domain", "memory", "hugepages").String(),
+			),
+			Field: field.Child("domain", "resources", "overcommitWithKSM").String(),
+		})
+	}
+	return causes
+}
+
 func validateMemoryLimitsNegativeOrNull(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.Resources.Limits.Memory().Value() < 0 {
 		causes = append(causes, metav1.StatusCause{
@@ -2550,11 +2897,35 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 				}
 			}
 		}
+
+		causes = append(causes, validateDiskIOTune(field.Index(idx).Child("ioTune"), disk.IOTune)...)
 	}
 
 	return causes
 }
 
+// libvirt rejects combining a total limit with a read or write limit of the same kind
+func validateDiskIOTune(field *k8sfield.Path, ioTune *v1.DiskIOTune) (causes []metav1.StatusCause) {
+	if ioTune == nil {
+		return causes
+	}
+	if ioTune.TotalBytesSec > 0 && (ioTune.ReadBytesSec > 0 || ioTune.WriteBytesSec > 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with readBytesSec or writeBytesSec", field.Child("totalBytesSec").String()),
+			Field:   field.Child("totalBytesSec").String(),
+		})
+	}
+	if ioTune.TotalIopsSec > 0 && (ioTune.ReadIopsSec > 0 || ioTune.WriteIopsSec > 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be combined with readIopsSec or writeIopsSec", field.Child("totalIopsSec").String()),
+			Field:   field.Child("totalIopsSec").String(),
+		})
+	}
+	return causes
+}
+
 // Rejects kernel boot defined with initrd/kernel path but without an image
 func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) (causes []metav1.StatusCause) {
 	if kernelBoot == nil {
@@ -2676,7 +3047,65 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 }
 
 func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	causes = append(causes, validatePersistentTPM(field, spec, config)...)
+	causes = append(causes, validatePersistentEFI(field, spec, config)...)
+	return causes
+}
+
+func validatePersistentEFI(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if spec.Domain.Firmware == nil || spec.Domain.Firmware.Bootloader == nil || spec.Domain.Firmware.Bootloader.EFI == nil {
+		return
+	}
+	efi := spec.Domain.Firmware.Bootloader.EFI
+	efiField := field.Child("domain", "firmware", "bootloader", "efi")
+
+	if backendstorage.HasPersistentEFI(spec) && !config.VMPersistentStateEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMPersistentState),
+			Field:   efiField.Child("persistent").String(),
+		})
+	}
+
+	if efi.SecureBootKeysSecretRef != nil {
+		if efi.SecureBoot != nil && !*efi.SecureBoot {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires SecureBoot to be enabled", efiField.Child("secureBootKeysSecretRef").String()),
+				Field:   efiField.Child("secureBootKeysSecretRef").String(),
+			})
+		}
+		if efi.SecureBootKeysSecretRef.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", efiField.Child("secureBootKeysSecretRef", "name").String()),
+				Field:   efiField.Child("secureBootKeysSecretRef", "name").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validatePersistentTPM(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	tpmField := field.Child("domain", "devices", "tpm")
 	if !backendstorage.HasPersistentTPMDevice(spec) {
+		if tpm := spec.Domain.Devices.TPM; tpm != nil {
+			if tpm.StateRetentionPolicy != "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s requires a persistent TPM", tpmField.Child("stateRetentionPolicy").String()),
+					Field:   tpmField.Child("stateRetentionPolicy").String(),
+				})
+			}
+			if tpm.EncryptionSecretRef != nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s requires a persistent TPM", tpmField.Child("encryptionSecretRef").String()),
+					Field:   tpmField.Child("encryptionSecretRef").String(),
+				})
+			}
+		}
 		return
 	}
 
@@ -2684,7 +3113,27 @@ func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMPersistentState),
-			Field:   field.Child("domain", "devices", "tpm", "persistent").String(),
+			Field:   tpmField.Child("persistent").String(),
+		})
+	}
+
+	tpm := spec.Domain.Devices.TPM
+	switch tpm.StateRetentionPolicy {
+	case "", v1.TPMStateRetentionPolicyDelete, v1.TPMStateRetentionPolicyRetain:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be either %s or %s", tpmField.Child("stateRetentionPolicy").String(),
+				v1.TPMStateRetentionPolicyDelete, v1.TPMStateRetentionPolicyRetain),
+			Field: tpmField.Child("stateRetentionPolicy").String(),
+		})
+	}
+
+	if tpm.EncryptionSecretRef != nil && tpm.EncryptionSecretRef.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must not be empty", tpmField.Child("encryptionSecretRef", "name").String()),
+			Field:   tpmField.Child("encryptionSecretRef", "name").String(),
 		})
 	}
 
@@ -2703,3 +3152,84 @@ func validateCPUHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpe
 	}
 	return causes
 }
+
+func validateMemoryHotplug(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.Memory == nil || spec.Domain.Memory.MaxGuest == nil {
+		return causes
+	}
+
+	if spec.Domain.Memory.Guest == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be set when %s is set", field.Child("domain", "memory", "guest").String(), field.Child("domain", "memory", "maxGuest").String()),
+			Field:   field.Child("domain", "memory", "guest").String(),
+		})
+		return causes
+	}
+
+	if spec.Domain.Memory.Guest.Cmp(*spec.Domain.Memory.MaxGuest) > 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Guest memory is greater than the maximum guest memory allowed"),
+			Field:   field.Child("domain", "memory", "guest").String(),
+		})
+	}
+
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil && spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("Memory hotplug is not supported together with guest NUMA mapping passthrough"),
+			Field:   field.Child("domain", "memory", "maxGuest").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateMemoryBalloon(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.Memory == nil || spec.Domain.Memory.Balloon == nil {
+		return causes
+	}
+	balloonField := field.Child("domain", "memory", "balloon")
+	balloon := spec.Domain.Memory.Balloon
+
+	if spec.Domain.Devices.AutoattachMemBalloon != nil && !*spec.Domain.Devices.AutoattachMemBalloon {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires %s to be enabled", balloonField.String(), field.Child("domain", "devices", "autoattachMemBalloon").String()),
+			Field:   balloonField.String(),
+		})
+	}
+
+	if spec.Domain.Memory.Hugepages != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not supported together with %s", balloonField.String(), field.Child("domain", "memory", "hugepages").String()),
+			Field:   balloonField.String(),
+		})
+	}
+
+	if balloon.FreeMemoryPercent != nil && *balloon.FreeMemoryPercent >= 100 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be lower than 100", balloonField.Child("freeMemoryPercent").String()),
+			Field:   balloonField.Child("freeMemoryPercent").String(),
+		})
+	}
+
+	if balloon.MinGuest != nil {
+		guestMemory := spec.Domain.Memory.Guest
+		if guestMemory == nil {
+			guestMemory = spec.Domain.Resources.Requests.Memory()
+		}
+		if balloon.MinGuest.Sign() <= 0 || balloon.MinGuest.Cmp(*guestMemory) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0 and not greater than the guest memory", balloonField.Child("minGuest").String()),
+				Field:   balloonField.Child("minGuest").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 
@@ -968,6 +969,20 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.domain.resources.requests.memory"))
 		})
+		It("should reject overcommitWithKSM together with hugepages", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+			}
+			vmi.Spec.Domain.Resources.OvercommitWithKSM = true
+			vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{}}
+			vmi.Spec.Domain.Memory.Hugepages.PageSize = "2Mi"
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.resources.overcommitWithKSM"))
+		})
 		It("should accept correct memory and hugepages size values", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -1575,6 +1590,65 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
 			Expect(causes).To(HaveLen(1))
 		})
+		Context("with a network binding plugin", func() {
+			registerBindingPlugin := func(name string) {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.NetworkBindingPluginsGate}
+				kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+					Binding: map[string]v1.InterfaceBindingPlugin{
+						name: {SidecarImage: "registry:5000/plugin:devel"},
+					},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+			}
+
+			newVMIWithBindingPlugin := func(name string) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:    "default",
+					Binding: &v1.PluginBinding{Name: name},
+				}}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				return vmi
+			}
+
+			It("should reject the interface when the feature gate is disabled", func() {
+				vmi := newVMIWithBindingPlugin("myplugin")
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].binding"))
+			})
+
+			It("should accept a registered plugin", func() {
+				registerBindingPlugin("myplugin")
+				vmi := newVMIWithBindingPlugin("myplugin")
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+
+			It("should reject a plugin that is not registered", func() {
+				registerBindingPlugin("myplugin")
+				vmi := newVMIWithBindingPlugin("otherplugin")
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].binding.name"))
+			})
+
+			It("should reject a plugin combined with a binding method", func() {
+				registerBindingPlugin("myplugin")
+				vmi := newVMIWithBindingPlugin("myplugin")
+				vmi.Spec.Domain.Devices.Interfaces[0].InterfaceBindingMethod = v1.InterfaceBindingMethod{
+					Masquerade: &v1.InterfaceMasquerade{},
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].binding"))
+			})
+		})
 		It("should accept networks with a pod network source and slirp interface with port", func() {
 			enableSlirpInterface()
 			vm := api.NewMinimalVMI("testvm")
@@ -1954,6 +2028,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			}
 		})
 
+		It("should reject an interface bandwidth limit without an average", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 1000},
+				Outbound: &v1.BandwidthLimit{Peak: 2000},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].bandwidth.outbound.average"))
+		})
+
+		It("should accept a valid interface filter", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Filter = &v1.InterfaceFilter{
+				Ingress: []v1.InterfaceFilterRule{{CIDR: "10.0.0.0/8", Protocol: "TCP", Ports: []int32{22, 443}}},
+				Egress:  []v1.InterfaceFilterRule{{CIDR: "fd10::/64"}, {Protocol: "ICMP"}},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject an interface filter on a non-bridge interface", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Filter = &v1.InterfaceFilter{}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].filter"))
+		})
+
+		DescribeTable("should reject an invalid interface filter rule", func(rule v1.InterfaceFilterRule, expectedField string) {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].Filter = &v1.InterfaceFilter{
+				Egress: []v1.InterfaceFilterRule{rule},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with an invalid CIDR", v1.InterfaceFilterRule{CIDR: "10.0.0.1"}, "fake.domain.devices.interfaces[0].filter.egress[0].cidr"),
+			Entry("with an unsupported protocol", v1.InterfaceFilterRule{Protocol: "SCTP"}, "fake.domain.devices.interfaces[0].filter.egress[0].protocol"),
+			Entry("with ports and no protocol", v1.InterfaceFilterRule{Ports: []int32{80}}, "fake.domain.devices.interfaces[0].filter.egress[0].ports"),
+			Entry("with an out of range port", v1.InterfaceFilterRule{Protocol: "UDP", Ports: []int32{53, 70000}}, "fake.domain.devices.interfaces[0].filter.egress[0].ports[1]"),
+		)
+
 		It("should accept valid NTP servers", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -1976,6 +2102,91 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(2))
 		})
 
+		It("should accept valid DHCP routes, lease time and IPv6 options", func() {
+			enableFeatureGate(virtconfig.Root)
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				Routes:           []v1.DHCPRoute{{Destination: "10.10.0.0/16", Gateway: "10.0.2.254"}},
+				LeaseTime:        &metav1.Duration{Duration: time.Hour},
+				IPXEBootFileName: "http://boot.kubevirt.io/boot.ipxe",
+				IPv6: &v1.DHCPv6Options{
+					DNSServers:           []string{"fd10:0:2::53"},
+					SearchDomains:        []string{"example.com"},
+					RouterAdvertisements: pointer.Bool(true),
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject invalid DHCP routes", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				Routes: []v1.DHCPRoute{
+					{Destination: "10.10.0.0", Gateway: "10.0.2.254"},
+					{Destination: "fd10::/64", Gateway: "fd10::1"},
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(3))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[0].destination"))
+			Expect(causes[1].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[1].destination"))
+			Expect(causes[2].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[1].gateway"))
+		})
+
+		It("should reject a non positive DHCP lease time", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				LeaseTime: &metav1.Duration{},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.leaseTime"))
+		})
+
+		It("should reject non-IPv6 DHCPv6 DNS servers", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				IPv6: &v1.DHCPv6Options{DNSServers: []string{"10.0.2.53", "hostname"}},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(2))
+		})
+
+		It("should reject router advertisements on a non masquerade interface", func() {
+			enableFeatureGate(virtconfig.Root)
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				IPv6: &v1.DHCPv6Options{RouterAdvertisements: pointer.Bool(true)},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.ipv6.routerAdvertisements"))
+		})
+
+		It("should reject router advertisements on a non-root VMI", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultMasqueradeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				IPv6: &v1.DHCPv6Options{RouterAdvertisements: pointer.Bool(true)},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.ipv6.routerAdvertisements"))
+			Expect(causes[0].Message).To(ContainSubstring("Root feature gate"))
+		})
+
 		It("should accept valid DHCPPrivateOptions", func() {
 			vmi := api.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -2972,6 +3183,25 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks.disk[0].pciAddress"))
 		})
 
+		DescribeTable("should validate the I/O throttling of disks", func(ioTune *v1.DiskIOTune, expectedFields ...string) {
+			vmi := api.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				IOTune: ioTune,
+			})
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			Entry("accept separate read and write limits", &v1.DiskIOTune{ReadBytesSec: 1024, WriteBytesSec: 1024, ReadIopsSec: 100, WriteIopsSec: 100}),
+			Entry("accept total limits", &v1.DiskIOTune{TotalBytesSec: 1024, TotalIopsSec: 100}),
+			Entry("reject total bytes combined with read bytes", &v1.DiskIOTune{TotalBytesSec: 1024, ReadBytesSec: 1024}, "fake[0].ioTune.totalBytesSec"),
+			Entry("reject total iops combined with write iops", &v1.DiskIOTune{TotalIopsSec: 100, WriteIopsSec: 100}, "fake[0].ioTune.totalIopsSec"),
+		)
+
 		It("should reject disk with multiple targets ", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -3893,6 +4123,61 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with AMD SEV-SNP and Intel TDX LaunchSecurity", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: pointer.Bool(false),
+					},
+				},
+			}
+		})
+
+		DescribeTable("should accept when the feature gate is enabled and OVMF is configured", func(launchSecurity *v1.LaunchSecurity, featureGate string) {
+			enableFeatureGate(featureGate)
+			vmi.Spec.Domain.LaunchSecurity = launchSecurity
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with SEV-SNP", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}, virtconfig.WorkloadEncryptionSEV),
+			Entry("with TDX", &v1.LaunchSecurity{TDX: &v1.TDX{}}, virtconfig.WorkloadEncryptionTDX),
+		)
+
+		DescribeTable("should reject when the feature gate is disabled", func(launchSecurity *v1.LaunchSecurity, featureGate string) {
+			vmi.Spec.Domain.LaunchSecurity = launchSecurity
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featureGate)))
+		},
+			Entry("with SEV-SNP", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}, virtconfig.WorkloadEncryptionSEV),
+			Entry("with TDX", &v1.LaunchSecurity{TDX: &v1.TDX{}}, virtconfig.WorkloadEncryptionTDX),
+		)
+
+		DescribeTable("should reject when UEFI is not configured", func(launchSecurity *v1.LaunchSecurity, featureGate, technology string) {
+			enableFeatureGate(featureGate)
+			vmi.Spec.Domain.LaunchSecurity = launchSecurity
+			vmi.Spec.Domain.Firmware = nil
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal(fmt.Sprintf("%s requires OVMF (UEFI)", technology)))
+		},
+			Entry("with SEV-SNP", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}, virtconfig.WorkloadEncryptionSEV, "SEV-SNP"),
+			Entry("with TDX", &v1.LaunchSecurity{TDX: &v1.TDX{}}, virtconfig.WorkloadEncryptionTDX, "TDX"),
+		)
+
+		It("should reject more than one launch security technology", func() {
+			enableFeatureGate(virtconfig.WorkloadEncryptionSEV)
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{SEV: &v1.SEV{}, SNP: &v1.SEVSNP{}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.launchSecurity"))
+		})
+	})
+
 	Context("with vsocks defined", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -4728,6 +5013,32 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(BeEmpty())
 			})
+			It("should accept vmi with a retained and encrypted persistent TPM", func() {
+				addPersistentTPM(vmi)
+				vmi.Spec.Domain.Devices.TPM.StateRetentionPolicy = v1.TPMStateRetentionPolicyRetain
+				vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef = &k8sv1.LocalObjectReference{Name: "tpm-key"}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+			DescribeTable("should reject", func(tpm *v1.TPMDevice, expectedField string) {
+				vmi.Spec.Domain.Devices.TPM = tpm
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("an unknown state retention policy",
+					&v1.TPMDevice{Persistent: pointer.BoolPtr(true), StateRetentionPolicy: "Recycle"},
+					"fake.domain.devices.tpm.stateRetentionPolicy"),
+				Entry("an encryption secret without a name",
+					&v1.TPMDevice{Persistent: pointer.BoolPtr(true), EncryptionSecretRef: &k8sv1.LocalObjectReference{}},
+					"fake.domain.devices.tpm.encryptionSecretRef.name"),
+				Entry("a state retention policy on a non persistent TPM",
+					&v1.TPMDevice{StateRetentionPolicy: v1.TPMStateRetentionPolicyRetain},
+					"fake.domain.devices.tpm.stateRetentionPolicy"),
+				Entry("an encryption secret on a non persistent TPM",
+					&v1.TPMDevice{EncryptionSecretRef: &k8sv1.LocalObjectReference{Name: "tpm-key"}},
+					"fake.domain.devices.tpm.encryptionSecretRef"),
+			)
 		})
 		Context("feature gate disabled", func() {
 			It("should reject when the feature gate is disabled", func() {
@@ -4738,9 +5049,113 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Expect(causes[0].Field).To(ContainSubstring("domain.devices.tpm.persistent"))
 				Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", virtconfig.VMPersistentState)))
 			})
+			It("should reject a persistent EFI when the feature gate is disabled", func() {
+				disableFeatureGates()
+				vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{
+					SecureBoot: pointer.BoolPtr(false),
+					Persistent: pointer.BoolPtr(true),
+				}}}
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.firmware.bootloader.efi.persistent"))
+			})
 		})
 	})
 
+	Context("with EFI Secure Boot keys", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Features = &v1.Features{SMM: &v1.FeatureState{Enabled: pointer.BoolPtr(true)}}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{
+				SecureBootKeysSecretRef: &k8sv1.LocalObjectReference{Name: "secure-boot-keys"},
+			}}}
+		})
+		It("should accept keys with SecureBoot enabled", func() {
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		It("should reject keys with SecureBoot disabled", func() {
+			vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot = pointer.BoolPtr(false)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.firmware.bootloader.efi.secureBootKeysSecretRef"))
+		})
+		It("should reject a keys secret without a name", func() {
+			vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBootKeysSecretRef.Name = ""
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.firmware.bootloader.efi.secureBootKeysSecretRef.name"))
+		})
+	})
+
+	Context("with panic devices", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			model := v1.PanicDeviceModelPvpanic
+			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: &model}}
+		})
+		DescribeTable("should accept", func(onCrash *v1.OnCrash) {
+			vmi.Spec.Domain.OnCrash = onCrash
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("no crash action", nil),
+			Entry("the default crash action", &v1.OnCrash{}),
+			Entry("the Restart crash action", &v1.OnCrash{Action: v1.CrashActionRestart}),
+			Entry("the MemoryDump crash action with a claim", &v1.OnCrash{Action: v1.CrashActionMemoryDump, MemoryDumpClaimName: "dump"}),
+		)
+		It("should reject an unknown panic device model", func() {
+			model := v1.PanicDeviceModel("s390")
+			vmi.Spec.Domain.Devices.PanicDevices = append(vmi.Spec.Domain.Devices.PanicDevices, v1.PanicDevice{Model: &model})
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.panicDevices[1].model"))
+		})
+		It("should reject a crash action without panic devices", func() {
+			vmi.Spec.Domain.Devices.PanicDevices = nil
+			vmi.Spec.Domain.OnCrash = &v1.OnCrash{Action: v1.CrashActionPause}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.onCrash"))
+		})
+		DescribeTable("should reject", func(onCrash *v1.OnCrash, expectedField string) {
+			vmi.Spec.Domain.OnCrash = onCrash
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown crash action", &v1.OnCrash{Action: "PowerOff"}, "fake.domain.onCrash.action"),
+			Entry("the MemoryDump crash action without a claim", &v1.OnCrash{Action: v1.CrashActionMemoryDump}, "fake.domain.onCrash.memoryDumpClaimName"),
+		)
+	})
+
+	Context("with a watchdog", func() {
+		DescribeTable("should accept", func(device v1.WatchdogDevice, claimName string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{Name: "watchdog", WatchdogDevice: device, MemoryDumpClaimName: claimName}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("an i6300esb watchdog with the default action", v1.WatchdogDevice{I6300ESB: &v1.I6300ESBWatchdog{}}, ""),
+			Entry("a diag288 watchdog with the dump action", v1.WatchdogDevice{Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionDump}}, "dump-pvc"),
+			Entry("an itco watchdog with the shutdown action", v1.WatchdogDevice{ITCO: &v1.ITCOWatchdog{Action: v1.WatchdogActionShutdown}}, ""),
+		)
+		DescribeTable("should reject", func(device v1.WatchdogDevice, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{Name: "watchdog", WatchdogDevice: device}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("a watchdog without a type", v1.WatchdogDevice{}, "fake.domain.devices.watchdog"),
+			Entry("a watchdog with two types", v1.WatchdogDevice{I6300ESB: &v1.I6300ESBWatchdog{}, ITCO: &v1.ITCOWatchdog{}}, "fake.domain.devices.watchdog"),
+			Entry("an unknown action", v1.WatchdogDevice{ITCO: &v1.ITCOWatchdog{Action: "pause"}}, "fake.domain.devices.watchdog"),
+			Entry("the dump action without a claim", v1.WatchdogDevice{Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionDump}}, "fake.domain.devices.watchdog.memoryDumpClaimName"),
+		)
+	})
+
 	Context("with multi-threaded QEMU migrations", func() {
 		DescribeTable("should", func(threadCountStr string, isValid bool) {
 			meta := metav1.ObjectMeta{Annotations: map[string]string{cmdclient.MultiThreadedQemuMigrationAnnotation: threadCountStr}}
@@ -4787,6 +5202,77 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			})
 		})
 	})
+
+	Context("with memory hotplug", func() {
+		DescribeTable("should validate guest memory against maxGuest", func(guest *resource.Quantity, numa *v1.NUMA, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			maxGuest := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:    guest,
+				MaxGuest: &maxGuest,
+			}
+			if numa != nil {
+				vmi.Spec.Domain.CPU = &v1.CPU{NUMA: numa}
+			}
+
+			causes := validateMemoryHotplug(k8sfield.NewPath("spec"), &vmi.Spec)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("allow guest memory below maxGuest", resource.NewQuantity(1024*1024*1024, resource.BinarySI), nil, ""),
+			Entry("deny guest memory above maxGuest", resource.NewQuantity(8*1024*1024*1024, resource.BinarySI), nil, "spec.domain.memory.guest"),
+			Entry("deny maxGuest without guest memory", nil, nil, "spec.domain.memory.guest"),
+			Entry("deny together with guest NUMA mapping passthrough", resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+				&v1.NUMA{GuestMappingPassthrough: &v1.NUMAGuestMappingPassthrough{}}, "spec.domain.memory.maxGuest"),
+		)
+	})
+
+	Context("with memory balloon", func() {
+		DescribeTable("should validate the balloon policy", func(mutate func(vmi *v1.VirtualMachineInstance), expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			guest := resource.MustParse("2Gi")
+			minGuest := resource.MustParse("1Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest: &guest,
+				Balloon: &v1.MemoryBalloon{
+					FreeMemoryPercent: pointer.Uint32(20),
+					MinGuest:          &minGuest,
+				},
+			}
+			mutate(vmi)
+
+			causes := validateMemoryBalloon(k8sfield.NewPath("spec"), &vmi.Spec)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("allow a valid policy", func(vmi *v1.VirtualMachineInstance) {}, ""),
+			Entry("deny with the memory balloon device disabled", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.AutoattachMemBalloon = pointer.Bool(false)
+			}, "spec.domain.memory.balloon"),
+			Entry("deny together with hugepages", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Memory.Hugepages = &v1.Hugepages{PageSize: "2Mi"}
+			}, "spec.domain.memory.balloon"),
+			Entry("deny freeMemoryPercent of 100", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Memory.Balloon.FreeMemoryPercent = pointer.Uint32(100)
+			}, "spec.domain.memory.balloon.freeMemoryPercent"),
+			Entry("deny minGuest above the guest memory", func(vmi *v1.VirtualMachineInstance) {
+				minGuest := resource.MustParse("4Gi")
+				vmi.Spec.Domain.Memory.Balloon.MinGuest = &minGuest
+			}, "spec.domain.memory.balloon.minGuest"),
+			Entry("deny minGuest above the memory request", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Memory.Guest = nil
+				vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("512Mi")}
+			}, "spec.domain.memory.balloon.minGuest"),
+		)
+	})
 })
 
 var _ = Describe("Function getNumberOfPodInterfaces()", func() {
@@ -322,17 +322,103 @@ func admitHotplug(
 		return response
 	}
 
+	if response := admitHotplugMemory(oldVMI.Spec.Domain.Memory, newVMI.Spec.Domain.Memory); response != nil {
+		return response
+	}
+
+	if response := admitDiskIOTune(oldVMI.Spec.Domain.Devices.Disks, newVMI.Spec.Domain.Devices.Disks, clusterConfig); response != nil {
+		return response
+	}
+
+	// I/O throttling changes are applied live and must not be treated as disk changes
 	return admitHotplugStorage(
 		newVMI.Spec.Volumes,
 		oldVMI.Spec.Volumes,
-		newVMI.Spec.Domain.Devices.Disks,
-		oldVMI.Spec.Domain.Devices.Disks,
+		withoutDiskIOTune(newVMI.Spec.Domain.Devices.Disks),
+		withoutDiskIOTune(oldVMI.Spec.Domain.Devices.Disks),
 		oldVMI.Status.VolumeStatus,
 		newVMI,
 		clusterConfig)
 
 }
 
+func admitHotplugMemory(oldMemory, newMemory *v1.Memory) *admissionv1.AdmissionResponse {
+	if oldMemory == nil || newMemory == nil {
+		if oldMemory != newMemory {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("Memory configuration changed"),
+				},
+			})
+		}
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(oldMemory.MaxGuest, newMemory.MaxGuest) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Memory maxGuest changed"),
+			},
+		})
+	}
+
+	if !equality.Semantic.DeepEqual(oldMemory.Guest, newMemory.Guest) {
+		if newMemory.MaxGuest == nil || newMemory.Guest == nil {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("Guest memory can only be changed on a VMI with hotpluggable memory"),
+				},
+			})
+		}
+		if newMemory.Guest.Cmp(*newMemory.MaxGuest) > 0 {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("Guest memory is greater than the maximum guest memory allowed"),
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func admitDiskIOTune(oldDisks, newDisks []v1.Disk, config *virtconfig.ClusterConfig) *admissionv1.AdmissionResponse {
+	if config.VMLiveUpdateFeaturesEnabled() {
+		return nil
+	}
+
+	oldDiskMap := getDiskMap(oldDisks)
+	for _, newDisk := range newDisks {
+		oldDisk, exists := oldDiskMap[newDisk.Name]
+		if !exists {
+			continue
+		}
+		if !equality.Semantic.DeepEqual(oldDisk.IOTune, newDisk.IOTune) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("I/O throttling of disk %s can only be changed with the %s feature gate enabled", newDisk.Name, virtconfig.VMLiveUpdateFeaturesGate),
+				},
+			})
+		}
+	}
+
+	return nil
+}
+
+func withoutDiskIOTune(disks []v1.Disk) []v1.Disk {
+	result := make([]v1.Disk, len(disks))
+	for i := range disks {
+		disks[i].DeepCopyInto(&result[i])
+		result[i].IOTune = nil
+	}
+	return result
+}
+
 func admitHotplugCPU(oldCPUTopology, newCPUTopology *v1.CPU) *admissionv1.AdmissionResponse {
 
 	if oldCPUTopology.MaxSockets != newCPUTopology.MaxSockets {
@@ -29,6 +29,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	authv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -39,6 +40,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
@@ -597,4 +599,99 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 				MaxSockets: 8,
 			},
 			BeFalse()))
+
+	DescribeTable("Updates in memory", func(oldMemory, newMemory *v1.Memory, expected types.GomegaMatcher) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		updateVmi := vmi.DeepCopy()
+		vmi.Spec.Domain.Memory = oldMemory
+		updateVmi.Spec.Domain.Memory = newMemory
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow hotplug of guest memory",
+			&v1.Memory{Guest: quantityPtr("1Gi"), MaxGuest: quantityPtr("4Gi")},
+			&v1.Memory{Guest: quantityPtr("2Gi"), MaxGuest: quantityPtr("4Gi")},
+			BeTrue()),
+		Entry("deny guest memory above maxGuest",
+			&v1.Memory{Guest: quantityPtr("1Gi"), MaxGuest: quantityPtr("4Gi")},
+			&v1.Memory{Guest: quantityPtr("8Gi"), MaxGuest: quantityPtr("4Gi")},
+			BeFalse()),
+		Entry("deny update of maxGuest",
+			&v1.Memory{Guest: quantityPtr("1Gi"), MaxGuest: quantityPtr("4Gi")},
+			&v1.Memory{Guest: quantityPtr("1Gi"), MaxGuest: quantityPtr("8Gi")},
+			BeFalse()),
+		Entry("deny guest memory change without hotpluggable memory",
+			&v1.Memory{Guest: quantityPtr("1Gi")},
+			&v1.Memory{Guest: quantityPtr("2Gi")},
+			BeFalse()),
+	)
+
+	DescribeTable("Updates in disk I/O throttling", func(liveUpdateEnabled bool, newIOTune *v1.DiskIOTune, expected types.GomegaMatcher) {
+		kvConfig := &v1.KubeVirtConfiguration{DeveloperConfiguration: &v1.DeveloperConfiguration{}}
+		if liveUpdateEnabled {
+			kvConfig.DeveloperConfiguration.FeatureGates = []string{virtconfig.VMLiveUpdateFeaturesGate}
+		}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		admitter := &VMIUpdateAdmitter{clusterConfig}
+
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "disk0",
+			VolumeSource: v1.VolumeSource{
+				ContainerDisk: &v1.ContainerDiskSource{Image: "fake"},
+			},
+		}}
+		vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+			Name:   "disk0",
+			IOTune: &v1.DiskIOTune{TotalBytesSec: 1024},
+		}}
+		updateVmi := vmi.DeepCopy()
+		updateVmi.Spec.Domain.Devices.Disks[0].IOTune = newIOTune
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := admitter.Admit(ar)
+		Expect(resp.Allowed).To(expected)
+	},
+		Entry("allow changing the limits with live update features enabled", true, &v1.DiskIOTune{TotalBytesSec: 2048}, BeTrue()),
+		Entry("allow removing the limits with live update features enabled", true, nil, BeTrue()),
+		Entry("deny changing the limits with live update features disabled", false, &v1.DiskIOTune{TotalBytesSec: 2048}, BeFalse()),
+		Entry("deny invalid limits", true, &v1.DiskIOTune{TotalBytesSec: 2048, ReadBytesSec: 1024}, BeFalse()),
+	)
 })
+
+func quantityPtr(q string) *resource.Quantity {
+	quantity := resource.MustParse(q)
+	return &quantity
+}
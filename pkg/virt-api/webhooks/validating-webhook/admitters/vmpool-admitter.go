@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -116,6 +117,11 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	causes = append(causes, validateVMPoolUpdateStrategy(field.Child("updateStrategy"), spec.UpdateStrategy)...)
+	causes = append(causes, validateVMPoolScaleInPolicy(field.Child("scaleInPolicy"), spec.ScaleInPolicy)...)
+	causes = append(causes, validateVMPoolNamingPolicy(field, spec)...)
+	causes = append(causes, validateVMPoolDataVolumeClaims(field, spec)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -135,3 +141,194 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validateVMPoolUpdateStrategy(field *k8sfield.Path, strategy *poolv1.VirtualMachinePoolUpdateStrategy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if strategy == nil {
+		return causes
+	}
+
+	if strategy.Type != "" && strategy.Type != poolv1.VirtualMachinePoolRollingUpdateStrategyType {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported update strategy type %s", strategy.Type),
+			Field:   field.Child("type").String(),
+		})
+	}
+
+	rollingUpdate := strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return causes
+	}
+	rollingUpdateField := field.Child("rollingUpdate")
+
+	switch rollingUpdate.Mode {
+	case "", poolv1.VirtualMachinePoolProactiveUpdateMode, poolv1.VirtualMachinePoolOpportunisticUpdateMode:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported update mode %s", rollingUpdate.Mode),
+			Field:   rollingUpdateField.Child("mode").String(),
+		})
+	}
+
+	maxUnavailable, unavailableCauses := validateIntOrPercent(rollingUpdateField.Child("maxUnavailable"), rollingUpdate.MaxUnavailable)
+	causes = append(causes, unavailableCauses...)
+	maxSurge, surgeCauses := validateIntOrPercent(rollingUpdateField.Child("maxSurge"), rollingUpdate.MaxSurge)
+	causes = append(causes, surgeCauses...)
+
+	if len(unavailableCauses) == 0 && len(surgeCauses) == 0 &&
+		rollingUpdate.MaxUnavailable != nil && maxUnavailable == 0 &&
+		(rollingUpdate.MaxSurge == nil || maxSurge == 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable may not be 0 when maxSurge is 0",
+			Field:   rollingUpdateField.Child("maxUnavailable").String(),
+		})
+	}
+
+	return causes
+}
+
+// validateIntOrPercent makes sure the value is a non-negative number or a percentage and returns the number
+// or the percentage value
+func validateVMPoolScaleInPolicy(field *k8sfield.Path, policy *poolv1.VirtualMachinePoolScaleInPolicy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if policy == nil {
+		return causes
+	}
+
+	seen := map[poolv1.VirtualMachinePoolScaleInOrder]bool{}
+	for i, order := range policy.Order {
+		orderField := field.Child("order").Index(i)
+		switch order {
+		case poolv1.VirtualMachinePoolNotReadyFirstScaleInOrder,
+			poolv1.VirtualMachinePoolNewestFirstScaleInOrder,
+			poolv1.VirtualMachinePoolOldestFirstScaleInOrder,
+			poolv1.VirtualMachinePoolLeastLoadedScaleInOrder:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported scale in order %s", order),
+				Field:   orderField.String(),
+			})
+			continue
+		}
+		if seen[order] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("scale in order %s is listed more than once", order),
+				Field:   orderField.String(),
+			})
+		}
+		seen[order] = true
+	}
+
+	if seen[poolv1.VirtualMachinePoolNewestFirstScaleInOrder] && seen[poolv1.VirtualMachinePoolOldestFirstScaleInOrder] {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("scale in orders %s and %s are mutually exclusive", poolv1.VirtualMachinePoolNewestFirstScaleInOrder, poolv1.VirtualMachinePoolOldestFirstScaleInOrder),
+			Field:   field.Child("order").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVMPoolNamingPolicy(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	switch spec.NamingPolicy {
+	case "", poolv1.VirtualMachinePoolIndexedNamingPolicy:
+	case poolv1.VirtualMachinePoolOrdinalNamingPolicy:
+		if spec.ScaleInPolicy != nil && len(spec.ScaleInPolicy.Order) > 0 {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("a scale in order can not be used with the %s naming policy", poolv1.VirtualMachinePoolOrdinalNamingPolicy),
+				Field:   field.Child("scaleInPolicy", "order").String(),
+			}}
+		}
+	default:
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported naming policy %s", spec.NamingPolicy),
+			Field:   field.Child("namingPolicy").String(),
+		}}
+	}
+	return nil
+}
+
+func validateVMPoolDataVolumeClaims(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	templateNames := map[string]bool{}
+	if spec.VirtualMachineTemplate != nil {
+		for _, template := range spec.VirtualMachineTemplate.Spec.DataVolumeTemplates {
+			templateNames[template.Name] = true
+		}
+	}
+
+	claimNames := map[string]bool{}
+	for i, template := range spec.DataVolumeClaimTemplates {
+		nameField := field.Child("dataVolumeClaimTemplates").Index(i).Child("metadata", "name")
+		switch {
+		case template.Name == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "DataVolume claim template name must not be empty",
+				Field:   nameField.String(),
+			})
+		case claimNames[template.Name]:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("DataVolume claim template %s is listed more than once", template.Name),
+				Field:   nameField.String(),
+			})
+		case templateNames[template.Name]:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("DataVolume claim template %s clashes with a dataVolumeTemplate of the VirtualMachine template", template.Name),
+				Field:   nameField.String(),
+			})
+		}
+		claimNames[template.Name] = true
+	}
+
+	if policy := spec.DataVolumeClaimRetentionPolicy; policy != nil {
+		switch policy.WhenScaled {
+		case "", poolv1.VirtualMachinePoolRetainDataVolumeClaimRetentionPolicyType, poolv1.VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("unsupported retention policy %s", policy.WhenScaled),
+				Field:   field.Child("dataVolumeClaimRetentionPolicy", "whenScaled").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString) (int, []metav1.StatusCause) {
+	if value == nil {
+		return 0, nil
+	}
+
+	// scaling a percentage by 100 returns the percentage itself
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, false)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a number or a percentage: %v", field.String(), err),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative", field.String()),
+			Field:   field.String(),
+		}}
+	}
+	return scaled, nil
+}
@@ -27,6 +27,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
@@ -131,8 +132,8 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.selector",
 		}),
 	)
-	It("should accept valid vm spec", func() {
-		pool := &poolv1.VirtualMachinePool{
+	newValidPool := func() *poolv1.VirtualMachinePool {
+		return &poolv1.VirtualMachinePool{
 			Spec: poolv1.VirtualMachinePoolSpec{
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
@@ -160,7 +161,10 @@ var _ = Describe("Validating Pool Admitter", func() {
 				},
 			},
 		}
-		poolBytes, _ := json.Marshal(&pool)
+	}
+
+	admitPool := func(pool *poolv1.VirtualMachinePool) *admissionv1.AdmissionResponse {
+		poolBytes, _ := json.Marshal(pool)
 
 		ar := &admissionv1.AdmissionReview{
 			Request: &admissionv1.AdmissionRequest{
@@ -171,7 +175,167 @@ var _ = Describe("Validating Pool Admitter", func() {
 			},
 		}
 
-		resp := poolAdmitter.Admit(ar)
+		return poolAdmitter.Admit(ar)
+	}
+
+	It("should accept valid vm spec", func() {
+		resp := admitPool(newValidPool())
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should accept a valid update strategy", func(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+			RollingUpdate: rollingUpdate,
+		}
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	},
+		Entry("with defaults", nil),
+		Entry("with proactive mode and numbers", &poolv1.VirtualMachinePoolRollingUpdate{
+			Mode:           poolv1.VirtualMachinePoolProactiveUpdateMode,
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(2)),
+			MaxSurge:       intOrStrPtr(intstr.FromInt(1)),
+		}),
+		Entry("with opportunistic mode and percentages", &poolv1.VirtualMachinePoolRollingUpdate{
+			Mode:           poolv1.VirtualMachinePoolOpportunisticUpdateMode,
+			MaxUnavailable: intOrStrPtr(intstr.FromString("25%")),
+			MaxSurge:       intOrStrPtr(intstr.FromString("10%")),
+		}),
+		Entry("with no unavailable VMs but surge", &poolv1.VirtualMachinePoolRollingUpdate{
+			MaxUnavailable: intOrStrPtr(intstr.FromInt(0)),
+			MaxSurge:       intOrStrPtr(intstr.FromInt(1)),
+		}),
+	)
+
+	DescribeTable("should reject an invalid update strategy", func(strategy *poolv1.VirtualMachinePoolUpdateStrategy, field string) {
+		pool := newValidPool()
+		pool.Spec.UpdateStrategy = strategy
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with an unknown type", &poolv1.VirtualMachinePoolUpdateStrategy{
+			Type: "Recreate",
+		}, "spec.updateStrategy.type"),
+		Entry("with an unknown mode", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{Mode: "Eventually"},
+		}, "spec.updateStrategy.rollingUpdate.mode"),
+		Entry("with a negative maxUnavailable", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxUnavailable: intOrStrPtr(intstr.FromInt(-1))},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+		Entry("with an invalid maxSurge percentage", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{MaxSurge: intOrStrPtr(intstr.FromString("many"))},
+		}, "spec.updateStrategy.rollingUpdate.maxSurge"),
+		Entry("with neither unavailable nor surge VMs", &poolv1.VirtualMachinePoolUpdateStrategy{
+			RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+				MaxUnavailable: intOrStrPtr(intstr.FromString("0%")),
+				MaxSurge:       intOrStrPtr(intstr.FromInt(0)),
+			},
+		}, "spec.updateStrategy.rollingUpdate.maxUnavailable"),
+	)
+
+	It("should accept a valid scale in policy", func() {
+		pool := newValidPool()
+		pool.Spec.ScaleInPolicy = &poolv1.VirtualMachinePoolScaleInPolicy{
+			Order: []poolv1.VirtualMachinePoolScaleInOrder{
+				poolv1.VirtualMachinePoolNotReadyFirstScaleInOrder,
+				poolv1.VirtualMachinePoolLeastLoadedScaleInOrder,
+				poolv1.VirtualMachinePoolNewestFirstScaleInOrder,
+			},
+		}
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject an invalid scale in policy", func(order []poolv1.VirtualMachinePoolScaleInOrder, field string) {
+		pool := newValidPool()
+		pool.Spec.ScaleInPolicy = &poolv1.VirtualMachinePoolScaleInPolicy{Order: order}
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with an unknown order", []poolv1.VirtualMachinePoolScaleInOrder{
+			poolv1.VirtualMachinePoolNewestFirstScaleInOrder, "BiggestFirst",
+		}, "spec.scaleInPolicy.order[1]"),
+		Entry("with a duplicate order", []poolv1.VirtualMachinePoolScaleInOrder{
+			poolv1.VirtualMachinePoolLeastLoadedScaleInOrder, poolv1.VirtualMachinePoolLeastLoadedScaleInOrder,
+		}, "spec.scaleInPolicy.order[1]"),
+		Entry("with contradicting orders", []poolv1.VirtualMachinePoolScaleInOrder{
+			poolv1.VirtualMachinePoolNewestFirstScaleInOrder, poolv1.VirtualMachinePoolOldestFirstScaleInOrder,
+		}, "spec.scaleInPolicy.order"),
+	)
+
+	It("should accept ordinal naming with DataVolume claim templates", func() {
+		pool := newValidPool()
+		pool.Spec.NamingPolicy = poolv1.VirtualMachinePoolOrdinalNamingPolicy
+		pool.Spec.DataVolumeClaimTemplates = []v1.DataVolumeTemplateSpec{
+			{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+		}
+		pool.Spec.DataVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolDataVolumeClaimRetentionPolicy{
+			WhenScaled: poolv1.VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType,
+		}
+
+		resp := admitPool(pool)
 		Expect(resp.Allowed).To(BeTrue())
 	})
+
+	DescribeTable("should reject invalid naming or DataVolume claims", func(mutate func(pool *poolv1.VirtualMachinePool), field string) {
+		pool := newValidPool()
+		mutate(pool)
+
+		resp := admitPool(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("with an unknown naming policy", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.NamingPolicy = "Random"
+		}, "spec.namingPolicy"),
+		Entry("with ordinal naming and a scale in order", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.NamingPolicy = poolv1.VirtualMachinePoolOrdinalNamingPolicy
+			pool.Spec.ScaleInPolicy = &poolv1.VirtualMachinePoolScaleInPolicy{
+				Order: []poolv1.VirtualMachinePoolScaleInOrder{poolv1.VirtualMachinePoolNewestFirstScaleInOrder},
+			}
+		}, "spec.scaleInPolicy.order"),
+		Entry("with an unnamed claim template", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.DataVolumeClaimTemplates = []v1.DataVolumeTemplateSpec{{}}
+		}, "spec.dataVolumeClaimTemplates[0].metadata.name"),
+		Entry("with a duplicate claim template", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.DataVolumeClaimTemplates = []v1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			}
+		}, "spec.dataVolumeClaimTemplates[1].metadata.name"),
+		Entry("with a claim template clashing with a VM dataVolumeTemplate", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.VirtualMachineTemplate.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			}
+			template := &pool.Spec.VirtualMachineTemplate.Spec.Template.Spec
+			template.Domain.Devices.Disks = append(template.Domain.Devices.Disks, v1.Disk{Name: "data"})
+			template.Volumes = append(template.Volumes, v1.Volume{
+				Name:         "data",
+				VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "data"}},
+			})
+			pool.Spec.DataVolumeClaimTemplates = []v1.DataVolumeTemplateSpec{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+			}
+		}, "spec.dataVolumeClaimTemplates[0].metadata.name"),
+		Entry("with an unknown retention policy", func(pool *poolv1.VirtualMachinePool) {
+			pool.Spec.DataVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolDataVolumeClaimRetentionPolicy{
+				WhenScaled: "Archive",
+			}
+		}, "spec.dataVolumeClaimRetentionPolicy.whenScaled"),
+	)
 })
+
+func intOrStrPtr(value intstr.IntOrString) *intstr.IntOrString {
+	return &value
+}
@@ -26,6 +26,8 @@ import (
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -98,7 +100,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 			case core.GroupName:
 				switch vmRestore.Spec.Target.Kind {
 				case "VirtualMachine":
-					causes, targetUID, targetVMExists, err = admitter.validateCreateVM(k8sfield.NewPath("spec"), vmRestore)
+					causes, targetUID, targetVMExists, err = admitter.validateCreateVM(k8sfield.NewPath("spec"), vmRestore, &ar.Request.UserInfo)
 					if err != nil {
 						return webhookutils.ToAdmissionResponseError(err)
 					}
@@ -141,6 +143,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 		for _, obj := range objects {
 			r := obj.(*snapshotv1.VirtualMachineRestore)
 			if equality.Semantic.DeepEqual(r.Spec.Target, vmRestore.Spec.Target) &&
+				vmRestoreTargetNamespace(r) == vmRestoreTargetNamespace(vmRestore) &&
 				(r.Status == nil || r.Status.Complete == nil || !*r.Status.Complete) {
 				cause := metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -183,22 +186,39 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 	return &reviewResponse
 }
 
-func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, uid *types.UID, targetVMExists bool, err error) {
+func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, userInfo *authv1.UserInfo) (causes []metav1.StatusCause, uid *types.UID, targetVMExists bool, err error) {
 	vmName := vmRestore.Spec.Target.Name
-	namespace := vmRestore.Namespace
+	namespace := vmRestoreTargetNamespace(vmRestore)
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
+	if namespace != vmRestore.Namespace {
+		namespaceCauses, err := admitter.validateTargetNamespace(field.Child("targetNamespace"), namespace, userInfo)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		causes = append(causes, namespaceCauses...)
+	}
+
 	vm, err := admitter.Client.VirtualMachine(namespace).Get(context.Background(), vmName, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// If the target VM does not exist it would be automatically created by the restore controller
-		return nil, nil, false, nil
+		return causes, nil, false, nil
 	}
 
 	if err != nil {
 		return nil, nil, false, err
 	}
 
+	if namespace != vmRestore.Namespace {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VirtualMachine %q already exists in namespace %q, restoring into another namespace requires a new VirtualMachine", vmName, namespace),
+			Field:   field.Child("target").String(),
+		})
+		return causes, &vm.UID, true, nil
+	}
+
 	rs, err := vm.RunStrategy()
 	if err != nil {
 		return nil, nil, true, err
@@ -226,6 +246,50 @@ func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRest
 	return causes, &vm.UID, true, nil
 }
 
+// validateTargetNamespace makes sure the requester may create the restored VM and its PVCs in the target namespace,
+// since the restore controller creates them on the requester's behalf
+func (admitter *VMRestoreAdmitter) validateTargetNamespace(field *k8sfield.Path, namespace string, userInfo *authv1.UserInfo) ([]metav1.StatusCause, error) {
+	var causes []metav1.StatusCause
+
+	for _, resource := range []authorizationv1.ResourceAttributes{
+		{Group: v1.GroupVersion.Group, Resource: "virtualmachines"},
+		{Group: "", Resource: "persistentvolumeclaims"},
+	} {
+		resource.Namespace = namespace
+		resource.Verb = "create"
+
+		extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+		for k, v := range userInfo.Extra {
+			extra[k] = authorizationv1.ExtraValue(v)
+		}
+
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:               userInfo.Username,
+				Groups:             userInfo.Groups,
+				UID:                userInfo.UID,
+				Extra:              extra,
+				ResourceAttributes: &resource,
+			},
+		}
+
+		sar, err := admitter.Client.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if !sar.Status.Allowed {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("User %q is not allowed to create %s in namespace %q", userInfo.Username, resource.Resource, namespace),
+				Field:   field.String(),
+			})
+		}
+	}
+
+	return causes, nil
+}
+
 func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sfield.Path) (causes []metav1.StatusCause) {
 	// Validate patches are either on labels/annotations or on elements under "/spec/" path only
 	for _, patch := range patches {
@@ -311,3 +375,10 @@ func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namesp
 
 	return causes, nil
 }
+
+func vmRestoreTargetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace != nil && *vmRestore.Spec.TargetNamespace != "" {
+		return *vmRestore.Spec.TargetNamespace
+	}
+	return vmRestore.Namespace
+}
@@ -29,12 +29,16 @@ import (
 	. "github.com/onsi/gomega"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -542,6 +546,58 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				Entry("should reject if target exists", true),
 			)
 
+			Context("when restoring into another namespace", func() {
+				const targetNamespace = "target-namespace"
+
+				var restore *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     "new-vm",
+							},
+							TargetNamespace:            pointer.String(targetNamespace),
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				It("should accept when the target VM does not exist", func() {
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject when the target VM exists", func() {
+					restore.Spec.Target.Name = vmName
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).ToNot(BeEmpty())
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target"))
+					Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("already exists in namespace"))
+				})
+
+				It("should reject when the user may not create VMs and PVCs in the target namespace", func() {
+					ar := createRestoreAdmissionReview(restore)
+					ar.Request.UserInfo.Username = unauthorizedRestoreUser
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(2))
+					for _, cause := range resp.Result.Details.Causes {
+						Expect(cause.Field).To(Equal("spec.targetNamespace"))
+					}
+				})
+			})
+
 			Context("when using Patches", func() {
 
 				var restore *snapshotv1.VirtualMachineRestore
@@ -654,6 +710,8 @@ func createRestoreUpdateAdmissionReview(old, current *snapshotv1.VirtualMachineR
 	return ar
 }
 
+const unauthorizedRestoreUser = "unauthorized-user"
+
 func createTestVMRestoreAdmitter(
 	config *virtconfig.ClusterConfig,
 	vm *v1.VirtualMachine,
@@ -668,6 +726,14 @@ func createTestVMRestoreAdmitter(
 		Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots("default")).AnyTimes()
 	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
 
+	k8sClient := k8sfake.NewSimpleClientset()
+	k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.Status.Allowed = sar.Spec.User != unauthorizedRestoreUser
+		return true, sar, nil
+	})
+	virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+
 	restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
 	for _, obj := range objs {
 		r, ok := obj.(*snapshotv1.VirtualMachineRestore)
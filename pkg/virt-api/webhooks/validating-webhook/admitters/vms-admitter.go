@@ -43,6 +43,7 @@ import (
 	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	typesutil "kubevirt.io/kubevirt/pkg/storage/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -56,6 +57,7 @@ type CloneAuthFunc func(pvcNamespace, pvcName, saNamespace, saName string) (bool
 type VMsAdmitter struct {
 	VirtClient          kubecli.KubevirtClient
 	DataSourceInformer  cache.SharedIndexInformer
+	VMInformer          cache.SharedIndexInformer
 	InstancetypeMethods instancetype.Methods
 	ClusterConfig       *virtconfig.ClusterConfig
 	cloneAuthFunc       CloneAuthFunc
@@ -75,6 +77,7 @@ func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.Kube
 	return &VMsAdmitter{
 		VirtClient:          client,
 		DataSourceInformer:  informers.DataSourceInformer,
+		VMInformer:          informers.VMInformer,
 		InstancetypeMethods: &instancetype.InstancetypeMethods{Clientset: client},
 		ClusterConfig:       clusterConfig,
 		cloneAuthFunc: func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error) {
@@ -153,6 +156,11 @@ func (admitter *VMsAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = admitter.validateMacAddressesUnique(ar.Request, &vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldVM := v1.VirtualMachine{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, &oldVM); err != nil {
@@ -173,6 +181,59 @@ func (admitter *VMsAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1
 	return &reviewResponse
 }
 
+// validateMacAddressesUnique rejects the MAC addresses already used by other VirtualMachines,
+// when the cluster MAC address pool is configured.
+func (admitter *VMsAdmitter) validateMacAddressesUnique(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
+	if admitter.ClusterConfig.GetMacAddressPool() == nil || admitter.VMInformer == nil || vm.Spec.Template == nil {
+		return nil
+	}
+
+	// On update only the MAC addresses added or changed since the old VM are checked
+	oldMacAddresses := map[string]struct{}{}
+	if ar.Operation == admissionv1.Update {
+		oldVM := &v1.VirtualMachine{}
+		if err := json.Unmarshal(ar.OldObject.Raw, oldVM); err != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeUnexpectedServerResponse,
+				Message: "Could not fetch old VM",
+			}}
+		}
+		if oldVM.Spec.Template != nil {
+			for _, iface := range oldVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+				oldMacAddresses[iface.MacAddress] = struct{}{}
+			}
+		}
+	}
+
+	vmKey := fmt.Sprintf("%s/%s", vm.Namespace, vm.Name)
+	field := k8sfield.NewPath("spec", "template", "spec", "domain", "devices", "interfaces")
+	var causes []metav1.StatusCause
+	for idx, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if iface.MacAddress == "" {
+			continue
+		}
+		if _, exists := oldMacAddresses[iface.MacAddress]; exists {
+			continue
+		}
+		// An invalid MAC address is reported by the spec validation
+		usedBy, err := macpool.UsedBy(admitter.VMInformer.GetIndexer(), iface.MacAddress)
+		if err != nil {
+			continue
+		}
+		for _, key := range usedBy {
+			if key != vmKey {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueDuplicate,
+					Message: fmt.Sprintf("MAC address %s is already used by VirtualMachine %s", iface.MacAddress, key),
+					Field:   field.Index(idx).Child("macAddress").String(),
+				})
+				break
+			}
+		}
+	}
+	return causes
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -439,6 +500,39 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 		}
 	}
 
+	if spec.Template.Spec.Domain.Memory != nil && spec.Template.Spec.Domain.Memory.MaxGuest != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("Memory maxGuest cannot be set directy in VM template"),
+			Field:   field.Child("template.spec.domain.memory.maxGuest").String(),
+		})
+	}
+
+	if spec.LiveUpdateFeatures != nil && spec.LiveUpdateFeatures.Memory != nil {
+		if spec.Instancetype != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("Live update features cannot be used when instance type is configured"),
+				Field:   field.Child("liveUpdateFeatures").String(),
+			})
+		}
+
+		memory := spec.Template.Spec.Domain.Memory
+		if memory == nil || memory.Guest == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("Guest memory must be set when memory live update is enabled"),
+				Field:   field.Child("template.spec.domain.memory.guest").String(),
+			})
+		} else if maxGuest := spec.LiveUpdateFeatures.Memory.MaxGuest; maxGuest != nil && memory.Guest.Cmp(*maxGuest) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Guest memory is greater than the maximum guest memory allowed"),
+				Field:   field.Child("liveUpdateFeatures").String(),
+			})
+		}
+	}
+
 	return causes
 }
 
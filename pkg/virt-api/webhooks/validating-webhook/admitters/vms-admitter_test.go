@@ -47,6 +47,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -833,6 +834,93 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.dataVolumeTemplate[0]"))
 	})
 
+	Context("with a MAC address pool", func() {
+		var vmInformer cache.SharedIndexInformer
+
+		newVMWithMac := func(name, macAddress string) *v1.VirtualMachine {
+			vmi := api.NewMinimalVMI(name)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = macAddress
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			return &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+				Spec: v1.VirtualMachineSpec{
+					Running:  &notRunning,
+					Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						NetworkConfiguration: &v1.NetworkConfiguration{
+							MacAddressPool: &v1.MacAddressPool{
+								Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"}},
+							},
+						},
+					},
+				},
+			})
+			vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, controller.GetVirtualMachineInformerIndexers())
+			vmsAdmitter.VMInformer = vmInformer
+			Expect(vmInformer.GetStore().Add(newVMWithMac("existing", "02:00:00:00:00:01"))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			disableFeatureGates()
+		})
+
+		It("should reject a MAC address used by another VM", func() {
+			resp := admitVm(vmsAdmitter, newVMWithMac("testvm", "02:00:00:00:00:01"))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueDuplicate))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.devices.interfaces[0].macAddress"))
+		})
+
+		It("should accept the MAC address of the VM itself", func() {
+			resp := admitVm(vmsAdmitter, newVMWithMac("existing", "02:00:00:00:00:01"))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a MAC address unused by other VMs", func() {
+			resp := admitVm(vmsAdmitter, newVMWithMac("testvm", "02:00:00:00:00:02"))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		updateVm := func(oldVM, vm *v1.VirtualMachine) *admissionv1.AdmissionResponse {
+			oldVMBytes, err := json.Marshal(oldVM)
+			Expect(err).ToNot(HaveOccurred())
+			vmBytes, err := json.Marshal(vm)
+			Expect(err).ToNot(HaveOccurred())
+
+			return vmsAdmitter.Admit(&admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Operation: admissionv1.Update,
+					Resource:  webhooks.VirtualMachineGroupVersionResource,
+					OldObject: runtime.RawExtension{Raw: oldVMBytes},
+					Object:    runtime.RawExtension{Raw: vmBytes},
+				},
+			})
+		}
+
+		It("should not revalidate a MAC address unchanged by the update", func() {
+			oldVM := newVMWithMac("testvm", "02:00:00:00:00:01")
+			vm := oldVM.DeepCopy()
+			vm.Labels = map[string]string{"updated": "true"}
+			Expect(updateVm(oldVM, vm).Allowed).To(BeTrue())
+		})
+
+		It("should reject an update changing the MAC address to one used by another VM", func() {
+			resp := updateVm(newVMWithMac("testvm", "02:00:00:00:00:02"), newVMWithMac("testvm", "02:00:00:00:00:01"))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Type).To(Equal(metav1.CauseTypeFieldValueDuplicate))
+		})
+	})
+
 	Context("with Volume", func() {
 
 		BeforeEach(func() {
@@ -1975,6 +2063,63 @@ var _ = Describe("Validating VM Admitter", func() {
 				})
 			})
 		})
+
+		Context("Memory", func() {
+			var vm *v1.VirtualMachine
+			maxGuest := resource.MustParse("4Gi")
+
+			BeforeEach(func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				guest := resource.MustParse("1Gi")
+				vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guest}
+				enableFeatureGate(virtconfig.VMLiveUpdateFeaturesGate)
+				vm = &v1.VirtualMachine{
+					Spec: v1.VirtualMachineSpec{
+						LiveUpdateFeatures: &v1.LiveUpdateFeatures{
+							Memory: &v1.LiveUpdateMemory{
+								MaxGuest: &maxGuest,
+							},
+						},
+						Running: &notRunning,
+						Template: &v1.VirtualMachineInstanceTemplateSpec{
+							Spec: vmi.Spec,
+						},
+					},
+				}
+			})
+
+			It("should accept a valid memory live update configuration", func() {
+				response := admitVm(vmsAdmitter, vm)
+				Expect(response.Allowed).To(BeTrue())
+			})
+
+			It("should reject configuration of maxGuest in VM template", func() {
+				vm.Spec.Template.Spec.Domain.Memory.MaxGuest = &maxGuest
+
+				response := admitVm(vmsAdmitter, vm)
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.memory.maxGuest"))
+			})
+
+			It("should reject VM creation when guest memory is not set", func() {
+				vm.Spec.Template.Spec.Domain.Memory = nil
+
+				response := admitVm(vmsAdmitter, vm)
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.memory.guest"))
+				Expect(response.Result.Details.Causes[0].Message).To(ContainSubstring("Guest memory must be set when memory live update is enabled"))
+			})
+
+			It("should reject VM creation when guest memory exceeds the maximum configured", func() {
+				guest := resource.MustParse("8Gi")
+				vm.Spec.Template.Spec.Domain.Memory.Guest = &guest
+
+				response := admitVm(vmsAdmitter, vm)
+				Expect(response.Allowed).To(BeFalse())
+				Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.liveUpdateFeatures"))
+				Expect(response.Result.Details.Causes[0].Message).To(ContainSubstring("Guest memory is greater than the maximum guest memory allowed"))
+			})
+		})
 	})
 })
 
@@ -0,0 +1,168 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotGroup Admitter", func() {
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "database"},
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotGroupAdmissionReview(newGroup())
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a valid group", func() {
+			ar := createSnapshotGroupAdmissionReview(newGroup())
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotGroup), field string) {
+			group := newGroup()
+			mutate(group)
+
+			ar := createSnapshotGroupAdmissionReview(group)
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an empty selector", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.Selector = metav1.LabelSelector{}
+			}, "spec.selector"),
+			Entry("an invalid selector", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: "Sometimes"},
+				}
+			}, "spec.selector"),
+			Entry("a negative failureDeadline", func(g *snapshotv1.VirtualMachineSnapshotGroup) {
+				g.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+			}, "spec.failureDeadline"),
+		)
+
+		It("should reject spec update", func() {
+			oldGroup := newGroup()
+			group := newGroup()
+			group.Spec.Selector.MatchLabels["app"] = "frontend"
+
+			ar := createSnapshotGroupAdmissionReview(group)
+			ar.Request.Operation = admissionv1.Update
+			oldBytes, _ := json.Marshal(oldGroup)
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldGroup := newGroup()
+			group := newGroup()
+			group.Labels = map[string]string{"key": "value"}
+
+			ar := createSnapshotGroupAdmissionReview(group)
+			ar.Request.Operation = admissionv1.Update
+			oldBytes, _ := json.Marshal(oldGroup)
+			ar.Request.OldObject = runtime.RawExtension{Raw: oldBytes}
+
+			resp := NewVMSnapshotGroupAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createSnapshotGroupAdmissionReview(group *snapshotv1.VirtualMachineSnapshotGroup) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(group)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotgroups",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
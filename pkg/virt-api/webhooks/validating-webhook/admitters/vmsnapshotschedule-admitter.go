@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMSnapshotScheduleAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	err := json.Unmarshal(ar.Request.Object.Raw, schedule)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create, admissionv1.Update:
+		causes = validateVMSnapshotScheduleSpec(k8sfield.NewPath("spec"), &schedule.Spec)
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func validateVMSnapshotScheduleSpec(field *k8sfield.Path, spec *snapshotv1.VirtualMachineSnapshotScheduleSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if _, err := cron.ParseStandard(spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule %q: %v", spec.Schedule, err),
+			Field:   field.Child("schedule").String(),
+		})
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(&spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   field.Child("selector").String(),
+		})
+	}
+
+	if retention := spec.Retention; retention != nil {
+		if retention.MaxCount != nil && *retention.MaxCount < 1 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "maxCount must be at least 1",
+				Field:   field.Child("retention", "maxCount").String(),
+			})
+		}
+		if retention.Expires != nil && retention.Expires.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "expires must be a positive duration",
+				Field:   field.Child("retention", "expires").String(),
+			})
+		}
+	}
+
+	if spec.FailureDeadline != nil && spec.FailureDeadline.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failureDeadline must not be negative",
+			Field:   field.Child("failureDeadline").String(),
+		})
+	}
+
+	return causes
+}
@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+				Retention: &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					MaxCount: pointer.Int32(7),
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a valid schedule", func() {
+			ar := createSnapshotScheduleAdmissionReview(newSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(mutate func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := newSchedule()
+			mutate(schedule)
+
+			ar := createSnapshotScheduleAdmissionReview(schedule)
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			Entry("an invalid cron expression", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every night"
+			}, "spec.schedule"),
+			Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+					{Key: "backup", Operator: "Sometimes"},
+				}
+			}, "spec.selector"),
+			Entry("a zero maxCount", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.MaxCount = pointer.Int32(0)
+			}, "spec.retention.maxCount"),
+			Entry("a non positive expiration", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention.Expires = &metav1.Duration{Duration: -time.Hour}
+			}, "spec.retention.expires"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
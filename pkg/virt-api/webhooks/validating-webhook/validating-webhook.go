@@ -71,6 +71,18 @@ func ServeVMRestores(resp http.ResponseWriter, req *http.Request, clusterConfig
 	validating_webhooks.Serve(resp, req, admitters.NewVMRestoreAdmitter(clusterConfig, virtCli, informers.VMRestoreInformer))
 }
 
+func ServeVMSnapshotSchedules(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMSnapshotScheduleAdmitter(clusterConfig))
+}
+
+func ServeVMSnapshotGroups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMSnapshotGroupAdmitter(clusterConfig))
+}
+
+func ServeVMBackups(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
+	validating_webhooks.Serve(resp, req, admitters.NewVMBackupAdmitter(clusterConfig))
+}
+
 func ServeVMExports(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig) {
 	validating_webhooks.Serve(resp, req, admitters.NewVMExportAdmitter(clusterConfig))
 }
@@ -49,6 +49,7 @@ const (
 	Root                       = "Root"
 	ClusterProfiler            = "ClusterProfiler"
 	WorkloadEncryptionSEV      = "WorkloadEncryptionSEV"
+	WorkloadEncryptionTDX      = "WorkloadEncryptionTDX"
 	// DockerSELinuxMCSWorkaround sets the SELinux level of all the non-compute virt-launcher containers to "s0".
 	DockerSELinuxMCSWorkaround = "DockerSELinuxMCSWorkaround"
 	PSA                        = "PSA"
@@ -70,6 +71,12 @@ const (
 	Multiarchitecture = "MultiArchitecture"
 	// VMLiveUpdateFeaturesGate allows updating ceratin VM fields, such as CPU sockets to enable hot-plug functionality.
 	VMLiveUpdateFeaturesGate = "VMLiveUpdateFeatures"
+	// IncrementalBackupGate enables VirtualMachineBackups, which back up only the blocks changed since a checkpoint
+	IncrementalBackupGate = "IncrementalBackup"
+	// CrossClusterLiveMigrationGate enables live migrating VMIs between clusters
+	CrossClusterLiveMigrationGate = "CrossClusterLiveMigration"
+	// NetworkBindingPluginsGate enables interfaces bound by plugins registered in the KubeVirt CR
+	NetworkBindingPluginsGate = "NetworkBindingPlugins"
 )
 
 var deprecatedFeatureGates = [...]string{
@@ -194,6 +201,10 @@ func (config *ClusterConfig) WorkloadEncryptionSEVEnabled() bool {
 	return config.isFeatureGateEnabled(WorkloadEncryptionSEV)
 }
 
+func (config *ClusterConfig) WorkloadEncryptionTDXEnabled() bool {
+	return config.isFeatureGateEnabled(WorkloadEncryptionTDX)
+}
+
 func (config *ClusterConfig) DockerSELinuxMCSWorkaroundEnabled() bool {
 	return config.isFeatureGateEnabled(DockerSELinuxMCSWorkaround)
 }
@@ -231,3 +242,12 @@ func (config *ClusterConfig) MultiArchitectureEnabled() bool {
 func (config *ClusterConfig) VMLiveUpdateFeaturesEnabled() bool {
 	return config.isFeatureGateEnabled(VMLiveUpdateFeaturesGate)
 }
+func (config *ClusterConfig) IncrementalBackupEnabled() bool {
+	return config.isFeatureGateEnabled(IncrementalBackupGate)
+}
+func (config *ClusterConfig) CrossClusterLiveMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(CrossClusterLiveMigrationGate)
+}
+func (config *ClusterConfig) NetworkBindingPluginsEnabled() bool {
+	return config.isFeatureGateEnabled(NetworkBindingPluginsGate)
+}
@@ -55,6 +55,7 @@ const (
 	DefaultNodeSelectors                            = ""
 	DefaultNetworkInterface                         = "bridge"
 	DefaultImagePullPolicy                          = k8sv1.PullIfNotPresent
+	DefaultVMStateStorageAccessMode                 = k8sv1.ReadWriteMany
 	DefaultAllowEmulation                           = false
 	DefaultUnsafeMigrationOverride                  = false
 	DefaultPermitSlirpInterface                     = false
@@ -229,6 +230,14 @@ func (c *ClusterConfig) IsBridgeInterfaceOnPodNetworkEnabled() bool {
 	return *c.GetConfig().NetworkConfiguration.PermitBridgeInterfaceOnPodNetwork
 }
 
+func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return c.GetConfig().NetworkConfiguration.Binding
+}
+
+func (c *ClusterConfig) GetMacAddressPool() *v1.MacAddressPool {
+	return c.GetConfig().NetworkConfiguration.MacAddressPool
+}
+
 func (c *ClusterConfig) GetDefaultClusterConfig() *v1.KubeVirtConfiguration {
 	return c.defaultConfig
 }
@@ -424,6 +433,13 @@ func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
 
+func (c *ClusterConfig) GetVMStateStorageAccessMode() k8sv1.PersistentVolumeAccessMode {
+	if accessMode := c.GetConfig().VMStateStorageAccessMode; accessMode != "" {
+		return accessMode
+	}
+	return DefaultVMStateStorageAccessMode
+}
+
 func (c *ClusterConfig) IsFreePageReportingDisabled() bool {
 	return c.GetConfig().VirtualMachineOptions != nil && c.GetConfig().VirtualMachineOptions.DisableFreePageReporting != nil
 }
@@ -440,3 +456,11 @@ func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 
 	return
 }
+
+func (c *ClusterConfig) GetMaximumGuestMemory() *resource.Quantity {
+	liveConfig := c.GetConfig().LiveUpdateConfiguration
+	if liveConfig != nil {
+		return liveConfig.MaxGuest
+	}
+	return nil
+}
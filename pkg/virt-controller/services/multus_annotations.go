@@ -35,7 +35,7 @@ import (
 )
 
 type multusNetworkAnnotation struct {
-	InterfaceName string `json:"interface"`
+	InterfaceName string `json:"interface,omitempty"`
 	Mac           string `json:"mac,omitempty"`
 	NetworkName   string `json:"name"`
 	Namespace     string `json:"namespace"`
@@ -61,11 +61,11 @@ func (mnap multusNetworkAnnotationPool) toString() (string, error) {
 	return string(multusNetworksAnnotation), nil
 }
 
-func GenerateMultusCNIAnnotation(namespace string, interfaces []v1.Interface, networks []v1.Network) (string, error) {
-	return GenerateMultusCNIAnnotationFromNameScheme(namespace, interfaces, networks, namescheme.CreateHashedNetworkNameScheme(networks))
+func GenerateMultusCNIAnnotation(namespace string, interfaces []v1.Interface, networks []v1.Network, bindingPlugins map[string]v1.InterfaceBindingPlugin) (string, error) {
+	return GenerateMultusCNIAnnotationFromNameScheme(namespace, interfaces, networks, namescheme.CreateHashedNetworkNameScheme(networks), bindingPlugins)
 }
 
-func GenerateMultusCNIAnnotationFromNameScheme(namespace string, interfaces []v1.Interface, networks []v1.Network, networkNameScheme map[string]string) (string, error) {
+func GenerateMultusCNIAnnotationFromNameScheme(namespace string, interfaces []v1.Interface, networks []v1.Network, networkNameScheme map[string]string, bindingPlugins map[string]v1.InterfaceBindingPlugin) (string, error) {
 	multusNetworkAnnotationPool := multusNetworkAnnotationPool{}
 
 	for _, network := range networks {
@@ -74,6 +74,9 @@ func GenerateMultusCNIAnnotationFromNameScheme(namespace string, interfaces []v1
 			multusNetworkAnnotationPool.add(
 				newMultusAnnotationData(namespace, interfaces, network, podInterfaceName))
 		}
+		if bindingPluginAnnotation := newBindingPluginMultusAnnotationData(namespace, interfaces, network, bindingPlugins); bindingPluginAnnotation != nil {
+			multusNetworkAnnotationPool.add(*bindingPluginAnnotation)
+		}
 	}
 
 	if !multusNetworkAnnotationPool.isEmpty() {
@@ -97,6 +100,25 @@ func newMultusAnnotationData(namespace string, interfaces []v1.Interface, networ
 	}
 }
 
+// newBindingPluginMultusAnnotationData returns the network attachment definition of the
+// binding plugin used by the network's interface, so that the plugin CNI can set up the pod
+// networking. The interface name is left to Multus, as the plugin CNI may not create one.
+func newBindingPluginMultusAnnotationData(namespace string, interfaces []v1.Interface, network v1.Network, bindingPlugins map[string]v1.InterfaceBindingPlugin) *multusNetworkAnnotation {
+	iface := vmispec.LookupInterfaceByName(interfaces, network.Name)
+	if iface == nil || iface.Binding == nil {
+		return nil
+	}
+	plugin, exists := bindingPlugins[iface.Binding.Name]
+	if !exists || plugin.NetworkAttachmentDefinition == "" {
+		return nil
+	}
+	namespace, networkName := getNamespaceAndNetworkName(namespace, plugin.NetworkAttachmentDefinition)
+	return &multusNetworkAnnotation{
+		Namespace:   namespace,
+		NetworkName: networkName,
+	}
+}
+
 func NonDefaultMultusNetworksIndexedByIfaceName(pod *k8sv1.Pod) map[string]networkv1.NetworkStatus {
 	indexedNetworkStatus := map[string]networkv1.NetworkStatus{}
 	podNetworkStatus, found := pod.Annotations[networkv1.NetworkStatusAnnot]
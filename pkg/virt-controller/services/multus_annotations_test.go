@@ -84,4 +84,29 @@ var _ = Describe("Multus annotations", func() {
 			Expect(multusAnnotationPool.toString()).To(BeIdenticalTo(expectedString))
 		})
 	})
+
+	Context("with a network binding plugin", func() {
+		var interfaces []v1.Interface
+		var networks []v1.Network
+
+		BeforeEach(func() {
+			interfaces = []v1.Interface{{Name: "default", Binding: &v1.PluginBinding{Name: "myplugin"}}}
+			networks = []v1.Network{*v1.DefaultPodNetwork()}
+		})
+
+		It("adds the network attachment definition of the plugin", func() {
+			bindingPlugins := map[string]v1.InterfaceBindingPlugin{
+				"myplugin": {NetworkAttachmentDefinition: "default/myplugin-nad"},
+			}
+			Expect(GenerateMultusCNIAnnotation(vmi.Namespace, interfaces, networks, bindingPlugins)).
+				To(Equal(`[{"name":"myplugin-nad","namespace":"default"}]`))
+		})
+
+		It("does not add an annotation when the plugin has no network attachment definition", func() {
+			bindingPlugins := map[string]v1.InterfaceBindingPlugin{
+				"myplugin": {SidecarImage: "registry:5000/plugin:devel"},
+			}
+			Expect(GenerateMultusCNIAnnotation(vmi.Namespace, interfaces, networks, bindingPlugins)).To(BeEmpty())
+		})
+	})
 })
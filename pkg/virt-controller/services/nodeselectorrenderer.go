@@ -14,6 +14,7 @@ type NodeSelectorRenderer struct {
 	cpuFeatureLabels []string
 	cpuModelLabel    string
 	hasDedicatedCPU  bool
+	hasKSM           bool
 	hyperv           bool
 	podNodeSelectors map[string]string
 	tscFrequency     *int64
@@ -61,6 +62,10 @@ func (nsr *NodeSelectorRenderer) Render() map[string]string {
 		nsr.enableSelectorLabel(topology.ToTSCSchedulableLabel(*nsr.tscFrequency))
 	}
 
+	if nsr.hasKSM {
+		nsr.enableSelectorLabel(v1.KSMEnabledLabel)
+	}
+
 	return nsr.podNodeSelectors
 }
 
@@ -78,6 +83,12 @@ func WithDedicatedCPU() NodeSelectorRendererOption {
 	}
 }
 
+func WithKSM() NodeSelectorRendererOption {
+	return func(renderer *NodeSelectorRenderer) {
+		renderer.hasKSM = true
+	}
+}
+
 func copySelectors(src map[string]string, dst map[string]string) {
 	for k, v := range src {
 		dst[k] = v
@@ -46,6 +46,16 @@ var _ = Describe("Node Selector Renderer", func() {
 				})
 			})
 
+			When("the KSM option is defined", func() {
+				BeforeEach(func() {
+					nsr = NewNodeSelectorRenderer(emptySelectors(), emptySelectors(), "", WithKSM())
+				})
+
+				It("must be scheduled on nodes featuring the `kubevirt.io/ksm-enabled` label", func() {
+					Expect(nsr.Render()).To(HaveLabel("kubevirt.io/ksm-enabled"))
+				})
+			})
+
 			When("Hyper V is defined", func() {
 				BeforeEach(func() {
 					nsr = NewNodeSelectorRenderer(emptySelectors(), emptySelectors(), "", WithHyperv(hypervFeatures()))
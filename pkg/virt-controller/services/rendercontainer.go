@@ -267,7 +267,22 @@ func requiredCapabilities(vmi *v1.VirtualMachineInstance) []k8sv1.Capability {
 	if !util.IsNonRootVMI(vmi) {
 		// add a CAP_SYS_NICE capability to allow setting cpu affinity
 		capabilities = append(capabilities, CAP_SYS_NICE)
+
+		// add a CAP_NET_RAW capability to allow sending router advertisements
+		if hasRouterAdvertisements(vmi) {
+			capabilities = append(capabilities, CAP_NET_RAW)
+		}
 	}
 
 	return capabilities
 }
+
+func hasRouterAdvertisements(vmi *v1.VirtualMachineInstance) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.DHCPOptions != nil && iface.DHCPOptions.IPv6 != nil &&
+			iface.DHCPOptions.IPv6.RouterAdvertisements != nil && *iface.DHCPOptions.IPv6.RouterAdvertisements {
+			return true
+		}
+	}
+	return false
+}
@@ -112,6 +112,21 @@ var _ = Describe("Container spec renderer", func() {
 						ConsistOf(allowedCapabilities))
 				})
 			})
+
+			Context("with router advertisements", func() {
+				BeforeEach(func() {
+					specRenderer = NewContainerSpecRenderer(
+						containerName,
+						img,
+						pullPolicy,
+						WithCapabilities(vmiWithRouterAdvertisements(simplestVMI())))
+				})
+
+				It("must request to add the NET_RAW capability", func() {
+					Expect(specRenderer.Render(exampleCommand).SecurityContext.Capabilities.Add).Should(
+						ConsistOf(append(allowedCapabilities, CAP_NET_RAW)))
+				})
+			})
 		})
 
 		Context("a VMI belonging to a non root user", func() {
@@ -128,6 +143,17 @@ var _ = Describe("Container spec renderer", func() {
 				Expect(specRenderer.Render(exampleCommand).SecurityContext.Capabilities.Add).Should(
 					ConsistOf(k8sv1.Capability(CAP_NET_BIND_SERVICE)))
 			})
+
+			It("cannot request the NET_RAW capability for router advertisements", func() {
+				const nonRootUser = 207
+				specRenderer = NewContainerSpecRenderer(
+					containerName,
+					img,
+					pullPolicy,
+					WithCapabilities(vmiWithRouterAdvertisements(nonRootVMI(nonRootUser))))
+				Expect(specRenderer.Render(exampleCommand).SecurityContext.Capabilities.Add).Should(
+					ConsistOf(k8sv1.Capability(CAP_NET_BIND_SERVICE)))
+			})
 		})
 	})
 
@@ -279,6 +305,16 @@ func nonRootVMI(user uint64) *v1.VirtualMachineInstance {
 	return vmi
 }
 
+func vmiWithRouterAdvertisements(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+	routerAdvertisements := true
+	vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+		Name:                   "default",
+		InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+		DHCPOptions:            &v1.DHCPOptions{IPv6: &v1.DHCPv6Options{RouterAdvertisements: &routerAdvertisements}},
+	}}
+	return vmi
+}
+
 func simplestVMI() *v1.VirtualMachineInstance {
 	return &v1.VirtualMachineInstance{
 		Spec: v1.VirtualMachineInstanceSpec{},
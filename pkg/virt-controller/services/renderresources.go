@@ -188,6 +188,19 @@ func WithMemoryOverhead(guestResourceSpec v1.ResourceRequirements, memoryOverhea
 	}
 }
 
+// WithKSMMemoryOvercommit lowers the memory request by the memory overcommit KSM provides on the nodes.
+// It has to be applied before the memory overhead is added, which is never overcommitted.
+func WithKSMMemoryOvercommit(topologyHints *v1.TopologyHints) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		memoryRequest, ok := renderer.vmRequests[k8sv1.ResourceMemory]
+		if !ok || topologyHints == nil || topologyHints.KSMMemoryOvercommit == nil || *topologyHints.KSMMemoryOvercommit <= 100 {
+			return
+		}
+		overcommittedRequest := memoryRequest.Value() * 100 / int64(*topologyHints.KSMMemoryOvercommit)
+		renderer.vmRequests[k8sv1.ResourceMemory] = *resource.NewQuantity(overcommittedRequest, memoryRequest.Format)
+	}
+}
+
 func WithCPUPinning(cpu *v1.CPU) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		vcpus := hardware.GetNumberOfVCPUs(cpu)
@@ -361,9 +374,9 @@ func GetMemoryOverhead(vmi *v1.VirtualMachineInstance, cpuArch string, additiona
 
 	addProbeOverheads(vmi, &overhead)
 
-	// Consider memory overhead for SEV guests.
+	// Consider memory overhead for SEV, SEV-SNP and TDX guests.
 	// Additional information can be found here: https://libvirt.org/kbase/launch_security_sev.html#memory
-	if util.IsSEVVMI(vmi) {
+	if util.IsConfidentialVMI(vmi) {
 		overhead.Add(resource.MustParse("256Mi"))
 	}
 
@@ -371,6 +384,10 @@ func GetMemoryOverhead(vmi *v1.VirtualMachineInstance, cpuArch string, additiona
 	// In `ps`, swtpm has VSZ of 53808 and RSS of 3496, so 53Mi should do
 	if vmi.Spec.Domain.Devices.TPM != nil {
 		overhead.Add(resource.MustParse("53Mi"))
+		// Encrypting the TPM state additionally spawns a virtsecretd process, with an RSS of around 15Mi
+		if vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef != nil {
+			overhead.Add(resource.MustParse("20Mi"))
+		}
 	}
 
 	// Additional overhead for each interface with Passt binding, that forwards all ports.
@@ -119,6 +119,36 @@ var _ = Describe("Resource pod spec renderer", func() {
 		})
 	})
 
+	Context("WithKSMMemoryOvercommit option", func() {
+		baseMemory := resource.MustParse("1000Mi")
+		var userSpecifiedMemory kubev1.ResourceList
+
+		BeforeEach(func() {
+			userSpecifiedMemory = kubev1.ResourceList{kubev1.ResourceMemory: baseMemory}
+		})
+
+		It("lowers the memory request by the ksm memory overcommit", func() {
+			overcommit := int32(125)
+			rr = NewResourceRenderer(
+				userSpecifiedMemory,
+				userSpecifiedMemory,
+				WithKSMMemoryOvercommit(&v1.TopologyHints{KSMMemoryOvercommit: &overcommit}),
+			)
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceMemory, resource.MustParse("800Mi")))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceMemory, baseMemory))
+		})
+
+		It("keeps the memory request if the nodes are not overcommitted", func() {
+			overcommit := int32(100)
+			rr = NewResourceRenderer(
+				userSpecifiedMemory,
+				userSpecifiedMemory,
+				WithKSMMemoryOvercommit(&v1.TopologyHints{KSMMemoryOvercommit: &overcommit}),
+			)
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceMemory, baseMemory))
+		})
+	})
+
 	Context("WithCPUPinning option", func() {
 		userCPURequest := resource.MustParse("200m")
 		userSpecifiedCPU := kubev1.ResourceList{kubev1.ResourceCPU: userCPURequest}
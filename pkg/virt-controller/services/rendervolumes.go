@@ -332,11 +332,11 @@ func withAccessCredentials(accessCredentials []v1.AccessCredential) VolumeRender
 	}
 }
 
-func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+func withBackendStorage(vmi *v1.VirtualMachineInstance, pvcStore cache.Store) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
-		if backendstorage.HasPersistentTPMDevice(&vmi.Spec) {
-			volumeName := vmi.Name + "-tpm"
-			pvcName := backendstorage.PVCForVMI(vmi)
+		if backendstorage.IsBackendStorageNeededForVMI(vmi) {
+			volumeName := backendstorage.PodVolumeName(vmi)
+			pvcName := backendstorage.CurrentPVCName(vmi, pvcStore)
 			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
 				Name: volumeName,
 				VolumeSource: k8sv1.VolumeSource{
@@ -347,11 +347,9 @@ func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
 				},
 			})
 
-			swtpmPath := "/var/lib/libvirt/swtpm"
-			localCaPath := "/var/lib/swtpm-localca"
 			if util.IsNonRootVMI(vmi) {
-				// For non-root VMIs, the TPM state lives under /var/run/kubevirt-private/libvirt/qemu/swtpm
-				// To persist it, we need the persistent PVC to be mounted under that location.
+				// For non-root VMIs, the TPM state and the NVRAM live under /var/run/kubevirt-private/libvirt/qemu
+				// To persist them, we need the persistent PVC to be mounted under that location.
 				// /var/run/kubevirt-private is an emptyDir, and k8s would automatically create the right sub-directories under it.
 				// However, the sub-directories would get created as root:<fsGroup>, with a mode like 0755 (drwxr-xr-x), preventing write access to them.
 				// Depending on the storage class used, the SELinux label of the sub-directories can also be problematic (like nfs_t for nfs-csi).
@@ -368,25 +366,82 @@ func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
 					Name:      "private-libvirt-qemu",
 					MountPath: filepath.Join(util.VirtPrivateDir, "libvirt", "qemu"),
 				})
-				swtpmPath = filepath.Join(util.VirtPrivateDir, "libvirt", "qemu", "swtpm")
-				localCaPath = filepath.Join(util.VirtPrivateDir, "var", "lib", "swtpm-localca")
 			}
+
+			if backendstorage.HasPersistentTPMDevice(&vmi.Spec) {
+				swtpmPath := "/var/lib/libvirt/swtpm"
+				localCaPath := "/var/lib/swtpm-localca"
+				if util.IsNonRootVMI(vmi) {
+					swtpmPath = filepath.Join(util.VirtPrivateDir, "libvirt", "qemu", "swtpm")
+					localCaPath = filepath.Join(util.VirtPrivateDir, "var", "lib", "swtpm-localca")
+				}
+				renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+					Name:      volumeName,
+					ReadOnly:  false,
+					MountPath: swtpmPath,
+					SubPath:   "swtpm",
+				}, k8sv1.VolumeMount{
+					Name:      volumeName,
+					ReadOnly:  false,
+					MountPath: localCaPath,
+					SubPath:   "swtpm-localca",
+				})
+			}
+
+			if backendstorage.HasPersistentEFI(&vmi.Spec) {
+				renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+					Name:      volumeName,
+					ReadOnly:  false,
+					MountPath: backendstorage.NVRAMDir(vmi),
+					SubPath:   "nvram",
+				})
+			}
+		}
+		if backendstorage.HasEncryptedTPMState(&vmi.Spec) {
+			const volumeName = "vtpm-encryption"
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{
+						SecretName: vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef.Name,
+					},
+				},
+			})
 			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
 				Name:      volumeName,
-				ReadOnly:  false,
-				MountPath: swtpmPath,
-				SubPath:   "swtpm",
-			}, k8sv1.VolumeMount{
-				Name:      volumeName,
-				ReadOnly:  false,
-				MountPath: localCaPath,
-				SubPath:   "swtpm-localca",
+				MountPath: config.TPMEncryptionKeyDir,
+				ReadOnly:  true,
 			})
 		}
 		return nil
 	}
 }
 
+func withSecureBootKeys(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		firmware := vmi.Spec.Domain.Firmware
+		if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil ||
+			firmware.Bootloader.EFI.SecureBootKeysSecretRef == nil {
+			return nil
+		}
+		const volumeName = "secure-boot-keys"
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: volumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				Secret: &k8sv1.SecretVolumeSource{
+					SecretName: firmware.Bootloader.EFI.SecureBootKeysSecretRef.Name,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      volumeName,
+			MountPath: config.SecureBootKeysDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
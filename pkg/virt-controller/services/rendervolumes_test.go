@@ -334,6 +334,56 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+
+	Context("with a persistent EFI NVRAM", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			persistent := true
+			vmi = &v1.VirtualMachineInstance{}
+			vmi.Name = "testvmi"
+			vmi.Namespace = namespace
+			vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &v1.Bootloader{EFI: &v1.EFI{
+				Persistent:              &persistent,
+				SecureBootKeysSecretRef: &k8sv1.LocalObjectReference{Name: "keys"},
+			}}}
+		})
+
+		renderVolumes := func() {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withBackendStorage(vmi, cache.NewStore(cache.MetaNamespaceKeyFunc)), withSecureBootKeys(vmi))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("should mount the NVRAM directory of the backend storage and the Secure Boot keys", func() {
+			renderVolumes()
+			Expect(vsr.Volumes()).To(ContainElements(
+				k8sv1.Volume{
+					Name: "testvmi-tpm",
+					VolumeSource: k8sv1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "persistent-state-for-testvmi"},
+					},
+				},
+				k8sv1.Volume{
+					Name: "secure-boot-keys",
+					VolumeSource: k8sv1.VolumeSource{
+						Secret: &k8sv1.SecretVolumeSource{SecretName: "keys"},
+					},
+				}))
+			Expect(vsr.Mounts()).To(ContainElements(
+				k8sv1.VolumeMount{Name: "testvmi-tpm", MountPath: "/var/lib/libvirt/qemu/nvram", SubPath: "nvram"},
+				k8sv1.VolumeMount{Name: "secure-boot-keys", MountPath: "/var/run/kubevirt-private/secure-boot-keys", ReadOnly: true}))
+			Expect(vsr.Mounts()).ToNot(ContainElement(HaveField("SubPath", "swtpm")))
+		})
+
+		It("should mount the NVRAM directory under the private directory for a non-root VMI", func() {
+			vmi.Status.RuntimeUser = 107
+			renderVolumes()
+			Expect(vsr.Mounts()).To(ContainElement(
+				k8sv1.VolumeMount{Name: "testvmi-tpm", MountPath: "/var/run/kubevirt-private/libvirt/qemu/nvram", SubPath: "nvram"}))
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
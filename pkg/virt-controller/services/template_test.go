@@ -226,6 +226,35 @@ var _ = Describe("Template", func() {
 
 		})
 
+		It("should add a hook sidecar and the network attachment of a network binding plugin", func() {
+			_, kvInformer, svc = configFactory(defaultArch)
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.NetworkBindingPluginsGate}
+			kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{
+					"myplugin": {
+						SidecarImage:                "registry:5000/plugin:devel",
+						NetworkAttachmentDefinition: "default/myplugin-nad",
+					},
+				},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+			vmi := newMinimalWithContainerDisk("random")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Binding: &v1.PluginBinding{Name: "myplugin"}}}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Command).To(ContainElements("--hook-sidecars", "1"))
+			Expect(pod.Spec.Containers).To(ContainElement(And(
+				HaveField("Name", "hook-sidecar-0"),
+				HaveField("Image", "registry:5000/plugin:devel"),
+			)))
+			Expect(pod.Annotations).To(HaveKeyWithValue(MultusNetworksAnnotation, `[{"name":"myplugin-nad","namespace":"default"}]`))
+		})
+
 		Context("with NonRoot feature-gate", func() {
 			var vmi *v1.VirtualMachineInstance
 			BeforeEach(func() {
@@ -4221,9 +4250,9 @@ var _ = Describe("Template", func() {
 
 	})
 
-	Context("AMD SEV LaunchSecurity", func() {
-		It("should not run privileged with SEV device resource", func() {
-			vmi := v1.VirtualMachineInstance{
+	Context("LaunchSecurity", func() {
+		newConfidentialVMI := func(launchSecurity *v1.LaunchSecurity) *v1.VirtualMachineInstance {
+			return &v1.VirtualMachineInstance{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "testvmi",
 					Namespace: "namespace",
@@ -4231,13 +4260,14 @@ var _ = Describe("Template", func() {
 				},
 				Spec: v1.VirtualMachineInstanceSpec{
 					Domain: v1.DomainSpec{
-						LaunchSecurity: &v1.LaunchSecurity{
-							SEV: &v1.SEV{},
-						},
+						LaunchSecurity: launchSecurity,
 					},
 				},
 			}
-			pod, err := svc.RenderLaunchManifest(&vmi)
+		}
+
+		DescribeTable("should not run privileged with SEV device resource", func(launchSecurity *v1.LaunchSecurity) {
+			pod, err := svc.RenderLaunchManifest(newConfidentialVMI(launchSecurity))
 			Expect(err).ToNot(HaveOccurred())
 
 			Expect(pod.Spec.Containers).To(HaveLen(1))
@@ -4246,6 +4276,17 @@ var _ = Describe("Template", func() {
 			sev, ok := pod.Spec.Containers[0].Resources.Limits[SevDevice]
 			Expect(ok).To(BeTrue())
 			Expect(int(sev.Value())).To(Equal(1))
+		},
+			Entry("with AMD SEV", &v1.LaunchSecurity{SEV: &v1.SEV{}}),
+			Entry("with AMD SEV-SNP", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}),
+		)
+
+		It("should not request the SEV device with Intel TDX", func() {
+			pod, err := svc.RenderLaunchManifest(newConfidentialVMI(&v1.LaunchSecurity{TDX: &v1.TDX{}}))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(kubev1.ResourceName(SevDevice)))
 		})
 	})
 
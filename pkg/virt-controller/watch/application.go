@@ -153,7 +153,8 @@ type VirtControllerApp struct {
 	vmiInformer   cache.SharedIndexInformer
 	vmiRecorder   record.EventRecorder
 
-	namespaceStore cache.Store
+	namespaceStore    cache.Store
+	namespaceInformer cache.SharedIndexInformer
 
 	kubeVirtInformer cache.SharedIndexInformer
 
@@ -182,14 +183,20 @@ type VirtControllerApp struct {
 	migrationController *MigrationController
 	migrationInformer   cache.SharedIndexInformer
 
+	migrationPolicyController *MigrationPolicyController
+
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
 	caExportConfigMapInformer    cache.SharedIndexInformer
+	caConfigMapInformer          cache.SharedIndexInformer
 	exportRouteConfigMapInformer cache.SharedInformer
 	exportServiceInformer        cache.SharedIndexInformer
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	snapshotGroupController      *snapshot.VMSnapshotGroupController
+	backupController             *snapshot.VMBackupController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -197,6 +204,9 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmSnapshotGroupInformer      cache.SharedIndexInformer
+	vmBackupInformer             cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -250,6 +260,9 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	snapshotScheduleControllerThreads int
+	snapshotGroupControllerThreads    int
+	backupControllerThreads           int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
 
@@ -358,7 +371,8 @@ func Execute() {
 	app.vmiInformer = app.informerFactory.VMI()
 	app.kvPodInformer = app.informerFactory.KubeVirtPod()
 	app.nodeInformer = app.informerFactory.KubeVirtNode()
-	app.namespaceStore = app.informerFactory.Namespace().GetStore()
+	app.namespaceInformer = app.informerFactory.Namespace()
+	app.namespaceStore = app.namespaceInformer.GetStore()
 	app.vmiCache = app.vmiInformer.GetStore()
 	app.vmiRecorder = app.newRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
 
@@ -380,8 +394,12 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
+	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
+	app.caConfigMapInformer = app.informerFactory.KubeVirtCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
 	app.unmanagedSecretInformer = app.informerFactory.UnmanagedSecrets()
 	app.allPodInformer = app.informerFactory.Pod()
@@ -434,6 +452,9 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initSnapshotGroupController()
+	app.initBackupController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -541,6 +562,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.migrationPolicyController.Run(stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -551,6 +573,21 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotGroupController.Run(vca.snapshotGroupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot group controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.backupController.Run(vca.backupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -644,6 +681,16 @@ func (vca *VirtControllerApp) initCommon() {
 		panic(err)
 	}
 
+	vca.migrationPolicyController, err = NewMigrationPolicyController(
+		vca.clientSet,
+		vca.migrationPolicyInformer,
+		vca.vmiInformer,
+		vca.namespaceInformer,
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
 }
 
@@ -793,6 +840,48 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotGroupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-group-controller")
+	vca.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+		Client:                  vca.clientSet,
+		VMSnapshotGroupInformer: vca.vmSnapshotGroupInformer,
+		VMSnapshotInformer:      vca.vmSnapshotInformer,
+		VMInformer:              vca.vmInformer,
+		VMIInformer:             vca.vmiInformer,
+		Recorder:                recorder,
+	}
+	if err := vca.snapshotGroupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initBackupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
+	vca.backupController = &snapshot.VMBackupController{
+		Client:           vca.clientSet,
+		VMBackupInformer: vca.vmBackupInformer,
+		VMIInformer:      vca.vmiInformer,
+		Recorder:         recorder,
+	}
+	if err := vca.backupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -805,6 +894,7 @@ func (vca *VirtControllerApp) initExportController() {
 		ServiceInformer:             vca.exportServiceInformer,
 		Recorder:                    recorder,
 		ConfigMapInformer:           vca.caExportConfigMapInformer,
+		KubeVirtCAConfigMapInformer: vca.caConfigMapInformer,
 		IngressCache:                vca.ingressCache,
 		RouteCache:                  vca.routeCache,
 		KubevirtNamespace:           vca.kubevirtNamespace,
@@ -813,6 +903,7 @@ func (vca *VirtControllerApp) initExportController() {
 		VolumeSnapshotProvider:      vca.snapshotController,
 		VMSnapshotInformer:          vca.vmSnapshotInformer,
 		VMSnapshotContentInformer:   vca.vmSnapshotContentInformer,
+		VMBackupInformer:            vca.vmBackupInformer,
 		VMInformer:                  vca.vmInformer,
 		VMIInformer:                 vca.vmiInformer,
 		CRDInformer:                 vca.crdInformer,
@@ -930,6 +1021,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.snapshotGroupControllerThreads, "snapshot-group-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot group controller")
+
+	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for backup controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
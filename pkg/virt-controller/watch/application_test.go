@@ -91,6 +91,7 @@ var _ = Describe("Application", func() {
 
 		pdbInformer, _ := testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&kubev1.Namespace{})
 		podInformer, _ := testutils.NewFakeInformerFor(&kubev1.Pod{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&kubev1.ResourceQuota{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&kubev1.PersistentVolumeClaim{})
@@ -102,6 +103,9 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmBackupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineBackup{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&kubev1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&kubev1.ConfigMap{})
@@ -160,6 +164,7 @@ var _ = Describe("Application", func() {
 			virtClient,
 			config,
 		)
+		app.migrationPolicyController, _ = NewMigrationPolicyController(virtClient, migrationPolicyInformer, vmiInformer, namespaceInformer)
 		app.snapshotController = &snapshot.VMSnapshotController{
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
@@ -188,6 +193,30 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+			Client:                  virtClient,
+			VMSnapshotGroupInformer: vmSnapshotGroupInformer,
+			VMSnapshotInformer:      vmSnapshotInformer,
+			VMInformer:              vmInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+		}
+		_ = app.snapshotGroupController.Init()
+		app.backupController = &snapshot.VMBackupController{
+			Client:           virtClient,
+			VMBackupInformer: vmBackupInformer,
+			VMIInformer:      vmiInformer,
+			Recorder:         recorder,
+		}
+		_ = app.backupController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			TemplateService:             services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h"),
@@ -197,11 +226,13 @@ var _ = Describe("Application", func() {
 			DataVolumeInformer:          dataVolumeInformer,
 			ServiceInformer:             exportServiceInformer,
 			ConfigMapInformer:           configMapInformer,
+			KubeVirtCAConfigMapInformer: configMapInformer,
 			RouteConfigMapInformer:      routeConfigMapInformer,
 			Recorder:                    recorder,
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
 			CRDInformer:                 crdInformer,
@@ -56,8 +56,8 @@ func generateMacAddressPatches(interfaces []k6tv1.Interface, newMacAddresses map
 
 	for idx, iface := range interfaces {
 		// If a new mac address is not specified for the current interface an empty mac address would be assigned.
-		// This is OK for clusters that have Kube Mac Pool enabled. For clusters that don't have KMP it is the users'
-		// responsibility to assign new mac address to every network interface.
+		// This is OK for clusters that have the KubeVirt MAC address pool or Kube Mac Pool enabled. For other clusters
+		// it is the users' responsibility to assign new mac address to every network interface.
 		newMac := newMacAddresses[iface.Name]
 		patches = append(patches, fmt.Sprintf(macAddressPatchPattern, idx, newMac))
 	}
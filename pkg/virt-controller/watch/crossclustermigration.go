@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// Cross cluster migrations have no target pod in this cluster. The sending side hands
+// the VMI off to its virt-handler right away, which tunnels the migration to the
+// endpoint of the receiving cluster. The receiving side uses the pod of a pre-populated
+// VMI, which virt-handler prepares as migration target instead of booting it.
+
+func isCrossClusterMigration(migration *virtv1.VirtualMachineInstanceMigration) bool {
+	return migration.IsCrossClusterSource() || migration.IsCrossClusterTarget()
+}
+
+func (c *MigrationController) updateCrossClusterStatus(migration *virtv1.VirtualMachineInstanceMigration, migrationCopy *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	state := vmi.Status.MigrationState
+	isHandedOff := state != nil && state.MigrationUID == migration.UID
+
+	switch {
+	case isHandedOff && state.Failed:
+		migrationCopy.Status.Phase = virtv1.MigrationFailed
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "virt-handler reported the cross cluster migration failed")
+		log.Log.Object(migration).Errorf("VMI %s/%s reported cross cluster migration failed", vmi.Namespace, vmi.Name)
+	case isHandedOff && state.Completed:
+		// The sending VMI is finalized once its domain left the cluster, so this must be checked first
+		migrationCopy.Status.Phase = virtv1.MigrationSucceeded
+		c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulMigrationReason, "virt-handler reported the cross cluster migration succeeded")
+		log.Log.Object(migration).Infof("VMI reported cross cluster migration succeeded.")
+	case vmi.IsFinal():
+		migrationCopy.Status.Phase = virtv1.MigrationFailed
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "Migration failed vmi shutdown during migration.")
+		log.Log.Object(migration).Error("Unable to migrate vmi because vmi is shutdown.")
+	case migration.DeletionTimestamp != nil && !c.isMigrationHandedOff(migration, vmi):
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "Migration failed due to being canceled")
+		if !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested) {
+			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+				Type:          virtv1.VirtualMachineInstanceMigrationAbortRequested,
+				Status:        k8sv1.ConditionTrue,
+				LastProbeTime: v1.Now(),
+			})
+		}
+		migrationCopy.Status.Phase = virtv1.MigrationFailed
+	case migration.TargetIsHandedOff() && !isHandedOff:
+		migrationCopy.Status.Phase = virtv1.MigrationFailed
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "VMI's migration state was cleared or taken over by another migration job during active migration.")
+		log.Log.Object(migration).Error("vmi migration state cleared or taken over during migration")
+	case migration.DeletionTimestamp != nil:
+		if !conditionManager.HasCondition(migration, virtv1.VirtualMachineInstanceMigrationAbortRequested) {
+			migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+				Type:          virtv1.VirtualMachineInstanceMigrationAbortRequested,
+				Status:        k8sv1.ConditionTrue,
+				LastProbeTime: v1.Now(),
+			})
+		}
+	default:
+		switch migration.Status.Phase {
+		case virtv1.MigrationPhaseUnset:
+			canMigrate, err := c.canMigrateVMI(migration, vmi)
+			if err != nil {
+				return err
+			}
+			if canMigrate {
+				migrationCopy.Status.Phase = virtv1.MigrationPending
+			} else {
+				migrationCopy.Status.Phase = virtv1.MigrationFailed
+				c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "VMI is not eligible for migration because another migration job is in progress.")
+				log.Log.Object(migration).Error("Migration object not eligible for migration because another job is in progress")
+			}
+		case virtv1.MigrationPending:
+			if migration.IsCrossClusterSource() && isHandedOff {
+				migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+			} else if migration.IsCrossClusterTarget() && (vmi.IsScheduling() || vmi.IsScheduled()) {
+				migrationCopy.Status.Phase = virtv1.MigrationScheduling
+			}
+		case virtv1.MigrationScheduling:
+			if vmi.IsScheduled() {
+				migrationCopy.Status.Phase = virtv1.MigrationScheduled
+			}
+		case virtv1.MigrationScheduled:
+			if isHandedOff && state.TargetNode != "" {
+				migrationCopy.Status.Phase = virtv1.MigrationPreparingTarget
+			}
+		case virtv1.MigrationPreparingTarget:
+			if state.CrossCluster != nil && state.CrossCluster.ConnectURL != "" {
+				migrationCopy.Status.Phase = virtv1.MigrationTargetReady
+			}
+		case virtv1.MigrationTargetReady:
+			if state.StartTimestamp != nil {
+				migrationCopy.Status.Phase = virtv1.MigrationRunning
+			}
+		}
+	}
+
+	// expose the connect URL of the receiving side and the progress of the sending side
+	if isHandedOff {
+		migrationCopy.Status.MigrationState = state.DeepCopy()
+	}
+	return nil
+}
+
+func (c *MigrationController) syncCrossCluster(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	switch migration.Status.Phase {
+	case virtv1.MigrationPending:
+		if migration.DeletionTimestamp == nil && migration.IsCrossClusterSource() && vmi.IsRunning() {
+			return c.handleCrossClusterSourceHandoff(migration, vmi)
+		}
+	case virtv1.MigrationScheduled:
+		if migration.DeletionTimestamp == nil && migration.IsCrossClusterTarget() && vmi.IsScheduled() {
+			return c.handleCrossClusterTargetHandoff(migration, vmi)
+		}
+	case virtv1.MigrationTargetReady, virtv1.MigrationRunning:
+		if migration.DeletionTimestamp != nil && migration.IsCrossClusterSource() && vmi.Status.MigrationState != nil {
+			return c.markMigrationAbortInVmiStatus(migration, vmi)
+		}
+	}
+	return nil
+}
+
+func (c *MigrationController) handleCrossClusterSourceHandoff(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+		// already handed off
+		return nil
+	}
+
+	volumeMigration := migration.Spec.SendTo.VolumeMigration
+	if volumeMigration == "" {
+		volumeMigration = virtv1.CrossClusterVolumeMigrationPreSynced
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		MigrationUID: migration.UID,
+		SourceNode:   vmi.Status.NodeName,
+		CrossCluster: &virtv1.CrossClusterMigrationState{
+			MigrationID:     migration.Spec.SendTo.MigrationID,
+			ConnectURL:      migration.Spec.SendTo.ConnectURL,
+			VolumeMigration: volumeMigration,
+		},
+	}
+
+	if err := c.setMigrationConfiguration(vmiCopy); err != nil {
+		return err
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	c.addHandOffKey(controller.MigrationKey(migration))
+	log.Log.Object(vmi).Infof("Handed off cross cluster migration %s/%s to source virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulHandOverPodReason, "Migration to %s is ready to be started by virt-handler.", migration.Spec.SendTo.ConnectURL)
+	return nil
+}
+
+func (c *MigrationController) handleCrossClusterTargetHandoff(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+		// already handed off
+		return nil
+	}
+
+	pod, err := controller.CurrentVMIPod(vmi, c.podInformer)
+	if err != nil {
+		return err
+	}
+	if !podExists(pod) {
+		return nil
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+		MigrationUID: migration.UID,
+		TargetNode:   vmi.Status.NodeName,
+		TargetPod:    pod.Name,
+		CrossCluster: &virtv1.CrossClusterMigrationState{
+			MigrationID: migration.Spec.Receive.MigrationID,
+		},
+	}
+
+	if err := c.patchVMI(vmi, vmiCopy); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
+		return err
+	}
+
+	c.addHandOffKey(controller.MigrationKey(migration))
+	log.Log.Object(vmi).Infof("Handed off cross cluster migration %s/%s to target virt-handler.", migration.Namespace, migration.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulHandOverPodReason, "Migration target pod is ready for preparation by virt-handler.")
+	return nil
+}
@@ -63,6 +63,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
@@ -367,6 +368,14 @@ func (c *MigrationController) execute(key string) error {
 		if err != nil {
 			return err
 		}
+
+		// Only the migration state of the VMI tells which backend storage PVC is not used anymore
+		if vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID {
+			err = backendstorage.CleanupAfterMigration(vmi, c.clientset, c.pvcInformer.GetStore())
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -446,6 +455,10 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "Migration failed because vmi does not exist.")
 		log.Log.Object(migration).Error("vmi does not exist")
+	} else if isCrossClusterMigration(migration) {
+		if err := c.updateCrossClusterStatus(migration, migrationCopy, vmi); err != nil {
+			return err
+		}
 	} else if vmi.IsFinal() {
 		migrationCopy.Status.Phase = virtv1.MigrationFailed
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedMigrationReason, "Migration failed vmi shutdown during migration.")
@@ -578,7 +591,8 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 			}
 
 			if vmi.Status.MigrationState.Completed &&
-				!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) {
+				!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) &&
+				!vmiConditionManager.HasCondition(vmi, virtv1.VirtualMachineInstanceMemoryChange) {
 				migrationCopy.Status.Phase = virtv1.MigrationSucceeded
 				c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulMigrationReason, "Source node reported migration succeeded")
 				log.Log.Object(migration).Infof("VMI reported migration succeeded.")
@@ -641,6 +655,14 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 		return fmt.Errorf("failed to render launch manifest: %v", err)
 	}
 
+	targetPVCName, err := backendstorage.CreateMigrationTargetIfNeeded(vmi, c.clientset, c.pvcInformer.GetStore())
+	if err != nil {
+		return fmt.Errorf("failed to prepare the backend storage of the target pod: %v", err)
+	}
+	if targetPVCName != "" {
+		backendstorage.SetPodPVC(vmi, templatePod, targetPVCName)
+	}
+
 	antiAffinityTerm := k8sv1.PodAffinityTerm{
 		LabelSelector: &v1.LabelSelector{
 			MatchLabels: map[string]string{
@@ -868,6 +890,11 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 	// the vmi and prepare the local environment for the migration
 	vmiCopy.ObjectMeta.Labels[virtv1.MigrationTargetNodeNameLabel] = pod.Spec.NodeName
 
+	if backendstorage.IsBackendStorageNeededForVMI(vmi) {
+		vmiCopy.Status.MigrationState.SourcePersistentStatePVCName = backendstorage.CurrentPVCName(vmi, c.pvcInformer.GetStore())
+		vmiCopy.Status.MigrationState.TargetPersistentStatePVCName = backendstorage.PVCNameFromPod(vmi, pod)
+	}
+
 	if controller.VMIHasHotplugVolumes(vmiCopy) {
 		attachmentPods, err := controller.AttachmentPods(pod, c.podInformer)
 		if err != nil {
@@ -881,14 +908,8 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 		}
 	}
 
-	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
-	err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs)
-	if err != nil {
-		return fmt.Errorf("failed to match migration policy: %v", err)
-	}
-
-	if !c.isMigrationPolicyMatched(vmiCopy) {
-		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	if err := c.setMigrationConfiguration(vmiCopy); err != nil {
+		return err
 	}
 
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
@@ -899,7 +920,7 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 		vmiCopy.ObjectMeta.Labels[virtv1.VirtualMachinePodCPULimitsLabel] = strconv.Itoa(int(cpuLimitsCount))
 	}
 
-	err = c.patchVMI(vmi, vmiCopy)
+	err := c.patchVMI(vmi, vmiCopy)
 	if err != nil {
 		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedHandOverPodReason, fmt.Sprintf("Failed to set MigrationStat in VMI status. :%v", err))
 		return err
@@ -911,6 +932,21 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 	return nil
 }
 
+// setMigrationConfiguration stores the migration configuration of the matching
+// migration policy, or the cluster wide one, in the migration state of the VMI
+func (c *MigrationController) setMigrationConfiguration(vmi *virtv1.VirtualMachineInstance) error {
+	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	err := c.matchMigrationPolicy(vmi, clusterMigrationConfigs)
+	if err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+
+	if !c.isMigrationPolicyMatched(vmi) {
+		vmi.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
+	}
+	return nil
+}
+
 func (c *MigrationController) markMigrationAbortInVmiStatus(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 
 	if vmi.Status.MigrationState == nil {
@@ -1227,6 +1263,10 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 		return fmt.Errorf("vmi is inelgible for migration because another migration job is running")
 	}
 
+	if isCrossClusterMigration(migration) {
+		return c.syncCrossCluster(migration, vmi)
+	}
+
 	switch migration.Status.Phase {
 	case virtv1.MigrationPending:
 		if migration.DeletionTimestamp != nil {
@@ -1664,6 +1664,26 @@ var _ = Describe("Migration watcher", func() {
 					policyInfo{"zz", 2, 2}, policyInfo{"aa", 2, 2}),
 			)
 
+			DescribeTable("should prefer the policy with the highest priority", func(expectedMatchedPolicyName string, lessDetailedPriority, moreDetailedPriority *int32) {
+				lessDetailed := tests.PreparePolicyAndVMIWithNsAndVmiLabels(vmi, &namespace, 1, 0)
+				lessDetailed.Name = "less-detailed"
+				lessDetailed.Spec.Priority = lessDetailedPriority
+				moreDetailed := tests.PreparePolicyAndVMIWithNsAndVmiLabels(vmi, &namespace, 3, 2)
+				moreDetailed.Name = "more-detailed"
+				moreDetailed.Spec.Priority = moreDetailedPriority
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(*lessDetailed, *moreDetailed)
+				matchedPolicy := MatchPolicy(policyList, vmi, &namespace)
+
+				Expect(matchedPolicy).ToNot(BeNil())
+				Expect(matchedPolicy.Name).To(Equal(expectedMatchedPolicyName))
+			},
+				Entry("over a more detailed policy", "less-detailed", pointer.Int32(10), nil),
+				Entry("over a more detailed policy with a lower priority", "less-detailed", pointer.Int32(2), pointer.Int32(1)),
+				Entry("with a negative priority losing to the default one", "less-detailed", nil, pointer.Int32(-1)),
+				Entry("and fall back to details on equal priorities", "more-detailed", pointer.Int32(5), pointer.Int32(5)),
+			)
+
 			It("policy with one non-fitting label should not match", func() {
 				const labelKeyFmt = "%s-key-0"
 
@@ -1761,6 +1781,37 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set parallel migration connections",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelMigrationConnections = pointer.Uint32(4) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelMigrationConnections).To(HaveValue(Equal(uint32(4))))
+				},
+				true,
+			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					compression := virtv1.MigrationCompressionZstd
+					p.Compression = &compression
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Compression).To(HaveValue(Equal(virtv1.MigrationCompressionZstd)))
+				},
+				true,
+			),
+			Entry("set progress timeout",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ProgressTimeout = &stubNumber },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ProgressTimeout).To(HaveValue(Equal(stubNumber)))
+				},
+				true,
+			),
+			Entry("allow workload disruption",
+				func(p *migrationsv1.MigrationPolicySpec) { p.AllowWorkloadDisruption = pointer.Bool(true) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.AllowWorkloadDisruption).To(HaveValue(BeTrue()))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -1849,6 +1900,145 @@ var _ = Describe("Migration watcher", func() {
 		})
 	})
 
+	Context("Cross cluster migration", func() {
+
+		newCrossClusterState := func(migration *virtv1.VirtualMachineInstanceMigration) *virtv1.VirtualMachineInstanceMigrationState {
+			return &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID: migration.UID,
+				CrossCluster: &virtv1.CrossClusterMigrationState{
+					MigrationID: "migration-id",
+				},
+			}
+		}
+
+		Context("on the sending side", func() {
+			var vmi *virtv1.VirtualMachineInstance
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Running)
+				vmi.Status.NodeName = "node02"
+				migration = newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Spec.SendTo = &virtv1.VirtualMachineInstanceMigrationSendTo{
+					MigrationID: "migration-id",
+					ConnectURL:  "192.168.1.1:8080",
+				}
+			})
+
+			It("should hand the VMI over to the source virt-handler without creating a target pod", func() {
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				patch := fmt.Sprintf(`[{ "op": "add", "path": "/status/migrationState", "value": {"sourceNode":"node02","migrationUid":"testmigration",%s,"crossCluster":{"migrationID":"migration-id","connectURL":"192.168.1.1:8080","volumeMigration":"PreSynced"}} }]`, getMigrationConfigPatch())
+				shouldExpectVirtualMachineInstancePatch(vmi, patch)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+			})
+
+			It("should move to TargetReady once the VMI was handed off", func() {
+				vmi.Status.MigrationState = newCrossClusterState(migration)
+				vmi.Status.MigrationState.SourceNode = "node02"
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				shouldExpectMigrationTargetReadyState(migration)
+
+				controller.Execute()
+			})
+
+			It("should succeed once the VMI left the cluster", func() {
+				migration.Status.Phase = virtv1.MigrationRunning
+				vmi.Status.Phase = virtv1.Succeeded
+				vmi.Status.MigrationState = newCrossClusterState(migration)
+				vmi.Status.MigrationState.SourceNode = "node02"
+				vmi.Status.MigrationState.Completed = true
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				shouldExpectMigrationCompletedState(migration)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulMigrationReason)
+			})
+
+			It("should fail if the VMI reported a failed migration", func() {
+				migration.Status.Phase = virtv1.MigrationRunning
+				vmi.Status.MigrationState = newCrossClusterState(migration)
+				vmi.Status.MigrationState.SourceNode = "node02"
+				vmi.Status.MigrationState.Completed = true
+				vmi.Status.MigrationState.Failed = true
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				shouldExpectMigrationFailedState(migration)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, FailedMigrationReason)
+			})
+		})
+
+		Context("on the receiving side", func() {
+			var vmi *virtv1.VirtualMachineInstance
+			var migration *virtv1.VirtualMachineInstanceMigration
+
+			BeforeEach(func() {
+				vmi = newVirtualMachine("testvmi", virtv1.Scheduled)
+				vmi.Status.NodeName = "node01"
+				vmi.Annotations[virtv1.CrossClusterMigrationTargetAnnotation] = "migration-id"
+				migration = newMigration("testmigration", vmi.Name, virtv1.MigrationScheduled)
+				migration.Spec.Receive = &virtv1.VirtualMachineInstanceMigrationReceive{
+					MigrationID: "migration-id",
+				}
+			})
+
+			It("should hand the pod of the VMI over to the target virt-handler", func() {
+				pod := newSourcePodForVirtualMachine(vmi)
+				pod.Spec.NodeName = "node01"
+				Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+				addMigration(migration)
+				mockQueue.ExpectAdds(1)
+				vmiSource.Add(vmi)
+				mockQueue.Wait()
+
+				patch := fmt.Sprintf(`[{ "op": "add", "path": "/status/migrationState", "value": {"targetNode":"node01","targetPod":"%s","migrationUid":"testmigration","crossCluster":{"migrationID":"migration-id"}} }]`, pod.Name)
+				shouldExpectVirtualMachineInstancePatch(vmi, patch)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+			})
+
+			DescribeTable("should move through the migration phases", func(phase, expectedPhase virtv1.VirtualMachineInstanceMigrationPhase, updateState func(*virtv1.VirtualMachineInstanceMigrationState)) {
+				migration.Status.Phase = phase
+				vmi.Status.MigrationState = newCrossClusterState(migration)
+				vmi.Status.MigrationState.TargetNode = "node01"
+				updateState(vmi.Status.MigrationState)
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				migrationInterface.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+					Expect(arg.(*virtv1.VirtualMachineInstanceMigration).Status.Phase).To(Equal(expectedPhase))
+					Expect(arg.(*virtv1.VirtualMachineInstanceMigration).Status.MigrationState).To(Equal(vmi.Status.MigrationState))
+					return arg, nil
+				})
+
+				controller.Execute()
+			},
+				Entry("from Scheduled to PreparingTarget once handed off", virtv1.MigrationScheduled, virtv1.MigrationPreparingTarget,
+					func(*virtv1.VirtualMachineInstanceMigrationState) {}),
+				Entry("from PreparingTarget to TargetReady once the connect URL is known", virtv1.MigrationPreparingTarget, virtv1.MigrationTargetReady,
+					func(state *virtv1.VirtualMachineInstanceMigrationState) {
+						state.CrossCluster.ConnectURL = "192.168.1.2:45678"
+					}),
+				Entry("from TargetReady to Running once the domain arrived", virtv1.MigrationTargetReady, virtv1.MigrationRunning,
+					func(state *virtv1.VirtualMachineInstanceMigrationState) {
+						state.CrossCluster.ConnectURL = "192.168.1.2:45678"
+						state.StartTimestamp = now()
+					}),
+			)
+		})
+	})
+
 	Context("Migration backoff", func() {
 		var vmi *virtv1.VirtualMachineInstance
 
@@ -1,12 +1,219 @@
 package watch
 
 import (
+	"context"
+	"sort"
+	"time"
+
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
 )
 
+// migrationPolicyStatusKey is the single queue key of the MigrationPolicyController. Since a VMI is governed by
+// at most one policy, the statuses of all policies are computed together.
+const migrationPolicyStatusKey = "migrationpolicies"
+
+// MigrationPolicyController keeps the status of every migration policy up to date with the VMIs it governs.
+type MigrationPolicyController struct {
+	clientset               kubecli.KubevirtClient
+	Queue                   workqueue.RateLimitingInterface
+	migrationPolicyInformer cache.SharedIndexInformer
+	vmiInformer             cache.SharedIndexInformer
+	namespaceInformer       cache.SharedIndexInformer
+}
+
+// NewMigrationPolicyController creates a new instance of the MigrationPolicyController struct.
+func NewMigrationPolicyController(clientset kubecli.KubevirtClient, migrationPolicyInformer, vmiInformer, namespaceInformer cache.SharedIndexInformer) (*MigrationPolicyController, error) {
+	c := &MigrationPolicyController{
+		clientset:               clientset,
+		Queue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-migrationpolicy"),
+		migrationPolicyInformer: migrationPolicyInformer,
+		vmiInformer:             vmiInformer,
+		namespaceInformer:       namespaceInformer,
+	}
+
+	_, err := c.migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateMigrationPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateVirtualMachineInstance,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *MigrationPolicyController) enqueue(_ interface{}) {
+	c.Queue.Add(migrationPolicyStatusKey)
+}
+
+func (c *MigrationPolicyController) updateMigrationPolicy(old, curr interface{}) {
+	oldPolicy := old.(*v1alpha1.MigrationPolicy)
+	currPolicy := curr.(*v1alpha1.MigrationPolicy)
+	if !equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		c.enqueue(curr)
+	}
+}
+
+func (c *MigrationPolicyController) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*k6tv1.VirtualMachineInstance)
+	currVMI := curr.(*k6tv1.VirtualMachineInstance)
+	if !equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) || oldVMI.IsFinal() != currVMI.IsFinal() {
+		c.enqueue(curr)
+	}
+}
+
+func (c *MigrationPolicyController) updateNamespace(old, curr interface{}) {
+	oldNamespace := old.(*k8sv1.Namespace)
+	currNamespace := curr.(*k8sv1.Namespace)
+	if !equality.Semantic.DeepEqual(oldNamespace.Labels, currNamespace.Labels) {
+		c.enqueue(curr)
+	}
+}
+
+// Run runs the passed in MigrationPolicyController.
+func (c *MigrationPolicyController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting migration policy controller.")
+
+	// Wait for cache sync before we start the migration policy controller
+	cache.WaitForCacheSync(stopCh, c.migrationPolicyInformer.HasSynced, c.vmiInformer.HasSynced, c.namespaceInformer.HasSynced)
+
+	// All policies are handled under a single key, a single worker is enough
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy controller.")
+}
+
+func (c *MigrationPolicyController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *MigrationPolicyController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(); err != nil {
+		log.Log.Reason(err).Info("reenqueuing migration policies")
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Info("processed migration policies")
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *MigrationPolicyController) execute() error {
+	policyList := &v1alpha1.MigrationPolicyList{}
+	for _, obj := range c.migrationPolicyInformer.GetStore().List() {
+		policyList.Items = append(policyList.Items, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	if len(policyList.Items) == 0 {
+		return nil
+	}
+
+	governedVMIs, err := c.governedVMIs(policyList)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, policy := range policyList.Items {
+		vmis := governedVMIs[policy.Name]
+		if equality.Semantic.DeepEqual(policy.Status.VirtualMachineInstances, vmis) {
+			continue
+		}
+
+		policyCopy := policy.DeepCopy()
+		policyCopy.Status.VirtualMachineInstances = vmis
+		if _, err := c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, metav1.UpdateOptions{}); err != nil {
+			log.Log.Object(policyCopy).Reason(err).Error("Failed to update the governed VMIs of migration policy")
+			errs = append(errs, err)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// governedVMIs returns, by policy name, the sorted list of the VMIs that are not final and are matched to that policy.
+func (c *MigrationPolicyController) governedVMIs(policyList *v1alpha1.MigrationPolicyList) (map[string][]v1alpha1.GovernedVirtualMachineInstance, error) {
+	governedVMIs := map[string][]v1alpha1.GovernedVirtualMachineInstance{}
+
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		vmi := obj.(*k6tv1.VirtualMachineInstance)
+		if vmi.IsFinal() {
+			continue
+		}
+
+		obj, exists, err := c.namespaceInformer.GetStore().GetByKey(vmi.Namespace)
+		if err != nil {
+			return nil, err
+		} else if !exists {
+			continue
+		}
+
+		policy := MatchPolicy(policyList, vmi, obj.(*k8sv1.Namespace))
+		if policy == nil {
+			continue
+		}
+		governedVMIs[policy.Name] = append(governedVMIs[policy.Name], v1alpha1.GovernedVirtualMachineInstance{
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+		})
+	}
+
+	for _, vmis := range governedVMIs {
+		sort.Slice(vmis, func(i, j int) bool {
+			if vmis[i].Namespace != vmis[j].Namespace {
+				return vmis[i].Namespace < vmis[j].Namespace
+			}
+			return vmis[i].Name < vmis[j].Name
+		})
+	}
+
+	return governedVMIs, nil
+}
+
 type migrationPolicyMatchScore struct {
 	matchingVMILabels int
 	matchingNSLabels  int
@@ -34,7 +241,10 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 
 // MatchPolicy returns the policy that is matched to the vmi, or nil of no policy is matched.
 //
-// Since every policy can specify VMI and Namespace labels to match to, matching is done by returning the most
+// Policies with an explicit priority take precedence: only the matching policies with the highest priority
+// are considered, a policy without a priority having priority 0.
+//
+// Since every policy can specify VMI and Namespace labels to match to, matching is then done by returning the most
 // detailed policy, meaning the policy that matches the VMI and specifies the most labels that matched either
 // the VMI or its namespace labels.
 //
@@ -44,11 +254,20 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) *v1alpha1.MigrationPolicy {
 	var mathingPolicies []v1alpha1.MigrationPolicy
 	bestScore := migrationPolicyMatchScore{}
+	bestPriority := int32(0)
 
 	for _, policy := range policyList.Items {
 		doesMatch, curScore := countMatchingLabels(&policy, vmi.Labels, vmiNamespace.Labels)
+		if !doesMatch {
+			continue
+		}
 
-		if !doesMatch || curScore.lessThan(bestScore) {
+		curPriority := policyPriority(&policy)
+		if len(mathingPolicies) == 0 || curPriority > bestPriority {
+			bestPriority = curPriority
+			bestScore = curScore
+			mathingPolicies = []v1alpha1.MigrationPolicy{policy}
+		} else if curPriority < bestPriority || curScore.lessThan(bestScore) {
 			continue
 		} else if curScore.greaterThan(bestScore) {
 			bestScore = curScore
@@ -79,6 +298,13 @@ func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMac
 	return &mathingPolicies[firstPolicyNameLexicographicOrderIdx]
 }
 
+func policyPriority(policy *v1alpha1.MigrationPolicy) int32 {
+	if policy.Spec.Priority == nil {
+		return 0
+	}
+	return *policy.Spec.Priority
+}
+
 // countMatchingLabels checks if a policy matches to a VMI and the number of matching labels.
 // In the case that doesMatch is false, matchingLabels needs to be dismissed and not counted on.
 func countMatchingLabels(policy *v1alpha1.MigrationPolicy, vmiLabels, namespaceLabels map[string]string) (doesMatch bool, score migrationPolicyMatchScore) {
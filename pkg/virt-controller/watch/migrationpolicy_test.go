@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("MigrationPolicy controller", func() {
+	var (
+		controller              *MigrationPolicyController
+		migrationsClient        *kubevirtfake.Clientset
+		migrationPolicyInformer cache.SharedIndexInformer
+		vmiInformer             cache.SharedIndexInformer
+		namespaceInformer       cache.SharedIndexInformer
+	)
+
+	newPolicy := func(name string, priority *int32, vmiSelector migrationsv1.LabelSelector) *migrationsv1.MigrationPolicy {
+		policy := kubecli.NewMinimalMigrationPolicy(name)
+		policy.Spec.Priority = priority
+		policy.Spec.Selectors = &migrationsv1.Selectors{VirtualMachineInstanceSelector: vmiSelector}
+		return policy
+	}
+
+	addPolicy := func(policy *migrationsv1.MigrationPolicy) {
+		Expect(migrationPolicyInformer.GetStore().Add(policy)).To(Succeed())
+		_, err := migrationsClient.MigrationsV1alpha1().MigrationPolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(namespace, name string, phase virtv1.VirtualMachineInstancePhase, labels map[string]string) {
+		vmi := &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+			Status:     virtv1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	addNamespace := func(name string) {
+		Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}})).To(Succeed())
+	}
+
+	governedVMIs := func(policyName string) []migrationsv1.GovernedVirtualMachineInstance {
+		policy, err := migrationsClient.MigrationsV1alpha1().MigrationPolicies().Get(context.Background(), policyName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status.VirtualMachineInstances
+	}
+
+	countStatusUpdates := func() int {
+		updates := 0
+		for _, action := range migrationsClient.Actions() {
+			if action.GetVerb() == "update" && action.GetSubresource() == "status" {
+				updates++
+			}
+		}
+		return updates
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		migrationsClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().MigrationPolicy().Return(migrationsClient.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+
+		migrationPolicyInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+
+		var err error
+		controller, err = NewMigrationPolicyController(virtClient, migrationPolicyInformer, vmiInformer, namespaceInformer)
+		Expect(err).ToNot(HaveOccurred())
+
+		addNamespace("ns1")
+		addNamespace("ns2")
+	})
+
+	It("should list the VMIs governed by each policy", func() {
+		addPolicy(newPolicy("generic", nil, migrationsv1.LabelSelector{"app": "db"}))
+		addPolicy(newPolicy("prioritized", pointer.Int32(1), migrationsv1.LabelSelector{"tier": "gold"}))
+
+		addVMI("ns2", "db-b", virtv1.Running, map[string]string{"app": "db"})
+		addVMI("ns1", "db-c", virtv1.Running, map[string]string{"app": "db"})
+		addVMI("ns1", "db-a", virtv1.Scheduling, map[string]string{"app": "db"})
+		addVMI("ns1", "db-gold", virtv1.Running, map[string]string{"app": "db", "tier": "gold"})
+		addVMI("ns1", "db-done", virtv1.Succeeded, map[string]string{"app": "db"})
+		addVMI("ns1", "web", virtv1.Running, map[string]string{"app": "web"})
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(governedVMIs("generic")).To(Equal([]migrationsv1.GovernedVirtualMachineInstance{
+			{Namespace: "ns1", Name: "db-a"},
+			{Namespace: "ns1", Name: "db-c"},
+			{Namespace: "ns2", Name: "db-b"},
+		}))
+		Expect(governedVMIs("prioritized")).To(Equal([]migrationsv1.GovernedVirtualMachineInstance{
+			{Namespace: "ns1", Name: "db-gold"},
+		}))
+	})
+
+	It("should remove VMIs which are no longer governed by a policy", func() {
+		policy := newPolicy("generic", nil, migrationsv1.LabelSelector{"app": "db"})
+		policy.Status.VirtualMachineInstances = []migrationsv1.GovernedVirtualMachineInstance{{Namespace: "ns1", Name: "gone"}}
+		addPolicy(policy)
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(governedVMIs("generic")).To(BeEmpty())
+	})
+
+	It("should not update policies whose governed VMIs did not change", func() {
+		policy := newPolicy("generic", nil, migrationsv1.LabelSelector{"app": "db"})
+		policy.Status.VirtualMachineInstances = []migrationsv1.GovernedVirtualMachineInstance{{Namespace: "ns1", Name: "db"}}
+		addPolicy(policy)
+		addPolicy(newPolicy("unused", nil, migrationsv1.LabelSelector{"app": "web"}))
+		addVMI("ns1", "db", virtv1.Running, map[string]string{"app": "db"})
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(countStatusUpdates()).To(BeZero())
+	})
+})
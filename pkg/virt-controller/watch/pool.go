@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
@@ -22,6 +25,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -33,8 +37,11 @@ import (
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 )
 
@@ -50,6 +57,7 @@ type PoolController struct {
 	expectations     *controller.UIDTrackingControllerExpectations
 	burstReplicas    uint
 	statusUpdater    *status.VMPStatusUpdater
+	cloneAuthFunc    CloneAuthFunc
 }
 
 const (
@@ -57,6 +65,9 @@ const (
 	SuccessfulUpdateVirtualMachineReason = "SuccessfulUpdate"
 
 	defaultAddDelay = 1 * time.Second
+
+	// cpuLoadTimeout bounds the time spent fetching the CPU load of a VMI when picking VMs to scale in
+	cpuLoadTimeout = 10 * time.Second
 )
 
 const (
@@ -65,6 +76,9 @@ const (
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
 
+	FailedDataVolumeClaimDeleteReason     = "FailedDataVolumeClaimDelete"
+	SuccessfulDataVolumeClaimDeleteReason = "SuccessfulDataVolumeClaimDelete"
+
 	SuccessfulPausedPoolReason = "SuccessfulPaused"
 	SuccessfulResumePoolReason = "SuccessfulResume"
 )
@@ -92,6 +106,11 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 		statusUpdater:    status.NewVMPStatusUpdater(clientset),
 	}
 
+	proxy := &sarProxy{client: clientset}
+	c.cloneAuthFunc = func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error) {
+		return cdiclone.CanServiceAccountClonePVC(proxy, pvcNamespace, pvcName, saNamespace, saName)
+	}
+
 	_, err := c.poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.addPool,
 		DeleteFunc: c.deletePool,
@@ -470,13 +489,15 @@ func (c *PoolController) listVMsFromNamespace(namespace string) ([]*virtv1.Virtu
 	return vms, nil
 }
 
-func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+func wantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
 	}
+	return 1
+}
 
-	return len(vms) - int(wantedReplicas)
+func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, surge int) int {
+	return len(vms) - (wantedReplicas(pool) + surge)
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -492,9 +513,11 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 
 // filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
 func (c *PoolController) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
-	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
-	})
+	return filterVMs(vms, isReadyVM)
+}
+
+func isReadyVM(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
 }
 
 func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine) bool) []*virtv1.VirtualMachine {
@@ -519,16 +542,25 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 	// make sure we count already deleting VMs here during scale in.
 	count = count - (len(vms) - len(elgibleVMs))
 
-	if len(elgibleVMs) == 0 || count == 0 {
+	unprotectedVMs := filterVMs(elgibleVMs, func(vm *virtv1.VirtualMachine) bool {
+		return !isScaleInProtected(vm)
+	})
+	if count > len(unprotectedVMs) && len(unprotectedVMs) < len(elgibleVMs) {
+		log.Log.Object(pool).Infof("Not removing %d VMs which are protected from scale in", len(elgibleVMs)-len(unprotectedVMs))
+	}
+	elgibleVMs = unprotectedVMs
+
+	if len(elgibleVMs) == 0 || count <= 0 {
 		return nil
 	} else if count > len(elgibleVMs) {
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	if pool.Spec.NamingPolicy == poolv1.VirtualMachinePoolOrdinalNamingPolicy {
+		sortByOrdinalDescending(elgibleVMs)
+	} else {
+		c.sortScaleInCandidates(pool, elgibleVMs)
+	}
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
@@ -553,6 +585,12 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 			}
 			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Deleted VM %s/%s with uid %v from pool", vm.Namespace, vm.Name, vm.ObjectMeta.UID)
 			log.Log.Object(pool).Infof("Deleted vm %s/%s from pool", vm.Namespace, vm.Name)
+
+			if deleteDataVolumeClaimsWhenScaled(pool) {
+				if err := c.deleteDataVolumeClaims(pool, vm.Name); err != nil {
+					errChan <- err
+				}
+			}
 		}(i)
 	}
 
@@ -568,6 +606,110 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 	return nil
 }
 
+// sortByOrdinalDescending orders the VMs so that the highest ordinals come first,
+// VMs whose name does not end with an ordinal come before all others.
+func sortByOrdinalDescending(vms []*virtv1.VirtualMachine) {
+	ordinal := func(vm *virtv1.VirtualMachine) int {
+		index, err := indexFromName(vm.Name)
+		if err != nil {
+			return math.MaxInt
+		}
+		return index
+	}
+	sort.SliceStable(vms, func(i, j int) bool {
+		return ordinal(vms[i]) > ordinal(vms[j])
+	})
+}
+
+func isScaleInProtected(vm *virtv1.VirtualMachine) bool {
+	return vm.Annotations[poolv1.VirtualMachinePoolScaleInProtectionAnnotation] == "true"
+}
+
+// sortScaleInCandidates orders the VMs by the scale in policy of the pool, the VMs to remove first come first.
+func (c *PoolController) sortScaleInCandidates(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) {
+	// random delete strategy, also breaks the ties left by the scale in policy
+	rand.Shuffle(len(vms), func(i, j int) {
+		vms[i], vms[j] = vms[j], vms[i]
+	})
+
+	if pool.Spec.ScaleInPolicy == nil || len(pool.Spec.ScaleInPolicy.Order) == 0 {
+		return
+	}
+	order := pool.Spec.ScaleInPolicy.Order
+
+	var loads map[string]int32
+	for _, criterion := range order {
+		if criterion == poolv1.VirtualMachinePoolLeastLoadedScaleInOrder {
+			loads = c.getCPULoads(vms)
+		}
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		for _, criterion := range order {
+			if less, decided := compareScaleInCandidates(criterion, vms[i], vms[j], loads); decided {
+				return less
+			}
+		}
+		return false
+	})
+}
+
+// compareScaleInCandidates reports whether a should be removed before b, and whether the criterion tells them apart at all.
+func compareScaleInCandidates(criterion poolv1.VirtualMachinePoolScaleInOrder, a, b *virtv1.VirtualMachine, loads map[string]int32) (less bool, decided bool) {
+	switch criterion {
+	case poolv1.VirtualMachinePoolNotReadyFirstScaleInOrder:
+		aReady, bReady := isReadyVM(a), isReadyVM(b)
+		return !aReady, aReady != bReady
+	case poolv1.VirtualMachinePoolNewestFirstScaleInOrder:
+		return b.CreationTimestamp.Before(&a.CreationTimestamp), !a.CreationTimestamp.Equal(&b.CreationTimestamp)
+	case poolv1.VirtualMachinePoolOldestFirstScaleInOrder:
+		return a.CreationTimestamp.Before(&b.CreationTimestamp), !a.CreationTimestamp.Equal(&b.CreationTimestamp)
+	case poolv1.VirtualMachinePoolLeastLoadedScaleInOrder:
+		aLoad, aKnown := loads[a.Name]
+		bLoad, bKnown := loads[b.Name]
+		if aKnown && bKnown {
+			return aLoad < bLoad, aLoad != bLoad
+		}
+		// VMs with an unknown load go last
+		return aKnown, aKnown != bKnown
+	}
+	return false, false
+}
+
+// getCPULoads returns the vCPU utilization of the VMs with a running VMI by VM name.
+// VMs whose utilization could not be fetched are left out.
+func (c *PoolController) getCPULoads(vms []*virtv1.VirtualMachine) map[string]int32 {
+	loads := map[string]int32{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, vm := range vms {
+		vmi, exists := c.getVMI(vm)
+		if !exists || vmi.Status.Phase != virtv1.Running {
+			continue
+		}
+
+		wg.Add(1)
+		go func(vm *virtv1.VirtualMachine) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), cpuLoadTimeout)
+			defer cancel()
+
+			cpuLoad, err := c.clientset.VirtualMachineInstance(vm.Namespace).CPULoad(ctx, vm.Name)
+			if err != nil {
+				log.Log.Object(vm).Reason(err).Warning("Failed to get the CPU load of the VMI")
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			loads[vm.Name] = cpuLoad.UtilizationPercent
+		}(vm)
+	}
+	wg.Wait()
+
+	return loads
+}
+
 func generateVMName(index int, baseName string) string {
 	return fmt.Sprintf("%s-%d", baseName, index)
 }
@@ -647,6 +789,124 @@ func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachin
 	return spec
 }
 
+// poolVMSpec returns the spec of the VM with the given name and index from the pool template
+func poolVMSpec(pool *poolv1.VirtualMachinePool, vmName string, idx int) *virtv1.VirtualMachineSpec {
+	spec := indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), idx)
+	return claimVMSpec(spec, pool.Spec.DataVolumeClaimTemplates, vmName)
+}
+
+// dataVolumeClaimName returns the name of the DataVolume created from a claim template for a VM of the pool
+func dataVolumeClaimName(templateName, vmName string) string {
+	return fmt.Sprintf("%s-%s", templateName, vmName)
+}
+
+// claimVMSpec points the volumes which refer to a DataVolume claim template to the DataVolumes of the VM
+func claimVMSpec(spec *virtv1.VirtualMachineSpec, claimTemplates []virtv1.DataVolumeTemplateSpec, vmName string) *virtv1.VirtualMachineSpec {
+	if len(claimTemplates) == 0 || spec.Template == nil {
+		return spec
+	}
+
+	claimNameMap := map[string]string{}
+	for _, template := range claimTemplates {
+		claimNameMap[template.Name] = dataVolumeClaimName(template.Name, vmName)
+	}
+
+	for i, volume := range spec.Template.Spec.Volumes {
+		if volume.VolumeSource.PersistentVolumeClaim != nil {
+			claimName, ok := claimNameMap[volume.VolumeSource.PersistentVolumeClaim.ClaimName]
+			if ok {
+				spec.Template.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = claimName
+			}
+		} else if volume.VolumeSource.DataVolume != nil {
+			claimName, ok := claimNameMap[volume.VolumeSource.DataVolume.Name]
+			if ok {
+				spec.Template.Spec.Volumes[i].DataVolume.Name = claimName
+			}
+		}
+	}
+
+	return spec
+}
+
+// ensureDataVolumeClaims creates the DataVolumes of a VM from the claim templates of the pool.
+// DataVolumes which survived a previous VM with the same name are reused.
+func (c *PoolController) ensureDataVolumeClaims(pool *poolv1.VirtualMachinePool, vmName string) error {
+	for _, template := range pool.Spec.DataVolumeClaimTemplates {
+		template.Name = dataVolumeClaimName(template.Name, vmName)
+
+		dataVolume, err := storagetypes.GenerateDataVolumeFromTemplate(c.clientset, template, pool.Namespace, pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.PriorityClassName)
+		if err != nil {
+			return fmt.Errorf("unable to create DataVolume manifest: %v", err)
+		}
+		if err := c.authorizeDataVolumeClaim(pool, dataVolume); err != nil {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, UnauthorizedDataVolumeCreateReason, "Not authorized to create DataVolume %s: %v", dataVolume.Name, err)
+			return fmt.Errorf("Not authorized to create DataVolume: %v", err)
+		}
+		// the pool owns the DataVolumes, so that they survive the deletion of the VM
+		dataVolume.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+
+		_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(pool.Namespace).Create(context.Background(), dataVolume, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			continue
+		} else if err != nil {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDataVolumeCreateReason, "Error creating DataVolume %s: %v", dataVolume.Name, err)
+			return fmt.Errorf("Failed to create DataVolume: %v", err)
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDataVolumeCreateReason, "Created DataVolume %s", dataVolume.Name)
+	}
+
+	return nil
+}
+
+func (c *PoolController) authorizeDataVolumeClaim(pool *poolv1.VirtualMachinePool, dataVolume *cdiv1.DataVolume) error {
+	if dataVolume.Spec.SourceRef != nil {
+		return fmt.Errorf("DataVolume sourceRef not supported")
+	}
+
+	if dataVolume.Spec.Source == nil || dataVolume.Spec.Source.PVC == nil {
+		return nil
+	}
+
+	serviceAccount := "default"
+	for _, vol := range pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes {
+		if vol.ServiceAccount != nil {
+			serviceAccount = vol.ServiceAccount.ServiceAccountName
+		}
+	}
+
+	allowed, reason, err := c.cloneAuthFunc(dataVolume.Spec.Source.PVC.Namespace, dataVolume.Spec.Source.PVC.Name, pool.Namespace, serviceAccount)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf(reason)
+	}
+
+	return nil
+}
+
+func deleteDataVolumeClaimsWhenScaled(pool *poolv1.VirtualMachinePool) bool {
+	policy := pool.Spec.DataVolumeClaimRetentionPolicy
+	return policy != nil && policy.WhenScaled == poolv1.VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType
+}
+
+// deleteDataVolumeClaims deletes the DataVolumes which were created from the claim templates of the pool for a VM
+func (c *PoolController) deleteDataVolumeClaims(pool *poolv1.VirtualMachinePool, vmName string) error {
+	for _, template := range pool.Spec.DataVolumeClaimTemplates {
+		name := dataVolumeClaimName(template.Name, vmName)
+		err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(pool.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDataVolumeClaimDeleteReason, "Error deleting DataVolume %s: %v", name, err)
+			return fmt.Errorf("Failed to delete DataVolume %s: %v", name, err)
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDataVolumeClaimDeleteReason, "Deleted DataVolume %s", name)
+	}
+
+	return nil
+}
+
 func injectPoolRevisionLabelsIntoVM(vm *virtv1.VirtualMachine, revisionName string) *virtv1.VirtualMachine {
 
 	if vm.Labels == nil {
@@ -766,9 +1026,16 @@ func (c *PoolController) scaleOut(pool *poolv1.VirtualMachinePool, count int) er
 
 			vm.Labels = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vm.Annotations = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vm.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
+			vm.Spec = *poolVMSpec(pool, name, index)
 			vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
 
+			if err := c.ensureDataVolumeClaims(pool, name); err != nil {
+				c.expectations.CreationObserved(poolKey)
+				log.Log.Object(pool).Reason(err).Errorf("Failed to create the DataVolumes of vm %s/%s", pool.Namespace, name)
+				errChan <- err
+				return
+			}
+
 			vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
 
 			vm, err = c.clientset.VirtualMachine(vm.Namespace).Create(context.Background(), vm)
@@ -797,7 +1064,12 @@ func (c *PoolController) scaleOut(pool *poolv1.VirtualMachinePool, count int) er
 }
 
 func (c *PoolController) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (syncError, bool) {
-	diff := c.calcDiff(pool, vms)
+	surge, err := c.rolloutSurge(pool, vms)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error while detecting outdated VMs: %v", err), FailedScaleOutReason}, false
+	}
+
+	diff := c.calcDiff(pool, vms, surge)
 	if diff == 0 {
 		// nothing to do
 		return nil, true
@@ -848,7 +1120,7 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 
 			vmCopy.Labels = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vmCopy.Annotations = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vmCopy.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
+			vmCopy.Spec = *poolVMSpec(pool, vmCopy.Name, index)
 			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
 
 			_, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy)
@@ -874,34 +1146,59 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 	return nil
 }
 
-func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+// proactiveUpdate restarts outdated VMIs and patches the revision label of VMIs which are already up-to-date.
+// At most restartBudget VMIs which are available get restarted, a negative budget means no limit.
+func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine, restartBudget int) error {
+	type vmiUpdate struct {
+		vm         *virtv1.VirtualMachine
+		vmi        *virtv1.VirtualMachineInstance
+		updateType proactiveUpdateType
+	}
+
+	rollingUpdate := getRollingUpdate(pool)
+
+	var updates []vmiUpdate
+	var availableRestarts []vmiUpdate
+	for _, vm := range vmUpdatedList {
+		vmi, exists := c.getVMI(vm)
+		if !exists || vmi.DeletionTimestamp != nil {
+			// no VMI to update or VMI is already deleting
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(vm, vmi)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case updateType == proactiveUpdateTypeNone:
+		case updateType == proactiveUpdateTypeRestart && rollingUpdate != nil && !isProactiveRollingUpdate(rollingUpdate):
+			// running VMIs pick up the change on their next restart
+		case updateType == proactiveUpdateTypeRestart && restartBudget >= 0 && c.isAvailableVM(vm):
+			// restarting an unavailable VM does not lower the availability of the pool
+			availableRestarts = append(availableRestarts, vmiUpdate{vm, vmi, updateType})
+		default:
+			updates = append(updates, vmiUpdate{vm, vmi, updateType})
+		}
+	}
+
+	if restartBudget >= 0 && len(availableRestarts) > restartBudget {
+		log.Log.Object(pool).Infof("Restarting %d of %d outdated VMs in pool", restartBudget, len(availableRestarts))
+		availableRestarts = availableRestarts[:restartBudget]
+	}
+	updates = append(updates, availableRestarts...)
+
 	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
+	wg.Add(len(updates))
+	errChan := make(chan error, len(updates))
+	for i := 0; i < len(updates); i++ {
 		go func(idx int) {
 			defer wg.Done()
-			vm := vmUpdatedList[idx]
+			vm := updates[idx].vm
+			vmi := updates[idx].vmi
 
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
-
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
-
-			}
-			switch updateType {
+			switch updates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, &v1.DeleteOptions{})
 				if err != nil {
@@ -1084,6 +1381,130 @@ func (c *PoolController) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv
 
 }
 
+func (c *PoolController) getVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachineInstance, bool) {
+	obj, exists, _ := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return nil, false
+	}
+	return obj.(*virtv1.VirtualMachineInstance), true
+}
+
+// isAvailableVM returns true if the VM is ready and its VMI is not being shut down
+func (c *PoolController) isAvailableVM(vm *virtv1.VirtualMachine) bool {
+	if !isReadyVM(vm) {
+		return false
+	}
+	vmi, exists := c.getVMI(vm)
+	return exists && vmi.DeletionTimestamp == nil
+}
+
+// isOutdatedReplica returns true if either the VM or its running VMI do not match the current pool template
+func (c *PoolController) isOutdatedReplica(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
+	outdated, err := c.isOutdatedVM(pool, vm)
+	if err != nil || outdated {
+		return outdated, err
+	}
+
+	vmi, exists := c.getVMI(vm)
+	if !exists || vmi.DeletionTimestamp != nil {
+		return false, nil
+	}
+	updateType, err := c.isOutdatedVMI(vm, vmi)
+	return updateType == proactiveUpdateTypeRestart, err
+}
+
+func (c *PoolController) countOutdatedVMs(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	count := 0
+	for _, vm := range vms {
+		outdated, err := c.isOutdatedReplica(pool, vm)
+		if err != nil {
+			return 0, err
+		}
+		if outdated {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func getRollingUpdate(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePoolRollingUpdate {
+	if pool.Spec.UpdateStrategy == nil {
+		return nil
+	}
+	if pool.Spec.UpdateStrategy.RollingUpdate == nil {
+		return &poolv1.VirtualMachinePoolRollingUpdate{}
+	}
+	return pool.Spec.UpdateStrategy.RollingUpdate
+}
+
+// rollingUpdateLimits resolves maxUnavailable and maxSurge of the rolling update against the wanted replicas
+func rollingUpdateLimits(pool *poolv1.VirtualMachinePool, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) (int, int, error) {
+	defaultMaxUnavailable := intstr.FromInt(1)
+	defaultMaxSurge := intstr.FromInt(0)
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxUnavailable, defaultMaxUnavailable), wantedReplicas(pool), false)
+	if err != nil {
+		return 0, 0, err
+	}
+	maxSurge, err := intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(rollingUpdate.MaxSurge, defaultMaxSurge), wantedReplicas(pool), true)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if maxUnavailable == 0 && maxSurge == 0 {
+		// the update could never make progress
+		maxUnavailable = 1
+	}
+	return maxUnavailable, maxSurge, nil
+}
+
+func isProactiveRollingUpdate(rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) bool {
+	return rollingUpdate.Mode != poolv1.VirtualMachinePoolOpportunisticUpdateMode
+}
+
+// rolloutSurge returns how many VMs are created over the wanted replicas while outdated VMs are restarted
+func (c *PoolController) rolloutSurge(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil || !isProactiveRollingUpdate(rollingUpdate) {
+		return 0, nil
+	}
+
+	_, maxSurge, err := rollingUpdateLimits(pool, rollingUpdate)
+	if err != nil || maxSurge == 0 {
+		return 0, err
+	}
+
+	outdated, err := c.countOutdatedVMs(pool, filterDeletingVMs(vms))
+	if err != nil || outdated == 0 {
+		return 0, err
+	}
+	return maxSurge, nil
+}
+
+// restartBudget returns how many available VMs may be restarted to pick up the current pool template.
+// A negative budget means that all outdated VMs are restarted at once.
+func (c *PoolController) restartBudget(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) (int, error) {
+	rollingUpdate := getRollingUpdate(pool)
+	if rollingUpdate == nil {
+		return -1, nil
+	}
+	if !isProactiveRollingUpdate(rollingUpdate) {
+		return 0, nil
+	}
+
+	maxUnavailable, _, err := rollingUpdateLimits(pool, rollingUpdate)
+	if err != nil {
+		return 0, err
+	}
+
+	available := len(filterVMs(filterDeletingVMs(vms), c.isAvailableVM))
+	budget := available - (wantedReplicas(pool) - maxUnavailable)
+	if budget < 0 {
+		return 0, nil
+	}
+	return budget, nil
+}
+
 func (c *PoolController) pruneUnusedRevisions(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) syncError {
 
 	keys, err := c.revisionInformer.GetIndexer().IndexKeys("vmpool", string(pool.UID))
@@ -1160,7 +1581,12 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 		return &syncErrorImpl{fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason}, false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	restartBudget, err := c.restartBudget(pool, vms)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error while calculating the update budget: %v", err), FailedUpdateReason}, false
+	}
+
+	err = c.proactiveUpdate(pool, vmUpdatedList, restartBudget)
 	if err != nil {
 		return &syncErrorImpl{fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason}, false
 	}
@@ -1250,6 +1676,13 @@ func (c *PoolController) updateStatus(origPool *poolv1.VirtualMachinePool, vms [
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
 
+	outdatedReplicas, err := c.countOutdatedVMs(pool, vms)
+	if err != nil {
+		return err
+	}
+	pool.Status.OutdatedReplicas = int32(outdatedReplicas)
+	pool.Status.UpdatedReplicas = pool.Status.Replicas - pool.Status.OutdatedReplicas
+
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		err := c.statusUpdater.UpdateStatus(pool)
 		if err != nil {
@@ -29,8 +29,11 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -41,8 +44,10 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
@@ -106,6 +111,7 @@ var _ = Describe("Pool", func() {
 		var mockQueue *testutils.MockWorkQueue
 		var client *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var cdiClient *cdifake.Clientset
 
 		syncCaches := func(stop chan struct{}) {
 			go vmiInformer.Run(stop)
@@ -195,6 +201,13 @@ var _ = Describe("Pool", func() {
 			})
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
 
+			cdiClient = cdifake.NewSimpleClientset()
+			cdiClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+
 			syncCaches(stop)
 		})
 
@@ -266,6 +279,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			poolRevision := createPoolRevision(pool)
 
 			pool.Generation = 123
@@ -327,6 +341,7 @@ var _ = Describe("Pool", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.OutdatedReplicas = 1
 
 			oldPoolRevision := createPoolRevision(pool)
 
@@ -381,6 +396,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -401,6 +417,7 @@ var _ = Describe("Pool", func() {
 
 			pool.Status.Replicas = 1
 			pool.Status.ReadyReplicas = 1
+			pool.Status.UpdatedReplicas = 1
 			addPool(pool)
 			addVM(vm)
 			addCR(poolRevision)
@@ -715,6 +732,353 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
 
 		})
+
+		Context("with a rolling update strategy", func() {
+			intOrStr := func(value intstr.IntOrString) *intstr.IntOrString {
+				return &value
+			}
+
+			// createOutdatedPool returns a pool whose VMI template changed after its ready VMs got started
+			createOutdatedPool := func(replicas int32, rollingUpdate *poolv1.VirtualMachinePoolRollingUpdate) *poolv1.VirtualMachinePool {
+				pool, vm := DefaultPool(replicas)
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type:          poolv1.VirtualMachinePoolRollingUpdateStrategyType,
+					RollingUpdate: rollingUpdate,
+				}
+				oldPoolRevision := createPoolRevision(pool)
+
+				pool.Generation = 123
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				newPoolRevision := createPoolRevision(pool)
+
+				pool.Status.Replicas = replicas
+				pool.Status.ReadyReplicas = replicas
+				pool.Status.OutdatedReplicas = replicas
+				addPool(pool)
+
+				for i := 0; i < int(replicas); i++ {
+					vm := injectPoolRevisionLabelsIntoVM(vm.DeepCopy(), newPoolRevision.Name)
+					vm.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					vm.UID = types.UID(vm.Name)
+					markVmAsReady(vm)
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Namespace = vm.Namespace
+					vmi.Labels = mapCopy(vm.Spec.Template.ObjectMeta.Labels)
+					vmi.Labels[virtv1.VirtualMachinePoolRevisionName] = oldPoolRevision.Name
+					vmi.OwnerReferences = []metav1.OwnerReference{{
+						APIVersion:         virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+						Kind:               virtv1.VirtualMachineGroupVersionKind.Kind,
+						Name:               vm.ObjectMeta.Name,
+						UID:                vm.ObjectMeta.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					}}
+					markAsReady(vmi)
+
+					addVM(vm)
+					addVMI(vmi, true)
+				}
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+				expectControllerRevisionCreation(newPoolRevision)
+
+				return pool
+			}
+
+			It("should restart at most maxUnavailable outdated VMs at once", func() {
+				createOutdatedPool(3, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: intOrStr(intstr.FromInt(1)),
+				})
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should resolve maxUnavailable percentages against the replicas", func() {
+				createOutdatedPool(4, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: intOrStr(intstr.FromString("50%")),
+				})
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should not restart outdated VMs in opportunistic mode", func() {
+				createOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					Mode: poolv1.VirtualMachinePoolOpportunisticUpdateMode,
+				})
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+
+				controller.Execute()
+			})
+
+			It("should create maxSurge additional VMs before restarting outdated VMs", func() {
+				pool := createOutdatedPool(2, &poolv1.VirtualMachinePoolRollingUpdate{
+					MaxUnavailable: intOrStr(intstr.FromInt(0)),
+					MaxSurge:       intOrStr(intstr.FromInt(1)),
+				})
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).Times(1).Do(func(ctx context.Context, arg interface{}) {
+					newVM := arg.(*v1.VirtualMachine)
+					Expect(newVM.Name).To(Equal(fmt.Sprintf("%s-2", pool.Name)))
+					Expect(newVM.Labels).To(HaveKeyWithValue(virtv1.VirtualMachinePoolRevisionName, getRevisionName(pool)))
+				}).Return(&v1.VirtualMachine{}, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+		})
+
+		Context("when scaling in", func() {
+			// addPoolVMs adds count VMs to the pool, each one created a minute after the previous one
+			addPoolVMs := func(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, count int) []*virtv1.VirtualMachine {
+				var vms []*virtv1.VirtualMachine
+				created := time.Now().Add(-time.Hour)
+				for i := 0; i < count; i++ {
+					newVM := vm.DeepCopy()
+					newVM.Name = fmt.Sprintf("%s-%d", pool.Name, i)
+					newVM.UID = types.UID(newVM.Name)
+					newVM.CreationTimestamp = metav1.NewTime(created.Add(time.Duration(i) * time.Minute))
+					vms = append(vms, newVM)
+				}
+				return vms
+			}
+
+			expectPoolReplicas := func(replicas int32) {
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(testing.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.Replicas).To(Equal(replicas))
+					return true, update.GetObject(), nil
+				})
+			}
+
+			addRunningVMI := func(vm *virtv1.VirtualMachine) {
+				vmi := api.NewMinimalVMI(vm.Name)
+				vmi.Namespace = vm.Namespace
+				vmi.Status.Phase = virtv1.Running
+				vmi.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion:         virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					Kind:               virtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:               vm.ObjectMeta.Name,
+					UID:                vm.ObjectMeta.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				}}
+				addVMI(vmi, true)
+			}
+
+			It("should never delete VMs which are protected from scale in", func() {
+				pool, vm := DefaultPool(0)
+				addPool(pool)
+
+				vms := addPoolVMs(pool, vm, 3)
+				for _, protectedVM := range vms[:2] {
+					protectedVM.Annotations[poolv1.VirtualMachinePoolScaleInProtectionAnnotation] = "true"
+				}
+				for _, vm := range vms {
+					addVM(vm)
+				}
+				expectPoolReplicas(3)
+
+				vmInterface.EXPECT().Delete(context.Background(), vms[2].Name, gomock.Any()).Times(1).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			DescribeTable("should delete VMs in the order of the scale in policy", func(order poolv1.VirtualMachinePoolScaleInOrder, expectedIndexes []int) {
+				pool, vm := DefaultPool(2)
+				pool.Spec.ScaleInPolicy = &poolv1.VirtualMachinePoolScaleInPolicy{
+					Order: []poolv1.VirtualMachinePoolScaleInOrder{order},
+				}
+				addPool(pool)
+
+				vms := addPoolVMs(pool, vm, 4)
+				markVmAsReady(vms[0])
+				markVmAsReady(vms[3])
+				for _, vm := range vms {
+					addVM(vm)
+				}
+				expectPoolReplicas(4)
+
+				for _, idx := range expectedIndexes {
+					vmInterface.EXPECT().Delete(context.Background(), vms[idx].Name, gomock.Any()).Times(1).Return(nil)
+				}
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			},
+				Entry("newest first", poolv1.VirtualMachinePoolNewestFirstScaleInOrder, []int{2, 3}),
+				Entry("oldest first", poolv1.VirtualMachinePoolOldestFirstScaleInOrder, []int{0, 1}),
+				Entry("not ready first", poolv1.VirtualMachinePoolNotReadyFirstScaleInOrder, []int{1, 2}),
+			)
+
+			It("should delete the least loaded VMs first and break ties with later criteria", func() {
+				pool, vm := DefaultPool(3)
+				pool.Spec.ScaleInPolicy = &poolv1.VirtualMachinePoolScaleInPolicy{
+					Order: []poolv1.VirtualMachinePoolScaleInOrder{
+						poolv1.VirtualMachinePoolLeastLoadedScaleInOrder,
+						poolv1.VirtualMachinePoolOldestFirstScaleInOrder,
+					},
+				}
+				addPool(pool)
+
+				vms := addPoolVMs(pool, vm, 4)
+				for _, vm := range vms {
+					addVM(vm)
+				}
+				for _, vm := range vms[:3] {
+					addRunningVMI(vm)
+				}
+				expectPoolReplicas(4)
+
+				loads := map[string]int32{vms[0].Name: 80, vms[1].Name: 10, vms[2].Name: 10}
+				vmiInterface.EXPECT().CPULoad(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(func(_ context.Context, name string) (virtv1.VirtualMachineInstanceCPULoad, error) {
+					return virtv1.VirtualMachineInstanceCPULoad{UtilizationPercent: loads[name]}, nil
+				})
+				vmInterface.EXPECT().Delete(context.Background(), vms[1].Name, gomock.Any()).Times(1).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should delete the VMs with the highest ordinals first with ordinal naming", func() {
+				pool, vm := DefaultPool(2)
+				pool.Spec.NamingPolicy = poolv1.VirtualMachinePoolOrdinalNamingPolicy
+				addPool(pool)
+
+				for _, vm := range addPoolVMs(pool, vm, 4) {
+					addVM(vm)
+				}
+				expectPoolReplicas(4)
+
+				vmInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-3", pool.Name), gomock.Any()).Times(1).Return(nil)
+				vmInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-2", pool.Name), gomock.Any()).Times(1).Return(nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+		})
+
+		Context("with DataVolume claim templates", func() {
+			// createClaimPool returns a pool whose VMs mount a DataVolume created from a claim template
+			createClaimPool := func(replicas int32) (*poolv1.VirtualMachinePool, *virtv1.VirtualMachine) {
+				pool, vm := DefaultPool(replicas)
+				pool.Spec.DataVolumeClaimTemplates = []virtv1.DataVolumeTemplateSpec{{
+					ObjectMeta: metav1.ObjectMeta{Name: "data"},
+					Spec: cdiv1.DataVolumeSpec{
+						Source: &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}},
+					},
+				}}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes = []virtv1.Volume{{
+					Name:         "data",
+					VolumeSource: virtv1.VolumeSource{DataVolume: &virtv1.DataVolumeSource{Name: "data"}},
+				}}
+				return pool, vm
+			}
+
+			expectDataVolumeCreation := func(name string, err error) {
+				cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, _ error) {
+					created, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+
+					dataVolume := created.GetObject().(*cdiv1.DataVolume)
+					Expect(dataVolume.Name).To(Equal(name))
+					Expect(dataVolume.OwnerReferences).To(HaveLen(1))
+					Expect(dataVolume.OwnerReferences[0].Kind).To(Equal(poolv1.VirtualMachinePoolKind))
+					return true, dataVolume, err
+				})
+			}
+
+			expectVMWithDataVolume := func(vmName, dataVolumeName string) {
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).Times(1).Do(func(ctx context.Context, arg interface{}) {
+					newVM := arg.(*v1.VirtualMachine)
+					Expect(newVM.Name).To(Equal(vmName))
+					Expect(newVM.Spec.Template.Spec.Volumes).To(HaveLen(1))
+					Expect(newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal(dataVolumeName))
+				}).Return(&v1.VirtualMachine{}, nil)
+			}
+
+			It("should create the DataVolumes of a new replica", func() {
+				pool, _ := createClaimPool(1)
+				addPool(pool)
+				expectControllerRevisionCreation(createPoolRevision(pool))
+
+				expectDataVolumeCreation("data-my-pool-0", nil)
+				expectVMWithDataVolume("my-pool-0", "data-my-pool-0")
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDataVolumeCreateReason)
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should reattach the DataVolumes of a recreated replica", func() {
+				pool, _ := createClaimPool(1)
+				addPool(pool)
+				expectControllerRevisionCreation(createPoolRevision(pool))
+
+				expectDataVolumeCreation("data-my-pool-0", apierrors.NewAlreadyExists(cdiv1.SchemeGroupVersion.WithResource("datavolumes").GroupResource(), "data-my-pool-0"))
+				expectVMWithDataVolume("my-pool-0", "data-my-pool-0")
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			DescribeTable("on scale in", func(policy poolv1.VirtualMachinePoolDataVolumeClaimRetentionPolicyType, expectDeletion bool) {
+				pool, vm := createClaimPool(0)
+				pool.Spec.DataVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolDataVolumeClaimRetentionPolicy{
+					WhenScaled: policy,
+				}
+				addPool(pool)
+
+				vm.Name = "my-pool-0"
+				vm.Spec = *poolVMSpec(pool, vm.Name, 0)
+				addVM(vm)
+
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(testing.UpdateAction)
+					Expect(ok).To(BeTrue())
+					return true, update.GetObject(), nil
+				})
+				vmInterface.EXPECT().Delete(context.Background(), vm.Name, gomock.Any()).Times(1).Return(nil)
+				deleted := false
+				cdiClient.Fake.PrependReactor("delete", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					Expect(action.(testing.DeleteAction).GetName()).To(Equal("data-my-pool-0"))
+					deleted = true
+					return true, nil, nil
+				})
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				Expect(deleted).To(Equal(expectDeletion))
+			},
+				Entry("should retain the DataVolumes by default", poolv1.VirtualMachinePoolDataVolumeClaimRetentionPolicyType(""), false),
+				Entry("should retain the DataVolumes with the Retain policy", poolv1.VirtualMachinePoolRetainDataVolumeClaimRetentionPolicyType, false),
+				Entry("should delete the DataVolumes with the Delete policy", poolv1.VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType, true),
+			)
+		})
 	})
 })
 
@@ -71,3 +71,13 @@ func (_m *MockHinter) LowestTSCFrequencyOnCluster() (int64, error) {
 func (_mr *_MockHinterRecorder) LowestTSCFrequencyOnCluster() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "LowestTSCFrequencyOnCluster")
 }
+
+func (_m *MockHinter) LowestKSMMemoryOvercommitOnCluster() int32 {
+	ret := _m.ctrl.Call(_m, "LowestKSMMemoryOvercommitOnCluster")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+func (_mr *_MockHinterRecorder) LowestKSMMemoryOvercommitOnCluster() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "LowestKSMMemoryOvercommitOnCluster")
+}
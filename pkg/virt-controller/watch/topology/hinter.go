@@ -18,6 +18,7 @@ type Hinter interface {
 	IsTscFrequencyRequired(vmi *k6tv1.VirtualMachineInstance) bool
 	TSCFrequenciesInUse() []int64
 	LowestTSCFrequencyOnCluster() (int64, error)
+	LowestKSMMemoryOvercommitOnCluster() int32
 }
 
 type topologyHinter struct {
@@ -32,19 +33,31 @@ func (t *topologyHinter) IsTscFrequencyRequired(vmi *k6tv1.VirtualMachineInstanc
 
 func (t *topologyHinter) TopologyHintsForVMI(vmi *k6tv1.VirtualMachineInstance) (hints *k6tv1.TopologyHints, requirement TscFrequencyRequirementType, err error) {
 	requirement = GetTscFrequencyRequirement(vmi).Type
-	if requirement == NotRequired || vmi.Spec.Architecture != "amd64" {
-		return
+	if requirement != NotRequired && vmi.Spec.Architecture == "amd64" {
+		freq, err := t.LowestTSCFrequencyOnCluster()
+		if err != nil {
+			return nil, requirement, fmt.Errorf("failed to determine the lowest tsc frequency on the cluster: %v", err)
+		}
+		hints = &k6tv1.TopologyHints{TSCFrequency: pointer.Int64Ptr(freq)}
 	}
 
-	freq, err := t.LowestTSCFrequencyOnCluster()
-	if err != nil {
-		return nil, requirement, fmt.Errorf("failed to determine the lowest tsc frequency on the cluster: %v", err)
+	if IsKSMOvercommitRequested(vmi) {
+		if hints == nil {
+			hints = &k6tv1.TopologyHints{}
+		}
+		hints.KSMMemoryOvercommit = pointer.Int32(t.LowestKSMMemoryOvercommitOnCluster())
 	}
-
-	hints = &k6tv1.TopologyHints{TSCFrequency: pointer.Int64Ptr(freq)}
 	return
 }
 
+func (t *topologyHinter) LowestKSMMemoryOvercommitOnCluster() int32 {
+	nodes := FilterNodesFromCache(t.nodeStore.List(),
+		IsSchedulable,
+		HasKSMEnabled,
+	)
+	return LowestKSMMemoryOvercommit(nodes)
+}
+
 func (t *topologyHinter) LowestTSCFrequencyOnCluster() (int64, error) {
 	configTSCFrequency := t.clusterConfig.GetMinimumClusterTSCFrequency()
 	if configTSCFrequency != nil {
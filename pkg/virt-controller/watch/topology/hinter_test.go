@@ -86,6 +86,50 @@ var _ = Describe("Hinter", func() {
 		Entry("arm64", "arm64"),
 		Entry("ppc64le", "ppc64le"),
 	)
+
+	It("should return the lowest ksm memory overcommit of the schedulable ksm nodes", func() {
+		hinter := hinterWithNodes(
+			NodeWithKSMOvercommit("node0", "130", true),
+			NodeWithKSMOvercommit("node1", "115", true),
+			NodeWithKSMOvercommit("node2", "102", false),
+			NodeWithTSC("node3", 123, true),
+		)
+		g.Expect(hinter.LowestKSMMemoryOvercommitOnCluster()).To(g.BeNumerically("==", 115))
+	})
+
+	It("should not overcommit memory when a ksm node did not report its overcommit", func() {
+		hinter := hinterWithNodes(
+			NodeWithKSMOvercommit("node0", "130", true),
+			NodeWithKSMOvercommit("node1", "", true),
+		)
+		g.Expect(hinter.LowestKSMMemoryOvercommitOnCluster()).To(g.BeNumerically("==", 100))
+	})
+
+	It("should propose a ksm memory overcommit to overcommit-tolerant VMIs", func() {
+		hinter := hinterWithNodes(
+			NodeWithKSMOvercommit("node0", "130", true),
+			NodeWithTSC("node1", 1234, true),
+		)
+		vmi := vmiWithoutTSCFrequency("myvmi")
+		vmi.Spec.Architecture = "amd64"
+		vmi.Spec.Domain.Resources.OvercommitWithKSM = true
+		g.Expect(hinter.TopologyHintsForVMI(vmi)).To(g.Equal(
+			&virtv1.TopologyHints{
+				TSCFrequency:        pointer.Int64Ptr(1234),
+				KSMMemoryOvercommit: pointer.Int32(130),
+			},
+		))
+
+		vmi.Spec.Domain.CPU = nil
+		hints, requirement, err := hinter.TopologyHintsForVMI(vmi)
+		g.Expect(err).ToNot(g.HaveOccurred())
+		g.Expect(requirement).To(g.Equal(NotRequired))
+		g.Expect(hints).To(g.Equal(
+			&virtv1.TopologyHints{
+				KSMMemoryOvercommit: pointer.Int32(130),
+			},
+		))
+	})
 })
 
 func hinterWithNodes(nodes ...*v1.Node) *topologyHinter {
@@ -143,6 +187,22 @@ func NodeWithTSC(name string, frequency int64, scalable bool, schedulable ...int
 	}
 }
 
+func NodeWithKSMOvercommit(name string, overcommit string, schedulable bool) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				virtv1.KSMEnabledLabel: "true",
+				virtv1.NodeSchedulable: fmt.Sprintf("%v", schedulable),
+			},
+		},
+	}
+	if overcommit != "" {
+		node.Annotations = map[string]string{virtv1.KSMMemoryOvercommitAnnotation: overcommit}
+	}
+	return node
+}
+
 func NodeWithInvalidTSC(name string) *v1.Node {
 	return &v1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,70 @@
+package topology
+
+import (
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// noKSMMemoryOvercommit is the overcommit percentage of a node where KSM did not merge any page yet
+const noKSMMemoryOvercommit int32 = 100
+
+func HasKSMEnabled(node *v1.Node) bool {
+	if node == nil {
+		return false
+	}
+	return node.Labels[k6tv1.KSMEnabledLabel] == "true"
+}
+
+func KSMMemoryOvercommitFromNode(node *v1.Node) (int32, error) {
+	value, exists := node.Annotations[k6tv1.KSMMemoryOvercommitAnnotation]
+	if !exists {
+		return noKSMMemoryOvercommit, nil
+	}
+	overcommit, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return noKSMMemoryOvercommit, err
+	}
+	if overcommit < int64(noKSMMemoryOvercommit) {
+		return noKSMMemoryOvercommit, nil
+	}
+	return int32(overcommit), nil
+}
+
+// LowestKSMMemoryOvercommit returns the lowest memory overcommit percentage of the given nodes.
+// Nodes without a valid overcommit annotation are not overcommitted.
+func LowestKSMMemoryOvercommit(nodes []*v1.Node) int32 {
+	if len(nodes) == 0 {
+		return noKSMMemoryOvercommit
+	}
+	lowest, err := KSMMemoryOvercommitFromNode(nodes[0])
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Node %s has an invalid ksm memory overcommit", nodes[0].Name)
+	}
+	for _, node := range nodes[1:] {
+		overcommit, err := KSMMemoryOvercommitFromNode(node)
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("Node %s has an invalid ksm memory overcommit", node.Name)
+		}
+		if overcommit < lowest {
+			lowest = overcommit
+		}
+	}
+	return lowest
+}
+
+func IsKSMOvercommitRequested(vmi *k6tv1.VirtualMachineInstance) bool {
+	return vmi != nil && vmi.Spec.Domain.Resources.OvercommitWithKSM
+}
+
+func AreKSMTopologyHintsDefined(vmi *k6tv1.VirtualMachineInstance) bool {
+	if vmi == nil {
+		return false
+	}
+
+	topologyHints := vmi.Status.TopologyHints
+	return topologyHints != nil && topologyHints.KSMMemoryOvercommit != nil
+}
@@ -41,6 +41,7 @@ import (
 	k8score "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -60,6 +61,9 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/network/macpool"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
@@ -103,11 +107,16 @@ const (
 const (
 	HotPlugVolumeErrorReason           = "HotPlugVolumeError"
 	HotPlugCPUErrorReason              = "HotPlugCPUError"
+	HotPlugMemoryErrorReason           = "HotPlugMemoryError"
+	IOLimitsUpdateErrorReason          = "IOLimitsUpdateError"
+	InterfaceUpdateErrorReason         = "InterfaceUpdateError"
 	MemoryDumpErrorReason              = "MemoryDumpError"
 	FailedUpdateErrorReason            = "FailedUpdateError"
 	FailedCreateReason                 = "FailedCreate"
 	VMIFailedDeleteReason              = "FailedDelete"
 	HotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
+	FailedBackendStorageRetainReason   = "FailedBackendStorageRetain"
+	FailedMacAddressAllocationReason   = "FailedMacAddressAllocation"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -141,6 +150,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		},
 		statusUpdater: status.NewVMStatusUpdater(clientset),
 		clusterConfig: clusterConfig,
+		macAllocator:  macpool.NewAllocator(vmInformer.GetIndexer()),
 	}
 
 	_, err := c.vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -196,6 +206,7 @@ type VMController struct {
 	cloneAuthFunc          CloneAuthFunc
 	statusUpdater          *status.VMStatusUpdater
 	clusterConfig          *virtconfig.ClusterConfig
+	macAllocator           *macpool.Allocator
 }
 
 func (c *VMController) Run(threadiness int, stopCh <-chan struct{}) {
@@ -628,6 +639,227 @@ func (c *VMController) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *vi
 	return nil
 }
 
+func (c *VMController) VMIMemoryPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	oldGuest := vmi.Spec.Domain.Memory.Guest
+	newGuest := vm.Spec.Template.Spec.Domain.Memory.Guest
+
+	oldGuestJSON, err := json.Marshal(oldGuest)
+	if err != nil {
+		return err
+	}
+	newGuestJSON, err := json.Marshal(newGuest)
+	if err != nil {
+		return err
+	}
+
+	ops := []string{
+		fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/memory/guest", "value": %s}`, string(oldGuestJSON)),
+		fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/memory/guest", "value": %s}`, string(newGuestJSON)),
+	}
+
+	// The launcher pod of the migration target has to fit the new guest memory,
+	// grow the memory requirements of the VMI by the same amount.
+	delta := newGuest.DeepCopy()
+	delta.Sub(*oldGuest)
+	resources := []struct {
+		path      string
+		resources k8score.ResourceList
+	}{
+		{"/spec/domain/resources/requests/memory", vmi.Spec.Domain.Resources.Requests},
+		{"/spec/domain/resources/limits/memory", vmi.Spec.Domain.Resources.Limits},
+	}
+	for _, r := range resources {
+		oldMemory, exists := r.resources[k8score.ResourceMemory]
+		if !exists {
+			continue
+		}
+		newMemory := oldMemory.DeepCopy()
+		newMemory.Add(delta)
+
+		oldMemoryJSON, err := json.Marshal(oldMemory)
+		if err != nil {
+			return err
+		}
+		newMemoryJSON, err := json.Marshal(newMemory)
+		if err != nil {
+			return err
+		}
+		ops = append(ops,
+			fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s}`, r.path, string(oldMemoryJSON)),
+			fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s}`, r.path, string(newMemoryJSON)),
+		)
+	}
+	patch := fmt.Sprintf("[%s]", strings.Join(ops, ", "))
+
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &v1.PatchOptions{})
+
+	return err
+}
+
+// memoryChangeRestartReason returns why a change of the guest memory in the VM template
+// cannot be hotplugged into the running VMI. It returns an empty string if the memory was
+// not changed or if the change can be applied live.
+func memoryChangeRestartReason(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) string {
+	vmMemory := vm.Spec.Template.Spec.Domain.Memory
+	if vmMemory == nil || vmMemory.Guest == nil {
+		return ""
+	}
+
+	vmiMemory := vmi.Spec.Domain.Memory
+	if vmiMemory != nil && vmiMemory.Guest != nil && vmiMemory.Guest.Equal(*vmMemory.Guest) {
+		return ""
+	}
+
+	switch {
+	case vm.Spec.LiveUpdateFeatures == nil || vm.Spec.LiveUpdateFeatures.Memory == nil:
+		return "memory live update is not enabled for the VM"
+	case vmiMemory == nil || vmiMemory.Guest == nil || vmiMemory.MaxGuest == nil:
+		return "the running VMI was not started with hotpluggable memory"
+	case vmMemory.Guest.Cmp(*vmiMemory.Guest) < 0:
+		return "memory unplug is not supported"
+	case vmMemory.Guest.Cmp(*vmiMemory.MaxGuest) > 0:
+		return fmt.Sprintf("requested guest memory %s exceeds the maximum of %s", vmMemory.Guest.String(), vmiMemory.MaxGuest.String())
+	}
+
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceIsMigratable, k8score.ConditionFalse) {
+		return "memory hotplug requires a live migratable VMI"
+	}
+
+	return ""
+}
+
+func (c *VMController) handleMemoryChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmMemory := vm.Spec.Template.Spec.Domain.Memory
+	if vmMemory == nil || vmMemory.Guest == nil {
+		return nil
+	}
+
+	vmiMemory := vmi.Spec.Domain.Memory
+	if vmiMemory != nil && vmiMemory.Guest != nil && vmiMemory.Guest.Equal(*vmMemory.Guest) {
+		return nil
+	}
+
+	// changes which cannot be hotplugged are reported through the RestartRequired condition
+	if memoryChangeRestartReason(vm, vmi) != "" {
+		return nil
+	}
+
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8score.ConditionTrue) {
+		return fmt.Errorf("another memory hotplug is in progress")
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("memory hotplug is not allowed while VMI is migrating")
+	}
+
+	if err := c.VMIMemoryPatch(vm, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to hotplug memory: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// handleIOLimitsChangeRequest propagates changes of the disk I/O throttling limits from the
+// VM template to the running VMI, virt-handler applies them live.
+func (c *VMController) handleIOLimitsChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return nil
+	}
+
+	if !c.clusterConfig.VMLiveUpdateFeaturesEnabled() {
+		return nil
+	}
+
+	templateDisks := map[string]virtv1.Disk{}
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		templateDisks[disk.Name] = disk
+	}
+	newDisks := make([]virtv1.Disk, len(vmi.Spec.Domain.Devices.Disks))
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		newDisks[i] = *disk.DeepCopy()
+		if templateDisk, exists := templateDisks[disk.Name]; exists {
+			newDisks[i].IOTune = templateDisk.IOTune.DeepCopy()
+		}
+	}
+
+	if err := c.vmiDisksPatch(newDisks, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update I/O limits: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *VMController) vmiDisksPatch(newDisks []virtv1.Disk, vmi *virtv1.VirtualMachineInstance) error {
+	if equality.Semantic.DeepEqual(vmi.Spec.Domain.Devices.Disks, newDisks) {
+		return nil
+	}
+
+	oldDisksJSON, err := json.Marshal(vmi.Spec.Domain.Devices.Disks)
+	if err != nil {
+		return err
+	}
+
+	newDisksJSON, err := json.Marshal(newDisks)
+	if err != nil {
+		return err
+	}
+
+	testDisks := fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/disks", "value": %s}`, string(oldDisksJSON))
+	updateDisks := fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/devices/disks", "value": %s}`, string(newDisksJSON))
+
+	patch := fmt.Sprintf("[%s, %s]", testDisks, updateDisks)
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &v1.PatchOptions{})
+
+	return err
+}
+
+// handleInterfaceChangeRequest propagates the link states requested by the interface states
+// of the VM template to the running VMI, together with the interface bandwidth limits when the
+// live update features are enabled. virt-handler applies both live. They are sent in a single
+// patch, since both replace the interfaces of the VMI.
+func (c *VMController) handleInterfaceChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return nil
+	}
+
+	templateIfaces := map[string]virtv1.Interface{}
+	for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+		templateIfaces[iface.Name] = iface
+	}
+
+	liveUpdateEnabled := c.clusterConfig.VMLiveUpdateFeaturesEnabled()
+	vmiSpecCopy := vmi.Spec.DeepCopy()
+	for i, iface := range vmiSpecCopy.Domain.Devices.Interfaces {
+		templateIface, exists := templateIfaces[iface.Name]
+		if !exists {
+			continue
+		}
+		if liveUpdateEnabled {
+			vmiSpecCopy.Domain.Devices.Interfaces[i].Bandwidth = templateIface.Bandwidth.DeepCopy()
+		}
+		// interfaces being hot-unplugged are handled by the dynamic interface requests
+		if iface.State == virtv1.InterfaceStateAbsent || templateIface.State == virtv1.InterfaceStateAbsent {
+			continue
+		}
+		vmiSpecCopy.Domain.Devices.Interfaces[i].State = templateIface.State
+	}
+
+	if err := c.vmiInterfacesPatch(vmiSpecCopy, vmi); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update interfaces: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *VMController) handleMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vm.Status.MemoryDumpRequest == nil {
 		return nil
@@ -748,6 +980,12 @@ func (c *VMController) startStop(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualM
 				log.Log.Object(vm).Infof("processing forced restart request for VMI with phase %s and VM runStrategy: %s", vmi.Status.Phase, runStrategy)
 			}
 
+			if !forceRestart && migrations.MigratedToAnotherCluster(vmi) {
+				// The VirtualMachineInstance keeps running in another cluster and must not be started here again
+				log.Log.Object(vm).V(4).Info("VMI migrated to another cluster, not restarting it")
+				return nil
+			}
+
 			if forceRestart || vmi.IsFinal() {
 				log.Log.Object(vm).Infof("%s with VMI in phase %s and VM runStrategy: %s", stoppingVmMsg, vmi.Status.Phase, runStrategy)
 
@@ -1457,6 +1695,13 @@ func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.Virtual
 		}
 	}
 
+	if memory := vm.Spec.Template.Spec.Domain.Memory; memory != nil && memory.Guest != nil {
+		vmi.Status.Memory = &virtv1.MemoryStatus{
+			GuestAtBoot:  pointer.P(memory.Guest.DeepCopy()),
+			GuestCurrent: pointer.P(memory.Guest.DeepCopy()),
+		}
+	}
+
 	c.setupLiveFeatures(vm, vmi, VMIDefaults)
 
 	return vmi
@@ -1965,6 +2210,41 @@ func (c *VMController) addVMFinalizer(vm *virtv1.VirtualMachine, finalizer strin
 	return c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{})
 }
 
+// allocateMacAddresses assigns a MAC address of the cluster pool to every interface of a VM which is not
+// running and does not specify one. Allocating in the virt-controller leader only ensures that concurrent
+// allocations never hand out the same MAC address.
+func (c *VMController) allocateMacAddresses(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
+	pool := c.clusterConfig.GetMacAddressPool()
+	if pool == nil || vmi != nil || vm.Spec.Template == nil {
+		return vm, nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	allocated := false
+	interfaces := vmCopy.Spec.Template.Spec.Domain.Devices.Interfaces
+	for i := range interfaces {
+		if interfaces[i].MacAddress != "" {
+			continue
+		}
+		mac, err := c.macAllocator.Allocate(pool)
+		if err != nil {
+			return vm, err
+		}
+		interfaces[i].MacAddress = mac
+		allocated = true
+	}
+	if !allocated {
+		return vm, nil
+	}
+
+	log.Log.V(3).Object(vm).Info("Allocated MAC addresses from the cluster pool")
+	updatedVM, err := c.clientset.VirtualMachine(vm.Namespace).Update(context.Background(), vmCopy)
+	if err != nil {
+		return vm, err
+	}
+	return updatedVM, nil
+}
+
 // parseGeneration will parse for the last value after a '-'. It is assumed the
 // revision name is created with getVMRevisionName. If the name is not formatted
 // correctly and the generation cannot be found, then nil will be returned.
@@ -2059,6 +2339,8 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 
 	c.trimDoneVolumeRequests(vm)
 	trimDoneInterfaceRequests(vm)
+	requestCrashMemoryDump(vm, vmi)
+	requestWatchdogMemoryDump(vm, vmi)
 	c.updateMemoryDumpRequest(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
@@ -2306,6 +2588,7 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 	// ready condition is handled differently as it persists regardless if vmi exists or not
 	c.syncReadyConditionFromVMI(vm, vmi)
 	c.processFailureCondition(vm, vmi, syncErr)
+	c.processRestartRequiredCondition(vm, vmi)
 
 	// nothing to do if vmi hasn't been created yet.
 	if vmi == nil {
@@ -2314,8 +2597,9 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 
 	// sync VMI conditions, ignore list represents conditions that are not synced generically
 	syncIgnoreMap := map[string]interface{}{
-		string(virtv1.VirtualMachineReady):   nil,
-		string(virtv1.VirtualMachineFailure): nil,
+		string(virtv1.VirtualMachineReady):           nil,
+		string(virtv1.VirtualMachineFailure):         nil,
+		string(virtv1.VirtualMachineRestartRequired): nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -2373,6 +2657,36 @@ func (c *VMController) processFailureCondition(vm *virtv1.VirtualMachine, vmi *v
 	return
 }
 
+func (c *VMController) processRestartRequiredCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmConditionManager := controller.NewVirtualMachineConditionManager()
+
+	reason := ""
+	if vmi != nil && vmi.DeletionTimestamp == nil && !vmi.IsFinal() {
+		reason = memoryChangeRestartReason(vm, vmi)
+	}
+
+	if reason == "" {
+		if vmConditionManager.HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+			log.Log.Object(vm).V(4).Info("Removing restart required condition")
+			vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineRestartRequired)
+		}
+		return
+	}
+
+	message := fmt.Sprintf("a restart is required to apply the guest memory change: %s", reason)
+	if cond := vmConditionManager.GetCondition(vm, virtv1.VirtualMachineRestartRequired); cond != nil && cond.Message == message {
+		return
+	}
+
+	vmConditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineRestartRequired,
+		Reason:             "MemoryChangeNotHotpluggable",
+		Message:            message,
+		LastTransitionTime: v1.Now(),
+		Status:             k8score.ConditionTrue,
+	})
+}
+
 func (c *VMController) isTrimFirstChangeRequestNeeded(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (clearChangeRequest bool) {
 	if len(vm.Status.StateChangeRequests) == 0 {
 		return false
@@ -2417,6 +2731,74 @@ func (c *VMController) isTrimFirstChangeRequestNeeded(vm *virtv1.VirtualMachine,
 	return false
 }
 
+// requestCrashMemoryDump issues a memory dump request once the guest kernel panicked
+// if the VMI asks for its memory to be dumped on crash
+func requestCrashMemoryDump(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.Spec.Domain.OnCrash == nil ||
+		vmi.Spec.Domain.OnCrash.Action != virtv1.CrashActionMemoryDump ||
+		vmi.Spec.Domain.OnCrash.MemoryDumpClaimName == "" {
+		return
+	}
+
+	panicked := controller.NewVirtualMachineInstanceConditionManager().GetCondition(vmi, virtv1.VirtualMachineInstanceGuestPanicked)
+	if panicked == nil || panicked.Status != k8score.ConditionTrue {
+		return
+	}
+
+	if !memoryDumpRequestNeeded(vm, &panicked.LastTransitionTime) {
+		return
+	}
+
+	log.Log.Object(vm).Infof("Guest panicked, dumping its memory to pvc %s", vmi.Spec.Domain.OnCrash.MemoryDumpClaimName)
+	vm.Status.MemoryDumpRequest = &virtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: vmi.Spec.Domain.OnCrash.MemoryDumpClaimName,
+		Phase:     virtv1.MemoryDumpAssociating,
+	}
+}
+
+// requestWatchdogMemoryDump issues a memory dump request once the watchdog with the dump
+// action fired, virt-launcher resets the guest kept paused until then once the dump completes
+func requestWatchdogMemoryDump(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.Spec.Domain.Devices.Watchdog == nil ||
+		vmi.Spec.Domain.Devices.Watchdog.MemoryDumpClaimName == "" {
+		return
+	}
+
+	watchdogStatus := vmi.Status.WatchdogStatus
+	if watchdogStatus == nil || watchdogStatus.Action != virtv1.WatchdogActionDump ||
+		watchdogStatus.LastFiredTimestamp == nil {
+		return
+	}
+
+	if !memoryDumpRequestNeeded(vm, watchdogStatus.LastFiredTimestamp) {
+		return
+	}
+
+	log.Log.Object(vm).Infof("Watchdog fired, dumping the guest memory to pvc %s", vmi.Spec.Domain.Devices.Watchdog.MemoryDumpClaimName)
+	vm.Status.MemoryDumpRequest = &virtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: vmi.Spec.Domain.Devices.Watchdog.MemoryDumpClaimName,
+		Phase:     virtv1.MemoryDumpAssociating,
+	}
+}
+
+// memoryDumpRequestNeeded tells whether the guest memory still has to be dumped
+// for an event which happened at the given time
+func memoryDumpRequestNeeded(vm *virtv1.VirtualMachine, eventTime *v1.Time) bool {
+	if request := vm.Status.MemoryDumpRequest; request != nil {
+		switch request.Phase {
+		case virtv1.MemoryDumpCompleted, virtv1.MemoryDumpFailed:
+			// The event was already dumped
+			if request.EndTimestamp != nil && !request.EndTimestamp.Before(eventTime) {
+				return false
+			}
+		default:
+			// Don't interfere with a memory dump in progress
+			return false
+		}
+	}
+	return true
+}
+
 func (c *VMController) updateMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if vm.Status.MemoryDumpRequest == nil {
 		return
@@ -2557,6 +2939,10 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 
 	if vm.DeletionTimestamp != nil {
 		if vmi == nil || controller.HasFinalizer(vm, v1.FinalizerOrphanDependents) {
+			err = backendstorage.OrphanIfRetained(vm, c.clientset, c.pvcInformer.GetStore())
+			if err != nil {
+				return vm, &syncErrorImpl{fmt.Errorf("failed to retain the backend storage: %v", err), FailedBackendStorageRetainReason}, nil
+			}
 			vm, err = c.removeVMFinalizer(vm, virtv1.VirtualMachineControllerFinalizer)
 			if err != nil {
 				return vm, nil, err
@@ -2576,6 +2962,11 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 		}
 	}
 
+	vm, err = c.allocateMacAddresses(vm, vmi)
+	if err != nil {
+		return vm, &syncErrorImpl{fmt.Errorf("failed to allocate MAC addresses: %v", err), FailedMacAddressAllocationReason}, nil
+	}
+
 	if err := c.conditionallyBumpGenerationAnnotationOnVmi(vm, vmi); err != nil {
 		return nil, nil, err
 	}
@@ -2637,6 +3028,21 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling CPU change request: %v", err), HotPlugCPUErrorReason}
 		}
 
+		err = c.handleMemoryChangeRequest(vmCopy, vmi)
+		if err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling memory change request: %v", err), HotPlugMemoryErrorReason}
+		}
+
+		err = c.handleIOLimitsChangeRequest(vmCopy, vmi)
+		if err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling I/O limits change request: %v", err), IOLimitsUpdateErrorReason}
+		}
+
+		err = c.handleInterfaceChangeRequest(vmCopy, vmi)
+		if err != nil {
+			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling interface change request: %v", err), InterfaceUpdateErrorReason}
+		}
+
 		if syncErr == nil {
 			if !equality.Semantic.DeepEqual(vm, vmCopy) {
 				vm, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy)
@@ -2778,30 +3184,51 @@ func (c *VMController) setupLiveFeatures(
 	vm *virtv1.VirtualMachine,
 	vmi, VMIDefaults *virtv1.VirtualMachineInstance) {
 	const (
-		maxSocketsRatio = 4
+		maxSocketsRatio     = 4
+		maxGuestMemoryRatio = 4
 	)
 
 	if vm.Spec.LiveUpdateFeatures == nil {
 		return
 	}
 
-	if vmi.Spec.Domain.CPU == nil {
-		vmi.Spec.Domain.CPU = &virtv1.CPU{}
-	}
+	if vm.Spec.LiveUpdateFeatures.CPU != nil {
+		if vmi.Spec.Domain.CPU == nil {
+			vmi.Spec.Domain.CPU = &virtv1.CPU{}
+		}
 
-	if vm.Spec.LiveUpdateFeatures.CPU.MaxSockets != nil {
-		vmi.Spec.Domain.CPU.MaxSockets = *vm.Spec.LiveUpdateFeatures.CPU.MaxSockets
-	}
+		if vm.Spec.LiveUpdateFeatures.CPU.MaxSockets != nil {
+			vmi.Spec.Domain.CPU.MaxSockets = *vm.Spec.LiveUpdateFeatures.CPU.MaxSockets
+		}
 
-	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
-		vmi.Spec.Domain.CPU.MaxSockets = c.clusterConfig.GetMaximumCpuSockets()
-	}
+		if vmi.Spec.Domain.CPU.MaxSockets == 0 {
+			vmi.Spec.Domain.CPU.MaxSockets = c.clusterConfig.GetMaximumCpuSockets()
+		}
 
-	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
-		vmi.Spec.Domain.CPU.MaxSockets = vmi.Spec.Domain.CPU.Sockets * maxSocketsRatio
+		if vmi.Spec.Domain.CPU.MaxSockets == 0 {
+			vmi.Spec.Domain.CPU.MaxSockets = vmi.Spec.Domain.CPU.Sockets * maxSocketsRatio
+		}
+
+		if vmi.Spec.Domain.CPU.MaxSockets == 0 {
+			vmi.Spec.Domain.CPU.MaxSockets = VMIDefaults.Spec.Domain.CPU.Sockets * maxSocketsRatio
+		}
 	}
 
-	if vmi.Spec.Domain.CPU.MaxSockets == 0 {
-		vmi.Spec.Domain.CPU.MaxSockets = VMIDefaults.Spec.Domain.CPU.Sockets * maxSocketsRatio
+	if vm.Spec.LiveUpdateFeatures.Memory != nil &&
+		vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		vmi.Spec.Domain.Memory = vmi.Spec.Domain.Memory.DeepCopy()
+		guest := vmi.Spec.Domain.Memory.Guest
+
+		if vm.Spec.LiveUpdateFeatures.Memory.MaxGuest != nil {
+			vmi.Spec.Domain.Memory.MaxGuest = pointer.P(vm.Spec.LiveUpdateFeatures.Memory.MaxGuest.DeepCopy())
+		}
+
+		if maxGuest := c.clusterConfig.GetMaximumGuestMemory(); vmi.Spec.Domain.Memory.MaxGuest == nil && maxGuest != nil {
+			vmi.Spec.Domain.Memory.MaxGuest = pointer.P(maxGuest.DeepCopy())
+		}
+
+		if vmi.Spec.Domain.Memory.MaxGuest == nil {
+			vmi.Spec.Domain.Memory.MaxGuest = resource.NewQuantity(guest.Value()*maxGuestMemoryRatio, guest.Format)
+		}
 	}
 }
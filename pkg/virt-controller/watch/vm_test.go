@@ -2052,6 +2052,28 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("with run strategy Manual", virtv1.RunStrategyManual),
 		)
 
+		It("should not restart a VirtualMachineInstance which migrated to another cluster", func() {
+			vm, vmi := DefaultVirtualMachine(true)
+
+			vmi.Status.Phase = virtv1.Succeeded
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				SourceNode: "node01",
+				Completed:  true,
+				CrossCluster: &virtv1.CrossClusterMigrationState{
+					MigrationID: "migration-id",
+					ConnectURL:  "192.168.1.1:8080",
+				},
+			}
+
+			addVirtualMachine(vm)
+			vmiFeeder.Add(vmi)
+
+			shouldExpectVMIFinalizerRemoval(vmi)
+			vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Times(1).Return(vm, nil)
+
+			controller.Execute()
+		})
+
 		It("should not delete the VirtualMachineInstance again if it is already marked for deletion", func() {
 			vm, vmi := DefaultVirtualMachine(false)
 			vmi.DeletionTimestamp = now()
@@ -2529,6 +2551,122 @@ var _ = Describe("VirtualMachine", func() {
 				controller.Execute()
 			})
 
+			It("should request a memory dump once the guest panicked", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vm.Status.Created = true
+				vm.Status.Ready = true
+				vm.Spec.Template.Spec.Domain.OnCrash = &virtv1.OnCrash{
+					Action:              virtv1.CrashActionMemoryDump,
+					MemoryDumpClaimName: testPVCName,
+				}
+				addVirtualMachine(vm)
+
+				vmi.Spec = vm.Spec.Template.Spec
+				markAsReady(vmi)
+				vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+					Type:               virtv1.VirtualMachineInstanceGuestPanicked,
+					Status:             k8sv1.ConditionTrue,
+					LastTransitionTime: metav1.Now(),
+				})
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					Expect(arg.(*virtv1.VirtualMachine).Status.MemoryDumpRequest).To(Equal(&virtv1.VirtualMachineMemoryDumpRequest{
+						ClaimName: testPVCName,
+						Phase:     virtv1.MemoryDumpAssociating,
+					}))
+				}).Return(vm, nil)
+
+				controller.Execute()
+			})
+
+			DescribeTable("should not request a crash memory dump", func(onCrash *virtv1.OnCrash, panicked bool, request *virtv1.VirtualMachineMemoryDumpRequest) {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Spec.Domain.OnCrash = onCrash
+				if panicked {
+					vmi.Status.Conditions = []virtv1.VirtualMachineInstanceCondition{{
+						Type:               virtv1.VirtualMachineInstanceGuestPanicked,
+						Status:             k8sv1.ConditionTrue,
+						LastTransitionTime: metav1.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					}}
+				}
+				vm.Status.MemoryDumpRequest = request
+
+				requestCrashMemoryDump(vm, vmi)
+				Expect(vm.Status.MemoryDumpRequest).To(Equal(request))
+			},
+				Entry("when the guest did not panic",
+					&virtv1.OnCrash{Action: virtv1.CrashActionMemoryDump, MemoryDumpClaimName: testPVCName}, false, nil),
+				Entry("when the crash action is not MemoryDump",
+					&virtv1.OnCrash{Action: virtv1.CrashActionPause, MemoryDumpClaimName: testPVCName}, true, nil),
+				Entry("when a memory dump is in progress",
+					&virtv1.OnCrash{Action: virtv1.CrashActionMemoryDump, MemoryDumpClaimName: testPVCName}, true,
+					&virtv1.VirtualMachineMemoryDumpRequest{ClaimName: "other", Phase: virtv1.MemoryDumpInProgress}),
+				Entry("when the crash was already dumped",
+					&virtv1.OnCrash{Action: virtv1.CrashActionMemoryDump, MemoryDumpClaimName: testPVCName}, true,
+					&virtv1.VirtualMachineMemoryDumpRequest{ClaimName: testPVCName, Phase: virtv1.MemoryDumpCompleted, EndTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 5, 0, 0, time.UTC))}),
+			)
+
+			It("should request a memory dump once the watchdog with the dump action fired", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vm.Status.Created = true
+				vm.Status.Ready = true
+				vm.Spec.Template.Spec.Domain.Devices.Watchdog = &virtv1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: virtv1.WatchdogDevice{
+						I6300ESB: &virtv1.I6300ESBWatchdog{Action: virtv1.WatchdogActionDump},
+					},
+					MemoryDumpClaimName: testPVCName,
+				}
+				addVirtualMachine(vm)
+
+				vmi.Spec = vm.Spec.Template.Spec
+				markAsReady(vmi)
+				vmi.Status.WatchdogStatus = &virtv1.VirtualMachineInstanceWatchdogStatus{
+					FiredCount:         1,
+					LastFiredTimestamp: kvpointer.P(metav1.Now()),
+					Action:             virtv1.WatchdogActionDump,
+				}
+				vmiFeeder.Add(vmi)
+
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					Expect(arg.(*virtv1.VirtualMachine).Status.MemoryDumpRequest).To(Equal(&virtv1.VirtualMachineMemoryDumpRequest{
+						ClaimName: testPVCName,
+						Phase:     virtv1.MemoryDumpAssociating,
+					}))
+				}).Return(vm, nil)
+
+				controller.Execute()
+			})
+
+			DescribeTable("should not request a watchdog memory dump", func(claimName string, status *virtv1.VirtualMachineInstanceWatchdogStatus, request *virtv1.VirtualMachineMemoryDumpRequest) {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Spec.Domain.Devices.Watchdog = &virtv1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: virtv1.WatchdogDevice{
+						I6300ESB: &virtv1.I6300ESBWatchdog{Action: virtv1.WatchdogActionDump},
+					},
+					MemoryDumpClaimName: claimName,
+				}
+				vmi.Status.WatchdogStatus = status
+				vm.Status.MemoryDumpRequest = request
+
+				requestWatchdogMemoryDump(vm, vmi)
+				Expect(vm.Status.MemoryDumpRequest).To(Equal(request))
+			},
+				Entry("when the watchdog did not fire", testPVCName, nil, nil),
+				Entry("when no claim name is set", "",
+					&virtv1.VirtualMachineInstanceWatchdogStatus{FiredCount: 1, LastFiredTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)), Action: virtv1.WatchdogActionDump}, nil),
+				Entry("when the watchdog action taken is not dump", testPVCName,
+					&virtv1.VirtualMachineInstanceWatchdogStatus{FiredCount: 1, LastFiredTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)), Action: virtv1.WatchdogActionReset}, nil),
+				Entry("when a memory dump is in progress", testPVCName,
+					&virtv1.VirtualMachineInstanceWatchdogStatus{FiredCount: 1, LastFiredTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)), Action: virtv1.WatchdogActionDump},
+					&virtv1.VirtualMachineMemoryDumpRequest{ClaimName: "other", Phase: virtv1.MemoryDumpInProgress}),
+				Entry("when the firing was already dumped", testPVCName,
+					&virtv1.VirtualMachineInstanceWatchdogStatus{FiredCount: 1, LastFiredTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)), Action: virtv1.WatchdogActionDump},
+					&virtv1.VirtualMachineMemoryDumpRequest{ClaimName: testPVCName, Phase: virtv1.MemoryDumpCompleted, EndTimestamp: kvpointer.P(metav1.Date(2023, 1, 1, 0, 5, 0, 0, time.UTC))}),
+			)
+
 			It("should update memory dump phase to InProgress when memory dump in vm volumes", func() {
 				vm, vmi := DefaultVirtualMachine(true)
 				vm.Status.Created = true
@@ -4962,6 +5100,372 @@ var _ = Describe("VirtualMachine", func() {
 				vmi := controller.setupVMIFromVM(vm)
 				Expect(vmi.Spec.Domain.CPU.MaxSockets).To(Equal(defaultSockets * 4))
 			})
+
+			Context("memory", func() {
+				var (
+					guestMemory          = resource.MustParse("1Gi")
+					maxGuestFromSpec     = resource.MustParse("2Gi")
+					maxGuestFromConfig   = resource.MustParse("8Gi")
+					vmWithMemoryFeatures = func(maxGuest *resource.Quantity) *virtv1.VirtualMachine {
+						vm, _ := DefaultVirtualMachine(true)
+						vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{
+							Memory: &virtv1.LiveUpdateMemory{MaxGuest: maxGuest},
+						}
+						vm.Spec.Template.Spec.Domain.Memory = &virtv1.Memory{Guest: &guestMemory}
+						return vm
+					}
+				)
+
+				It("should honour the maximum guest memory from VM spec", func() {
+					vm := vmWithMemoryFeatures(&maxGuestFromSpec)
+					testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								LiveUpdateConfiguration: &virtv1.LiveUpdateConfiguration{
+									MaxGuest: &maxGuestFromConfig,
+								},
+							},
+						},
+					})
+
+					vmi := controller.setupVMIFromVM(vm)
+					Expect(vmi.Spec.Domain.Memory.MaxGuest.Value()).To(Equal(maxGuestFromSpec.Value()))
+					Expect(vm.Spec.Template.Spec.Domain.Memory.MaxGuest).To(BeNil())
+				})
+
+				It("should use maximum guest memory configured in cluster config when its not set in VM spec", func() {
+					vm := vmWithMemoryFeatures(nil)
+					testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								LiveUpdateConfiguration: &virtv1.LiveUpdateConfiguration{
+									MaxGuest: &maxGuestFromConfig,
+								},
+							},
+						},
+					})
+
+					vmi := controller.setupVMIFromVM(vm)
+					Expect(vmi.Spec.Domain.Memory.MaxGuest.Value()).To(Equal(maxGuestFromConfig.Value()))
+				})
+
+				It("should calculate max guest memory to be 4x times the guest memory when no max guest defined", func() {
+					vm := vmWithMemoryFeatures(nil)
+
+					vmi := controller.setupVMIFromVM(vm)
+					Expect(vmi.Spec.Domain.Memory.MaxGuest.Value()).To(Equal(guestMemory.Value() * 4))
+					Expect(vmi.Spec.Domain.CPU).To(BeNil())
+				})
+
+				It("should copy the guest memory to the VMI status", func() {
+					vm := vmWithMemoryFeatures(nil)
+
+					vmi := controller.setupVMIFromVM(vm)
+					Expect(vmi.Status.Memory).ToNot(BeNil())
+					Expect(vmi.Status.Memory.GuestAtBoot.Value()).To(Equal(guestMemory.Value()))
+					Expect(vmi.Status.Memory.GuestCurrent.Value()).To(Equal(guestMemory.Value()))
+				})
+			})
+		})
+
+		Context("Memory hotplug", func() {
+			var (
+				vm  *virtv1.VirtualMachine
+				vmi *virtv1.VirtualMachineInstance
+			)
+
+			BeforeEach(func() {
+				vm, vmi = DefaultVirtualMachine(true)
+				vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{
+					Memory: &virtv1.LiveUpdateMemory{},
+				}
+				vm.Spec.Template.Spec.Domain.Memory = &virtv1.Memory{Guest: kvpointer.P(resource.MustParse("2Gi"))}
+
+				vmi.Spec.Domain.Memory = &virtv1.Memory{
+					Guest:    kvpointer.P(resource.MustParse("1Gi")),
+					MaxGuest: kvpointer.P(resource.MustParse("4Gi")),
+				}
+				vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+					k8sv1.ResourceMemory: resource.MustParse("1Gi"),
+				}
+			})
+
+			It("should patch the guest memory and the memory request of the VMI", func() {
+				ops := []string{
+					`{ "op": "test", "path": "/spec/domain/memory/guest", "value": "1Gi"}`,
+					`{ "op": "replace", "path": "/spec/domain/memory/guest", "value": "2Gi"}`,
+					`{ "op": "test", "path": "/spec/domain/resources/requests/memory", "value": "1Gi"}`,
+					`{ "op": "replace", "path": "/spec/domain/resources/requests/memory", "value": "2Gi"}`,
+				}
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte("["+strings.Join(ops, ", ")+"]"), &metav1.PatchOptions{}).Return(vmi, nil)
+
+				Expect(controller.handleMemoryChangeRequest(vm, vmi)).To(Succeed())
+
+				controller.processRestartRequiredCondition(vm, vmi)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired)).To(BeFalse())
+			})
+
+			It("should fail while another memory hotplug is in progress", func() {
+				vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+					Type:   virtv1.VirtualMachineInstanceMemoryChange,
+					Status: k8sv1.ConditionTrue,
+				})
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleMemoryChangeRequest(vm, vmi)).To(MatchError(ContainSubstring("another memory hotplug is in progress")))
+			})
+
+			DescribeTable("should require a restart instead of hotplugging", func(modify func(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance), reason string) {
+				modify(vm, vmi)
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleMemoryChangeRequest(vm, vmi)).To(Succeed())
+
+				controller.processRestartRequiredCondition(vm, vmi)
+				cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, virtv1.VirtualMachineRestartRequired)
+				Expect(cond).ToNot(BeNil())
+				Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(cond.Message).To(ContainSubstring(reason))
+			},
+				Entry("when memory live update is not enabled", func(vm *virtv1.VirtualMachine, _ *virtv1.VirtualMachineInstance) {
+					vm.Spec.LiveUpdateFeatures = nil
+				}, "memory live update is not enabled"),
+				Entry("when the VMI has no hotpluggable memory", func(_ *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+					vmi.Spec.Domain.Memory.MaxGuest = nil
+				}, "not started with hotpluggable memory"),
+				Entry("when the guest memory is decreased", func(vm *virtv1.VirtualMachine, _ *virtv1.VirtualMachineInstance) {
+					vm.Spec.Template.Spec.Domain.Memory.Guest = kvpointer.P(resource.MustParse("512Mi"))
+				}, "memory unplug is not supported"),
+				Entry("when the guest memory exceeds the maximum", func(vm *virtv1.VirtualMachine, _ *virtv1.VirtualMachineInstance) {
+					vm.Spec.Template.Spec.Domain.Memory.Guest = kvpointer.P(resource.MustParse("8Gi"))
+				}, "exceeds the maximum of 4Gi"),
+				Entry("when the VMI is not live migratable", func(_ *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+					vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+						Type:   virtv1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionFalse,
+					})
+				}, "requires a live migratable VMI"),
+			)
+
+			It("should remove the restart required condition once the VMI matches the VM", func() {
+				vm.Status.Conditions = append(vm.Status.Conditions, virtv1.VirtualMachineCondition{
+					Type:   virtv1.VirtualMachineRestartRequired,
+					Status: k8sv1.ConditionTrue,
+				})
+				vmi.Spec.Domain.Memory.Guest = kvpointer.P(resource.MustParse("2Gi"))
+
+				controller.processRestartRequiredCondition(vm, vmi)
+				Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired)).To(BeFalse())
+			})
+		})
+
+		Context("I/O limits", func() {
+			var (
+				vm  *virtv1.VirtualMachine
+				vmi *virtv1.VirtualMachineInstance
+			)
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.VMLiveUpdateFeaturesGate},
+							},
+						},
+					},
+				})
+
+				vm, vmi = DefaultVirtualMachine(true)
+				vmi.Status.Phase = virtv1.Running
+				vm.Spec.Template.Spec.Domain.Devices.Disks = []virtv1.Disk{{
+					Name:   "disk0",
+					IOTune: &virtv1.DiskIOTune{TotalIopsSec: 200},
+				}}
+				vmi.Spec.Domain.Devices.Disks = []virtv1.Disk{{
+					Name:   "disk0",
+					IOTune: &virtv1.DiskIOTune{TotalIopsSec: 100},
+				}}
+			})
+
+			It("should patch the changed limits of the VMI disks", func() {
+				ops := []string{
+					`{ "op": "test", "path": "/spec/domain/devices/disks", "value": [{"name":"disk0","ioTune":{"totalIopsSec":100}}]}`,
+					`{ "op": "replace", "path": "/spec/domain/devices/disks", "value": [{"name":"disk0","ioTune":{"totalIopsSec":200}}]}`,
+				}
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte("["+strings.Join(ops, ", ")+"]"), &metav1.PatchOptions{}).Return(vmi, nil)
+
+				Expect(controller.handleIOLimitsChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when the limits match", func() {
+				vmi.Spec.Domain.Devices.Disks[0].IOTune.TotalIopsSec = 200
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleIOLimitsChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when live update features are disabled", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleIOLimitsChangeRequest(vm, vmi)).To(Succeed())
+			})
+		})
+
+		Context("interface changes", func() {
+			var (
+				vm  *virtv1.VirtualMachine
+				vmi *virtv1.VirtualMachineInstance
+			)
+
+			BeforeEach(func() {
+				vm, vmi = DefaultVirtualMachine(true)
+				vmi.Status.Phase = virtv1.Running
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{
+					Name:  "default",
+					State: virtv1.InterfaceStateLinkDown,
+				}}
+				vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default"}}
+			})
+
+			It("should patch the changed link states of the VMI interfaces", func() {
+				networksJSON, err := json.Marshal(vmi.Spec.Networks)
+				Expect(err).ToNot(HaveOccurred())
+				ops := []string{
+					fmt.Sprintf(`{ "op": "test", "path": "/spec/networks", "value": %s}`, networksJSON),
+					`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default"}]}`,
+					fmt.Sprintf(`{ "op": "add", "path": "/spec/networks", "value": %s}`, networksJSON),
+					`{ "op": "add", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","state":"down"}]}`,
+				}
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte("["+strings.Join(ops, ", ")+"]"), &metav1.PatchOptions{}).Return(vmi, nil)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should patch the changed bandwidth limits and link states of the VMI interfaces at once", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							DeveloperConfiguration: &v1.DeveloperConfiguration{
+								FeatureGates: []string{virtconfig.VMLiveUpdateFeaturesGate},
+							},
+						},
+					},
+				})
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{Inbound: &virtv1.BandwidthLimit{Average: 1000}}
+
+				networksJSON, err := json.Marshal(vmi.Spec.Networks)
+				Expect(err).ToNot(HaveOccurred())
+				ops := []string{
+					fmt.Sprintf(`{ "op": "test", "path": "/spec/networks", "value": %s}`, networksJSON),
+					`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default"}]}`,
+					fmt.Sprintf(`{ "op": "add", "path": "/spec/networks", "value": %s}`, networksJSON),
+					`{ "op": "add", "path": "/spec/domain/devices/interfaces", "value": [{"name":"default","state":"down","bandwidth":{"inbound":{"average":1000}}}]}`,
+				}
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte("["+strings.Join(ops, ", ")+"]"), &metav1.PatchOptions{}).Return(vmi, nil)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the bandwidth limits when live update features are disabled", func() {
+				vmi.Spec.Domain.Devices.Interfaces[0].State = virtv1.InterfaceStateLinkDown
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].Bandwidth = &virtv1.InterfaceBandwidth{Inbound: &virtv1.BandwidthLimit{Average: 1000}}
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when the link states match", func() {
+				vmi.Spec.Domain.Devices.Interfaces[0].State = virtv1.InterfaceStateLinkDown
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when the interface is being unplugged", func() {
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].State = virtv1.InterfaceStateAbsent
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when it is not running", func() {
+				vmi.Status.Phase = virtv1.Scheduled
+				vmiInterface.EXPECT().Patch(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.handleInterfaceChangeRequest(vm, vmi)).To(Succeed())
+			})
+		})
+
+		Context("MAC address pool", func() {
+			var vm *virtv1.VirtualMachine
+
+			BeforeEach(func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							NetworkConfiguration: &v1.NetworkConfiguration{
+								MacAddressPool: &v1.MacAddressPool{
+									Ranges: []v1.MacAddressRange{{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:02"}},
+								},
+							},
+						},
+					},
+				})
+
+				usedVM, _ := DefaultVirtualMachineWithNames(false, "used", "used")
+				usedVM.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default", MacAddress: "02:00:00:00:00:00"}}
+				Expect(vmInformer.GetIndexer().Add(usedVM)).To(Succeed())
+
+				vm, _ = DefaultVirtualMachine(false)
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{
+					{Name: "default"},
+					{Name: "secondary", MacAddress: "de:ad:00:00:be:af"},
+				}
+			})
+
+			It("should allocate unused MAC addresses to the interfaces without one", func() {
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, arg *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+					interfaces := arg.Spec.Template.Spec.Domain.Devices.Interfaces
+					Expect(interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+					Expect(interfaces[1].MacAddress).To(Equal("de:ad:00:00:be:af"))
+					return arg, nil
+				})
+
+				updatedVM, err := controller.allocateMacAddresses(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+			})
+
+			It("should not hand out a MAC address twice before the VM update is observed", func() {
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, arg *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+					return arg, nil
+				}).Times(2)
+
+				firstVM, err := controller.allocateMacAddresses(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				secondVM, err := controller.allocateMacAddresses(vm, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(firstVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:01"))
+				Expect(secondVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(Equal("02:00:00:00:00:02"))
+			})
+
+			It("should not allocate MAC addresses while the VMI exists", func() {
+				vmInterface.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+				updatedVM, err := controller.allocateMacAddresses(vm, api.NewMinimalVMI(vm.Name))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVM).To(Equal(vm))
+			})
+
+			It("should fail when the pool is exhausted", func() {
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+				vmInterface.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+				_, err := controller.allocateMacAddresses(vm, nil)
+				Expect(err).To(HaveOccurred())
+			})
 		})
 
 		Context("CPU topology", func() {
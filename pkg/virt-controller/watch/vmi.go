@@ -728,6 +728,10 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			c.syncCPUHotplug(vmiCopy)
 		}
 
+		if c.requireMemoryHotplug(vmiCopy) {
+			c.syncMemoryHotplug(vmiCopy)
+		}
+
 	case vmi.IsScheduled():
 		// Nothing here
 		break
@@ -1183,7 +1187,7 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 			return nil
 		}
 		// let's check if we already have topology hints or if we are still waiting for them
-		if vmi.Status.TopologyHints == nil && c.topologyHinter.IsTscFrequencyRequired(vmi) {
+		if vmi.Status.TopologyHints == nil && (c.topologyHinter.IsTscFrequencyRequired(vmi) || topology.IsKSMOvercommitRequested(vmi)) {
 			log.Log.V(3).Object(vmi).Infof("Delaying pod creation until topology hints are set")
 			return nil
 		}
@@ -2275,7 +2279,7 @@ func (c *VMIController) handleDynamicInterfaceRequests(namespace string, interfa
 
 	indexedMultusStatusIfaces := services.NonDefaultMultusNetworksIndexedByIfaceName(pod)
 	networkToPodIfaceMap := namescheme.CreateNetworkNameSchemeByPodNetworkStatus(networks, indexedMultusStatusIfaces)
-	multusAnnotations, err := services.GenerateMultusCNIAnnotationFromNameScheme(namespace, interfaces, networks, networkToPodIfaceMap)
+	multusAnnotations, err := services.GenerateMultusCNIAnnotationFromNameScheme(namespace, interfaces, networks, networkToPodIfaceMap, c.clusterConfig.GetNetworkBindings())
 	if err != nil {
 		return err
 	}
@@ -2359,3 +2363,27 @@ func (c *VMIController) requireCPUHotplug(vmi *virtv1.VirtualMachineInstance) bo
 
 	return hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU) != hardware.GetNumberOfVCPUs(cpuTopoLogyFromStatus)
 }
+
+func (c *VMIController) syncMemoryHotplug(vmi *virtv1.VirtualMachineInstance) {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+	condition := virtv1.VirtualMachineInstanceCondition{
+		Type:   virtv1.VirtualMachineInstanceMemoryChange,
+		Status: k8sv1.ConditionTrue,
+	}
+	if !vmiConditions.HasCondition(vmi, condition.Type) {
+		vmiConditions.UpdateCondition(vmi, &condition)
+		log.Log.Object(vmi).V(4).Infof("hot plug memory vmi %s", vmi.Name)
+	}
+}
+
+func (c *VMIController) requireMemoryHotplug(vmi *virtv1.VirtualMachineInstance) bool {
+	if vmi.Status.Memory == nil ||
+		vmi.Status.Memory.GuestCurrent == nil ||
+		vmi.Spec.Domain.Memory == nil ||
+		vmi.Spec.Domain.Memory.Guest == nil ||
+		vmi.Spec.Domain.Memory.MaxGuest == nil {
+		return false
+	}
+
+	return !vmi.Spec.Domain.Memory.Guest.Equal(*vmi.Status.Memory.GuestCurrent)
+}
@@ -3090,6 +3090,16 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				runController(vmi)
 				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
 			})
+
+			It("does not need to happen until the ksm memory overcommit is set on overcommit-tolerant VMIs", func() {
+				vmi := NewPendingVirtualMachine("testvmi")
+				vmi.Spec.Domain.Resources.OvercommitWithKSM = true
+
+				vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					Expect(topology.AreKSMTopologyHintsDefined(arg.(*virtv1.VirtualMachineInstance))).To(BeTrue())
+				}).Return(vmi, nil)
+				runController(vmi)
+			})
 		})
 	})
 
@@ -3410,7 +3420,7 @@ func setReadyCondition(vmi *virtv1.VirtualMachineInstance, status k8sv1.Conditio
 	})
 }
 func NewPodForVirtualMachine(vmi *virtv1.VirtualMachineInstance, phase k8sv1.PodPhase, podNetworkStatus ...networkv1.NetworkStatus) *k8sv1.Pod {
-	multusAnnotations, _ := services.GenerateMultusCNIAnnotation(vmi.Namespace, vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks)
+	multusAnnotations, _ := services.GenerateMultusCNIAnnotation(vmi.Namespace, vmi.Spec.Domain.Devices.Interfaces, vmi.Spec.Networks, nil)
 	podAnnotations := map[string]string{
 		virtv1.DomainAnnotation: vmi.Name,
 	}
@@ -213,12 +213,11 @@ func (c *WorkloadUpdateController) updateVmi(_, obj interface{}) {
 		return
 	}
 
-	condManager := controller.NewVirtualMachineInstanceConditionManager()
 	if vmi.IsFinal() {
 		return
 	}
 
-	if !condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) || migrationutils.IsMigrating(vmi) {
+	if !(controller.VMIHasHotplugCPU(vmi) || controller.VMIHasHotplugMemory(vmi)) || migrationutils.IsMigrating(vmi) {
 		return
 	}
 
@@ -317,11 +316,10 @@ func (c *WorkloadUpdateController) isOutdated(vmi *virtv1.VirtualMachineInstance
 }
 
 func (c *WorkloadUpdateController) doesRequireMigration(vmi *virtv1.VirtualMachineInstance) bool {
-	condManager := controller.NewVirtualMachineInstanceConditionManager()
 	if vmi.IsFinal() {
 		return false
 	}
-	if condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) && !migrationutils.IsMigrating(vmi) {
+	if (controller.VMIHasHotplugCPU(vmi) || controller.VMIHasHotplugMemory(vmi)) && !migrationutils.IsMigrating(vmi) {
 		return true
 	}
 
@@ -79,6 +79,8 @@ type MigrationOptions struct {
 	AllowAutoConverge        bool
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
+	Compression              v1.MigrationCompression
+	AllowWorkloadDisruption  bool
 }
 
 type LauncherClient interface {
@@ -109,9 +111,15 @@ type LauncherClient interface {
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error
+	SyncVirtualMachineIOLimits(vmi *v1.VirtualMachineInstance) error
+	SyncVirtualMachineInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
+	GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	BackupVirtualMachine(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceBackupOptions) error
+	AbortVirtualMachineBackup(*v1.VirtualMachineInstance) error
 }
 
 type VirtLauncherClient struct {
@@ -541,6 +549,18 @@ func (c *VirtLauncherClient) SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstan
 	return c.genericSendVMICmd("SyncVirtualMachineCPUs", c.v1client.SyncVirtualMachineCPUs, vmi, options)
 }
 
+func (c *VirtLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("SyncVirtualMachineMemory", c.v1client.SyncVirtualMachineMemory, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) SyncVirtualMachineIOLimits(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("SyncVirtualMachineIOLimits", c.v1client.SyncVirtualMachineIOLimits, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) SyncVirtualMachineInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("SyncVirtualMachineInterfaceLinkStates", c.v1client.SyncVirtualMachineInterfaceLinkStates, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
 func (c *VirtLauncherClient) SignalTargetPodCleanup(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("SignalTargetPodCleanup", c.v1client.SignalTargetPodCleanup, vmi, &cmdv1.VirtualMachineOptions{})
 }
@@ -828,6 +848,36 @@ func (c *VirtLauncherClient) GetLaunchMeasurement(vmi *v1.VirtualMachineInstance
 	return sevMeasurementInfo, nil
 }
 
+func (c *VirtLauncherClient) GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.AttestationReportRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Nonce: nonce,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	attestationReportResponse, err := c.v1client.GetAttestationReport(ctx, request)
+	if err = handleError(err, "GetAttestationReport", attestationReportResponse.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	attestationReport := &v1.AttestationReport{}
+	if err := json.Unmarshal(attestationReportResponse.GetAttestationReport(), attestationReport); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling attestation report response")
+		return nil, err
+	}
+
+	return attestationReport, nil
+}
+
 func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance, sevSecretOptions *v1.SEVSecretOptions) error {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
@@ -853,3 +903,33 @@ func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance,
 
 	return handleError(err, "InjectLaunchSecret", response)
 }
+
+func (c *VirtLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, backupOptions *v1.VirtualMachineInstanceBackupOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	optionsJson, err := json.Marshal(backupOptions)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	response, err := c.v1client.BackupVirtualMachine(ctx, request)
+
+	return handleError(err, "BackupVirtualMachine", response)
+}
+
+func (c *VirtLauncherClient) AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("AbortVirtualMachineBackup", c.v1client.AbortVirtualMachineBackup, vmi, &cmdv1.VirtualMachineOptions{})
+}
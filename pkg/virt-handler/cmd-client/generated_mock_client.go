@@ -311,6 +311,36 @@ func (_mr *_MockLauncherClientRecorder) SyncVirtualMachineCPUs(arg0, arg1 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SyncVirtualMachineCPUs", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "SyncVirtualMachineMemory", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) SyncVirtualMachineMemory(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SyncVirtualMachineMemory", arg0)
+}
+
+func (_m *MockLauncherClient) SyncVirtualMachineIOLimits(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "SyncVirtualMachineIOLimits", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) SyncVirtualMachineIOLimits(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SyncVirtualMachineIOLimits", arg0)
+}
+
+func (_m *MockLauncherClient) SyncVirtualMachineInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "SyncVirtualMachineInterfaceLinkStates", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) SyncVirtualMachineInterfaceLinkStates(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SyncVirtualMachineInterfaceLinkStates", arg0)
+}
+
 func (_m *MockLauncherClient) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	ret := _m.ctrl.Call(_m, "GetSEVInfo")
 	ret0, _ := ret[0].(*v1.SEVPlatformInfo)
@@ -333,6 +363,17 @@ func (_mr *_MockLauncherClientRecorder) GetLaunchMeasurement(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetLaunchMeasurement", arg0)
 }
 
+func (_m *MockLauncherClient) GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error) {
+	ret := _m.ctrl.Call(_m, "GetAttestationReport", vmi, nonce)
+	ret0, _ := ret[0].(*v1.AttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GetAttestationReport(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetAttestationReport", arg0, arg1)
+}
+
 func (_m *MockLauncherClient) InjectLaunchSecret(_param0 *v1.VirtualMachineInstance, _param1 *v1.SEVSecretOptions) error {
 	ret := _m.ctrl.Call(_m, "InjectLaunchSecret", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -342,3 +383,23 @@ func (_m *MockLauncherClient) InjectLaunchSecret(_param0 *v1.VirtualMachineInsta
 func (_mr *_MockLauncherClientRecorder) InjectLaunchSecret(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
+
+func (_m *MockLauncherClient) BackupVirtualMachine(_param0 *v1.VirtualMachineInstance, _param1 *v1.VirtualMachineInstanceBackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVirtualMachine", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) BackupVirtualMachine(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVirtualMachine", arg0, arg1)
+}
+
+func (_m *MockLauncherClient) AbortVirtualMachineBackup(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "AbortVirtualMachineBackup", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) AbortVirtualMachineBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortVirtualMachineBackup", arg0)
+}
@@ -20,6 +20,7 @@
 package migrationproxy
 
 import (
+	"bufio"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -29,6 +30,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
@@ -43,8 +45,16 @@ const (
 	LibvirtBlockMigrationPort  = 49153
 )
 
+const (
+	// maxStreamHeaderLength bounds the header announcing a cross cluster migration stream
+	maxStreamHeaderLength = 256
+)
+
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 
+// streamHeaderTimeout is the time the sending cluster has to announce a cross cluster migration stream
+var streamHeaderTimeout = 10 * time.Second
+
 type ProxyManager interface {
 	StartTargetListener(key string, targetUnixFiles []string) error
 	GetTargetListenerPorts(key string) map[string]int
@@ -54,6 +64,9 @@ type ProxyManager interface {
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
+	StartCrossClusterTargetListener(key string, migrationID string, targetUnixFiles []string) error
+	StartCrossClusterSourceListener(key string, migrationID string, connectURL string, ports []int, baseDir string) error
+
 	OpenListenerCount() int
 
 	InitiateGracefulShutdown()
@@ -65,6 +78,9 @@ type migrationProxyManager struct {
 	managerLock     sync.Mutex
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
+	// Cross cluster migrations additionally trust the CAs of the peer clusters
+	crossClusterServerTLSConfig *tls.Config
+	crossClusterClientTLSConfig *tls.Config
 
 	isShuttingDown bool
 	config         *virtconfig.ClusterConfig
@@ -89,6 +105,13 @@ type migrationProxy struct {
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
 
+	// Cross cluster migrations carry all libvirt streams over a single
+	// TCP endpoint. Every connection starts with a header naming the
+	// migration and the libvirt port the stream belongs to.
+	migrationID   string
+	streamPort    int
+	streamSockets map[int]string
+
 	logger *log.FilteredLogger
 }
 
@@ -114,16 +137,35 @@ func GetMigrationPortsList(isBlockMigration bool) (ports []int) {
 	return
 }
 
-func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
+func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, crossClusterServerTLSConfig *tls.Config, crossClusterClientTLSConfig *tls.Config, config *virtconfig.ClusterConfig) ProxyManager {
 	return &migrationProxyManager{
-		sourceProxies:   make(map[string][]*migrationProxy),
-		targetProxies:   make(map[string][]*migrationProxy),
-		serverTLSConfig: serverTLSConfig,
-		clientTLSConfig: clientTLSConfig,
-		config:          config,
+		sourceProxies:               make(map[string][]*migrationProxy),
+		targetProxies:               make(map[string][]*migrationProxy),
+		serverTLSConfig:             serverTLSConfig,
+		clientTLSConfig:             clientTLSConfig,
+		crossClusterServerTLSConfig: crossClusterServerTLSConfig,
+		crossClusterClientTLSConfig: crossClusterClientTLSConfig,
+		config:                      config,
 	}
 }
 
+func (m *migrationProxyManager) isTLSDisabled() bool {
+	return m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS
+}
+
+func (m *migrationProxyManager) tlsConfigs() (serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) {
+	if m.isTLSDisabled() {
+		return nil, nil
+	}
+	return m.serverTLSConfig, m.clientTLSConfig
+}
+
+// crossClusterTLSConfigs ignores DisableTLS, the streams of a cross cluster
+// migration leave the cluster network and must never be sent in cleartext.
+func (m *migrationProxyManager) crossClusterTLSConfigs() (serverTLSConfig *tls.Config, clientTLSConfig *tls.Config) {
+	return m.crossClusterServerTLSConfig, m.crossClusterClientTLSConfig
+}
+
 func SourceUnixFile(baseDir string, key string) string {
 	return filepath.Join(baseDir, "migrationproxy", key+"-source.sock")
 }
@@ -169,12 +211,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 
 	zeroAddress := ip.GetIPZeroAddress()
 	proxiesList := []*migrationProxy{}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs()
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
 		proxy := NewTargetProxy(zeroAddress, 0, serverTLSConfig, clientTLSConfig, targetUnixFile, key)
@@ -217,20 +254,20 @@ func ConstructProxyKey(id string, port int) string {
 	return key
 }
 
+func getPortFromSocket(id string, path string) int {
+	for _, port := range migrationPortsRange {
+		key := ConstructProxyKey(id, port)
+		if strings.Contains(path, key) {
+			return port
+		}
+	}
+	return 0
+}
+
 func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]int {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	getPortFromSocket := func(id string, path string) int {
-		for _, port := range migrationPortsRange {
-			key := ConstructProxyKey(id, port)
-			if strings.Contains(path, key) {
-				return port
-			}
-		}
-		return 0
-	}
-
 	curProxies, exists := m.targetProxies[key]
 	targetSrcPortMap := make(map[string]int)
 
@@ -296,12 +333,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 			}
 		}
 	}
-	serverTLSConfig := m.serverTLSConfig
-	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
-		serverTLSConfig = nil
-		clientTLSConfig = nil
-	}
+	serverTLSConfig, clientTLSConfig := m.tlsConfigs()
 	proxiesList := []*migrationProxy{}
 	for destPort, srcPort := range destSrcPortMap {
 		proxyKey := ConstructProxyKey(key, srcPort)
@@ -343,6 +375,115 @@ func (m *migrationProxyManager) StopSourceListener(key string) {
 	}
 }
 
+// StartCrossClusterTargetListener exposes a single TCP listener which accepts the
+// libvirt streams of a migration coming from another cluster and dispatches them
+// to the matching target unix sockets. The listener port is reported by
+// GetTargetListenerPorts like the one of the libvirt control socket.
+func (m *migrationProxyManager) StartCrossClusterTargetListener(key string, migrationID string, targetUnixFiles []string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+
+	streamSockets := make(map[int]string)
+	for _, targetUnixFile := range targetUnixFiles {
+		streamSockets[getPortFromSocket(key, targetUnixFile)] = targetUnixFile
+	}
+
+	isExistingProxy := func(curProxies []*migrationProxy) bool {
+		if len(curProxies) != 1 || curProxies[0].migrationID != migrationID ||
+			len(curProxies[0].streamSockets) != len(streamSockets) {
+			return false
+		}
+		for port, file := range streamSockets {
+			if curProxies[0].streamSockets[port] != file {
+				return false
+			}
+		}
+		return true
+	}
+
+	if curProxies, exists := m.targetProxies[key]; exists {
+		if isExistingProxy(curProxies) {
+			// No Op, already exists
+			return nil
+		}
+		for _, curProxy := range curProxies {
+			curProxy.logger.Infof("Manager stopping proxy on target node due to new cross cluster migration")
+			curProxy.Stop()
+		}
+	}
+
+	serverTLSConfig, clientTLSConfig := m.crossClusterTLSConfigs()
+	proxy := NewCrossClusterTargetProxy(ip.GetIPZeroAddress(), 0, serverTLSConfig, clientTLSConfig, streamSockets, key, migrationID)
+	if err := proxy.Start(); err != nil {
+		proxy.Stop()
+		delete(m.targetProxies, key)
+		return err
+	}
+	proxy.logger.Infof("Manager created cross cluster proxy on target")
+	m.targetProxies[key] = []*migrationProxy{proxy}
+	return nil
+}
+
+// StartCrossClusterSourceListener exposes a unix socket for each of the given libvirt
+// ports and tunnels all of them to the single endpoint of the receiving cluster.
+func (m *migrationProxyManager) StartCrossClusterSourceListener(key string, migrationID string, connectURL string, ports []int, baseDir string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.isShuttingDown {
+		return fmt.Errorf("unable to process new migration connections during virt-handler shutdown")
+	}
+
+	isExistingProxy := func(curProxies []*migrationProxy) bool {
+		if len(curProxies) != len(ports) {
+			return false
+		}
+		for _, curProxy := range curProxies {
+			if curProxy.targetAddress != connectURL || curProxy.migrationID != migrationID {
+				return false
+			}
+		}
+		return true
+	}
+
+	if curProxies, exists := m.sourceProxies[key]; exists {
+		if isExistingProxy(curProxies) {
+			// No Op, already exists
+			return nil
+		}
+		for _, curProxy := range curProxies {
+			curProxy.logger.Infof("Manager is stopping proxy on source node due to new target location")
+			curProxy.Stop()
+		}
+	}
+
+	serverTLSConfig, clientTLSConfig := m.crossClusterTLSConfigs()
+	proxiesList := []*migrationProxy{}
+	for _, port := range ports {
+		filePath := SourceUnixFile(baseDir, ConstructProxyKey(key, port))
+		os.RemoveAll(filePath)
+
+		proxy := NewCrossClusterSourceProxy(filePath, connectURL, serverTLSConfig, clientTLSConfig, key, migrationID, port)
+		if err := proxy.Start(); err != nil {
+			proxy.Stop()
+			// close all already created proxies for this key
+			for _, curProxy := range proxiesList {
+				curProxy.Stop()
+			}
+			delete(m.sourceProxies, key)
+			return err
+		}
+		proxiesList = append(proxiesList, proxy)
+		proxy.logger.Infof("Manager created cross cluster proxy on source node")
+	}
+	m.sourceProxies[key] = proxiesList
+	return nil
+}
+
 // SRC POD ENV(migration unix socket) <-> HOST ENV (tcp client) <-----> HOST ENV (tcp server) <-> TARGET POD ENV (virtqemud unix socket)
 
 // Source proxy exposes a unix socket server and pipes to an outbound TCP connection.
@@ -377,6 +518,67 @@ func NewTargetProxy(tcpBindAddress string, tcpBindPort int, serverTLSConfig *tls
 
 }
 
+// Cross cluster source proxy exposes a unix socket server and pipes to an outbound TCP connection
+// to the receiving cluster, announcing the libvirt port the stream belongs to.
+func NewCrossClusterSourceProxy(unixSocketPath string, tcpTargetAddress string, serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, vmiUID string, migrationID string, streamPort int) *migrationProxy {
+	proxy := NewSourceProxy(unixSocketPath, tcpTargetAddress, serverTLSConfig, clientTLSConfig, vmiUID)
+	proxy.migrationID = migrationID
+	proxy.streamPort = streamPort
+	proxy.logger = proxy.logger.With("migrationID", migrationID)
+	return proxy
+}
+
+// Cross cluster target proxy listens on a tcp socket and pipes each stream to the
+// unix socket matching the libvirt port announced by the sending cluster.
+func NewCrossClusterTargetProxy(tcpBindAddress string, tcpBindPort int, serverTLSConfig *tls.Config, clientTLSConfig *tls.Config, streamSockets map[int]string, vmiUID string, migrationID string) *migrationProxy {
+	proxy := NewTargetProxy(tcpBindAddress, tcpBindPort, serverTLSConfig, clientTLSConfig, "", vmiUID)
+	proxy.migrationID = migrationID
+	proxy.streamSockets = streamSockets
+	proxy.logger = log.Log.With("uid", vmiUID).With("migrationID", migrationID)
+	return proxy
+}
+
+func (m *migrationProxy) isCrossClusterSource() bool {
+	return m.migrationID != "" && m.targetProtocol == "tcp"
+}
+
+func (m *migrationProxy) isCrossClusterTarget() bool {
+	return m.migrationID != "" && m.targetProtocol == "unix"
+}
+
+func writeStreamHeader(conn io.Writer, migrationID string, port int) error {
+	_, err := fmt.Fprintf(conn, "%s %d\n", migrationID, port)
+	return err
+}
+
+// readStreamHeader reads the header announcing a cross cluster migration stream, the
+// reader has to be limited to maxStreamHeaderLength bytes of buffer.
+func (m *migrationProxy) readStreamHeader(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadSlice('\n')
+	if err == bufio.ErrBufferFull {
+		return "", fmt.Errorf("stream header exceeds %d bytes", maxStreamHeaderLength)
+	} else if err != nil {
+		return "", fmt.Errorf("failed to read stream header: %v", err)
+	}
+	header := string(line)
+	fields := strings.Fields(header)
+	if len(fields) != 2 {
+		return "", fmt.Errorf("malformed stream header %q", header)
+	}
+	if fields[0] != m.migrationID {
+		return "", fmt.Errorf("stream belongs to unexpected migration %s", fields[0])
+	}
+	port, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return "", fmt.Errorf("malformed stream port %q: %v", fields[1], err)
+	}
+	socket, exists := m.streamSockets[port]
+	if !exists {
+		return "", fmt.Errorf("no target socket for stream port %d", port)
+	}
+	return socket, nil
+}
+
 func (m *migrationProxy) createTcpListener() error {
 	var listener net.Listener
 	var err error
@@ -442,17 +644,47 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 	outBoundErr := make(chan error, 1)
 	inBoundErr := make(chan error, 1)
 
+	var inbound io.Reader = fd
+	targetAddress := m.targetAddress
+	if m.isCrossClusterTarget() {
+		reader := bufio.NewReaderSize(fd, maxStreamHeaderLength)
+		if err := fd.SetReadDeadline(time.Now().Add(streamHeaderTimeout)); err != nil {
+			m.logger.Reason(err).Error("unable to set the deadline of the cross cluster migration stream header")
+			return
+		}
+		socket, err := m.readStreamHeader(reader)
+		if err != nil {
+			m.logger.Reason(err).Error("rejecting cross cluster migration stream")
+			return
+		}
+		if err := fd.SetReadDeadline(time.Time{}); err != nil {
+			m.logger.Reason(err).Error("unable to clear the deadline of the cross cluster migration stream")
+			return
+		}
+		targetAddress = socket
+		// the reader may already hold data following the header
+		inbound = reader
+	}
+
 	var conn net.Conn
 	var err error
 	if m.targetProtocol == "tcp" && m.clientTLSConfig != nil {
-		conn, err = tls.Dial(m.targetProtocol, m.targetAddress, m.clientTLSConfig)
+		conn, err = tls.Dial(m.targetProtocol, targetAddress, m.clientTLSConfig)
 	} else {
-		conn, err = net.Dial(m.targetProtocol, m.targetAddress)
+		conn, err = net.Dial(m.targetProtocol, targetAddress)
 	}
 	if err != nil {
 		m.logger.Reason(err).Error("unable to create outbound leg of proxy to host")
 		return
 	}
+	defer conn.Close()
+
+	if m.isCrossClusterSource() {
+		if err := writeStreamHeader(conn, m.migrationID, m.streamPort); err != nil {
+			m.logger.Reason(err).Error("unable to announce cross cluster migration stream")
+			return
+		}
+	}
 
 	go func() {
 		//from outbound connection to proxy
@@ -462,7 +694,7 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 	}()
 	go func() {
 		//from proxy to outbound connection
-		n, err := io.Copy(conn, fd)
+		n, err := io.Copy(conn, inbound)
 		m.logger.Infof("%d bytes copied from inbound to outbound", n)
 		outBoundErr <- err
 	}()
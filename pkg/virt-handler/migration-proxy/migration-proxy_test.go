@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -163,7 +164,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
 				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
@@ -213,6 +214,160 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
 			)
 
+			DescribeTable("by tunneling all streams of a cross cluster migration through a single endpoint", func(migrationConfig *v1.MigrationConfiguration) {
+				key := "mykey"
+				directMigrationPort := "49152"
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+				directSock := filepath.Join(tmpDir, key+"-"+directMigrationPort)
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer directListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: migrationConfig,
+				})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
+				Expect(manager.StartCrossClusterTargetListener(key, "migration-id", []string{virtqemudSock, directSock})).To(Succeed())
+				defer manager.StopTargetListener(key)
+
+				targetPorts := manager.GetTargetListenerPorts(key)
+				Expect(targetPorts).To(HaveLen(1))
+				var connectURL string
+				for port := range targetPorts {
+					connectURL = net.JoinHostPort("127.0.0.1", port)
+				}
+
+				Expect(manager.StartCrossClusterSourceListener(key, "migration-id", connectURL, []int{0, 49152}, tmpDir)).To(Succeed())
+				defer manager.StopSourceListener(key)
+				Expect(manager.GetSourceListenerFiles(key)).To(HaveLen(2))
+
+				msgReader := func(listener net.Listener, messages chan string) {
+					fd, err := listener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+
+					var bytes [1024]byte
+					n, err := fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+					messages <- string(bytes[:n])
+				}
+
+				msgWriter := func(sockFile string, messages chan string, message string) {
+					conn, err := net.Dial("unix", sockFile)
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conn.Close()
+
+					_, err = conn.Write([]byte(message))
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(<-messages).To(Equal(message))
+				}
+
+				libvirtChan := make(chan string)
+				directChan := make(chan string)
+				go msgReader(virtqemudListener, libvirtChan)
+				go msgReader(directListener, directChan)
+
+				for _, sockFile := range manager.GetSourceListenerFiles(key) {
+					if strings.Contains(sockFile, directMigrationPort) {
+						msgWriter(sockFile, directChan, "some direct message")
+					} else {
+						msgWriter(sockFile, libvirtChan, "some libvirt message")
+					}
+				}
+			},
+				Entry("with TLS enabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(false)}),
+				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
+			)
+
+			It("by encrypting cross cluster streams even when TLS is disabled", func() {
+				key := "mykey"
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)},
+				})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
+				Expect(manager.StartCrossClusterTargetListener(key, "migration-id", []string{virtqemudSock})).To(Succeed())
+				defer manager.StopTargetListener(key)
+
+				targetPorts := manager.GetTargetListenerPorts(key)
+				Expect(targetPorts).To(HaveLen(1))
+				for port := range targetPorts {
+					conn, err := tls.Dial("tcp", net.JoinHostPort("127.0.0.1", port), tlsConfig)
+					Expect(err).ShouldNot(HaveOccurred())
+					conn.Close()
+				}
+			})
+
+			It("by rejecting cross cluster streams of another migration", func() {
+				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+				virtqemudListener, err := net.Listen("unix", virtqemudSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer virtqemudListener.Close()
+
+				targetProxy := NewCrossClusterTargetProxy("0.0.0.0", 12345, tlsConfig, tlsConfig, map[int]string{0: virtqemudSock}, "123", "expected-id")
+				defer targetProxy.Stop()
+				Expect(targetProxy.Start()).To(Succeed())
+
+				conn, err := tls.Dial("tcp", "127.0.0.1:12345", tlsConfig)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+				Expect(writeStreamHeader(conn, "other-id", 0)).To(Succeed())
+
+				var bytes [1]byte
+				_, err = conn.Read(bytes[0:])
+				Expect(err).To(HaveOccurred())
+			})
+
+			Context("by rejecting cross cluster streams", func() {
+				BeforeEach(func() {
+					virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
+					virtqemudListener, err := net.Listen("unix", virtqemudSock)
+					Expect(err).ShouldNot(HaveOccurred())
+					DeferCleanup(virtqemudListener.Close)
+
+					targetProxy := NewCrossClusterTargetProxy("0.0.0.0", 12346, tlsConfig, tlsConfig, map[int]string{0: virtqemudSock}, "123", "expected-id")
+					DeferCleanup(targetProxy.Stop)
+					Expect(targetProxy.Start()).To(Succeed())
+				})
+
+				dial := func() *tls.Conn {
+					conn, err := tls.Dial("tcp", "127.0.0.1:12346", tlsConfig)
+					Expect(err).ShouldNot(HaveOccurred())
+					DeferCleanup(conn.Close)
+					return conn
+				}
+
+				expectClosedByProxy := func(conn *tls.Conn) {
+					Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+					var bytes [1]byte
+					_, err := conn.Read(bytes[0:])
+					Expect(err).To(HaveOccurred())
+					netErr, isNetErr := err.(net.Error)
+					Expect(isNetErr && netErr.Timeout()).To(BeFalse(), "the proxy should close the connection")
+				}
+
+				It("with a header exceeding the maximum length", func() {
+					conn := dial()
+					_, err := conn.Write([]byte(strings.Repeat("a", maxStreamHeaderLength+1)))
+					Expect(err).ShouldNot(HaveOccurred())
+					expectClosedByProxy(conn)
+				})
+
+				It("with a header not sent in time", func() {
+					originalTimeout := streamHeaderTimeout
+					streamHeaderTimeout = 100 * time.Millisecond
+					DeferCleanup(func() { streamHeaderTimeout = originalTimeout })
+
+					expectClosedByProxy(dial())
+				})
+			})
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -231,7 +386,7 @@ var _ = Describe("MigrationProxy", func() {
 				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 					MigrationConfiguration: migrationConfig,
 				})
-				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
@@ -128,6 +128,7 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 
 	n.hostCapabilities.items = usableModels
 	n.SEV = hostDomCapabilities.SEV
+	n.TDX = hostDomCapabilities.TDX
 
 	return nil
 }
@@ -209,9 +210,33 @@ func (n *NodeLabeller) getDomCapabilities() (HostDomCapabilities, error) {
 		hostDomCapabilities.SEV.SupportedES = "no"
 	}
 
+	hostDomCapabilities.SEV.SupportedSNP = "no"
+	hostDomCapabilities.TDX.Supported = "no"
+	for _, secType := range hostDomCapabilities.LaunchSecurity.supportedTypes() {
+		switch secType {
+		case "sev-snp":
+			hostDomCapabilities.SEV.SupportedSNP = "yes"
+		case "tdx":
+			hostDomCapabilities.TDX.Supported = "yes"
+		}
+	}
+
 	return hostDomCapabilities, err
 }
 
+// supportedTypes returns the launch security types listed in the sectype enum
+func (l LaunchSecurityConfiguration) supportedTypes() []string {
+	if l.Supported != "yes" {
+		return nil
+	}
+	for _, enum := range l.Enums {
+		if enum.Name == "sectype" {
+			return enum.Values
+		}
+	}
+	return nil
+}
+
 // LoadFeatures loads features for given cpu name
 func (n *NodeLabeller) loadFeatures(fileName string) (cpuFeatures, error) {
 	if fileName == "" {
@@ -207,6 +207,20 @@ var _ = Describe("Node-labeller config", func() {
 			Entry("when both SEV and SEV-ES are supported", true, true),
 			Entry("when neither SEV nor SEV-ES are supported", false, false),
 		)
+
+		DescribeTable("for SEV-SNP and TDX",
+			func(domCapabilitiesFileName, expectedSNP, expectedTDX string) {
+				nlController.domCapabilitiesFileName = domCapabilitiesFileName
+				err := nlController.loadDomCapabilities()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(nlController.SEV.SupportedSNP).To(Equal(expectedSNP))
+				Expect(nlController.TDX.Supported).To(Equal(expectedTDX))
+			},
+			Entry("when launch security is not reported", "domcapabilities_sev.xml", "no", "no"),
+			Entry("when SEV-SNP is supported", "domcapabilities_snp.xml", "yes", "no"),
+			Entry("when TDX is supported", "domcapabilities_tdx.xml", "no", "yes"),
+		)
 	})
 
 	It("Make sure proper labels are removed on removeLabellerLabels()", func() {
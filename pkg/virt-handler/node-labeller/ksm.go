@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	memInfoPath = "/proc/meminfo"
+
+	ksmPagesToScanFile   = "pages_to_scan"
+	ksmSleepMillisecFile = "sleep_millisecs"
+	ksmPagesSharedFile   = "pages_shared"
+	ksmPagesSharingFile  = "pages_sharing"
+
+	defaultKSMPagesToScanMin             = 64
+	defaultKSMPagesToScanMax             = 1250
+	defaultKSMSleepMillisecs             = 10
+	defaultKSMFreeMemoryThresholdPercent = 20
+
+	// ksmPagesToScanBoost and ksmPagesToScanDecay are the steps the number of pages to scan
+	// is moved by on every tuning period, depending on the memory pressure of the node
+	ksmPagesToScanBoost = 300
+	ksmPagesToScanDecay = 50
+
+	// ksmSleepReferenceMemoryKiB is the node memory the configured sleep interval refers to (16Gi)
+	ksmSleepReferenceMemoryKiB = 16 * 1024 * 1024
+
+	ksmTuningInterval = 20 * time.Second
+)
+
+type memInfo struct {
+	// MemTotal and MemAvailable are in KiB, as reported by /proc/meminfo
+	MemTotal     uint64
+	MemAvailable uint64
+}
+
+func loadMemInfo(path string) (*memInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info := &memInfo{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var target *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			target = &info.MemTotal
+		case "MemAvailable:":
+			target = &info.MemAvailable
+		default:
+			continue
+		}
+		if *target, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s from %s: %v", fields[0], path, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if info.MemTotal == 0 {
+		return nil, fmt.Errorf("MemTotal not found in %s", path)
+	}
+	return info, nil
+}
+
+func (n *NodeLabeller) ksmFilePath(name string) string {
+	return filepath.Join(filepath.Dir(n.KSM.SysfsFilePath), name)
+}
+
+func (n *NodeLabeller) readKSMValue(name string) (uint64, error) {
+	value, err := os.ReadFile(n.ksmFilePath(name))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(value)), 10, 64)
+}
+
+func (n *NodeLabeller) writeKSMValue(name string, value uint64) error {
+	return os.WriteFile(n.ksmFilePath(name), []byte(fmt.Sprintf("%d\n", value)), 0644)
+}
+
+func uint32OrDefault(value *uint32, defaultValue uint64) uint64 {
+	if value == nil {
+		return defaultValue
+	}
+	return uint64(*value)
+}
+
+// calculateKSMTuning returns the number of pages to scan and the sleep interval KSM should use
+// on a node, given the current number of pages to scan and the memory of the node.
+func calculateKSMTuning(tuning *kubevirtv1.KSMTuning, info *memInfo, pagesToScan uint64) (uint64, uint64) {
+	pagesToScanMin := uint32OrDefault(tuning.PagesToScanMin, defaultKSMPagesToScanMin)
+	pagesToScanMax := uint32OrDefault(tuning.PagesToScanMax, defaultKSMPagesToScanMax)
+	threshold := uint32OrDefault(tuning.FreeMemoryThresholdPercent, defaultKSMFreeMemoryThresholdPercent)
+
+	if info.MemAvailable*100 < info.MemTotal*threshold {
+		pagesToScan += ksmPagesToScanBoost
+	} else if pagesToScan > ksmPagesToScanDecay {
+		pagesToScan -= ksmPagesToScanDecay
+	} else {
+		pagesToScan = 0
+	}
+	if pagesToScan > pagesToScanMax {
+		pagesToScan = pagesToScanMax
+	}
+	if pagesToScan < pagesToScanMin {
+		pagesToScan = pagesToScanMin
+	}
+
+	sleepMillisecs := uint32OrDefault(tuning.SleepMillisecs, defaultKSMSleepMillisecs) * ksmSleepReferenceMemoryKiB / info.MemTotal
+	if sleepMillisecs == 0 {
+		sleepMillisecs = 1
+	}
+
+	return pagesToScan, sleepMillisecs
+}
+
+// tuneKSM adjusts the KSM scanning rate to the memory pressure of the node.
+// Only KSM enabled by the handler is tuned, and only if tuning is configured.
+func (n *NodeLabeller) tuneKSM() {
+	if !n.ksmManaged.Load() {
+		return
+	}
+	ksmConfig := n.clusterConfig.GetKSMConfiguration()
+	if ksmConfig == nil || ksmConfig.Tuning == nil {
+		return
+	}
+
+	info, err := loadMemInfo(n.KSM.MemInfoFilePath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Unable to read the node memory info, skipping ksm tuning")
+		return
+	}
+	currentPagesToScan, err := n.readKSMValue(ksmPagesToScanFile)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Error("Unable to read ksm pages to scan, skipping ksm tuning")
+		return
+	}
+
+	pagesToScan, sleepMillisecs := calculateKSMTuning(ksmConfig.Tuning, info, currentPagesToScan)
+	if pagesToScan != currentPagesToScan {
+		if err := n.writeKSMValue(ksmPagesToScanFile, pagesToScan); err != nil {
+			log.DefaultLogger().Reason(err).Error("Unable to write ksm pages to scan")
+			return
+		}
+		log.DefaultLogger().V(4).Infof("KSM pages to scan changed from %d to %d", currentPagesToScan, pagesToScan)
+	}
+	if err := n.writeKSMValue(ksmSleepMillisecFile, sleepMillisecs); err != nil {
+		log.DefaultLogger().Reason(err).Error("Unable to write ksm sleep interval")
+	}
+}
+
+// reportKSMStats publishes the pages merged by KSM on the node and the memory overcommit
+// they allow, so that virt-controller can take them into account for overcommit-tolerant VMIs.
+func (n *NodeLabeller) reportKSMStats(node *v1.Node) {
+	pagesShared, err := n.readKSMValue(ksmPagesSharedFile)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Unable to read ksm shared pages")
+		return
+	}
+	pagesSharing, err := n.readKSMValue(ksmPagesSharingFile)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Unable to read ksm sharing pages")
+		return
+	}
+	info, err := loadMemInfo(n.KSM.MemInfoFilePath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warning("Unable to read the node memory info")
+		return
+	}
+
+	savedKiB := pagesSharing * uint64(os.Getpagesize()) / 1024
+	overcommit := 100 + savedKiB*100/info.MemTotal
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[kubevirtv1.KSMPagesSharedAnnotation] = strconv.FormatUint(pagesShared, 10)
+	node.Annotations[kubevirtv1.KSMPagesSharingAnnotation] = strconv.FormatUint(pagesSharing, 10)
+	node.Annotations[kubevirtv1.KSMMemoryOvercommitAnnotation] = strconv.FormatUint(overcommit, 10)
+}
+
+func removeKSMStats(node *v1.Node) {
+	delete(node.Annotations, kubevirtv1.KSMPagesSharedAnnotation)
+	delete(node.Annotations, kubevirtv1.KSMPagesSharingAnnotation)
+	delete(node.Annotations, kubevirtv1.KSMMemoryOvercommitAnnotation)
+}
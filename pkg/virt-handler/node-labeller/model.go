@@ -38,8 +38,10 @@ type cpuInfo struct {
 
 // HostDomCapabilities represents structure for parsing output of virsh capabilities
 type HostDomCapabilities struct {
-	CPU CPU              `xml:"cpu"`
-	SEV SEVConfiguration `xml:"features>sev"`
+	CPU            CPU                         `xml:"cpu"`
+	SEV            SEVConfiguration            `xml:"features>sev"`
+	LaunchSecurity LaunchSecurityConfiguration `xml:"features>launchSecurity"`
+	TDX            TDXConfiguration            `xml:"-"`
 }
 
 // CPU represents slice of cpu modes
@@ -97,10 +99,27 @@ type SEVConfiguration struct {
 	MaxGuests       uint   `xml:"maxGuests"`
 	MaxESGuests     uint   `xml:"maxESGuests"`
 	SupportedES     string `xml:"-"`
+	SupportedSNP    string `xml:"-"`
+}
+
+// LaunchSecurityConfiguration lists the launch security technologies libvirt can use on the host
+type LaunchSecurityConfiguration struct {
+	Supported string `xml:"supported,attr"`
+	Enums     []Enum `xml:"enum"`
+}
+
+type Enum struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"value"`
+}
+
+type TDXConfiguration struct {
+	Supported string
 }
 
 type KSMConfiguration struct {
-	Available     bool
-	SysfsFilePath string
-	Enabled       bool
+	Available       bool
+	SysfsFilePath   string
+	MemInfoFilePath string
+	Enabled         bool
 }
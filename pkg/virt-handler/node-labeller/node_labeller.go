@@ -28,6 +28,7 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
@@ -65,6 +66,8 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.RealtimeLabel,
 	kubevirtv1.SEVLabel,
 	kubevirtv1.SEVESLabel,
+	kubevirtv1.SEVSNPLabel,
+	kubevirtv1.TDXLabel,
 	kubevirtv1.HostModelCPULabel,
 	kubevirtv1.HostModelRequiredFeaturesLabel,
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
@@ -90,7 +93,10 @@ type NodeLabeller struct {
 	capabilities            *api.Capabilities
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
+	TDX                     TDXConfiguration
 	KSM                     KSMConfiguration
+	// ksmManaged is set while the handler keeps KSM enabled on the node, it is read by the ksm tuning loop
+	ksmManaged atomic.Bool
 }
 
 func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, host, namespace string, recorder record.EventRecorder) (*NodeLabeller, error) {
@@ -109,7 +115,7 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.
 		volumePath:              volumePath,
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool, 0)},
-		KSM:                     KSMConfiguration{SysfsFilePath: ksmSysFsFilePath},
+		KSM:                     KSMConfiguration{SysfsFilePath: ksmSysFsFilePath, MemInfoFilePath: memInfoPath},
 	}
 
 	err := n.loadAll()
@@ -131,6 +137,7 @@ func (n *NodeLabeller) Run(threadiness int, stop chan struct{}) {
 
 	interval := 3 * time.Minute
 	go wait.JitterUntil(func() { n.queue.Add(n.host) }, interval, 1.2, true, stop)
+	go wait.JitterUntil(n.tuneKSM, ksmTuningInterval, 1.2, true, stop)
 
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(n.runWorker, time.Second, stop)
@@ -208,6 +215,11 @@ func (n *NodeLabeller) run() error {
 
 	node := originalNode.DeepCopy()
 	n.handleKSM(node)
+	if n.KSM.Enabled {
+		n.reportKSMStats(node)
+	} else {
+		removeKSMStats(node)
+	}
 
 	if !skipNodeLabelling(node) {
 		//prepare new labels
@@ -336,6 +348,14 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatu
 		newLabels[kubevirtv1.SEVESLabel] = ""
 	}
 
+	if n.SEV.SupportedSNP == "yes" {
+		newLabels[kubevirtv1.SEVSNPLabel] = ""
+	}
+
+	if n.TDX.Supported == "yes" {
+		newLabels[kubevirtv1.TDXLabel] = ""
+	}
+
 	if n.KSM.Enabled {
 		newLabels[kubevirtv1.KSMEnabledLabel] = "true"
 	}
@@ -451,6 +471,7 @@ func (n *NodeLabeller) loadKSM() {
 // If the node labels match the selector terms, the ksm will be enabled.
 // Empty Selector will enable ksm for every node
 func (n *NodeLabeller) handleKSM(node *v1.Node) {
+	n.ksmManaged.Store(false)
 	n.loadKSM()
 	if !n.KSM.Available {
 		return
@@ -474,6 +495,7 @@ func (n *NodeLabeller) handleKSM(node *v1.Node) {
 	}
 
 	n.enableKSM(node)
+	n.ksmManaged.Store(n.KSM.Enabled)
 }
 
 func (n *NodeLabeller) enableKSM(node *v1.Node) {
@@ -24,6 +24,7 @@ package nodelabeller
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
@@ -189,6 +190,12 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(res).To(BeTrue())
 	})
 
+	It("should add SEV-SNP label", func() {
+		expectNodePatch(kubevirtv1.SEVSNPLabel)
+		res := nlController.execute()
+		Expect(res).To(BeTrue())
+	})
+
 	It("should add usable cpu model labels for the host cpu model", func() {
 		expectNodePatch(
 			kubevirtv1.HostModelCPULabel+"Skylake-Client-IBRS",
@@ -265,6 +272,89 @@ var _ = Describe("Node-labeller ", func() {
 				nil, "1\n",
 			),
 		)
+
+		Context("with tuning", func() {
+			var ksmDir string
+
+			writeKSMFile := func(name, value string) {
+				Expect(os.WriteFile(filepath.Join(ksmDir, name), []byte(value), 0644)).To(Succeed())
+			}
+
+			readKSMFile := func(name string) string {
+				value, err := os.ReadFile(filepath.Join(ksmDir, name))
+				Expect(err).ToNot(HaveOccurred())
+				return string(value)
+			}
+
+			initTunedNodeLabeller := func(ksmValue string, nodeLabels, nodeAnnotations map[string]string) {
+				initNodeLabeller(kv, ksmValue, nodeLabels, nodeAnnotations)
+				ksmDir = GinkgoT().TempDir()
+				writeKSMFile("run", ksmValue)
+				writeKSMFile(ksmPagesToScanFile, "100\n")
+				writeKSMFile(ksmSleepMillisecFile, "20\n")
+				writeKSMFile(ksmPagesSharedFile, "1000\n")
+				// 1Gi worth of merged pages
+				writeKSMFile(ksmPagesSharingFile, fmt.Sprintf("%d\n", 1024*1024*1024/os.Getpagesize()))
+				// 4Gi of memory, 10% available
+				writeKSMFile("meminfo", "MemTotal:        4194304 kB\nMemFree:          209715 kB\nMemAvailable:     419430 kB\n")
+				nlController.KSM.SysfsFilePath = filepath.Join(ksmDir, "run")
+				nlController.KSM.MemInfoFilePath = filepath.Join(ksmDir, "meminfo")
+			}
+
+			BeforeEach(func() {
+				kv.Spec.Configuration.KSMConfiguration.Tuning = &kubevirtv1.KSMTuning{}
+			})
+
+			It("should publish the ksm stats and the memory overcommit of the node", func() {
+				initTunedNodeLabeller("0\n", map[string]string{"test_label": "true"}, make(map[string]string))
+				expectNodePatch(
+					fmt.Sprintf(`"%s":"1000"`, kubevirtv1.KSMPagesSharedAnnotation),
+					fmt.Sprintf(`"%s":"%d"`, kubevirtv1.KSMPagesSharingAnnotation, 1024*1024*1024/os.Getpagesize()),
+					fmt.Sprintf(`"%s":"125"`, kubevirtv1.KSMMemoryOvercommitAnnotation),
+				)
+				Expect(nlController.execute()).To(BeTrue())
+				Expect(nlController.ksmManaged.Load()).To(BeTrue())
+			})
+
+			It("should remove the ksm stats once ksm is disabled", func() {
+				initTunedNodeLabeller("1\n", map[string]string{"test_label": "false"}, map[string]string{
+					kubevirtv1.KSMHandlerManagedAnnotation:   "true",
+					kubevirtv1.KSMPagesSharedAnnotation:      "1000",
+					kubevirtv1.KSMPagesSharingAnnotation:     "2000",
+					kubevirtv1.KSMMemoryOvercommitAnnotation: "101",
+				})
+				expectNodePatch(`{"op":"replace","path":"/metadata/annotations","value":{}}`)
+				Expect(nlController.execute()).To(BeTrue())
+				Expect(nlController.ksmManaged.Load()).To(BeFalse())
+			})
+
+			DescribeTable("should adjust the ksm scanning rate", func(pagesToScan, memAvailable string, expectedPagesToScan string) {
+				initTunedNodeLabeller("1\n", map[string]string{"test_label": "true"}, make(map[string]string))
+				writeKSMFile(ksmPagesToScanFile, pagesToScan)
+				writeKSMFile("meminfo", fmt.Sprintf("MemTotal:        4194304 kB\nMemAvailable:     %s kB\n", memAvailable))
+				nlController.ksmManaged.Store(true)
+
+				nlController.tuneKSM()
+
+				Expect(readKSMFile(ksmPagesToScanFile)).To(Equal(expectedPagesToScan))
+				// 10ms for 16Gi scaled to 4Gi
+				Expect(readKSMFile(ksmSleepMillisecFile)).To(Equal("40\n"))
+			},
+				Entry("boosting it under memory pressure", "100\n", "419430", "400\n"),
+				Entry("capping it to the maximum", "1200\n", "419430", "1250\n"),
+				Entry("decaying it without memory pressure", "400\n", "2097152", "350\n"),
+				Entry("keeping it above the minimum", "100\n", "2097152", "64\n"),
+			)
+
+			It("should not tune ksm which is not managed by the handler", func() {
+				initTunedNodeLabeller("1\n", map[string]string{"test_label": "true"}, make(map[string]string))
+
+				nlController.tuneKSM()
+
+				Expect(readKSMFile(ksmPagesToScanFile)).To(Equal("100\n"))
+				Expect(readKSMFile(ksmSleepMillisecFile)).To(Equal("20\n"))
+			})
+		})
 	})
 
 	AfterEach(func() {
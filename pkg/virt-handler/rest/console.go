@@ -43,6 +43,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 //const failedRetrieveVMI = "Failed to retrieve VMI"
@@ -242,6 +243,41 @@ func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restfu
 	}, make(chan struct{})) // It is legitimate and up to the guest-application to accept multiple connections.
 }
 
+// BackupNBDHandler proxies the NBD server of a pull mode backup to an export
+// server, which may only read the backups of the namespace of its certificate
+func (t *ConsoleHandler) BackupNBDHandler(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter("namespace")
+	if !isPeerOfNamespace(request.Request, namespace) {
+		response.WriteError(http.StatusForbidden, fmt.Errorf("reading the backups of namespace %s is not allowed", namespace))
+		return
+	}
+	vmi, code, err := getVMI(request, t.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, api.BackupNBDSocketName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for the backup NBD server")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{})) // The export server opens a connection per request.
+}
+
+func isPeerOfNamespace(request *http.Request, namespace string) bool {
+	if request.TLS == nil || len(request.TLS.PeerCertificates) == 0 {
+		return false
+	}
+	for _, organization := range request.TLS.PeerCertificates[0].Subject.Organization {
+		if organization == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 func newStopChan(uid types.UID, lock *sync.Mutex, stopChans map[types.UID](chan struct{})) chan struct{} {
 	lock.Lock()
 	defer lock.Unlock()
@@ -21,11 +21,14 @@ package rest
 import (
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 
 	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -34,9 +37,13 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
 
 const (
+	// cpuLoadSamplingWindow is the time between the two domain stats samples the CPU load is calculated from
+	cpuLoadSamplingWindow = time.Second
+
 	failedRetrieveVMI      = "Failed to retrieve VMI"
 	failedDetectCmdClient  = "Failed to detect cmd client"
 	failedConnectCmdClient = "Failed to connect cmd client"
@@ -145,6 +152,56 @@ func (lh *LifecycleHandler) UnfreezeHandler(request *restful.Request, response *
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) BackupHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("Request with no body: backup options are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve backup options from request"))
+		return
+	}
+
+	opts := &v1.VirtualMachineInstanceBackupOptions{}
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal backup options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal backup options"))
+		return
+	}
+
+	err = client.BackupVirtualMachine(vmi, opts)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to start backup of VMI")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) AbortBackupHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	err = client.AbortVirtualMachineBackup(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to abort backup of VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) SoftRebootHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
@@ -218,6 +275,69 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetCPULoad(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	first, exists, err := client.GetDomainStats()
+	if err != nil || !exists {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, fmt.Errorf("failed to get domain stats: %v", err))
+		return
+	}
+	start := time.Now()
+	time.Sleep(cpuLoadSamplingWindow)
+	second, exists, err := client.GetDomainStats()
+	if err != nil || !exists {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, fmt.Errorf("failed to get domain stats: %v", err))
+		return
+	}
+
+	cpuLoad, err := calculateCPULoad(first, second, time.Since(start))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to calculate the CPU load")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(cpuLoad)
+}
+
+// calculateCPULoad averages the vCPU time consumed between two domain stats samples
+// over all vCPUs and the time which passed between the samples
+func calculateCPULoad(first, second *stats.DomainStats, window time.Duration) (*v1.VirtualMachineInstanceCPULoad, error) {
+	firstTime, _ := totalVcpuTime(first)
+	secondTime, vcpus := totalVcpuTime(second)
+	if vcpus == 0 || window <= 0 {
+		return nil, fmt.Errorf("domain stats do not report the vCPU time")
+	}
+	if secondTime < firstTime {
+		return nil, fmt.Errorf("vCPU time decreased between samples, the domain was probably restarted")
+	}
+
+	utilization := math.Round(float64(secondTime-firstTime) * 100 / (float64(window.Nanoseconds()) * float64(vcpus)))
+	return &v1.VirtualMachineInstanceCPULoad{
+		VCPUs:              int32(vcpus),
+		UtilizationPercent: int32(math.Min(utilization, 100)),
+		Window:             metav1.Duration{Duration: window},
+	}, nil
+}
+
+func totalVcpuTime(domainStats *stats.DomainStats) (uint64, int) {
+	var total uint64
+	vcpus := 0
+	for _, vcpu := range domainStats.Vcpu {
+		if vcpu.TimeSet {
+			total += vcpu.Time
+			vcpus++
+		}
+	}
+	return total, vcpus
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiInformer)
 	if err != nil {
@@ -278,6 +398,24 @@ func (lh *LifecycleHandler) SEVQueryLaunchMeasurementHandler(request *restful.Re
 	response.WriteEntity(sevMeasurementInfo)
 }
 
+func (lh *LifecycleHandler) AttestationReportHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Retrieving attestation report")
+
+	attestationReport, err := client.GetAttestationReport(vmi, request.QueryParameter("nonce"))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get VMI attestation report")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(attestationReport)
+}
+
 func (lh *LifecycleHandler) SEVInjectLaunchSecretHandler(request *restful.Request, response *restful.Response) {
 	vmi, client, err := lh.getVMILauncherClient(request, response)
 	if err != nil {
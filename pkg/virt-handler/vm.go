@@ -54,6 +54,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -62,6 +63,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -102,6 +104,7 @@ import (
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
+	FilterDroppedPackets(vmi *v1.VirtualMachineInstance, launcherPid int) (map[string]*v1.InterfaceFilterDroppedPackets, error)
 }
 
 type netstat interface {
@@ -116,6 +119,9 @@ const (
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 )
 
+// filterStatusUpdateInterval is the minimal interval between two reads of the dropped packets of the interface filters
+const filterStatusUpdateInterval = time.Minute
+
 const (
 	//VolumeReadyReason is the reason set when the volume is ready.
 	VolumeReadyReason = "VolumeReady"
@@ -219,6 +225,7 @@ func NewController(
 		hostCpuModel:                hostCpuModel,
 		vmiExpectations:             controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		sriovHotplugExecutorPool:    executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
+		filterStatusExecutorPool:    executor.NewRateLimitedExecutorPool(executor.NewConstantBackoffCreator(filterStatusUpdateInterval)),
 		ioErrorRetryManager:         NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
 	}
 
@@ -311,6 +318,7 @@ type VirtualMachineController struct {
 	hotplugVolumeMounter     hotplug_volume.VolumeMounter
 	clusterConfig            *virtconfig.ClusterConfig
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
+	filterStatusExecutorPool *executor.RateLimitedExecutorPool
 
 	netConf netconf
 	netStat netstat
@@ -656,7 +664,14 @@ func (d *VirtualMachineController) migrationSourceUpdateVMIStatus(origVMI *v1.Vi
 	// way of transferring ownership. The only option here is to move the
 	// vmi to failed.  The cluster vmi controller will then tear down the
 	// resulting pods.
-	if migrationHost == "" {
+	if migrations.IsCrossClusterMigrationSource(vmi) {
+		// the domain left the cluster, there is no target node to hand the VMI over to.
+		vmi.Status.Phase = v1.Succeeded
+		vmi.Status.MigrationState.Completed = true
+
+		d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), fmt.Sprintf("The VirtualMachineInstance migrated to another cluster (%s).", vmi.Status.MigrationState.CrossCluster.ConnectURL))
+		log.Log.Object(vmi).Infof("migration completed to another cluster (%s)", vmi.Status.MigrationState.CrossCluster.ConnectURL)
+	} else if migrationHost == "" {
 		// migrated to unknown host.
 		vmi.Status.Phase = v1.Failed
 		vmi.Status.MigrationState.Completed = true
@@ -738,12 +753,19 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 	}
 
 	domainExists := domain != nil
+	isCrossClusterTarget := d.isCrossClusterMigrationTarget(vmi) &&
+		vmi.Status.MigrationState != nil && vmi.Status.MigrationState.CrossCluster != nil
 
 	// Handle post migration
 	if domainExists && vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.TargetNodeDomainDetected {
 		// record that we've see the domain populated on the target's node
 		log.Log.Object(vmi).Info("The target node received the migrated domain")
 		vmiCopy.Status.MigrationState.TargetNodeDomainDetected = true
+		// the sending side is not visible in this cluster, so the receiving side reports the start
+		if isCrossClusterTarget && vmiCopy.Status.MigrationState.StartTimestamp == nil {
+			now := metav1.Now()
+			vmiCopy.Status.MigrationState.StartTimestamp = &now
+		}
 
 		// adjust QEMU process memlock limits in order to enable old virt-launcher pod's to
 		// perform hotplug host-devices on post migration.
@@ -765,6 +787,26 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 		now := metav1.Now()
 		vmiCopy.Status.MigrationState.TargetNodeDomainReadyTimestamp = &now
 		d.finalizeMigration(vmiCopy)
+
+		if isCrossClusterTarget {
+			// there is no source node in this cluster to hand the VMI over, take ownership right away
+			vmiCopy.Status.Phase = v1.Running
+			vmiCopy.Status.MigrationState.Completed = true
+			vmiCopy.Status.MigrationState.EndTimestamp = &now
+			d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Migrated.String(), "The VirtualMachineInstance was received from another cluster.")
+		}
+	} else if !domainExists && isCrossClusterTarget &&
+		vmi.Status.MigrationState.TargetNodeDomainDetected &&
+		!vmi.Status.MigrationState.Completed {
+
+		// the incoming domain vanished, the sending side aborted the migration
+		log.Log.Object(vmi).Error("The migrated domain disappeared from the target node")
+		now := metav1.Now()
+		vmiCopy.Status.Phase = v1.Failed
+		vmiCopy.Status.MigrationState.Completed = true
+		vmiCopy.Status.MigrationState.Failed = true
+		vmiCopy.Status.MigrationState.EndTimestamp = &now
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.Migrated.String(), "The migration from another cluster failed.")
 	}
 
 	if !migrations.IsMigrating(vmi) {
@@ -792,6 +834,15 @@ func (d *VirtualMachineController) migrationTargetUpdateVMIStatus(vmi *v1.Virtua
 			vmiCopy.Status.MigrationState.TargetDirectMigrationNodePorts = destSrcPortsMap
 		}
 
+		// all streams of a cross cluster migration share the listener of the libvirt connection
+		if isCrossClusterTarget {
+			for port, srcPort := range destSrcPortsMap {
+				if srcPort == 0 {
+					vmiCopy.Status.MigrationState.CrossCluster.ConnectURL = net.JoinHostPort(d.migrationIpAddress, port)
+				}
+			}
+		}
+
 		// If the migrated VMI requires dedicated CPUs, report the new pod CPU set to the source node
 		// via the VMI migration status in order to patch the domain pre migration
 		if vmi.IsCPUDedicated() {
@@ -1098,6 +1149,31 @@ func (d *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
+func (d *VirtualMachineController) updateGuestPanickedConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if isGuestPanicked(domain) {
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestPanicked) {
+			log.Log.Object(vmi).V(3).Info("Adding guest panicked condition")
+			now := metav1.NewTime(time.Now())
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceGuestPanicked,
+				Status:             k8sv1.ConditionTrue,
+				LastProbeTime:      now,
+				LastTransitionTime: now,
+				Reason:             "GuestPanicked",
+				Message:            "The guest kernel panicked",
+			})
+		}
+	} else if condManager.HasCondition(vmi, v1.VirtualMachineInstanceGuestPanicked) {
+		log.Log.Object(vmi).V(3).Info("Removing guest panicked condition")
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGuestPanicked)
+	}
+}
+
+// isGuestPanicked reports whether the guest kernel panicked and the crashed guest was preserved
+func isGuestPanicked(domain *api.Domain) bool {
+	return domain != nil && domain.Status.Status == api.Crashed && domain.Status.Reason == api.ReasonPanicked
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
@@ -1154,6 +1230,60 @@ func (d *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func (d *VirtualMachineController) updateBackupStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Backup == nil {
+		return
+	}
+
+	backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+	backupStatus := &v1.VirtualMachineInstanceBackupStatus{
+		Name:           backupMetadata.Name,
+		Mode:           backupMetadata.Mode,
+		Checkpoint:     backupMetadata.Checkpoint,
+		StartTimestamp: backupMetadata.StartTimestamp,
+		EndTimestamp:   backupMetadata.EndTimestamp,
+		Completed:      backupMetadata.Completed,
+		Failed:         backupMetadata.Failed,
+		FailureReason:  backupMetadata.FailureReason,
+	}
+	for _, volume := range backupMetadata.Volumes {
+		backupStatus.Volumes = append(backupStatus.Volumes, v1.VirtualMachineInstanceBackupVolume{
+			VolumeName:  volume.Name,
+			DiskTarget:  volume.DiskTarget,
+			ExportName:  volume.ExportName,
+			DirtyBitmap: volume.DirtyBitmap,
+			Path:        volume.Path,
+		})
+	}
+	vmi.Status.BackupStatus = backupStatus
+}
+
+func (d *VirtualMachineController) updateWatchdogStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Watchdog == nil {
+		return
+	}
+
+	watchdogMetadata := domain.Spec.Metadata.KubeVirt.Watchdog
+	vmi.Status.WatchdogStatus = &v1.VirtualMachineInstanceWatchdogStatus{
+		FiredCount:         watchdogMetadata.FiredCount,
+		LastFiredTimestamp: watchdogMetadata.LastFiredTimestamp,
+		Action:             v1.WatchdogAction(watchdogMetadata.Action),
+	}
+}
+
+func (d *VirtualMachineController) updateBalloonStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.Balloon == nil {
+		return
+	}
+
+	balloonMetadata := domain.Spec.Metadata.KubeVirt.Balloon
+	if vmi.Status.Memory == nil {
+		vmi.Status.Memory = &v1.MemoryStatus{}
+	}
+	vmi.Status.Memory.BalloonTarget = resource.NewQuantity(int64(balloonMetadata.Target)*1024, resource.BinarySI)
+	vmi.Status.Memory.BalloonActual = resource.NewQuantity(int64(balloonMetadata.Actual)*1024, resource.BinarySI)
+}
+
 func IsoGuestVolumePath(vmi *v1.VirtualMachineInstance, volume *v1.Volume) (string, bool) {
 	var volPath string
 
@@ -1273,9 +1403,51 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	d.updateGuestInfoFromDomain(vmi, domain)
 	d.updateVolumeStatusesFromDomain(vmi, domain)
 	d.updateFSFreezeStatus(vmi, domain)
+	d.updateBackupStatus(vmi, domain)
+	d.updateWatchdogStatus(vmi, domain)
+	d.updateBalloonStatus(vmi, domain)
 	d.updateMachineType(vmi, domain)
 	err = d.netStat.UpdateStatus(vmi, domain)
-	return err
+	if err != nil {
+		return err
+	}
+	d.updateInterfaceFilterStatus(vmi)
+	return nil
+}
+
+func (d *VirtualMachineController) updateInterfaceFilterStatus(vmi *v1.VirtualMachineInstance) {
+	filtered := false
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Filter != nil {
+			filtered = true
+			break
+		}
+	}
+	if !filtered {
+		return
+	}
+
+	// Reading the counters runs nft in the launcher network namespace and nearly every read
+	// changes the status, so the counters are refreshed at most once per interval.
+	err := d.filterStatusExecutorPool.LoadOrStore(vmi.UID).Exec(func() error {
+		isolationRes, err := d.podIsolationDetector.Detect(vmi)
+		if err != nil {
+			return fmt.Errorf(failedDetectIsolationFmt, err)
+		}
+		droppedPackets, err := d.netConf.FilterDroppedPackets(vmi, isolationRes.Pid())
+		if err != nil {
+			return err
+		}
+		for i := range vmi.Status.Interfaces {
+			if ifaceDroppedPackets, exists := droppedPackets[vmi.Status.Interfaces[i].Name]; exists {
+				vmi.Status.Interfaces[i].FilterDroppedPackets = ifaceDroppedPackets
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to read the interface filter counters")
+	}
 }
 
 func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) error {
@@ -1286,6 +1458,7 @@ func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	d.updatePausedConditions(vmi, domain, condManager)
+	d.updateGuestPanickedConditions(vmi, domain, condManager)
 
 	return nil
 }
@@ -1491,6 +1664,14 @@ func (d *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses SEV", v1.VirtualMachineInstanceReasonSEVNotMigratable), isBlockMigration
 	}
 
+	if util.IsSEVSNPVMI(vmi) {
+		return newNonMigratableCondition("VMI uses SEV-SNP", v1.VirtualMachineInstanceReasonSEVNotMigratable), isBlockMigration
+	}
+
+	if util.IsTDXVMI(vmi) {
+		return newNonMigratableCondition("VMI uses TDX", v1.VirtualMachineInstanceReasonTDXNotMigratable), isBlockMigration
+	}
+
 	if reservation.HasVMIPersistentReservation(vmi) {
 		return newNonMigratableCondition("VMI uses SCSI persitent reservation", v1.VirtualMachineInstanceReasonPRNotMigratable), isBlockMigration
 	}
@@ -1659,6 +1840,10 @@ func (d *VirtualMachineController) migrationTargetExecute(vmi *v1.VirtualMachine
 
 	if vmiExists && vmi.IsRunning() {
 		shouldUpdate = true
+	} else if vmiExists && d.isCrossClusterMigrationTarget(vmi) && vmi.IsScheduled() &&
+		vmi.Status.MigrationState != nil && vmi.Status.MigrationState.TargetNode == d.host {
+		// the receiving VMI of a cross cluster migration is prepared once virt-controller handed it off
+		shouldUpdate = true
 	}
 
 	if !vmiExists || vmi.DeletionTimestamp != nil {
@@ -1783,9 +1968,10 @@ func (d *VirtualMachineController) defaultExecute(key string,
 	}
 
 	domainAlive := domainExists &&
-		domain.Status.Status != api.Shutoff &&
-		domain.Status.Status != api.Crashed &&
-		domain.Status.Status != ""
+		(isGuestPanicked(domain) ||
+			(domain.Status.Status != api.Shutoff &&
+				domain.Status.Status != api.Crashed &&
+				domain.Status.Status != ""))
 
 	domainMigrated := domainExists && domainMigrated(domain)
 
@@ -2060,6 +2246,7 @@ func (d *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	d.teardownNetwork(vmi)
 
 	d.sriovHotplugExecutorPool.Delete(vmi.UID)
+	d.filterStatusExecutorPool.Delete(vmi.UID)
 
 	// Watch dog file and command client must be the last things removed here
 	err = d.closeLauncherClient(vmi)
@@ -2364,6 +2551,10 @@ func (d *VirtualMachineController) isOrphanedMigrationSource(vmi *v1.VirtualMach
 
 func (d *VirtualMachineController) isPreMigrationTarget(vmi *v1.VirtualMachineInstance) bool {
 
+	if d.isCrossClusterMigrationTarget(vmi) {
+		return true
+	}
+
 	migrationTargetNodeName, ok := vmi.Labels[v1.MigrationTargetNodeNameLabel]
 
 	if ok &&
@@ -2376,6 +2567,12 @@ func (d *VirtualMachineController) isPreMigrationTarget(vmi *v1.VirtualMachineIn
 	return false
 }
 
+// isCrossClusterMigrationTarget returns true if this node hosts a VMI which waits
+// for its domain to be migrated from another cluster. Such a VMI must never be booted.
+func (d *VirtualMachineController) isCrossClusterMigrationTarget(vmi *v1.VirtualMachineInstance) bool {
+	return migrations.IsCrossClusterMigrationTarget(vmi) && vmi.Status.NodeName == d.host
+}
+
 func (d *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.VirtualMachineInstance) error {
 	ifaces := vmi.Spec.Domain.Devices.Interfaces
 	if len(ifaces) == 0 {
@@ -2456,7 +2653,7 @@ func (d *VirtualMachineController) isMigrationSource(vmi *v1.VirtualMachineInsta
 
 	if vmi.Status.MigrationState != nil &&
 		vmi.Status.MigrationState.SourceNode == d.host &&
-		vmi.Status.MigrationState.TargetNodeAddress != "" &&
+		(vmi.Status.MigrationState.TargetNodeAddress != "" || migrations.IsCrossClusterMigrationSource(vmi)) &&
 		!vmi.Status.MigrationState.Completed {
 
 		return true
@@ -2480,7 +2677,10 @@ func (d *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 	baseDir := fmt.Sprintf(filepath.Join(d.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	migrationTargetSockets = append(migrationTargetSockets, socketFile)
 
-	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration
+	// the receiving side of a cross cluster migration can't tell whether the
+	// source copies volumes, so it always accepts the block migration stream
+	isCrossClusterTarget := d.isCrossClusterMigrationTarget(vmi)
+	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration || isCrossClusterTarget
 	migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
 	for _, port := range migrationPortsRange {
 		key := migrationproxy.ConstructProxyKey(string(vmi.UID), port)
@@ -2488,6 +2688,9 @@ func (d *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
 	}
+	if isCrossClusterTarget {
+		return d.migrationProxy.StartCrossClusterTargetListener(string(vmi.UID), vmi.Status.MigrationState.CrossCluster.MigrationID, migrationTargetSockets)
+	}
 	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
 	if err != nil {
 		return err
@@ -2512,6 +2715,14 @@ func (d *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 	// pass in the virt-launcher's baseDir to reach the unix sockets.
 	baseDir := fmt.Sprintf(filepath.Join(d.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	d.migrationProxy.StopTargetListener(string(vmi.UID))
+	if migrations.IsCrossClusterMigrationSource(vmi) {
+		crossCluster := vmi.Status.MigrationState.CrossCluster
+		isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration ||
+			crossCluster.VolumeMigration == v1.CrossClusterVolumeMigrationBlock
+		// port 0 stands for the libvirt control connection
+		ports := append([]int{0}, migrationproxy.GetMigrationPortsList(isBlockMigration)...)
+		return d.migrationProxy.StartCrossClusterSourceListener(string(vmi.UID), crossCluster.MigrationID, crossCluster.ConnectURL, ports, baseDir)
+	}
 	if vmi.Status.MigrationState.TargetDirectMigrationNodePorts == nil {
 		msg := "No migration proxy has been created for this vmi"
 		return fmt.Errorf("%s", msg)
@@ -2592,7 +2803,16 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 		}
 
-		if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
+		if migrationConfiguration.Compression != nil {
+			options.Compression = *migrationConfiguration.Compression
+		}
+		if migrationConfiguration.AllowWorkloadDisruption != nil {
+			options.AllowWorkloadDisruption = *migrationConfiguration.AllowWorkloadDisruption
+		}
+
+		if migrationConfiguration.ParallelMigrationConnections != nil {
+			options.ParallelMigrationThreads = pointer.P(uint(*migrationConfiguration.ParallelMigrationConnections))
+		} else if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
 			threadCount, err := strconv.Atoi(threadCountStr)
 
 			if err != nil {
@@ -2849,7 +3069,8 @@ func (d *VirtualMachineController) configureHousekeepingCgroup(vmi *v1.VirtualMa
 	return nil
 }
 
-func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMachineInstance, domainExists bool) error {
+func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMachineInstance, domain *api.Domain) error {
+	domainExists := domain != nil
 	client, err := d.getLauncherClient(origVMI)
 	if err != nil {
 		return fmt.Errorf(unableCreateVirtLauncherConnectionFmt, err)
@@ -2930,7 +3151,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return fmt.Errorf("preparing host-disks failed: %v", err)
 		}
 
-		if virtutil.IsSEVVMI(vmi) {
+		if virtutil.IsSEVVMI(vmi) || virtutil.IsSEVSNPVMI(vmi) {
 			sevDevice, err := safepath.JoinNoFollow(virtLauncherRootMount, filepath.Join("dev", "sev"))
 			if err != nil {
 				return err
@@ -3006,6 +3227,9 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 	period := d.clusterConfig.GetMemBalloonStatsPeriod()
 
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, d.capabilities, disksInfo, d.clusterConfig)
+	if d.clusterConfig.NetworkBindingPluginsEnabled() {
+		options.InterfaceDomainAttachment = netbinding.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetNetworkBindings())
+	}
 
 	err = client.SyncVirtualMachine(vmi, options)
 	if err != nil {
@@ -3043,10 +3267,146 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 		if err := d.hotplugVolumeMounter.Unmount(vmi); err != nil {
 			return err
 		}
+
+		if d.clusterConfig.VMLiveUpdateFeaturesEnabled() && ioLimitsChanged(vmi, domain) {
+			if err := client.SyncVirtualMachineIOLimits(vmi); err != nil {
+				log.Log.Object(vmi).Error(err.Error())
+				d.recorder.Event(vmi, k8sv1.EventTypeWarning, "IOLimitsUpdate", err.Error())
+				errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+			}
+		}
+
+		if interfaceLinkStatesChanged(vmi) {
+			if err := client.SyncVirtualMachineInterfaceLinkStates(vmi); err != nil {
+				log.Log.Object(vmi).Error(err.Error())
+				d.recorder.Event(vmi, k8sv1.EventTypeWarning, "InterfaceLinkStateUpdate", err.Error())
+				errorTolerantFeaturesError = append(errorTolerantFeaturesError, err)
+			}
+		}
 	}
 	return errors.NewAggregate(errorTolerantFeaturesError)
 }
 
+// ioLimitsChanged reports whether the disk I/O throttling or interface bandwidth limits of the VMI
+// differ from the ones of the domain
+func ioLimitsChanged(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if domain == nil {
+		return false
+	}
+	disksByName := map[string]v1.Disk{}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		disksByName[disk.Name] = disk
+	}
+	for _, domainDisk := range domain.Spec.Devices.Disks {
+		if domainDisk.Alias == nil {
+			continue
+		}
+		disk, exists := disksByName[domainDisk.Alias.GetName()]
+		if !exists {
+			continue
+		}
+		if diskIOTuneOrEmpty(disk.IOTune) != domainDiskIOTune(domainDisk.IOTune) {
+			return true
+		}
+	}
+
+	interfacesByName := map[string]v1.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		interfacesByName[iface.Name] = iface
+	}
+	for _, domainIface := range domain.Spec.Devices.Interfaces {
+		if domainIface.Alias == nil {
+			continue
+		}
+		iface, exists := interfacesByName[domainIface.Alias.GetName()]
+		if !exists {
+			continue
+		}
+		var inbound, outbound *v1.BandwidthLimit
+		if iface.Bandwidth != nil {
+			inbound, outbound = iface.Bandwidth.Inbound, iface.Bandwidth.Outbound
+		}
+		var domainInbound, domainOutbound *api.BandWidthLimit
+		if domainIface.BandWidth != nil {
+			domainInbound, domainOutbound = domainIface.BandWidth.Inbound, domainIface.BandWidth.Outbound
+		}
+		if bandwidthLimitOrEmpty(inbound) != domainBandwidthLimit(domainInbound) ||
+			bandwidthLimitOrEmpty(outbound) != domainBandwidthLimit(domainOutbound) {
+			return true
+		}
+	}
+	return false
+}
+
+func diskIOTuneOrEmpty(ioTune *v1.DiskIOTune) v1.DiskIOTune {
+	if ioTune == nil {
+		return v1.DiskIOTune{}
+	}
+	return *ioTune
+}
+
+func domainDiskIOTune(ioTune *api.BlockIOTune) v1.DiskIOTune {
+	if ioTune == nil {
+		return v1.DiskIOTune{}
+	}
+	return v1.DiskIOTune{
+		TotalBytesSec: ioTune.TotalBytesSec,
+		ReadBytesSec:  ioTune.ReadBytesSec,
+		WriteBytesSec: ioTune.WriteBytesSec,
+		TotalIopsSec:  ioTune.TotalIopsSec,
+		ReadIopsSec:   ioTune.ReadIopsSec,
+		WriteIopsSec:  ioTune.WriteIopsSec,
+	}
+}
+
+func bandwidthLimitOrEmpty(limit *v1.BandwidthLimit) v1.BandwidthLimit {
+	if limit == nil {
+		return v1.BandwidthLimit{}
+	}
+	return *limit
+}
+
+func domainBandwidthLimit(limit *api.BandWidthLimit) v1.BandwidthLimit {
+	if limit == nil {
+		return v1.BandwidthLimit{}
+	}
+	return v1.BandwidthLimit{
+		Average: uint32(limit.Average),
+		Peak:    uint32(limit.Peak),
+		Burst:   uint32(limit.Burst),
+	}
+}
+
+// interfaceLinkStatesChanged reports whether the link state requested by an interface state
+// differs from the link state reported in the VMI status
+func interfaceLinkStatesChanged(vmi *v1.VirtualMachineInstance) bool {
+	statusByName := map[string]v1.VirtualMachineInstanceNetworkInterface{}
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		statusByName[ifaceStatus.Name] = ifaceStatus
+	}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.State == v1.InterfaceStateAbsent {
+			continue
+		}
+		ifaceStatus, exists := statusByName[iface.Name]
+		if !exists {
+			continue
+		}
+		if linkStateOrUp(ifaceStatus.LinkState) != linkStateOrUp(string(iface.State)) {
+			return true
+		}
+	}
+	return false
+}
+
+// linkStateOrUp returns the link state, which is up unless set otherwise
+func linkStateOrUp(linkState string) string {
+	if linkState == "" {
+		return string(v1.InterfaceStateLinkUp)
+	}
+	return linkState
+}
+
 func (d *VirtualMachineController) hotplugSriovInterfaces(vmi *v1.VirtualMachineInstance) error {
 	sriovSpecInterfaces := netvmispec.FilterSRIOVInterfaces(vmi.Spec.Domain.Devices.Interfaces)
 	sriovStatusInterfaces := netvmispec.FilterStatusInterfacesByNames(vmi.Status.Interfaces, netvmispec.InterfacesNames(sriovSpecInterfaces))
@@ -3130,7 +3490,7 @@ func (d *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstanc
 	} else if d.isMigrationSource(vmi) {
 		return d.vmUpdateHelperMigrationSource(vmi, domain)
 	} else {
-		return d.vmUpdateHelperDefault(vmi, domain != nil)
+		return d.vmUpdateHelperDefault(vmi, domain)
 	}
 }
 
@@ -3174,6 +3534,10 @@ func (d *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case api.Shutoff, api.Crashed:
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
+				if isGuestPanicked(domain) {
+					// The crashed guest is kept around for inspection
+					return v1.Running, nil
+				}
 				return v1.Failed, nil
 			case api.ReasonDestroyed:
 				// When ACPI is available, the domain was tried to be shutdown,
@@ -3276,6 +3640,11 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to change vCPUs")
 	}
 
+	if err := d.hotplugMemory(vmi, client); err != nil {
+		log.Log.Object(vmi).Reason(err).Error(errorMessage)
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to update guest memory")
+	}
+
 	if err := client.FinalizeVirtualMachineMigration(vmi); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
@@ -3439,3 +3808,28 @@ func (d *VirtualMachineController) hotplugCPU(vmi *v1.VirtualMachineInstance, cl
 
 	return nil
 }
+
+func (d *VirtualMachineController) hotplugMemory(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
+	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
+
+	removeVMIMemoryChangeCondition := func() {
+		vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceMemoryChange)
+	}
+	defer removeVMIMemoryChangeCondition()
+
+	if !vmiConditions.HasCondition(vmi, v1.VirtualMachineInstanceMemoryChange) {
+		return nil
+	}
+
+	if err := client.SyncVirtualMachineMemory(vmi); err != nil {
+		return err
+	}
+
+	if vmi.Status.Memory == nil {
+		vmi.Status.Memory = &v1.MemoryStatus{}
+	}
+	currentGuest := vmi.Spec.Domain.Memory.Guest.DeepCopy()
+	vmi.Status.Memory.GuestCurrent = &currentGuest
+
+	return nil
+}
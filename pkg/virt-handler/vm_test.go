@@ -204,7 +204,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		mockContainerDiskMounter = container_disk.NewMockMounter(ctrl)
 		mockHotplugVolumeMounter = hotplug_volume.NewMockVolumeMounter(ctrl)
 
-		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, tlsConfig, tlsConfig, config)
 		controller, _ = NewController(recorder,
 			virtClient,
 			host,
@@ -719,6 +719,123 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 
+		It("should sync the I/O limits of a running VMI when they changed and live update features are enabled", func() {
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{
+					FeatureGates: []string{virtconfig.VMLiveUpdateFeaturesGate},
+				},
+			})
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:   "disk0",
+				IOTune: &v1.DiskIOTune{TotalBytesSec: 1048576},
+			}}
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Disks = []api.Disk{{Alias: api.NewUserDefinedAlias("disk0")}}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			client.EXPECT().SyncVirtualMachineIOLimits(vmi)
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Return(vmi, nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+		})
+
+		It("should sync the interface link states of a running VMI when they changed", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:  "default",
+				State: v1.InterfaceStateLinkDown,
+			}}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default"}}
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			client.EXPECT().SyncVirtualMachineInterfaceLinkStates(vmi)
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Return(vmi, nil).AnyTimes()
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+		})
+
+		DescribeTable("should detect I/O limits changes", func(diskIOTune *v1.DiskIOTune, domainIOTune *api.BlockIOTune, bandwidth *v1.InterfaceBandwidth, domainBandwidth *api.BandWidth, expectChanged bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0", IOTune: diskIOTune}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Bandwidth: bandwidth}}
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Devices.Disks = []api.Disk{{Alias: api.NewUserDefinedAlias("disk0"), IOTune: domainIOTune}}
+			domain.Spec.Devices.Interfaces = []api.Interface{{Alias: api.NewUserDefinedAlias("default"), BandWidth: domainBandwidth}}
+
+			Expect(ioLimitsChanged(vmi, domain)).To(Equal(expectChanged))
+		},
+			Entry("when a disk limit is set", &v1.DiskIOTune{TotalIopsSec: 100}, nil, nil, nil, true),
+			Entry("when a disk limit is updated", &v1.DiskIOTune{TotalIopsSec: 100}, &api.BlockIOTune{TotalIopsSec: 200}, nil, nil, true),
+			Entry("when a disk limit is removed", nil, &api.BlockIOTune{TotalIopsSec: 200}, nil, nil, true),
+			Entry("when a bandwidth limit is set", nil, nil,
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000}}, nil, true),
+			Entry("when a bandwidth limit is updated", nil, nil,
+				&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Peak: 2000}},
+				&api.BandWidth{Outbound: &api.BandWidthLimit{Average: 1000}}, true),
+			Entry("unless the disk limits match", &v1.DiskIOTune{ReadBytesSec: 1024}, &api.BlockIOTune{ReadBytesSec: 1024}, nil, nil, false),
+			Entry("unless the bandwidth limits match", nil, nil,
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimit{Average: 1000, Burst: 10}},
+				&api.BandWidth{Inbound: &api.BandWidthLimit{Average: 1000, Burst: 10}}, false),
+			Entry("unless no limit is set", nil, nil, &v1.InterfaceBandwidth{}, nil, false),
+		)
+
+		DescribeTable("should detect interface link state changes", func(state v1.InterfaceState, linkState string, expectChanged bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", State: state}}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", LinkState: linkState}}
+
+			Expect(interfaceLinkStatesChanged(vmi)).To(Equal(expectChanged))
+		},
+			Entry("from up to down", v1.InterfaceStateLinkDown, "up", true),
+			Entry("from down to up", v1.InterfaceStateLinkUp, "down", true),
+			Entry("from down to unset", v1.InterfaceState(""), "down", true),
+			Entry("from unreported to down", v1.InterfaceStateLinkDown, "", true),
+			Entry("unless unset and up", v1.InterfaceState(""), "up", false),
+			Entry("unless unset and unreported", v1.InterfaceState(""), "", false),
+			Entry("unless the interface is being unplugged", v1.InterfaceStateAbsent, "down", false),
+		)
+
 		It("should update from Scheduled to Running, if it sees a running Domain", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1125,6 +1242,42 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 
+		It("should keep a panicked guest running and add the guest panicked condition", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Crashed
+			domain.Status.Reason = api.ReasonPanicked
+
+			updatedVMI := vmi.DeepCopy()
+			updatedVMI.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+				{
+					Type:   v1.VirtualMachineInstanceGuestPanicked,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+			vmiInterface.EXPECT().Update(context.Background(), NewVMICondMatcher(*updatedVMI))
+
+			controller.Execute()
+		})
+
 		It("should move VirtualMachineInstance from Scheduled to Failed if watchdog file is missing", func() {
 			cmdclient.MarkSocketUnresponsive(sockFile)
 			vmi := api2.NewMinimalVMI("testvmi")
@@ -2201,6 +2354,65 @@ var _ = Describe("VirtualMachineInstance", func() {
 		testutils.ExpectEvent(recorder, "failed to change vCPUs")
 	})
 
+	It("should hotplug memory and update the memory status once the migration target is ready", func() {
+		vmi := api2.NewMinimalVMI("testvmi")
+		vmi.UID = vmiTestUUID
+		vmi.ObjectMeta.ResourceVersion = "1"
+		vmi.Status.Phase = v1.Running
+		vmi.Labels = make(map[string]string)
+		vmi.Status.NodeName = "othernode"
+		vmi.Labels[v1.MigrationTargetNodeNameLabel] = host
+		pastTime := metav1.NewTime(metav1.Now().Add(time.Duration(-10) * time.Second))
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			TargetNode:               host,
+			TargetNodeAddress:        "127.0.0.1:12345",
+			SourceNode:               "othernode",
+			MigrationUID:             "123",
+			TargetNodeDomainDetected: false,
+			StartTimestamp:           &pastTime,
+		}
+
+		guestAtBoot := resource.MustParse("1Gi")
+		newGuest := resource.MustParse("2Gi")
+		maxGuest := resource.MustParse("4Gi")
+		vmi.Spec.Domain.Memory = &v1.Memory{
+			Guest:    &newGuest,
+			MaxGuest: &maxGuest,
+		}
+		vmi.Status.Memory = &v1.MemoryStatus{
+			GuestAtBoot:  &guestAtBoot,
+			GuestCurrent: &guestAtBoot,
+		}
+
+		vmiConditions := virtcontroller.NewVirtualMachineInstanceConditionManager()
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceMemoryChange,
+			Status: k8sv1.ConditionTrue,
+		})
+
+		mockWatchdog.CreateFile(vmi)
+		domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+		domain.Status.Status = api.Running
+
+		domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+			UID:            "123",
+			StartTimestamp: &pastTime,
+		}
+		domainFeeder.Add(domain)
+		vmiFeeder.Add(vmi)
+
+		client.EXPECT().Ping().AnyTimes()
+		client.EXPECT().FinalizeVirtualMachineMigration(gomock.Any())
+		client.EXPECT().SyncVirtualMachineMemory(gomock.Any())
+		vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, vmiObj *v1.VirtualMachineInstance) {
+			Expect(vmiObj.Status.Memory.GuestCurrent.Value()).To(Equal(newGuest.Value()))
+			Expect(vmiObj.Status.Memory.GuestAtBoot.Value()).To(Equal(guestAtBoot.Value()))
+			Expect(vmiConditions.HasCondition(vmiObj, v1.VirtualMachineInstanceMemoryChange)).To(BeFalse())
+		})
+
+		controller.Execute()
+	})
+
 	Context("check if migratable", func() {
 
 		var testBlockPvc *k8sv1.PersistentVolumeClaim
@@ -2692,6 +2904,20 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonSEVNotMigratable))
 		})
 
+		DescribeTable("should not be allowed to live-migrate if the VMI is a confidential VM", func(launchSecurity *v1.LaunchSecurity, expectedReason string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.LaunchSecurity = launchSecurity
+
+			condition, isBlockMigration := controller.calculateLiveMigrationCondition(vmi)
+			Expect(isBlockMigration).To(BeFalse())
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(expectedReason))
+		},
+			Entry("with SEV-SNP", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}, v1.VirtualMachineInstanceReasonSEVNotMigratable),
+			Entry("with TDX", &v1.LaunchSecurity{TDX: &v1.TDX{}}, v1.VirtualMachineInstanceReasonTDXNotMigratable),
+		)
+
 		It("should not be allowed to live-migrate if the VMI uses SCSI persistent reservation", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 
@@ -2954,6 +3180,86 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 			testutils.ExpectEvent(recorder, VMIStarted)
 		})
+
+		It("should update the backup status in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			now := metav1.Now()
+			domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+				Name:           "backup",
+				Mode:           v1.BackupModePull,
+				Checkpoint:     "backup",
+				StartTimestamp: &now,
+				Volumes: []api.BackupVolumeMetadata{
+					{
+						Name:        "disk0",
+						DiskTarget:  "vda",
+						ExportName:  "disk0",
+						DirtyBitmap: "backup-disk0",
+					},
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+				backupStatus := arg.(*v1.VirtualMachineInstance).Status.BackupStatus
+				Expect(backupStatus).ToNot(BeNil())
+				Expect(backupStatus.Name).To(Equal("backup"))
+				Expect(backupStatus.Mode).To(Equal(v1.BackupModePull))
+				Expect(backupStatus.Checkpoint).To(Equal("backup"))
+				Expect(backupStatus.Completed).To(BeFalse())
+				Expect(backupStatus.Volumes).To(ConsistOf(v1.VirtualMachineInstanceBackupVolume{
+					VolumeName:  "disk0",
+					DiskTarget:  "vda",
+					ExportName:  "disk0",
+					DirtyBitmap: "backup-disk0",
+				}))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, VMIStarted)
+		})
+
+		It("should update the watchdog status in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			now := metav1.Now()
+			domain.Spec.Metadata.KubeVirt.Watchdog = &api.WatchdogMetadata{
+				FiredCount:         2,
+				LastFiredTimestamp: &now,
+				Action:             string(v1.WatchdogActionDump),
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+				watchdogStatus := arg.(*v1.VirtualMachineInstance).Status.WatchdogStatus
+				Expect(watchdogStatus).ToNot(BeNil())
+				Expect(watchdogStatus.FiredCount).To(Equal(int64(2)))
+				Expect(watchdogStatus.LastFiredTimestamp).To(Equal(&now))
+				Expect(watchdogStatus.Action).To(Equal(v1.WatchdogActionDump))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, VMIStarted)
+		})
 	})
 
 	Context("VirtualMachineInstance controller gets informed about disk information", func() {
@@ -3491,6 +3797,10 @@ func (nc *netConfStub) Teardown(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+func (nc *netConfStub) FilterDroppedPackets(vmi *v1.VirtualMachineInstance, launcherPid int) (map[string]*v1.InterfaceFilterDroppedPackets, error) {
+	return nil, nil
+}
+
 func (nc *netConfStub) HotUnplugInterfaces(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
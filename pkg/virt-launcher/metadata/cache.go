@@ -31,6 +31,9 @@ type Cache struct {
 	GracePeriod      SafeData[api.GracePeriodMetadata]
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
+	Backup           SafeData[api.BackupMetadata]
+	Balloon          SafeData[api.BalloonMetadata]
+	Watchdog         SafeData[api.WatchdogMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -44,6 +47,9 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.Backup.dirtyChanel = cache.notificationSignal
+	cache.Balloon.dirtyChanel = cache.notificationSignal
+	cache.Watchdog.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
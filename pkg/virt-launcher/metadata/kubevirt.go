@@ -44,5 +44,14 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.Backup.Load(); exists {
+		kubevirtMetadata.Backup = &value
+	}
+	if value, exists := metadataCache.Balloon.Load(); exists {
+		kubevirtMetadata.Balloon = &value
+	}
+	if value, exists := metadataCache.Watchdog.Load(); exists {
+		kubevirtMetadata.Watchdog = &value
+	}
 	return kubevirtMetadata
 }
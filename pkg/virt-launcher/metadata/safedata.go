@@ -21,10 +21,11 @@ package metadata
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 )
 
-type SafeData[T comparable] struct {
+type SafeData[T any] struct {
 	m           sync.Mutex
 	initialized bool
 	dirtyChanel chan<- struct{}
@@ -66,13 +67,15 @@ func (d *SafeData[T]) Set(data T) {
 // In case a notification channel exists and the data changed, a signal is sent.
 //
 // Access to the data is protected by locks during the execution.
+// Change detection compares the data before and after the execution, therefore referenced
+// data (e.g. slices) must be replaced and not mutated in place.
 func (d *SafeData[T]) WithSafeBlock(f func(data *T, initialized bool)) {
 	d.m.Lock()
 	defer d.m.Unlock()
 	oldData := d.data
 	f(&d.data, d.initialized)
 	d.initialized = true
-	if oldData != d.data {
+	if !reflect.DeepEqual(oldData, d.data) {
 		d.notify()
 	}
 }
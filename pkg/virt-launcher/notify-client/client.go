@@ -60,9 +60,10 @@ type Notifier struct {
 }
 
 type libvirtEvent struct {
-	Domain     string
-	Event      *libvirt.DomainEventLifecycle
-	AgentEvent *libvirt.DomainEventAgentLifecycle
+	Domain        string
+	Event         *libvirt.DomainEventLifecycle
+	AgentEvent    *libvirt.DomainEventAgentLifecycle
+	WatchdogEvent *libvirt.DomainEventWatchdog
 }
 
 func NewNotifier(virtShareDir string) *Notifier {
@@ -338,6 +339,11 @@ func eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEve
 				event := watch.Event{Type: watch.Added, Object: domain}
 				client.SendDomainEvent(event)
 				updateEvents(event, domain, events)
+			} else if cli.IsGuestPanicked(libvirtEvent.Event) {
+				err := client.SendK8sEvent(vmi, "Warning", "GuestPanicked", "The guest kernel panicked")
+				if err != nil {
+					log.Log.Reason(err).Error("Could not send k8s event")
+				}
 			}
 		}
 		if interfaceStatus != nil {
@@ -421,6 +427,9 @@ func (n *Notifier) StartDomainNotifier(
 				domainCache = util.NewDomainFromName(event.Domain, vmi.UID)
 				eventCallback(domainConn, domainCache, event, n, deleteNotificationSent, interfaceStatuses, guestOsInfo, vmi, fsFreezeStatus, metadataCache)
 				log.Log.Infof("Domain name event: %v", domainCache.Spec.Name)
+				if event.WatchdogEvent != nil {
+					handleWatchdogEvent(domainConn, event.Domain, n, vmi, metadataCache)
+				}
 				if event.AgentEvent != nil {
 					if event.AgentEvent.State == libvirt.CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED {
 						agentPoller.Start()
@@ -540,10 +549,80 @@ func (n *Notifier) StartDomainNotifier(
 		return err
 	}
 
+	domainEventWatchdogCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventWatchdog) {
+		log.Log.Infof("Watchdog event with action %d received", event.Action)
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info(cantDetermineLibvirtDomainName)
+		}
+		select {
+		case eventChan <- libvirtEvent{WatchdogEvent: event, Domain: name}:
+		default:
+			log.Log.Infof(libvirtEventChannelFull)
+		}
+	}
+	err = domainConn.DomainEventWatchdogRegister(domainEventWatchdogCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register watchdog event callback with libvirt")
+		return err
+	}
+
 	log.Log.Infof("Registered libvirt event notify callback")
 	return nil
 }
 
+func handleWatchdogEvent(c cli.Connection, domainName string, client *Notifier, vmi *v1.VirtualMachineInstance, metadataCache *metadata.Cache) {
+	action := v1.WatchdogActionReset
+	if vmi.Spec.Domain.Devices.Watchdog != nil {
+		action = converter.GetWatchdogAction(vmi.Spec.Domain.Devices.Watchdog)
+	}
+
+	// The guest of a VMI owned by a VirtualMachine stays paused until virt-controller had its
+	// memory dumped to the watchdog memory dump PVC, virt-launcher resets it afterwards.
+	dumpPending := action == v1.WatchdogActionDump && isOwnedByVirtualMachine(vmi)
+
+	metadataCache.Watchdog.WithSafeBlock(func(watchdogMetadata *api.WatchdogMetadata, _ bool) {
+		now := metav1.Now()
+		watchdogMetadata.FiredCount++
+		watchdogMetadata.LastFiredTimestamp = &now
+		watchdogMetadata.Action = string(action)
+		watchdogMetadata.DumpPending = dumpPending
+	})
+
+	err := client.SendK8sEvent(vmi, "Warning", "WatchdogFired", fmt.Sprintf("The guest watchdog fired, action taken: %s", action))
+	if err != nil {
+		log.Log.Reason(err).Error("Could not send k8s event")
+	}
+
+	if action == v1.WatchdogActionDump && !dumpPending {
+		log.Log.Object(vmi).Info("Not dumping the guest memory after the watchdog fired, the VMI is not owned by a VirtualMachine")
+		resetGuest(c, domainName)
+	}
+}
+
+func isOwnedByVirtualMachine(vmi *v1.VirtualMachineInstance) bool {
+	controllerRef := metav1.GetControllerOf(vmi)
+	return controllerRef != nil && controllerRef.Kind == v1.VirtualMachineGroupVersionKind.Kind
+}
+
+// resetGuest resets the guest paused by the watchdog and resumes it.
+func resetGuest(c cli.Connection, domainName string) {
+	dom, err := c.LookupDomainByName(domainName)
+	if err != nil {
+		log.Log.Reason(err).Error("Could not fetch the Domain to reset it.")
+		return
+	}
+	defer dom.Free()
+
+	if err := dom.Reset(0); err != nil {
+		log.Log.Reason(err).Error("Could not reset the guest after the watchdog fired.")
+		return
+	}
+	if err := dom.Resume(); err != nil {
+		log.Log.Reason(err).Error("Could not resume the guest after the watchdog fired.")
+	}
+}
+
 func (n *Notifier) SendK8sEvent(vmi *v1.VirtualMachineInstance, severity string, reason string, message string) error {
 	vmiRef, err := reference.GetReference(scheme, vmi)
 	if err != nil {
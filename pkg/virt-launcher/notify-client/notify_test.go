@@ -33,6 +33,7 @@ import (
 	api2 "kubevirt.io/client-go/api"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -346,6 +347,79 @@ var _ = Describe("Notify", func() {
 			Expect(event).To(Equal(fmt.Sprintf("%s %s %s involvedObject{kind=VirtualMachineInstance,apiVersion=kubevirt.io/v1}", eventType, eventReason, eventMessage)))
 		})
 
+		It("Should generate a k8s event and record the firing when the watchdog fires", func() {
+			vmi := api2.NewMinimalVMI("fake-vmi")
+			vmi.UID = "4321"
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+				Name: "watchdog",
+				WatchdogDevice: v1.WatchdogDevice{
+					I6300ESB: &v1.I6300ESBWatchdog{Action: v1.WatchdogActionPoweroff},
+				},
+			}
+			vmiStore.Add(vmi)
+			metadataCache := metadata.NewCache()
+
+			handleWatchdogEvent(nil, "test", client, vmi, metadataCache)
+			handleWatchdogEvent(nil, "test", client, vmi, metadataCache)
+
+			event := <-recorder.Events
+			Expect(event).To(Equal("Warning WatchdogFired The guest watchdog fired, action taken: poweroff involvedObject{kind=VirtualMachineInstance,apiVersion=kubevirt.io/v1}"))
+			watchdogMetadata, exists := metadataCache.Watchdog.Load()
+			Expect(exists).To(BeTrue())
+			Expect(watchdogMetadata.FiredCount).To(Equal(int64(2)))
+			Expect(watchdogMetadata.LastFiredTimestamp).ToNot(BeNil())
+			Expect(watchdogMetadata.Action).To(Equal(string(v1.WatchdogActionPoweroff)))
+			Expect(watchdogMetadata.DumpPending).To(BeFalse())
+		})
+
+		Context("with the watchdog dump action", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmi = api2.NewMinimalVMI("fake-vmi")
+				vmi.UID = "4321"
+				vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{
+					Name: "watchdog",
+					WatchdogDevice: v1.WatchdogDevice{
+						Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionDump},
+					},
+					MemoryDumpClaimName: "dump-pvc",
+				}
+			})
+
+			It("Should keep the guest paused until its memory is dumped when the VMI is owned by a VirtualMachine", func() {
+				vm := &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "fake-vm", UID: "1234"}}
+				vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+				vmiStore.Add(vmi)
+				metadataCache := metadata.NewCache()
+
+				handleWatchdogEvent(nil, "test", client, vmi, metadataCache)
+
+				Expect(<-recorder.Events).To(ContainSubstring("action taken: dump"))
+				watchdogMetadata, exists := metadataCache.Watchdog.Load()
+				Expect(exists).To(BeTrue())
+				Expect(watchdogMetadata.DumpPending).To(BeTrue())
+			})
+
+			It("Should reset the guest right away when the VMI is not owned by a VirtualMachine", func() {
+				ctrl := gomock.NewController(GinkgoT())
+				mockCon := cli.NewMockConnection(ctrl)
+				mockDomain := cli.NewMockVirDomain(ctrl)
+				mockCon.EXPECT().LookupDomainByName("test").Return(mockDomain, nil)
+				mockDomain.EXPECT().Reset(uint32(0)).Return(nil)
+				mockDomain.EXPECT().Resume().Return(nil)
+				mockDomain.EXPECT().Free()
+				vmiStore.Add(vmi)
+				metadataCache := metadata.NewCache()
+
+				handleWatchdogEvent(mockCon, "test", client, vmi, metadataCache)
+
+				Expect(<-recorder.Events).To(ContainSubstring("action taken: dump"))
+				watchdogMetadata, exists := metadataCache.Watchdog.Load()
+				Expect(exists).To(BeTrue())
+				Expect(watchdogMetadata.DumpPending).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("Version mismatch", func() {
@@ -164,9 +164,80 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupMetadata) DeepCopyInto(out *BackupMetadata) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]BackupVolumeMetadata, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupMetadata.
+func (in *BackupMetadata) DeepCopy() *BackupMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupVolumeMetadata) DeepCopyInto(out *BackupVolumeMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupVolumeMetadata.
+func (in *BackupVolumeMetadata) DeepCopy() *BackupVolumeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupVolumeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BalloonMetadata) DeepCopyInto(out *BalloonMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BalloonMetadata.
+func (in *BalloonMetadata) DeepCopy() *BalloonMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BalloonMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -180,6 +251,22 @@ func (in *BandWidth) DeepCopy() *BandWidth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandWidthLimit) DeepCopyInto(out *BandWidthLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandWidthLimit.
+func (in *BandWidthLimit) DeepCopy() *BandWidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandWidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockIO) DeepCopyInto(out *BlockIO) {
 	*out = *in
@@ -196,6 +283,22 @@ func (in *BlockIO) DeepCopy() *BlockIO {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockIOTune) DeepCopyInto(out *BlockIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockIOTune.
+func (in *BlockIOTune) DeepCopy() *BlockIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Boot) DeepCopyInto(out *Boot) {
 	*out = *in
@@ -741,6 +844,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(Watchdog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Panics != nil {
+		in, out := &in.Panics, &out.Panics
+		*out = make([]PanicDevice, len(*in))
+		copy(*out, *in)
+	}
 	if in.Rng != nil {
 		in, out := &in.Rng, &out.Rng
 		*out = new(Rng)
@@ -768,13 +876,20 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.TPMs != nil {
 		in, out := &in.TPMs, &out.TPMs
 		*out = make([]TPM, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.VSOCK != nil {
 		in, out := &in.VSOCK, &out.VSOCK
 		*out = new(VSOCK)
 		**out = **in
 	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryDevice)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -848,6 +963,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(BlockIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1010,6 +1130,147 @@ func (in *Domain) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackup) DeepCopyInto(out *DomainBackup) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(DomainBackupServer)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainBackupDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackup.
+func (in *DomainBackup) DeepCopy() *DomainBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDisk) DeepCopyInto(out *DomainBackupDisk) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(DomainBackupDiskTarget)
+		**out = **in
+	}
+	if in.Driver != nil {
+		in, out := &in.Driver, &out.Driver
+		*out = new(DomainBackupDiskDriver)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDisk.
+func (in *DomainBackupDisk) DeepCopy() *DomainBackupDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDiskDriver) DeepCopyInto(out *DomainBackupDiskDriver) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDiskDriver.
+func (in *DomainBackupDiskDriver) DeepCopy() *DomainBackupDiskDriver {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDiskDriver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupDiskTarget) DeepCopyInto(out *DomainBackupDiskTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupDiskTarget.
+func (in *DomainBackupDiskTarget) DeepCopy() *DomainBackupDiskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupDiskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainBackupServer) DeepCopyInto(out *DomainBackupServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainBackupServer.
+func (in *DomainBackupServer) DeepCopy() *DomainBackupServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainBackupServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpoint) DeepCopyInto(out *DomainCheckpoint) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainCheckpointDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpoint.
+func (in *DomainCheckpoint) DeepCopy() *DomainCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainCheckpointDisk) DeepCopyInto(out *DomainCheckpointDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainCheckpointDisk.
+func (in *DomainCheckpointDisk) DeepCopy() *DomainCheckpointDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainCheckpointDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainGuestInfo) DeepCopyInto(out *DomainGuestInfo) {
 	*out = *in
@@ -1081,6 +1342,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
 	out.XMLName = in.XMLName
 	out.Memory = in.Memory
+	if in.MaxMemory != nil {
+		in, out := &in.MaxMemory, &out.MaxMemory
+		*out = new(MaxMemory)
+		**out = **in
+	}
 	if in.MemoryBacking != nil {
 		in, out := &in.MemoryBacking, &out.MemoryBacking
 		*out = new(MemoryBacking)
@@ -1914,7 +2180,7 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.BandWidth != nil {
 		in, out := &in.BandWidth, &out.BandWidth
 		*out = new(BandWidth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
@@ -2066,6 +2332,21 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(BalloonMetadata)
+		**out = **in
+	}
+	if in.Watchdog != nil {
+		in, out := &in.Watchdog, &out.Watchdog
+		*out = new(WatchdogMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2159,6 +2440,22 @@ func (in *MTU) DeepCopy() *MTU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxMemory) DeepCopyInto(out *MaxMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxMemory.
+func (in *MaxMemory) DeepCopy() *MaxMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemBalloon) DeepCopyInto(out *MemBalloon) {
 	*out = *in
@@ -2327,6 +2624,38 @@ func (in *MemoryBackingSource) DeepCopy() *MemoryBackingSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryDevice) DeepCopyInto(out *MemoryDevice) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(MemoryTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alias != nil {
+		in, out := &in.Alias, &out.Alias
+		*out = new(Alias)
+		**out = **in
+	}
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = new(Address)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryDevice.
+func (in *MemoryDevice) DeepCopy() *MemoryDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemoryDumpMetadata) DeepCopyInto(out *MemoryDumpMetadata) {
 	*out = *in
@@ -2351,6 +2680,30 @@ func (in *MemoryDumpMetadata) DeepCopy() *MemoryDumpMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryTarget) DeepCopyInto(out *MemoryTarget) {
+	*out = *in
+	out.Size = in.Size
+	out.Block = in.Block
+	out.Requested = in.Requested
+	if in.Current != nil {
+		in, out := &in.Current, &out.Current
+		*out = new(Memory)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryTarget.
+func (in *MemoryTarget) DeepCopy() *MemoryTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metadata) DeepCopyInto(out *Metadata) {
 	*out = *in
@@ -2578,6 +2931,22 @@ func (in *OSType) DeepCopy() *OSType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicDevice.
+func (in *PanicDevice) DeepCopy() *PanicDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnly) DeepCopyInto(out *ReadOnly) {
 	*out = *in
@@ -3022,7 +3391,7 @@ func (in *SysInfo) DeepCopy() *SysInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPM) DeepCopyInto(out *TPM) {
 	*out = *in
-	out.Backend = in.Backend
+	in.Backend.DeepCopyInto(&out.Backend)
 	return
 }
 
@@ -3039,6 +3408,11 @@ func (in *TPM) DeepCopy() *TPM {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TPMBackend) DeepCopyInto(out *TPMBackend) {
 	*out = *in
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(TPMEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -3052,6 +3426,22 @@ func (in *TPMBackend) DeepCopy() *TPMBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TPMEncryption) DeepCopyInto(out *TPMEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TPMEncryption.
+func (in *TPMEncryption) DeepCopy() *TPMEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(TPMEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -3248,3 +3638,23 @@ func (in *Watchdog) DeepCopy() *Watchdog {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchdogMetadata) DeepCopyInto(out *WatchdogMetadata) {
+	*out = *in
+	if in.LastFiredTimestamp != nil {
+		in, out := &in.LastFiredTimestamp, &out.LastFiredTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchdogMetadata.
+func (in *WatchdogMetadata) DeepCopy() *WatchdogMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchdogMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
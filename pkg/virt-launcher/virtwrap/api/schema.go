@@ -100,6 +100,10 @@ const (
 	HostDevicePCI  = "pci"
 	HostDeviceMDev = "mdev"
 	AddressPCI     = "pci"
+
+	// BackupNBDSocketName is the unix socket, in the private directory of the VMI,
+	// the disks of a pull mode backup are exported on
+	BackupNBDSocketName = "virt-backup-nbd"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -194,6 +198,7 @@ type DomainSpec struct {
 	Name           string          `xml:"name"`
 	UUID           string          `xml:"uuid,omitempty"`
 	Memory         Memory          `xml:"memory"`
+	MaxMemory      *MaxMemory      `xml:"maxMemory,omitempty"`
 	MemoryBacking  *MemoryBacking  `xml:"memoryBacking,omitempty"`
 	OS             OS              `xml:"os"`
 	SysInfo        *SysInfo        `xml:"sysinfo,omitempty"`
@@ -210,6 +215,7 @@ type DomainSpec struct {
 	NUMATune       *NUMATune       `xml:"numatune"`
 	IOThreads      *IOThreads      `xml:"iothreads,omitempty"`
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 }
 
 type CPUTune struct {
@@ -367,6 +373,9 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
+	Balloon          *BalloonMetadata          `xml:"balloon,omitempty"`
+	Watchdog         *WatchdogMetadata         `xml:"watchdog,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -383,6 +392,40 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type BackupMetadata struct {
+	Name           string                              `xml:"name,omitempty"`
+	Mode           v1.VirtualMachineInstanceBackupMode `xml:"mode,omitempty"`
+	Checkpoint     string                              `xml:"checkpoint,omitempty"`
+	StartTimestamp *metav1.Time                        `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time                        `xml:"endTimestamp,omitempty"`
+	Completed      bool                                `xml:"completed,omitempty"`
+	Failed         bool                                `xml:"failed,omitempty"`
+	FailureReason  string                              `xml:"failureReason,omitempty"`
+	Volumes        []BackupVolumeMetadata              `xml:"volumes>volume,omitempty"`
+}
+
+// BalloonMetadata holds the memory balloon target set by the launcher
+// and the current balloon size reported by the guest, both in KiB.
+type BalloonMetadata struct {
+	Target uint64 `xml:"target,omitempty"`
+	Actual uint64 `xml:"actual,omitempty"`
+}
+
+type WatchdogMetadata struct {
+	FiredCount         int64        `xml:"firedCount,omitempty"`
+	LastFiredTimestamp *metav1.Time `xml:"lastFiredTimestamp,omitempty"`
+	Action             string       `xml:"action,omitempty"`
+	DumpPending        bool         `xml:"dumpPending,omitempty"`
+}
+
+type BackupVolumeMetadata struct {
+	Name        string `xml:"name,attr"`
+	DiskTarget  string `xml:"diskTarget,attr,omitempty"`
+	ExportName  string `xml:"exportName,attr,omitempty"`
+	DirtyBitmap string `xml:"dirtyBitmap,attr,omitempty"`
+	Path        string `xml:"path,attr,omitempty"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -423,6 +466,30 @@ type Memory struct {
 	Unit  string `xml:"unit,attr"`
 }
 
+type MaxMemory struct {
+	Value uint64 `xml:",chardata"`
+	Unit  string `xml:"unit,attr"`
+	Slots uint64 `xml:"slots,attr,omitempty"`
+}
+
+// MemoryDevice describes a memory device which can be resized while the domain is running
+// See: https://libvirt.org/formatdomain.html#memory-devices
+type MemoryDevice struct {
+	XMLName xml.Name      `xml:"memory"`
+	Model   string        `xml:"model,attr"`
+	Target  *MemoryTarget `xml:"target"`
+	Alias   *Alias        `xml:"alias,omitempty"`
+	Address *Address      `xml:"address,omitempty"`
+}
+
+type MemoryTarget struct {
+	Size      Memory  `xml:"size"`
+	Node      string  `xml:"node"`
+	Block     Memory  `xml:"block"`
+	Requested Memory  `xml:"requested"`
+	Current   *Memory `xml:"current,omitempty"`
+}
+
 // MemoryBacking mirroring libvirt XML under https://libvirt.org/formatdomain.html#elementsMemoryBacking
 type MemoryBacking struct {
 	HugePages    *HugePages           `xml:"hugepages,omitempty"`
@@ -479,12 +546,14 @@ type Devices struct {
 	Serials     []Serial           `xml:"serial"`
 	Consoles    []Console          `xml:"console"`
 	Watchdog    *Watchdog          `xml:"watchdog,omitempty"`
+	Panics      []PanicDevice      `xml:"panic,omitempty"`
 	Rng         *Rng               `xml:"rng,omitempty"`
 	Filesystems []FilesystemDevice `xml:"filesystem,omitempty"`
 	Redirs      []RedirectedDevice `xml:"redirdev,omitempty"`
 	SoundCards  []SoundCard        `xml:"sound,omitempty"`
 	TPMs        []TPM              `xml:"tpm,omitempty"`
 	VSOCK       *VSOCK             `xml:"vsock,omitempty"`
+	Memory      *MemoryDevice      `xml:"memory,omitempty"`
 }
 
 type TPM struct {
@@ -493,9 +562,14 @@ type TPM struct {
 }
 
 type TPMBackend struct {
-	Type            string `xml:"type,attr"`
-	Version         string `xml:"version,attr"`
-	PersistentState string `xml:"persistent_state,attr,omitempty"`
+	Type            string         `xml:"type,attr"`
+	Version         string         `xml:"version,attr"`
+	PersistentState string         `xml:"persistent_state,attr,omitempty"`
+	Encryption      *TPMEncryption `xml:"encryption,omitempty"`
+}
+
+type TPMEncryption struct {
+	Secret string `xml:"secret,attr"`
 }
 
 // RedirectedDevice describes a device to be redirected
@@ -625,6 +699,17 @@ type Disk struct {
 	Capacity           *int64         `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool           `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable     `xml:"shareable,omitempty"`
+	IOTune             *BlockIOTune   `xml:"iotune,omitempty"`
+}
+
+// BlockIOTune mirroring libvirt XML under https://libvirt.org/formatdomain.html#hard-drives-floppy-disks-cdroms
+type BlockIOTune struct {
+	TotalBytesSec uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec  uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec  uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec   uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec  uint64 `xml:"write_iops_sec,omitempty"`
 }
 
 type DiskAuth struct {
@@ -777,7 +862,16 @@ type LinkState struct {
 	State string `xml:"state,attr"`
 }
 
+// BandWidth mirroring libvirt XML under https://libvirt.org/formatnetwork.html#quality-of-service
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+type BandWidthLimit struct {
+	Average uint `xml:"average,attr"`
+	Peak    uint `xml:"peak,attr,omitempty"`
+	Burst   uint `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -1087,6 +1181,10 @@ type Watchdog struct {
 	Address *Address `xml:"address,omitempty"`
 }
 
+type PanicDevice struct {
+	Model string `xml:"model,attr,omitempty"`
+}
+
 // Rng represents the source of entropy from host to VM
 type Rng struct {
 	// Model attribute specifies what type of RNG device is provided
@@ -1211,3 +1309,51 @@ func VMINamespaceKeyFunc(vmi *v1.VirtualMachineInstance) string {
 	domName := fmt.Sprintf("%s_%s", vmi.Namespace, vmi.Name)
 	return domName
 }
+
+// DomainBackup is the description of a libvirt backup job
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server,omitempty"`
+	Disks       []DomainBackupDisk  `xml:"disks>disk"`
+}
+
+type DomainBackupServer struct {
+	Transport string `xml:"transport,attr,omitempty"`
+	Name      string `xml:"name,attr,omitempty"`
+	Port      string `xml:"port,attr,omitempty"`
+	Socket    string `xml:"socket,attr,omitempty"`
+}
+
+type DomainBackupDisk struct {
+	Name         string                  `xml:"name,attr"`
+	Backup       string                  `xml:"backup,attr"`
+	BackupMode   string                  `xml:"backupmode,attr,omitempty"`
+	Incremental  string                  `xml:"incremental,attr,omitempty"`
+	Type         string                  `xml:"type,attr,omitempty"`
+	ExportName   string                  `xml:"exportname,attr,omitempty"`
+	ExportBitmap string                  `xml:"exportbitmap,attr,omitempty"`
+	Target       *DomainBackupDiskTarget `xml:"target,omitempty"`
+	Driver       *DomainBackupDiskDriver `xml:"driver,omitempty"`
+}
+
+type DomainBackupDiskTarget struct {
+	File string `xml:"file,attr"`
+}
+
+type DomainBackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+// DomainCheckpoint is the description of a libvirt checkpoint
+type DomainCheckpoint struct {
+	XMLName xml.Name               `xml:"domaincheckpoint"`
+	Name    string                 `xml:"name"`
+	Disks   []DomainCheckpointDisk `xml:"disks>disk"`
+}
+
+type DomainCheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr"`
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// sevAttestationNonceLength is the size of the nonce QEMU embeds into a SEV attestation report
+const sevAttestationNonceLength = 16
+
+type qmpCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type sevAttestationReportArguments struct {
+	MNonce string `json:"mnonce"`
+}
+
+type sevAttestationReportResult struct {
+	Return struct {
+		Data string `json:"data"`
+	} `json:"return"`
+}
+
+func (l *LibvirtDomainManager) GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error) {
+	switch {
+	case kutil.IsSEVSNPVMI(vmi):
+		return nil, fmt.Errorf("SEV-SNP attestation reports can only be requested from within the guest")
+	case kutil.IsTDXVMI(vmi):
+		return nil, fmt.Errorf("TDX attestation reports can only be requested from within the guest")
+	case !kutil.IsSEVVMI(vmi):
+		return nil, fmt.Errorf("VMI is not a confidential guest")
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(nonce); err != nil || len(decoded) != sevAttestationNonceLength {
+		return nil, fmt.Errorf("nonce must be %d base64 encoded bytes", sevAttestationNonceLength)
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return nil, err
+	}
+	defer dom.Free()
+
+	cmd, err := json.Marshal(qmpCommand{
+		Execute:   "query-sev-attestation-report",
+		Arguments: sevAttestationReportArguments{MNonce: nonce},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := dom.QemuMonitorCommand(string(cmd), libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Querying the SEV attestation report failed")
+		return nil, err
+	}
+
+	result := sevAttestationReportResult{}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse SEV attestation report: %v", err)
+	}
+
+	return &v1.AttestationReport{
+		Type:   "sev",
+		Nonce:  nonce,
+		Report: result.Return.Data,
+	}, nil
+}
@@ -0,0 +1,382 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	failedDomainBackup     = "Domain backup failed"
+	backupJobPollInterval  = 1 * time.Second
+	backupDirtyBitmapFmt   = "backup-%s"
+	backupTargetFileSuffix = ".qcow2"
+)
+
+// backupDisk is a domain disk included in a backup job
+type backupDisk struct {
+	volumeName  string
+	target      string
+	incremental bool
+}
+
+// BackupVMI starts a libvirt backup job of the persistent disks of the domain.
+// A checkpoint is recorded along with the backup for every disk able to track
+// its changed blocks, later backups can be taken incrementally from it.
+func (l *LibvirtDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error {
+	if options.Name == "" {
+		return fmt.Errorf("backup name is required")
+	}
+	if options.Mode == v1.BackupModePush && options.Target == "" {
+		return fmt.Errorf("target is required for a push mode backup")
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	if backup, exists := l.metadataCache.Backup.Load(); exists && !backup.Completed {
+		if backup.Name == options.Name {
+			// backup already started
+			return nil
+		}
+		return fmt.Errorf("backup %s is in progress", backup.Name)
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return err
+	}
+	defer dom.Free()
+
+	domainDisks, err := getAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+
+	disks := getBackupDisks(vmi, domainDisks, options)
+	if len(disks) == 0 {
+		return fmt.Errorf("no disk to back up")
+	}
+
+	backupDir := ""
+	if options.Mode == v1.BackupModePush {
+		backupDir = filepath.Join(hostdisk.GetMountedHostDiskDir(options.Target), options.Name)
+		if err := os.MkdirAll(backupDir, 0750); err != nil {
+			return err
+		}
+	}
+
+	backupXML, err := xml.Marshal(newDomainBackup(vmi, domainDisks, disks, options, backupDir))
+	if err != nil {
+		return err
+	}
+
+	checkpointXML := ""
+	if checkpoint := newDomainCheckpoint(domainDisks, disks, options); checkpoint != nil {
+		checkpointBytes, err := xml.Marshal(checkpoint)
+		if err != nil {
+			return err
+		}
+		checkpointXML = string(checkpointBytes)
+	}
+
+	log.Log.Object(vmi).V(3).Infof("Starting backup with XML %s and checkpoint XML %s", string(backupXML), checkpointXML)
+	if err := dom.BackupBegin(string(backupXML), checkpointXML, 0); err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedDomainBackup)
+		return err
+	}
+
+	l.initializeBackupMetadata(options, disks, checkpointXML != "", backupDir)
+
+	if options.Mode == v1.BackupModePush {
+		go l.waitForBackupJob(vmi)
+	}
+	return nil
+}
+
+// AbortVMIBackup stops the running backup job of the domain. A pull mode
+// backup job only ends this way.
+func (l *LibvirtDomainManager) AbortVMIBackup(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	backup, exists := l.metadataCache.Backup.Load()
+	if !exists || backup.Completed {
+		return nil
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedGetDomain)
+		return err
+	}
+	defer dom.Free()
+
+	if err := dom.AbortJob(); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Aborting the backup job failed")
+		return err
+	}
+
+	if backup.Mode != v1.BackupModePush {
+		l.setBackupResult(false, "")
+	}
+	return nil
+}
+
+func getBackupDisks(vmi *v1.VirtualMachineInstance, domainDisks []api.Disk, options *v1.VirtualMachineInstanceBackupOptions) []backupDisk {
+	persistentVolumes := map[string]bool{}
+	for _, volume := range vmi.Spec.Volumes {
+		volSrc := volume.VolumeSource
+		if volume.Name == options.Target {
+			continue
+		}
+		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil || volSrc.HostDisk != nil {
+			persistentVolumes[volume.Name] = true
+		}
+	}
+
+	var disks []backupDisk
+	for _, disk := range domainDisks {
+		if disk.Device == "cdrom" || disk.Alias == nil || !persistentVolumes[disk.Alias.GetName()] {
+			continue
+		}
+		disks = append(disks, backupDisk{
+			volumeName:  disk.Alias.GetName(),
+			target:      disk.Target.Device,
+			incremental: options.IncrementalFrom != nil && supportsCheckpoints(disk),
+		})
+	}
+	return disks
+}
+
+// supportsCheckpoints tells whether the changed blocks of the disk can be
+// tracked, persistent dirty bitmaps require a qcow2 image
+func supportsCheckpoints(disk api.Disk) bool {
+	return disk.Driver != nil && disk.Driver.Type == "qcow2"
+}
+
+func newDomainBackup(vmi *v1.VirtualMachineInstance, domainDisks []api.Disk, disks []backupDisk, options *v1.VirtualMachineInstanceBackupOptions, backupDir string) *api.DomainBackup {
+	backup := &api.DomainBackup{
+		Mode: "pull",
+	}
+	if options.Mode == v1.BackupModePush {
+		backup.Mode = "push"
+	} else {
+		// The export is only reachable from within the pod, virt-handler proxies
+		// it to the authenticated export server
+		backup.Server = &api.DomainBackupServer{
+			Transport: "unix",
+			Socket:    fmt.Sprintf("/var/run/kubevirt-private/%s/%s", vmi.UID, api.BackupNBDSocketName),
+		}
+	}
+
+	included := map[string]backupDisk{}
+	for _, disk := range disks {
+		included[disk.target] = disk
+	}
+
+	for _, domainDisk := range domainDisks {
+		disk, exists := included[domainDisk.Target.Device]
+		if !exists {
+			backup.Disks = append(backup.Disks, api.DomainBackupDisk{
+				Name:   domainDisk.Target.Device,
+				Backup: "no",
+			})
+			continue
+		}
+
+		domainBackupDisk := api.DomainBackupDisk{
+			Name:       disk.target,
+			Backup:     "yes",
+			BackupMode: "full",
+		}
+		if disk.incremental {
+			domainBackupDisk.BackupMode = "incremental"
+			domainBackupDisk.Incremental = *options.IncrementalFrom
+		}
+		if options.Mode == v1.BackupModePush {
+			domainBackupDisk.Type = "file"
+			domainBackupDisk.Target = &api.DomainBackupDiskTarget{
+				File: filepath.Join(backupDir, disk.volumeName+backupTargetFileSuffix),
+			}
+			domainBackupDisk.Driver = &api.DomainBackupDiskDriver{
+				Type: "qcow2",
+			}
+		} else {
+			domainBackupDisk.ExportName = disk.volumeName
+			if disk.incremental {
+				domainBackupDisk.ExportBitmap = fmt.Sprintf(backupDirtyBitmapFmt, disk.volumeName)
+			}
+		}
+		backup.Disks = append(backup.Disks, domainBackupDisk)
+	}
+	return backup
+}
+
+// newDomainCheckpoint returns the checkpoint to record along with the backup,
+// or nil when none of the backed up disks is able to track its changed blocks
+func newDomainCheckpoint(domainDisks []api.Disk, disks []backupDisk, options *v1.VirtualMachineInstanceBackupOptions) *api.DomainCheckpoint {
+	if options.Checkpoint == "" {
+		return nil
+	}
+
+	included := map[string]bool{}
+	for _, disk := range disks {
+		included[disk.target] = true
+	}
+
+	checkpoint := &api.DomainCheckpoint{
+		Name: options.Checkpoint,
+	}
+	tracked := false
+	for _, domainDisk := range domainDisks {
+		checkpointDisk := api.DomainCheckpointDisk{
+			Name:       domainDisk.Target.Device,
+			Checkpoint: "no",
+		}
+		if included[domainDisk.Target.Device] && supportsCheckpoints(domainDisk) {
+			checkpointDisk.Checkpoint = "bitmap"
+			tracked = true
+		}
+		checkpoint.Disks = append(checkpoint.Disks, checkpointDisk)
+	}
+	if !tracked {
+		return nil
+	}
+	return checkpoint
+}
+
+func (l *LibvirtDomainManager) initializeBackupMetadata(options *v1.VirtualMachineInstanceBackupOptions, disks []backupDisk, checkpointCreated bool, backupDir string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
+		now := metav1.Now()
+		*backupMetadata = api.BackupMetadata{
+			Name:           options.Name,
+			Mode:           options.Mode,
+			StartTimestamp: &now,
+		}
+		if checkpointCreated {
+			backupMetadata.Checkpoint = options.Checkpoint
+		}
+		for _, disk := range disks {
+			volume := api.BackupVolumeMetadata{
+				Name:       disk.volumeName,
+				DiskTarget: disk.target,
+			}
+			if options.Mode == v1.BackupModePush {
+				volume.Path = filepath.Join(filepath.Base(backupDir), disk.volumeName+backupTargetFileSuffix)
+			} else {
+				volume.ExportName = disk.volumeName
+				if disk.incremental {
+					volume.DirtyBitmap = fmt.Sprintf(backupDirtyBitmapFmt, disk.volumeName)
+				}
+			}
+			backupMetadata.Volumes = append(backupMetadata.Volumes, volume)
+		}
+	})
+	log.Log.V(4).Infof("initialize backup metadata: %s", l.metadataCache.Backup.String())
+}
+
+func (l *LibvirtDomainManager) setBackupResult(failed bool, reason string) {
+	l.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, initialized bool) {
+		if !initialized || backupMetadata.Completed {
+			return
+		}
+
+		now := metav1.Now()
+		backupMetadata.Completed = true
+		backupMetadata.EndTimestamp = &now
+		backupMetadata.Failed = failed
+		backupMetadata.FailureReason = reason
+	})
+	log.Log.V(4).Infof("set backup results in metadata: %s", l.metadataCache.Backup.String())
+}
+
+// waitForBackupJob records the result of a push mode backup job once it ends
+func (l *LibvirtDomainManager) waitForBackupJob(vmi *v1.VirtualMachineInstance) {
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		logger.Reason(err).Error(failedGetDomain)
+		l.setBackupResult(true, fmt.Sprintf("%s: %v", failedDomainBackup, err))
+		return
+	}
+	defer dom.Free()
+
+	ticker := time.NewTicker(backupJobPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		jobInfo, err := dom.GetJobInfo()
+		if err != nil {
+			logger.Reason(err).Error("Failed to get the backup job info")
+			l.setBackupResult(true, fmt.Sprintf("%s: %v", failedDomainBackup, err))
+			return
+		}
+		if jobInfo.Type != libvirt.DOMAIN_JOB_NONE {
+			continue
+		}
+
+		failed, reason := getBackupJobResult(dom)
+		if failed {
+			logger.Error(reason)
+		} else {
+			logger.Info("Completed backup successfully")
+		}
+		l.setBackupResult(failed, reason)
+		return
+	}
+}
+
+func getBackupJobResult(dom cli.VirDomain) (bool, string) {
+	stats, err := dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+	if err != nil {
+		return true, fmt.Sprintf("%s: %v", failedDomainBackup, err)
+	}
+
+	switch stats.Type {
+	case libvirt.DOMAIN_JOB_COMPLETED:
+		return false, ""
+	case libvirt.DOMAIN_JOB_CANCELLED:
+		return true, fmt.Sprintf("%s: backup job aborted", failedDomainBackup)
+	default:
+		if stats.ErrorMessageSet {
+			return true, fmt.Sprintf("%s: %s", failedDomainBackup, stats.ErrorMessage)
+		}
+		return true, failedDomainBackup
+	}
+}
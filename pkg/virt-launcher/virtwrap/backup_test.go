@@ -0,0 +1,225 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/utils/pointer"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Backup", func() {
+	const (
+		vmName       = "testvmi"
+		namespace    = "testnamespace"
+		backupVMIUID = "1234"
+	)
+
+	newBackupVMI := func() *v1.VirtualMachineInstance {
+		vmi := newVMI(namespace, vmName)
+		vmi.UID = backupVMIUID
+		vmi.Spec.Volumes = []v1.Volume{
+			{
+				Name: "rootdisk",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{},
+				},
+			},
+			{
+				Name: "datadisk",
+				VolumeSource: v1.VolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: "dv"},
+				},
+			},
+			{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{},
+				},
+			},
+		}
+		return vmi
+	}
+
+	newDomainDisks := func() []api.Disk {
+		return []api.Disk{
+			{
+				Device: "disk",
+				Target: api.DiskTarget{Device: "vda"},
+				Driver: &api.DiskDriver{Type: "qcow2"},
+				Alias:  api.NewUserDefinedAlias("rootdisk"),
+			},
+			{
+				Device: "disk",
+				Target: api.DiskTarget{Device: "vdb"},
+				Driver: &api.DiskDriver{Type: "raw"},
+				Alias:  api.NewUserDefinedAlias("datadisk"),
+			},
+			{
+				Device: "disk",
+				Target: api.DiskTarget{Device: "vdc"},
+				Driver: &api.DiskDriver{Type: "raw"},
+				Alias:  api.NewUserDefinedAlias("cloudinit"),
+			},
+		}
+	}
+
+	Context("backup description", func() {
+		It("should only back up persistent disks", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull}
+			disks := getBackupDisks(newBackupVMI(), newDomainDisks(), options)
+			Expect(disks).To(ConsistOf(
+				backupDisk{volumeName: "rootdisk", target: "vda"},
+				backupDisk{volumeName: "datadisk", target: "vdb"},
+			))
+		})
+
+		It("should only back up qcow2 disks incrementally", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull, IncrementalFrom: pointer.String("previous")}
+			disks := getBackupDisks(newBackupVMI(), newDomainDisks(), options)
+			Expect(disks).To(ConsistOf(
+				backupDisk{volumeName: "rootdisk", target: "vda", incremental: true},
+				backupDisk{volumeName: "datadisk", target: "vdb"},
+			))
+		})
+
+		It("should export the disks over NBD in pull mode", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull, IncrementalFrom: pointer.String("previous")}
+			domainDisks := newDomainDisks()
+			backup := newDomainBackup(newBackupVMI(), domainDisks, getBackupDisks(newBackupVMI(), domainDisks, options), options, "")
+			Expect(backup.Mode).To(Equal("pull"))
+			Expect(backup.Server).To(Equal(&api.DomainBackupServer{Transport: "unix", Socket: "/var/run/kubevirt-private/" + backupVMIUID + "/virt-backup-nbd"}))
+			Expect(backup.Disks).To(ConsistOf(
+				api.DomainBackupDisk{Name: "vda", Backup: "yes", BackupMode: "incremental", Incremental: "previous", ExportName: "rootdisk", ExportBitmap: "backup-rootdisk"},
+				api.DomainBackupDisk{Name: "vdb", Backup: "yes", BackupMode: "full", ExportName: "datadisk"},
+				api.DomainBackupDisk{Name: "vdc", Backup: "no"},
+			))
+		})
+
+		It("should write the disks to the target in push mode", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePush, Target: "target"}
+			domainDisks := newDomainDisks()
+			backup := newDomainBackup(newBackupVMI(), domainDisks, getBackupDisks(newBackupVMI(), domainDisks, options), options, "/backups/backup")
+			Expect(backup.Mode).To(Equal("push"))
+			Expect(backup.Server).To(BeNil())
+			Expect(backup.Disks).To(ConsistOf(
+				api.DomainBackupDisk{Name: "vda", Backup: "yes", BackupMode: "full", Type: "file",
+					Target: &api.DomainBackupDiskTarget{File: "/backups/backup/rootdisk.qcow2"}, Driver: &api.DomainBackupDiskDriver{Type: "qcow2"}},
+				api.DomainBackupDisk{Name: "vdb", Backup: "yes", BackupMode: "full", Type: "file",
+					Target: &api.DomainBackupDiskTarget{File: "/backups/backup/datadisk.qcow2"}, Driver: &api.DomainBackupDiskDriver{Type: "qcow2"}},
+				api.DomainBackupDisk{Name: "vdc", Backup: "no"},
+			))
+		})
+
+		It("should only track the changed blocks of qcow2 disks", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull, Checkpoint: "backup"}
+			domainDisks := newDomainDisks()
+			checkpoint := newDomainCheckpoint(domainDisks, getBackupDisks(newBackupVMI(), domainDisks, options), options)
+			Expect(checkpoint).ToNot(BeNil())
+			Expect(checkpoint.Name).To(Equal("backup"))
+			Expect(checkpoint.Disks).To(ConsistOf(
+				api.DomainCheckpointDisk{Name: "vda", Checkpoint: "bitmap"},
+				api.DomainCheckpointDisk{Name: "vdb", Checkpoint: "no"},
+				api.DomainCheckpointDisk{Name: "vdc", Checkpoint: "no"},
+			))
+		})
+
+		It("should not create a checkpoint without qcow2 disks", func() {
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull, Checkpoint: "backup"}
+			domainDisks := newDomainDisks()
+			domainDisks[0].Driver.Type = "raw"
+			Expect(newDomainCheckpoint(domainDisks, getBackupDisks(newBackupVMI(), domainDisks, options), options)).To(BeNil())
+		})
+	})
+
+	Context("backup job", func() {
+		var (
+			ctrl          *gomock.Controller
+			mockConn      *cli.MockConnection
+			mockDomain    *cli.MockVirDomain
+			metadataCache *metadata.Cache
+			manager       DomainManager
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			mockConn = cli.NewMockConnection(ctrl)
+			mockDomain = cli.NewMockVirDomain(ctrl)
+			metadataCache = metadata.NewCache()
+			manager, _ = newLibvirtDomainManager(mockConn, "/var/run/kubevirt", "/var/run/kubevirt/container-disks", nil, "/usr/share/OVMF", nil, nil, metadataCache)
+
+			mockConn.EXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(newBackupVMI())).Return(mockDomain, nil).AnyTimes()
+			mockDomain.EXPECT().Free().AnyTimes()
+		})
+
+		It("should start a pull mode backup and record it in the metadata", func() {
+			domainSpec := api.DomainSpec{}
+			domainSpec.Devices.Disks = newDomainDisks()
+			domainXML, err := xml.Marshal(domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(string(domainXML), nil)
+			mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(backupXML, checkpointXML string, _ libvirt.DomainBackupBeginFlags) error {
+				Expect(backupXML).To(ContainSubstring(`<domainbackup mode="pull">`))
+				Expect(checkpointXML).To(ContainSubstring(`<name>backup</name>`))
+				return nil
+			})
+
+			options := &v1.VirtualMachineInstanceBackupOptions{Name: "backup", Mode: v1.BackupModePull, Checkpoint: "backup"}
+			Expect(manager.BackupVMI(newBackupVMI(), options)).To(Succeed())
+			// starting the same backup again is a no-op
+			Expect(manager.BackupVMI(newBackupVMI(), options)).To(Succeed())
+
+			backup, exists := metadataCache.Backup.Load()
+			Expect(exists).To(BeTrue())
+			Expect(backup.Name).To(Equal("backup"))
+			Expect(backup.Checkpoint).To(Equal("backup"))
+			Expect(backup.Completed).To(BeFalse())
+			Expect(backup.Volumes).To(ConsistOf(
+				api.BackupVolumeMetadata{Name: "rootdisk", DiskTarget: "vda", ExportName: "rootdisk"},
+				api.BackupVolumeMetadata{Name: "datadisk", DiskTarget: "vdb", ExportName: "datadisk"},
+			))
+
+			By("refusing another backup while the first one is running")
+			Expect(manager.BackupVMI(newBackupVMI(), &v1.VirtualMachineInstanceBackupOptions{Name: "other", Mode: v1.BackupModePull})).ToNot(Succeed())
+
+			By("completing the pull mode backup once aborted")
+			mockDomain.EXPECT().AbortJob().Return(nil)
+			Expect(manager.AbortVMIBackup(newBackupVMI())).To(Succeed())
+			backup, _ = metadataCache.Backup.Load()
+			Expect(backup.Completed).To(BeTrue())
+			Expect(backup.Failed).To(BeFalse())
+		})
+
+		It("should not abort when no backup is running", func() {
+			Expect(manager.AbortVMIBackup(newBackupVMI())).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package balloon
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const (
+	DefaultFreeMemoryPercent = 20
+
+	// maxInflatePercent limits how much of the guest memory is reclaimed in a single step,
+	// giving the guest time to react before more memory is taken away
+	maxInflatePercent = 5
+	// minChangePercent is the smallest change of the balloon target worth applying
+	minChangePercent = 1
+)
+
+// CalculateTarget returns the balloon target in KiB which keeps the configured share of
+// the guest memory free, given the guest memory and the latest balloon statistics.
+// The second return value is false when the balloon should be left untouched.
+func CalculateTarget(policy *v1.MemoryBalloon, guestKiB uint64, mem *stats.DomainStatsMemory) (uint64, bool) {
+	if policy == nil || guestKiB == 0 || mem == nil {
+		return 0, false
+	}
+	if !mem.AvailableSet || !mem.UsableSet || !mem.ActualBalloonSet || mem.Usable > mem.Available {
+		return 0, false
+	}
+
+	freePercent := uint64(DefaultFreeMemoryPercent)
+	if policy.FreeMemoryPercent != nil {
+		freePercent = uint64(*policy.FreeMemoryPercent)
+	}
+	if freePercent >= 100 {
+		return 0, false
+	}
+
+	minKiB := guestKiB / 2
+	if policy.MinGuest != nil {
+		minKiB = uint64(policy.MinGuest.Value()) / 1024
+	}
+	if minKiB > guestKiB {
+		minKiB = guestKiB
+	}
+
+	used := mem.Available - mem.Usable
+	target := used * 100 / (100 - freePercent)
+
+	current := mem.ActualBalloon
+	if maxStep := guestKiB * maxInflatePercent / 100; current > target && current-target > maxStep {
+		target = current - maxStep
+	}
+	if target < minKiB {
+		target = minKiB
+	}
+	if target > guestKiB {
+		target = guestKiB
+	}
+
+	if absDiff(target, current) < guestKiB*minChangePercent/100 {
+		return 0, false
+	}
+	return target, true
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,11 @@
+package balloon_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestBalloon(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
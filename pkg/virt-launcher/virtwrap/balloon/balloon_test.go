@@ -0,0 +1,77 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package balloon_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/balloon"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const guestKiB = 4 * 1024 * 1024
+
+func memoryStats(actual, available, usable uint64) *stats.DomainStatsMemory {
+	return &stats.DomainStatsMemory{
+		ActualBalloonSet: true,
+		ActualBalloon:    actual,
+		AvailableSet:     true,
+		Available:        available,
+		UsableSet:        true,
+		Usable:           usable,
+	}
+}
+
+var _ = Describe("Balloon target", func() {
+	DescribeTable("should be calculated from the guest memory usage", func(policy *v1.MemoryBalloon, mem *stats.DomainStatsMemory, expectedTarget uint64, expectedUpdate bool) {
+		target, update := balloon.CalculateTarget(policy, guestKiB, mem)
+		Expect(update).To(Equal(expectedUpdate))
+		if expectedUpdate {
+			Expect(target).To(Equal(expectedTarget))
+		}
+	},
+		Entry("not without a policy", nil, memoryStats(guestKiB, guestKiB, guestKiB/2), uint64(0), false),
+		Entry("not without balloon statistics", &v1.MemoryBalloon{}, &stats.DomainStatsMemory{}, uint64(0), false),
+		Entry("by inflating at most a step at a time",
+			&v1.MemoryBalloon{}, memoryStats(guestKiB, guestKiB, guestKiB-guestKiB/4),
+			uint64(guestKiB-guestKiB*5/100), true),
+		Entry("by inflating down to the desired free memory",
+			&v1.MemoryBalloon{FreeMemoryPercent: pointer.Uint32(50)}, memoryStats(2400*1024, 2400*1024, 1300*1024),
+			uint64(2200*1024), true),
+		Entry("by deflating immediately when the guest runs out of free memory",
+			&v1.MemoryBalloon{}, memoryStats(guestKiB/2, guestKiB/2, 0),
+			uint64(guestKiB*5/8), true),
+		Entry("by not going below half of the guest memory by default",
+			&v1.MemoryBalloon{FreeMemoryPercent: pointer.Uint32(0)}, memoryStats(guestKiB/2+100*1024, guestKiB/2+100*1024, guestKiB/2),
+			uint64(guestKiB/2), true),
+		Entry("by not going below minGuest",
+			&v1.MemoryBalloon{MinGuest: resource.NewQuantity(3*1024*1024*1024, resource.BinarySI)}, memoryStats(3200*1024, 3200*1024, 2688*1024),
+			uint64(3*1024*1024), true),
+		Entry("not when the change is too small",
+			&v1.MemoryBalloon{}, memoryStats(guestKiB, guestKiB, guestKiB/5),
+			uint64(0), false),
+	)
+})
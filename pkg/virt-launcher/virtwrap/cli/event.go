@@ -69,3 +69,10 @@ func (c *DomainEventDeviceRemoved) Deregister() error {
 func (c *DomainEventDeviceRemoved) EventChannel() <-chan interface{} {
 	return c.eventChan
 }
+
+// IsGuestPanicked reports whether the lifecycle event was emitted by a panic device
+// because the guest kernel panicked.
+func IsGuestPanicked(event *libvirt.DomainEventLifecycle) bool {
+	return event != nil && event.Event == libvirt.DOMAIN_EVENT_CRASHED &&
+		libvirt.DomainEventCrashedDetailType(event.Detail) == libvirt.DOMAIN_EVENT_CRASHED_PANICKED
+}
@@ -105,6 +105,16 @@ func (_mr *_MockConnectionRecorder) AgentEventLifecycleRegister(arg0 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AgentEventLifecycleRegister", arg0)
 }
 
+func (_m *MockConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error {
+	ret := _m.ctrl.Call(_m, "DomainEventWatchdogRegister", callback)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockConnectionRecorder) DomainEventWatchdogRegister(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainEventWatchdogRegister", arg0)
+}
+
 func (_m *MockConnection) VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error) {
 	ret := _m.ctrl.Call(_m, "VolatileDomainEventDeviceRemovedRegister", domain, callback)
 	ret0, _ := ret[0].(int)
@@ -211,6 +221,16 @@ func (_mr *_MockConnectionRecorder) GetSEVInfo() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetSEVInfo")
 }
 
+func (_m *MockConnection) DefineSecret(xml string, value []byte) error {
+	ret := _m.ctrl.Call(_m, "DefineSecret", xml, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockConnectionRecorder) DefineSecret(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DefineSecret", arg0, arg1)
+}
+
 // Mock of Stream interface
 type MockStream struct {
 	ctrl     *gomock.Controller
@@ -408,6 +428,16 @@ func (_mr *_MockVirDomainRecorder) DetachDeviceFlags(arg0, arg1 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DetachDeviceFlags", arg0, arg1)
 }
 
+func (_m *MockVirDomain) UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error {
+	ret := _m.ctrl.Call(_m, "UpdateDeviceFlags", xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) UpdateDeviceFlags(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateDeviceFlags", arg0, arg1)
+}
+
 func (_m *MockVirDomain) DestroyFlags(flags libvirt.DomainDestroyFlags) error {
 	ret := _m.ctrl.Call(_m, "DestroyFlags", flags)
 	ret0, _ := ret[0].(error)
@@ -438,6 +468,16 @@ func (_mr *_MockVirDomainRecorder) Reboot(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Reboot", arg0)
 }
 
+func (_m *MockVirDomain) Reset(flags uint32) error {
+	ret := _m.ctrl.Call(_m, "Reset", flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) Reset(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Reset", arg0)
+}
+
 func (_m *MockVirDomain) UndefineFlags(flags libvirt.DomainUndefineFlagsValues) error {
 	ret := _m.ctrl.Call(_m, "UndefineFlags", flags)
 	ret0, _ := ret[0].(error)
@@ -657,6 +697,36 @@ func (_mr *_MockVirDomainRecorder) SetVcpusFlags(arg0, arg1 interface{}) *gomock
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetVcpusFlags", arg0, arg1)
 }
 
+func (_m *MockVirDomain) SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error {
+	ret := _m.ctrl.Call(_m, "SetMemoryFlags", memory, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetMemoryFlags(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetMemoryFlags", arg0, arg1)
+}
+
+func (_m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	ret := _m.ctrl.Call(_m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetBlockIoTune(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetBlockIoTune", arg0, arg1, arg2)
+}
+
+func (_m *MockVirDomain) SetInterfaceParameters(device string, params *libvirt.DomainInterfaceParameters, flags libvirt.DomainModificationImpact) error {
+	ret := _m.ctrl.Call(_m, "SetInterfaceParameters", device, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetInterfaceParameters(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInterfaceParameters", arg0, arg1, arg2)
+}
+
 func (_m *MockVirDomain) GetLaunchSecurityInfo(flags uint32) (*libvirt.DomainLaunchSecurityParameters, error) {
 	ret := _m.ctrl.Call(_m, "GetLaunchSecurityInfo", flags)
 	ret0, _ := ret[0].(*libvirt.DomainLaunchSecurityParameters)
@@ -677,3 +747,24 @@ func (_m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecu
 func (_mr *_MockVirDomainRecorder) SetLaunchSecurityState(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetLaunchSecurityState", arg0, arg1)
 }
+
+func (_m *MockVirDomain) QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error) {
+	ret := _m.ctrl.Call(_m, "QemuMonitorCommand", command, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirDomainRecorder) QemuMonitorCommand(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "QemuMonitorCommand", arg0, arg1)
+}
+
+func (_m *MockVirDomain) BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error {
+	ret := _m.ctrl.Call(_m, "BackupBegin", backupXML, checkpointXML, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) BackupBegin(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupBegin", arg0, arg1, arg2)
+}
@@ -51,6 +51,7 @@ type Connection interface {
 	DomainEventDeviceAddedRegister(callback libvirt.DomainEventDeviceAddedCallback) error
 	DomainEventDeviceRemovedRegister(callback libvirt.DomainEventDeviceRemovedCallback) error
 	AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) error
+	DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) error
 	VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error)
 	DomainEventDeregister(registrationID int) error
 	ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error)
@@ -65,6 +66,9 @@ type Connection interface {
 	GetDomainStats(statsTypes libvirt.DomainStatsTypes, l *stats.DomainJobInfo, flags libvirt.ConnectGetAllDomainStatsFlags) ([]*stats.DomainStats, error)
 	GetQemuVersion() (string, error)
 	GetSEVInfo() (*api.SEVNodeParameters, error)
+	// helper method, not found in libvirt
+	// We add this helper to define a secret and set its value at once
+	DefineSecret(xml string, value []byte) error
 }
 
 type Stream interface {
@@ -91,6 +95,7 @@ type LibvirtConnection struct {
 	domainDeviceRemovedEventCallbacks      []libvirt.DomainEventDeviceRemovedCallback
 	domainEventMigrationIterationCallbacks []libvirt.DomainEventMigrationIterationCallback
 	agentEventCallbacks                    []libvirt.DomainEventAgentLifecycleCallback
+	domainWatchdogEventCallbacks           []libvirt.DomainEventWatchdogCallback
 }
 
 func (s *VirStream) Write(p []byte) (n int, err error) {
@@ -188,6 +193,17 @@ func (l *LibvirtConnection) AgentEventLifecycleRegister(callback libvirt.DomainE
 	return
 }
 
+func (l *LibvirtConnection) DomainEventWatchdogRegister(callback libvirt.DomainEventWatchdogCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainWatchdogEventCallbacks = append(l.domainWatchdogEventCallbacks, callback)
+	_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) VolatileDomainEventDeviceRemovedRegister(domain VirDomain, callback libvirt.DomainEventDeviceRemovedCallback) (int, error) {
 	var dom *libvirt.Domain
 	if domain != nil {
@@ -361,6 +377,23 @@ func (l *LibvirtConnection) GetSEVInfo() (*api.SEVNodeParameters, error) {
 	return sevNodeParameters, nil
 }
 
+func (l *LibvirtConnection) DefineSecret(xml string, value []byte) error {
+	if err := l.reconnectIfNecessary(); err != nil {
+		return err
+	}
+
+	secret, err := l.Connect.SecretDefineXML(xml, 0)
+	if err != nil {
+		l.checkConnectionLost(err)
+		return err
+	}
+	defer secret.Free()
+
+	err = secret.SetValue(value, 0)
+	l.checkConnectionLost(err)
+	return err
+}
+
 func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[string]string, error) {
 	devAliasMap := make(map[string]string)
 
@@ -456,6 +489,10 @@ func (l *LibvirtConnection) reconnectIfNecessary() (err error) {
 			log.Log.Info("Re-registered domain device removed callback")
 			_, err = l.Connect.DomainEventDeviceRemovedRegister(nil, callback)
 		}
+		for _, callback := range l.domainWatchdogEventCallbacks {
+			log.Log.Info("Re-registered domain watchdog callback")
+			_, err = l.Connect.DomainEventWatchdogRegister(nil, callback)
+		}
 
 		log.Log.Error("Re-registered domain and agent callbacks for new connection")
 
@@ -508,9 +545,11 @@ type VirDomain interface {
 	AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DetachDevice(xml string) error
 	DetachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
+	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
 	DestroyFlags(flags libvirt.DomainDestroyFlags) error
 	ShutdownFlags(flags libvirt.DomainShutdownFlags) error
 	Reboot(flags libvirt.DomainRebootFlagValues) error
+	Reset(flags uint32) error
 	UndefineFlags(flags libvirt.DomainUndefineFlagsValues) error
 	GetName() (string, error)
 	GetUUIDString() (string, error)
@@ -532,8 +571,13 @@ type VirDomain interface {
 	PinVcpuFlags(vcpu uint, cpuMap []bool, flags libvirt.DomainModificationImpact) error
 	PinEmulator(cpumap []bool, flags libvirt.DomainModificationImpact) error
 	SetVcpusFlags(vcpu uint, flags libvirt.DomainVcpuFlags) error
+	SetMemoryFlags(memory uint64, flags libvirt.DomainMemoryModFlags) error
+	SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error
+	SetInterfaceParameters(device string, params *libvirt.DomainInterfaceParameters, flags libvirt.DomainModificationImpact) error
 	GetLaunchSecurityInfo(flags uint32) (*libvirt.DomainLaunchSecurityParameters, error)
 	SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error
+	QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error)
+	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
 }
 
 func NewConnection(uri string, user string, pass string, checkInterval time.Duration) (Connection, error) {
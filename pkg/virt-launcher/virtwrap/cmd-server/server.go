@@ -191,6 +191,55 @@ func (l *Launcher) SyncVirtualMachineCPUs(_ context.Context, request *cmdv1.VMIR
 	return response, nil
 }
 
+func (l *Launcher) SyncVirtualMachineMemory(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.UpdateGuestMemory(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed update VMI guest memory")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("VMI guest memory has been updated")
+	return response, nil
+}
+
+func (l *Launcher) SyncVirtualMachineIOLimits(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.UpdateIOLimits(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed update VMI I/O limits")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
+func (l *Launcher) SyncVirtualMachineInterfaceLinkStates(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.UpdateInterfaceLinkStates(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed update VMI interface link states")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	return response, nil
+}
+
 func (l *Launcher) SyncVirtualMachine(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
@@ -698,6 +747,36 @@ func (l *Launcher) GetLaunchMeasurement(_ context.Context, request *cmdv1.VMIReq
 	return launchMeasurementResponse, nil
 }
 
+func (l *Launcher) GetAttestationReport(_ context.Context, request *cmdv1.AttestationReportRequest) (*cmdv1.AttestationReportResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	attestationReportResponse := &cmdv1.AttestationReportResponse{
+		Response: response,
+	}
+
+	if !attestationReportResponse.Response.Success {
+		return attestationReportResponse, nil
+	}
+
+	attestationReport, err := l.domainManager.GetAttestationReport(vmi, request.Nonce)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to get attestation report")
+		attestationReportResponse.Response.Success = false
+		attestationReportResponse.Response.Message = getErrorMessage(err)
+		return attestationReportResponse, nil
+	}
+
+	if attestationReportJson, err := json.Marshal(attestationReport); err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal attestation report")
+		attestationReportResponse.Response.Success = false
+		attestationReportResponse.Response.Message = getErrorMessage(err)
+		return attestationReportResponse, nil
+	} else {
+		attestationReportResponse.AttestationReport = attestationReportJson
+	}
+
+	return attestationReportResponse, nil
+}
+
 func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLaunchSecretRequest) (*cmdv1.Response, error) {
 	vmi, response := getVMIFromRequest(request.Vmi)
 	if !response.Success {
@@ -721,6 +800,47 @@ func (l *Launcher) InjectLaunchSecret(_ context.Context, request *cmdv1.InjectLa
 	return response, nil
 }
 
+func (l *Launcher) BackupVirtualMachine(_ context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	var backupOptions v1.VirtualMachineInstanceBackupOptions
+	if err := json.Unmarshal(request.Options, &backupOptions); err != nil {
+		response.Success = false
+		response.Message = "No valid backup options present in command server request"
+		return response, nil
+	}
+
+	if err := l.domainManager.BackupVMI(vmi, &backupOptions); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to start backup")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Started backup %s", backupOptions.Name)
+	return response, nil
+}
+
+func (l *Launcher) AbortVirtualMachineBackup(_ context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.AbortVMIBackup(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to abort backup")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Aborted backup")
+	return response, nil
+}
+
 func ReceivedEarlyExitSignal() bool {
 	_, earlyExit := os.LookupEnv(receivedEarlyExitSignalEnvVar)
 	return earlyExit
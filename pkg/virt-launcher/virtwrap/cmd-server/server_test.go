@@ -114,6 +114,23 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.UnfreezeVirtualMachine(vmi)).To(Succeed())
 		})
 
+		It("should start a backup of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &v1.VirtualMachineInstanceBackupOptions{
+				Name:       "backup",
+				Mode:       v1.BackupModePull,
+				Checkpoint: "backup",
+			}
+			domainManager.EXPECT().BackupVMI(vmi, options)
+			Expect(client.BackupVirtualMachine(vmi, options)).To(Succeed())
+		})
+
+		It("should abort the backup of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().AbortVMIBackup(vmi)
+			Expect(client.AbortVirtualMachineBackup(vmi)).To(Succeed())
+		})
+
 		It("should soft reboot a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().SoftRebootVMI(vmi)
@@ -312,6 +329,19 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(fetchedSEVMeasurementInfo).To(Equal(sevMeasurementInfo))
 		})
 
+		It("should return the attestation report of a vmi", func() {
+			attestationReport := &v1.AttestationReport{
+				Type:   "sev",
+				Nonce:  "AAAAAAAAAAAAAAAAAAAAAA==",
+				Report: "cmVwb3J0",
+			}
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetAttestationReport(vmi, attestationReport.Nonce).Return(attestationReport, nil)
+			fetchedAttestationReport, err := client.GetAttestationReport(vmi, attestationReport.Nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fetchedAttestationReport).To(Equal(attestationReport))
+		})
+
 		It("should inject a launch secret into a vmi", func() {
 			sevSecretOptions := &v1.SEVSecretOptions{}
 			vmi := v1.NewVMIReferenceFromName("testvmi")
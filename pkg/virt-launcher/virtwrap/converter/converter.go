@@ -36,6 +36,7 @@ import (
 	"strings"
 	"syscall"
 
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 
@@ -119,6 +120,8 @@ type ConverterContext struct {
 	ExpandDisksEnabled    bool
 	UseLaunchSecurity     bool
 	FreePageReporting     bool
+	// DomainAttachmentByInterfaceName holds the domain attachment type of the interfaces bound by a network binding plugin
+	DomainAttachmentByInterfaceName map[string]string
 }
 
 func contains(volumes []string, name string) bool {
@@ -235,10 +238,25 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if c.UseLaunchSecurity && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
+	disk.IOTune = Convert_v1_DiskIOTune_To_api_BlockIOTune(diskDevice.IOTune)
 
 	return nil
 }
 
+func Convert_v1_DiskIOTune_To_api_BlockIOTune(ioTune *v1.DiskIOTune) *api.BlockIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	return &api.BlockIOTune{
+		TotalBytesSec: ioTune.TotalBytesSec,
+		ReadBytesSec:  ioTune.ReadBytesSec,
+		WriteBytesSec: ioTune.WriteBytesSec,
+		TotalIopsSec:  ioTune.TotalIopsSec,
+		ReadIopsSec:   ioTune.ReadIopsSec,
+		WriteIopsSec:  ioTune.WriteIopsSec,
+	}
+}
+
 // Get expected disk capacity - a minimum between the request and the PVC capacity.
 // Returns nil when we have insufficient data to calculate this minimum.
 func getDiskCapacity(pvcInfo *v1.PersistentVolumeClaimInfo) *int64 {
@@ -903,12 +921,58 @@ func Convert_v1_EphemeralVolumeSource_To_api_Disk(volumeName string, disk *api.D
 
 func Convert_v1_Watchdog_To_api_Watchdog(source *v1.Watchdog, watchdog *api.Watchdog, _ *ConverterContext) error {
 	watchdog.Alias = api.NewUserDefinedAlias(source.Name)
-	if source.I6300ESB != nil {
+	switch {
+	case source.I6300ESB != nil:
 		watchdog.Model = "i6300esb"
-		watchdog.Action = string(source.I6300ESB.Action)
-		return nil
+	case source.Diag288 != nil:
+		watchdog.Model = "diag288"
+	case source.ITCO != nil:
+		watchdog.Model = "itco"
+	default:
+		return fmt.Errorf("watchdog %s can't be mapped, no watchdog type specified", source.Name)
+	}
+	watchdog.Action = string(GetWatchdogAction(source))
+	if watchdog.Action == string(v1.WatchdogActionDump) {
+		// libvirt would dump the memory inside the virt-launcher pod, pause the guest instead until its memory is dumped to a PVC
+		watchdog.Action = "pause"
+	}
+	return nil
+}
+
+// GetWatchdogAction returns the action taken when the watchdog fires
+func GetWatchdogAction(watchdog *v1.Watchdog) v1.WatchdogAction {
+	var action v1.WatchdogAction
+	switch {
+	case watchdog.I6300ESB != nil:
+		action = watchdog.I6300ESB.Action
+	case watchdog.Diag288 != nil:
+		action = watchdog.Diag288.Action
+	case watchdog.ITCO != nil:
+		action = watchdog.ITCO.Action
+	}
+	if action == "" {
+		return v1.WatchdogActionReset
+	}
+	return action
+}
+
+func convertPanicDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	for _, panicDevice := range vmi.Spec.Domain.Devices.PanicDevices {
+		newPanic := api.PanicDevice{}
+		if panicDevice.Model != nil {
+			newPanic.Model = string(*panicDevice.Model)
+		}
+		domain.Spec.Devices.Panics = append(domain.Spec.Devices.Panics, newPanic)
+	}
+	if len(domain.Spec.Devices.Panics) == 0 {
+		return
+	}
+
+	// Unless the guest is restarted, keep the crashed guest around so it can be inspected or dumped
+	domain.Spec.OnCrash = "preserve"
+	if onCrash := vmi.Spec.Domain.OnCrash; onCrash != nil && onCrash.Action == v1.CrashActionRestart {
+		domain.Spec.OnCrash = "restart"
 	}
-	return fmt.Errorf("watchdog %s can't be mapped, no watchdog type specified", source.Name)
 }
 
 func Convert_v1_Rng_To_api_Rng(_ *v1.Rng, rng *api.Rng, c *ConverterContext) error {
@@ -1145,6 +1209,10 @@ func convertV1ToAPISyNICTimer(syNICTimer *v1.SyNICTimer) *api.SyNICTimer {
 	return result
 }
 
+// defaultBalloonPolicyStatsPeriod is the balloon statistics period, in seconds, used for VMIs
+// with a balloon policy when the statistics are disabled cluster-wide
+const defaultBalloonPolicyStatsPeriod = 10
+
 func ConvertV1ToAPIBalloning(source *v1.Devices, ballooning *api.MemBalloon, c *ConverterContext) {
 	if source != nil && source.AutoattachMemBalloon != nil && *source.AutoattachMemBalloon == false {
 		ballooning.Model = "none"
@@ -1279,8 +1347,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				Type:     "pflash",
 			}
 
+			nvramDir := "/tmp"
+			if backendstorage.HasPersistentEFI(&vmi.Spec) {
+				nvramDir = backendstorage.NVRAMDir(vmi)
+			}
 			domain.Spec.OS.NVRam = &api.NVRam{
-				NVRam:    filepath.Join("/tmp", domain.Spec.Name),
+				NVRam:    filepath.Join(nvramDir, domain.Spec.Name),
 				Template: c.EFIConfiguration.EFIVars,
 			}
 		}
@@ -1322,16 +1394,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 
 	}
-	// Set SEV launch security parameters: https://libvirt.org/formatdomain.html#launch-security
+	// Set launch security parameters: https://libvirt.org/formatdomain.html#launch-security
 	if c.UseLaunchSecurity {
-		sevPolicyBits := launchsecurity.SEVPolicyToBits(vmi.Spec.Domain.LaunchSecurity.SEV.Policy)
-		// Cbitpos and ReducedPhysBits will be filled automatically by libvirt from the domain capabilities
-		domain.Spec.LaunchSecurity = &api.LaunchSecurity{
-			Type:    "sev",
-			Policy:  "0x" + strconv.FormatUint(uint64(sevPolicyBits), 16),
-			DHCert:  vmi.Spec.Domain.LaunchSecurity.SEV.DHCert,
-			Session: vmi.Spec.Domain.LaunchSecurity.SEV.Session,
-		}
+		domain.Spec.LaunchSecurity = convertLaunchSecurity(vmi.Spec.Domain.LaunchSecurity)
 		controllerDriver = &api.ControllerDriver{
 			IOMMU: "on",
 		}
@@ -1437,6 +1502,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	if err := convertHotplugMemory(vmi, domain); err != nil {
+		return err
+	}
+
 	volumeIndices := map[string]int{}
 	volumes := map[string]*v1.Volume{}
 	for i, volume := range vmi.Spec.Volumes {
@@ -1587,6 +1656,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Watchdog = newWatchdog
 	}
 
+	convertPanicDevices(vmi, domain)
+
 	if vmi.Spec.Domain.Devices.Rng != nil {
 		newRng := &api.Rng{}
 		err := Convert_v1_Rng_To_api_Rng(vmi.Spec.Domain.Devices.Rng, newRng, c)
@@ -1598,6 +1669,11 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	domain.Spec.Devices.Ballooning = &api.MemBalloon{}
 	ConvertV1ToAPIBalloning(&vmi.Spec.Domain.Devices, domain.Spec.Devices.Ballooning, c)
+	// The balloon policy is driven by the guest memory statistics, make sure they are collected
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Balloon != nil &&
+		domain.Spec.Devices.Ballooning.Model != "none" && domain.Spec.Devices.Ballooning.Stats == nil {
+		domain.Spec.Devices.Ballooning.Stats = &api.Stats{Period: defaultBalloonPolicyStatsPeriod}
+	}
 
 	if vmi.Spec.Domain.Devices.Inputs != nil {
 		inputDevices := make([]api.Input, 0)
@@ -1855,6 +1931,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			//   we decided to introduce them together. Ultimately, we should use tpm-crb for all cases,
 			//   as it is now the generally preferred model
 			domain.Spec.Devices.TPMs[0].Model = "tpm-crb"
+			if vmi.Spec.Domain.Devices.TPM.EncryptionSecretRef != nil {
+				// The secret itself gets defined by virt-launcher from the mounted encryption key
+				domain.Spec.Devices.TPMs[0].Backend.Encryption = &api.TPMEncryption{
+					Secret: string(vmi.UID),
+				}
+			}
 		}
 	}
 
@@ -2018,3 +2100,29 @@ func InterpretTransitionalModelType(useVirtioTransitional *bool) string {
 	}
 	return "virtio-non-transitional"
 }
+
+func convertLaunchSecurity(launchSecurity *v1.LaunchSecurity) *api.LaunchSecurity {
+	switch {
+	case launchSecurity.SNP != nil:
+		snpPolicyBits := launchsecurity.SEVSNPPolicyToBits(launchSecurity.SNP.Policy)
+		// Cbitpos and ReducedPhysBits will be filled automatically by libvirt from the domain capabilities
+		return &api.LaunchSecurity{
+			Type:   "sev-snp",
+			Policy: "0x" + strconv.FormatUint(snpPolicyBits, 16),
+		}
+	case launchSecurity.TDX != nil:
+		return &api.LaunchSecurity{
+			Type:   "tdx",
+			Policy: "0x" + strconv.FormatUint(launchsecurity.TDXPolicyToBits(), 16),
+		}
+	default:
+		sevPolicyBits := launchsecurity.SEVPolicyToBits(launchSecurity.SEV.Policy)
+		// Cbitpos and ReducedPhysBits will be filled automatically by libvirt from the domain capabilities
+		return &api.LaunchSecurity{
+			Type:    "sev",
+			Policy:  "0x" + strconv.FormatUint(uint64(sevPolicyBits), 16),
+			DHCert:  launchSecurity.SEV.DHCert,
+			Session: launchSecurity.SEV.Session,
+		}
+	}
+}
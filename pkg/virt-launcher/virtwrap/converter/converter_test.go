@@ -45,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
@@ -242,6 +243,34 @@ var _ = Describe("Converter", func() {
   <driver cache="none" error_policy="stop" name="qemu" type="" discard="unmap"></driver>
   <alias name="ua-mydisk"></alias>
   <shareable></shareable>
+</Disk>`
+			xml := diskToDiskXML(v1Disk)
+			Expect(xml).To(Equal(expectedXML))
+		})
+		It("should set the I/O throttling limits if requested", func() {
+			v1Disk := &v1.Disk{
+				Name: "mydisk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{
+						Bus: v1.VirtIO,
+					},
+				},
+				IOTune: &v1.DiskIOTune{
+					TotalBytesSec: 1048576,
+					ReadIopsSec:   100,
+					WriteIopsSec:  50,
+				},
+			}
+			var expectedXML = `<Disk device="disk" type="" model="virtio-non-transitional">
+  <source></source>
+  <target bus="virtio" dev="vda"></target>
+  <driver error_policy="stop" name="qemu" type="" discard="unmap"></driver>
+  <alias name="ua-mydisk"></alias>
+  <iotune>
+    <total_bytes_sec>1048576</total_bytes_sec>
+    <read_iops_sec>100</read_iops_sec>
+    <write_iops_sec>50</write_iops_sec>
+  </iotune>
 </Disk>`
 			xml := diskToDiskXML(v1Disk)
 			Expect(xml).To(Equal(expectedXML))
@@ -1396,6 +1425,13 @@ var _ = Describe("Converter", func() {
 			Expect(strings.Contains(xml, `<memory unit="b">2222222</memory>`)).To(BeTrue(), xml)
 		})
 
+		It("should collect balloon statistics for a balloon policy even if disabled cluster-wide", func() {
+			vmi.Spec.Domain.Memory = &v1.Memory{Balloon: &v1.MemoryBalloon{}}
+			c.MemBalloonStatsPeriod = 0
+			dom := vmiToDomain(vmi, c)
+			Expect(dom.Spec.Devices.Ballooning.Stats).To(Equal(&api.Stats{Period: 10}))
+		})
+
 		DescribeTable("should be converted to a libvirt Domain with vmi defaults set", func(arch string, domain string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Rng = &v1.Rng{}
@@ -1957,6 +1993,43 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.Memory.Unit).To(Equal("b"))
 		})
 
+		It("should add a virtio-mem device covering the hotpluggable memory", func() {
+			guestMemory := resource.MustParse("1Gi")
+			maxGuestMemory := resource.MustParse("4Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest:    &guestMemory,
+				MaxGuest: &maxGuestMemory,
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+			Expect(domainSpec.MaxMemory).ToNot(BeNil())
+			Expect(domainSpec.MaxMemory.Value).To(Equal(uint64(maxGuestMemory.Value())))
+			Expect(domainSpec.CPU.NUMA).ToNot(BeNil())
+			Expect(domainSpec.CPU.NUMA.Cells).To(HaveLen(1))
+			Expect(domainSpec.CPU.NUMA.Cells[0].Memory).To(Equal(uint64(guestMemory.Value() / 1024)))
+
+			Expect(domainSpec.Devices.Memory).ToNot(BeNil())
+			Expect(domainSpec.Devices.Memory.Model).To(Equal("virtio-mem"))
+			Expect(domainSpec.Devices.Memory.Target.Size.Value).To(Equal(uint64(3 * 1024 * 1024 * 1024)))
+			Expect(domainSpec.Devices.Memory.Target.Block.Value).To(Equal(uint64(2 * 1024 * 1024)))
+			Expect(domainSpec.Devices.Memory.Target.Requested.Value).To(BeZero())
+		})
+
+		It("should not add a virtio-mem device without a maximum guest memory", func() {
+			guestMemory := resource.MustParse("1Gi")
+			vmi.Spec.Domain.Memory = &v1.Memory{
+				Guest: &guestMemory,
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+			Expect(domainSpec.MaxMemory).To(BeNil())
+			Expect(domainSpec.Devices.Memory).To(BeNil())
+		})
+
 		It("should not add RNG when not present", func() {
 			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
 			Expect(domainSpec.Devices.Rng).To(BeNil())
@@ -2221,6 +2294,46 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(uint(bootOrder)))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		It("Should set the bandwidth limits of the interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface := v1.DefaultBridgeNetworkInterface()
+			iface.Bandwidth = &v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimit{Average: 1000, Peak: 2000, Burst: 512},
+				Outbound: &v1.BandwidthLimit{Average: 500},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound:  &api.BandWidthLimit{Average: 1000, Peak: 2000, Burst: 512},
+				Outbound: &api.BandWidthLimit{Average: 500},
+			}))
+		})
+		DescribeTable("Should set the link state of the interface", func(state v1.InterfaceState, expectedLinkState *api.LinkState) {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface := v1.DefaultBridgeNetworkInterface()
+			iface.State = state
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(Equal(expectedLinkState))
+		},
+			Entry("when the state is not set", v1.InterfaceState(""), nil),
+			Entry("when the state is up", v1.InterfaceStateLinkUp, &api.LinkState{State: "up"}),
+			Entry("when the state is down", v1.InterfaceStateLinkDown, &api.LinkState{State: "down"}),
+		)
+		DescribeTable("Should convert the interface state to a link state", func(state v1.InterfaceState, expectedLinkState *api.LinkState) {
+			Expect(Convert_v1_InterfaceState_To_api_LinkState(state)).To(Equal(expectedLinkState))
+		},
+			Entry("when the state is not set", v1.InterfaceState(""), &api.LinkState{State: "up"}),
+			Entry("when the state is up", v1.InterfaceStateLinkUp, &api.LinkState{State: "up"}),
+			Entry("when the state is down", v1.InterfaceStateLinkDown, &api.LinkState{State: "down"}),
+			Entry("when the interface is absent", v1.InterfaceStateAbsent, nil),
+		)
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			name1 := "Name"
@@ -2306,6 +2419,40 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(2), "the VMI spec should feature 2 interfaces")
 			Expect(domain.Spec.Devices.Interfaces[1].Type).To(Equal("ethernet"), "Macvtap interfaces must be of type `ethernet`")
 		})
+		It("Should leave interfaces bound by a plugin without domain attachment to the plugin sidecar", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", Binding: &v1.PluginBinding{Name: "myplugin"}}}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).NotTo(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
+		})
+		It("Should create a tap interface for a binding plugin with tap domain attachment", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			secondaryNetworkName := "net1"
+			vmi.Spec.Networks = []v1.Network{{
+				Name:          secondaryNetworkName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad1"}},
+			}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:       secondaryNetworkName,
+				Binding:    &v1.PluginBinding{Name: "myplugin"},
+				MacAddress: "de:ad:00:00:be:af",
+			}}
+			c.DomainAttachmentByInterfaceName = map[string]string{secondaryNetworkName: string(v1.Tap)}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).NotTo(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			domainIface := domain.Spec.Devices.Interfaces[0]
+			Expect(domainIface.Type).To(Equal("ethernet"))
+			Expect(domainIface.Target).To(Equal(&api.InterfaceTarget{
+				Device:  "tap" + namescheme.GenerateHashedInterfaceName(secondaryNetworkName)[3:],
+				Managed: "no",
+			}))
+			Expect(domainIface.MAC).To(Equal(&api.MAC{MAC: "de:ad:00:00:be:af"}))
+		})
 		It("Macvtap interfaces should allow setting boot order", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			firstMacvtapNetworkName := "net1"
@@ -3048,6 +3195,29 @@ var _ = Describe("Converter", func() {
 			Entry("should not use SecureBoot", False(), "OVMF_CODE.fd", "OVMF_VARS.fd"),
 			Entry("should not use SecureBoot when OVMF_CODE.fd not present", True(), "OVMF_CODE.secboot.fd", "OVMF_VARS.fd"),
 		)
+
+		DescribeTable("should keep a persistent NVRAM on the backend storage", func(nonRoot bool, nvramDir string) {
+			c.EFIConfiguration = &EFIConfiguration{
+				EFICode: "OVMF_CODE.fd",
+				EFIVars: "OVMF_VARS.fd",
+			}
+			if nonRoot {
+				vmi.Status.RuntimeUser = 107
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: False(),
+						Persistent: True(),
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.OS.NVRam.NVRam).To(Equal(filepath.Join(nvramDir, "mynamespace_testvmi")))
+		},
+			Entry("for a root VMI", false, "/var/lib/libvirt/qemu/nvram"),
+			Entry("for a non-root VMI", true, "/var/run/kubevirt-private/libvirt/qemu/nvram"),
+		)
 	})
 
 	Context("Kernel Boot", func() {
@@ -3249,6 +3419,44 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x" + strconv.FormatUint(uint64(sev.SEVPolicyNoDebug|sev.SEVPolicyEncryptedState), 16)))
 		})
 
+		It("should set LaunchSecurity domain element with 'sev-snp' type and default policy", func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				SNP: &v1.SEVSNP{},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity.Type).To(Equal("sev-snp"))
+			Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x30000"))
+			Expect(domain.Spec.LaunchSecurity.DHCert).To(BeEmpty())
+		})
+
+		It("should set LaunchSecurity domain element with 'sev-snp' type and SMT disallowed", func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				SNP: &v1.SEVSNP{
+					Policy: &v1.SEVSNPPolicy{
+						SMT: pointer.Bool(false),
+					},
+				},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity.Type).To(Equal("sev-snp"))
+			Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x20000"))
+		})
+
+		It("should set LaunchSecurity domain element with 'tdx' type", func() {
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{
+				TDX: &v1.TDX{},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity).ToNot(BeNil())
+			Expect(domain.Spec.LaunchSecurity.Type).To(Equal("tdx"))
+			Expect(domain.Spec.LaunchSecurity.Policy).To(Equal("0x10000000"))
+		})
+
 		It("should set IOMMU attribute of the RngDriver", func() {
 			rng := &api.Rng{}
 			Expect(Convert_v1_Rng_To_api_Rng(&v1.Rng{}, rng, c)).To(Succeed())
@@ -3295,6 +3503,119 @@ var _ = Describe("Converter", func() {
 		})
 	})
 
+	Context("with a TPM device", func() {
+		var (
+			vmi *v1.VirtualMachineInstance
+			c   *ConverterContext
+		)
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			vmi.UID = "a1b2c3d4-0000-4000-8000-000000000000"
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c = &ConverterContext{
+				AllowEmulation: true,
+			}
+		})
+
+		It("should use an emulated tpm-tis device by default", func() {
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+			Expect(domain.Spec.Devices.TPMs[0].Model).To(Equal("tpm-tis"))
+			Expect(domain.Spec.Devices.TPMs[0].Backend.PersistentState).To(BeEmpty())
+			Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(BeNil())
+		})
+
+		It("should use a persistent tpm-crb device", func() {
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: pointer.Bool(true)}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+			Expect(domain.Spec.Devices.TPMs[0].Model).To(Equal("tpm-crb"))
+			Expect(domain.Spec.Devices.TPMs[0].Backend.PersistentState).To(Equal("yes"))
+			Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(BeNil())
+		})
+
+		It("should encrypt the persistent state with the libvirt secret identified by the VMI UID", func() {
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{
+				Persistent:          pointer.Bool(true),
+				EncryptionSecretRef: &k8sv1.LocalObjectReference{Name: "tpm-key"},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.TPMs).To(HaveLen(1))
+			Expect(domain.Spec.Devices.TPMs[0].Backend.Encryption).To(Equal(&api.TPMEncryption{Secret: string(vmi.UID)}))
+		})
+	})
+
+	Context("with panic devices", func() {
+		var (
+			vmi *v1.VirtualMachineInstance
+			c   *ConverterContext
+		)
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c = &ConverterContext{
+				AllowEmulation: true,
+			}
+		})
+
+		It("should not set a crash action without panic devices", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Panics).To(BeEmpty())
+			Expect(domain.Spec.OnCrash).To(BeEmpty())
+		})
+
+		It("should add the panic devices and preserve the crashed guest by default", func() {
+			model := v1.PanicDeviceModelPvpanic
+			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{Model: &model}, {}}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Panics).To(Equal([]api.PanicDevice{{Model: "pvpanic"}, {}}))
+			Expect(domain.Spec.OnCrash).To(Equal("preserve"))
+		})
+
+		DescribeTable("should map the crash action", func(action v1.CrashAction, onCrash string) {
+			vmi.Spec.Domain.Devices.PanicDevices = []v1.PanicDevice{{}}
+			vmi.Spec.Domain.OnCrash = &v1.OnCrash{Action: action, MemoryDumpClaimName: "dump"}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.OnCrash).To(Equal(onCrash))
+		},
+			Entry("Restart", v1.CrashActionRestart, "restart"),
+			Entry("Pause", v1.CrashActionPause, "preserve"),
+			Entry("MemoryDump", v1.CrashActionMemoryDump, "preserve"),
+		)
+	})
+
+	Context("with a watchdog", func() {
+		var (
+			vmi *v1.VirtualMachineInstance
+			c   *ConverterContext
+		)
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c = &ConverterContext{
+				AllowEmulation: true,
+			}
+		})
+
+		DescribeTable("should map the watchdog", func(device v1.WatchdogDevice, model, action string, hasAddress bool) {
+			vmi.Annotations = map[string]string{v1.PlacePCIDevicesOnRootComplex: "true"}
+			vmi.Spec.Domain.Devices.Watchdog = &v1.Watchdog{Name: "mywatchdog", WatchdogDevice: device}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Watchdog).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Watchdog.Model).To(Equal(model))
+			Expect(domain.Spec.Devices.Watchdog.Action).To(Equal(action))
+			Expect(domain.Spec.Devices.Watchdog.Address != nil).To(Equal(hasAddress))
+		},
+			Entry("i6300esb with the default action", v1.WatchdogDevice{I6300ESB: &v1.I6300ESBWatchdog{}}, "i6300esb", "reset", true),
+			Entry("diag288 with the shutdown action", v1.WatchdogDevice{Diag288: &v1.Diag288Watchdog{Action: v1.WatchdogActionShutdown}}, "diag288", "shutdown", false),
+			Entry("itco with the dump action pausing the guest", v1.WatchdogDevice{ITCO: &v1.ITCOWatchdog{Action: v1.WatchdogActionDump}}, "itco", "pause", false),
+		)
+	})
+
 	Context("when TSC Frequency", func() {
 		var (
 			vmi *v1.VirtualMachineInstance
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+)
+
+const (
+	virtioMemModel = "virtio-mem"
+	// virtio-mem can not plug memory in chunks smaller than a transparent huge page
+	virtioMemDefaultBlockSize = 2 * 1024 * 1024
+	memoryHotplugSlots        = 1
+)
+
+// convertHotplugMemory adds a virtio-mem device to the domain which covers the range between
+// the guest memory and the maximum guest memory of the VMI. The device initially provides no
+// memory to the guest and is resized later on to hotplug memory.
+func convertHotplugMemory(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	memory := vmi.Spec.Domain.Memory
+	if memory == nil || memory.Guest == nil || memory.MaxGuest == nil {
+		return nil
+	}
+
+	blockSize := int64(virtioMemDefaultBlockSize)
+	if memory.Hugepages != nil {
+		pageSize, err := resource.ParseQuantity(memory.Hugepages.PageSize)
+		if err != nil {
+			return fmt.Errorf("failed to parse hugepages size %s: %v", memory.Hugepages.PageSize, err)
+		}
+		if pageSize.Value() > blockSize {
+			blockSize = pageSize.Value()
+		}
+	}
+
+	hotplugSize := memory.MaxGuest.Value() - memory.Guest.Value()
+	hotplugSize -= hotplugSize % blockSize
+	if hotplugSize <= 0 {
+		return nil
+	}
+
+	guestMemory, err := vcpu.QuantityToByte(*memory.Guest)
+	if err != nil {
+		return err
+	}
+
+	domain.Spec.MaxMemory = &api.MaxMemory{
+		Value: guestMemory.Value + uint64(hotplugSize),
+		Unit:  guestMemory.Unit,
+		Slots: memoryHotplugSlots,
+	}
+
+	// memory devices have to be assigned to a guest NUMA node
+	if domain.Spec.CPU.NUMA == nil {
+		domain.Spec.CPU.NUMA = &api.NUMA{
+			Cells: []api.NUMACell{
+				{
+					ID:     "0",
+					CPUs:   fmt.Sprintf("0-%d", domain.Spec.VCPU.CPUs-1),
+					Memory: guestMemory.Value / 1024,
+					Unit:   "KiB",
+				},
+			},
+		}
+	}
+
+	domain.Spec.Devices.Memory = &api.MemoryDevice{
+		Model: virtioMemModel,
+		Target: &api.MemoryTarget{
+			Size:      api.Memory{Value: uint64(hotplugSize), Unit: "b"},
+			Node:      "0",
+			Block:     api.Memory{Value: uint64(blockSize), Unit: "b"},
+			Requested: api.Memory{Value: 0, Unit: "b"},
+		},
+	}
+
+	return nil
+}
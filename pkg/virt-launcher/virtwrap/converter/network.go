@@ -32,6 +32,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 
 	"kubevirt.io/kubevirt/pkg/network/dns"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -62,6 +63,11 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			continue
 		}
 
+		// Unless the binding plugin asks for a tap device, its sidecar adds the interface to the domain
+		if iface.Binding != nil && c.DomainAttachmentByInterfaceName[iface.Name] != string(v1.Tap) {
+			continue
+		}
+
 		ifaceType := GetInterfaceType(&nonAbsentIfaces[i])
 		domainIface := api.Interface{
 			Model: &api.Model{
@@ -93,6 +99,12 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		domainIface.BandWidth = Convert_v1_InterfaceBandwidth_To_api_BandWidth(iface.Bandwidth)
+		// An unset state is the link up, which libvirt defaults to as well
+		if iface.State != "" {
+			domainIface.LinkState = Convert_v1_InterfaceState_To_api_LinkState(iface.State)
+		}
+
 		if iface.Bridge != nil || iface.Masquerade != nil {
 			// TODO:(ihar) consider abstracting interface type conversion /
 			// detection into drivers
@@ -130,6 +142,23 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			}
 		} else if iface.Passt != nil {
 			domain.Spec.Devices.Emulator = "/usr/bin/qrap"
+		} else if iface.Binding != nil {
+			// the tap device is created by the binding plugin CNI, following the
+			// naming of link.GenerateTapDeviceName
+			podInterfaceName := namescheme.HashedPodInterfaceName(*net)
+			domainIface.Type = "ethernet"
+			domainIface.Target = &api.InterfaceTarget{
+				Device:  "tap" + podInterfaceName[3:],
+				Managed: "no",
+			}
+			if iface.MacAddress != "" {
+				domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+			}
+			if iface.BootOrder != nil {
+				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+			} else {
+				domainIface.Rom = &api.Rom{Enabled: "no"}
+			}
 		}
 
 		if c.UseLaunchSecurity {
@@ -149,6 +178,39 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 	return domainInterfaces, nil
 }
 
+func Convert_v1_InterfaceBandwidth_To_api_BandWidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &api.BandWidth{
+		Inbound:  convertBandwidthLimit(bandwidth.Inbound),
+		Outbound: convertBandwidthLimit(bandwidth.Outbound),
+	}
+}
+
+// Convert_v1_InterfaceState_To_api_LinkState returns the link state requested by the interface state,
+// an unset state requesting the link up, or nil when the interface state does not control the link.
+func Convert_v1_InterfaceState_To_api_LinkState(state v1.InterfaceState) *api.LinkState {
+	switch state {
+	case "", v1.InterfaceStateLinkUp:
+		return &api.LinkState{State: string(v1.InterfaceStateLinkUp)}
+	case v1.InterfaceStateLinkDown:
+		return &api.LinkState{State: string(state)}
+	}
+	return nil
+}
+
+func convertBandwidthLimit(limit *v1.BandwidthLimit) *api.BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+	return &api.BandWidthLimit{
+		Average: uint(limit.Average),
+		Peak:    uint(limit.Peak),
+		Burst:   uint(limit.Burst),
+	}
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Slirp != nil {
 		// Slirp configuration works only with e1000 or rtl8139
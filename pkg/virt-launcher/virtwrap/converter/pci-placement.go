@@ -53,7 +53,8 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 			return err
 		}
 	}
-	if spec.Devices.Watchdog != nil {
+	// Only the i6300esb watchdog is a PCI device
+	if spec.Devices.Watchdog != nil && spec.Devices.Watchdog.Model == "i6300esb" {
 		spec.Devices.Watchdog.Address, err = assigner.PlacePCIDeviceAtNextSlot(spec.Devices.Watchdog.Address)
 		if err != nil {
 			return err
@@ -128,6 +128,14 @@ var _ = Describe("NumaPlacement", func() {
 			Expect(givenSpec.MemoryBacking).To(Equal(expectedMemoryBacking))
 		})
 
+		It("should replace the hugepages of a previous mapping", func() {
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+			Expect(givenSpec.CPU).To(Equal(expectedSpec.CPU))
+			Expect(givenSpec.NUMATune).To(Equal(expectedSpec.NUMATune))
+			Expect(givenSpec.MemoryBacking).To(Equal(expectedMemoryBacking))
+		})
+
 		It("should detect if not enough memory is requested", func() {
 			var err error
 			memory := resource.MustParse("2Mi")
@@ -591,6 +591,8 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 		memoryBytes = memoryBytes - mod*hugepagesSize
 	}
 
+	// Drop the pages of a previous mapping, the domain may be re-pinned for a migration target
+	domain.MemoryBacking.HugePages.HugePage = nil
 	virtualCellID := -1
 	for _, cell := range topology.NumaCells {
 		if vcpus, exists := numamap[cell.Id]; exists {
@@ -0,0 +1,331 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package efi
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// The layout of the OVMF variable store follows the edk2 headers
+// MdePkg/Include/Pi/PiFirmwareVolume.h and MdeModulePkg/Include/Guid/VariableFormat.h
+const (
+	fvSignature             = "_FVH"
+	fvSignatureOffset       = 40
+	fvHeaderLengthOffset    = 48
+	varStoreHeaderSize      = 28
+	varStoreFormatted       = 0x5a
+	varStoreHealthy         = 0xfe
+	authVarHeaderSize       = 60
+	varStartID              = 0x55aa
+	varAdded                = 0x3f
+	varInDeletedTransition  = 0xfe
+	signatureListHeaderSize = 28
+
+	// EFI_VARIABLE_NON_VOLATILE | EFI_VARIABLE_BOOTSERVICE_ACCESS | EFI_VARIABLE_RUNTIME_ACCESS |
+	// EFI_VARIABLE_TIME_BASED_AUTHENTICATED_WRITE_ACCESS
+	secureBootKeyAttributes = 0x27
+)
+
+type guid [16]byte
+
+var (
+	authenticatedVariableGUID = mustParseGUID("aaf32c78-947b-439a-a180-2e144ec37792")
+	globalVariableGUID        = mustParseGUID("8be4df61-93ca-11d2-aa0d-00e098032b8c")
+	imageSecurityDatabaseGUID = mustParseGUID("d719b2cb-3d3a-4596-a3bc-dad00e67656f")
+	certX509GUID              = mustParseGUID("a5c059a1-94e4-4aa7-87b5-ab155c2bf072")
+	// secureBootKeyOwnerGUID is the owner recorded for the certificates enrolled by KubeVirt
+	secureBootKeyOwnerGUID = mustParseGUID("ebc1026b-f526-4235-aa7a-a1bee0a88c69")
+)
+
+// mustParseGUID converts the textual form of a GUID to its mixed-endian binary form
+func mustParseGUID(s string) guid {
+	raw, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(raw) != len(guid{}) {
+		panic(fmt.Sprintf("invalid GUID %s", s))
+	}
+	var g guid
+	binary.LittleEndian.PutUint32(g[0:4], binary.BigEndian.Uint32(raw[0:4]))
+	binary.LittleEndian.PutUint16(g[4:6], binary.BigEndian.Uint16(raw[4:6]))
+	binary.LittleEndian.PutUint16(g[6:8], binary.BigEndian.Uint16(raw[6:8]))
+	copy(g[8:], raw[8:])
+	return g
+}
+
+// SecureBootKeys holds the certificates to enroll in the Secure Boot variables.
+// A nil list keeps the variable of the template variable store.
+type SecureBootKeys struct {
+	PK  []*x509.Certificate
+	KEK []*x509.Certificate
+	DB  []*x509.Certificate
+}
+
+// ReadSecureBootKeys loads the PEM encoded certificates of the Secure Boot keys secret mounted at dir
+func ReadSecureBootKeys(dir string) (*SecureBootKeys, error) {
+	keys := &SecureBootKeys{}
+	for name, certs := range map[string]*[]*x509.Certificate{
+		v1.EFISecureBootPKSecretKey:  &keys.PK,
+		v1.EFISecureBootKEKSecretKey: &keys.KEK,
+		v1.EFISecureBootDBSecretKey:  &keys.DB,
+	} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		*certs, err = parseCertificates(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the %s certificates: %v", name, err)
+		}
+	}
+	return keys, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	return certs, nil
+}
+
+type variable struct {
+	name   string
+	vendor guid
+	// raw is the whole variable, header included, without the trailing alignment
+	raw []byte
+}
+
+// EnrollSecureBootKeys writes to target a copy of the template variable store
+// where the Secure Boot variables are replaced by the given certificates.
+func EnrollSecureBootKeys(template, target string, keys *SecureBootKeys) error {
+	store, err := os.ReadFile(template)
+	if err != nil {
+		return err
+	}
+
+	start, end, err := variableArea(store)
+	if err != nil {
+		return fmt.Errorf("invalid variable store %s: %v", template, err)
+	}
+	variables, err := parseVariables(store[start:end])
+	if err != nil {
+		return fmt.Errorf("invalid variable store %s: %v", template, err)
+	}
+
+	now := time.Now().UTC()
+	for _, key := range []struct {
+		name   string
+		vendor guid
+		certs  []*x509.Certificate
+	}{
+		{"PK", globalVariableGUID, keys.PK},
+		{"KEK", globalVariableGUID, keys.KEK},
+		{"db", imageSecurityDatabaseGUID, keys.DB},
+	} {
+		if key.certs == nil {
+			continue
+		}
+		variables = setVariable(variables, newAuthenticatedVariable(key.name, key.vendor, signatureLists(key.certs), now))
+	}
+
+	area := bytes.Repeat([]byte{0xff}, end-start)
+	offset := 0
+	for _, variable := range variables {
+		if offset+len(variable.raw) > len(area) {
+			return fmt.Errorf("the Secure Boot keys do not fit in the variable store")
+		}
+		copy(area[offset:], variable.raw)
+		offset = alignVariable(offset + len(variable.raw))
+	}
+	copy(store[start:end], area)
+
+	return os.WriteFile(target, store, 0600)
+}
+
+// variableArea returns the bounds of the variables in the firmware volume holding the variable store
+func variableArea(store []byte) (int, int, error) {
+	if len(store) < fvHeaderLengthOffset+2 || string(store[fvSignatureOffset:fvSignatureOffset+4]) != fvSignature {
+		return 0, 0, fmt.Errorf("firmware volume signature not found")
+	}
+	headerStart := int(binary.LittleEndian.Uint16(store[fvHeaderLengthOffset:]))
+	if len(store) < headerStart+varStoreHeaderSize {
+		return 0, 0, fmt.Errorf("truncated variable store header")
+	}
+	header := store[headerStart : headerStart+varStoreHeaderSize]
+	if !bytes.Equal(header[0:16], authenticatedVariableGUID[:]) {
+		return 0, 0, fmt.Errorf("the variable store does not support authenticated variables")
+	}
+	if header[20] != varStoreFormatted || header[21] != varStoreHealthy {
+		return 0, 0, fmt.Errorf("the variable store is not formatted or not healthy")
+	}
+	end := headerStart + int(binary.LittleEndian.Uint32(header[16:20]))
+	if end > len(store) {
+		return 0, 0, fmt.Errorf("the variable store exceeds the firmware volume")
+	}
+	return alignVariable(headerStart + varStoreHeaderSize), end, nil
+}
+
+// parseVariables returns the live variables of the area, dropping the deleted ones
+func parseVariables(area []byte) ([]variable, error) {
+	var added, inDeletion []variable
+	for offset := 0; offset+authVarHeaderSize <= len(area); {
+		header := area[offset : offset+authVarHeaderSize]
+		if binary.LittleEndian.Uint16(header[0:2]) != varStartID {
+			break
+		}
+		nameSize := int(binary.LittleEndian.Uint32(header[36:40]))
+		dataSize := int(binary.LittleEndian.Uint32(header[40:44]))
+		size := authVarHeaderSize + nameSize + dataSize
+		if offset+size > len(area) {
+			return nil, fmt.Errorf("truncated variable at offset %d", offset)
+		}
+
+		raw := make([]byte, size)
+		copy(raw, area[offset:offset+size])
+		var vendor guid
+		copy(vendor[:], header[44:60])
+		v := variable{name: decodeName(raw[authVarHeaderSize : authVarHeaderSize+nameSize]), vendor: vendor, raw: raw}
+
+		switch header[2] {
+		case varAdded:
+			added = append(added, v)
+		case varAdded & varInDeletedTransition:
+			// The variable is only valid if its update was interrupted before the new copy got added
+			raw[2] = varAdded
+			inDeletion = append(inDeletion, v)
+		}
+		offset = alignVariable(offset + size)
+	}
+
+	for _, v := range inDeletion {
+		if indexOf(added, v.name, v.vendor) < 0 {
+			added = append(added, v)
+		}
+	}
+	return added, nil
+}
+
+func setVariable(variables []variable, v variable) []variable {
+	if i := indexOf(variables, v.name, v.vendor); i >= 0 {
+		variables[i] = v
+		return variables
+	}
+	return append(variables, v)
+}
+
+func indexOf(variables []variable, name string, vendor guid) int {
+	for i := range variables {
+		if variables[i].name == name && variables[i].vendor == vendor {
+			return i
+		}
+	}
+	return -1
+}
+
+func newAuthenticatedVariable(name string, vendor guid, data []byte, timestamp time.Time) variable {
+	encodedName := encodeName(name)
+	raw := make([]byte, authVarHeaderSize+len(encodedName)+len(data))
+	binary.LittleEndian.PutUint16(raw[0:2], varStartID)
+	raw[2] = varAdded
+	binary.LittleEndian.PutUint32(raw[4:8], secureBootKeyAttributes)
+	// EFI_TIME
+	binary.LittleEndian.PutUint16(raw[16:18], uint16(timestamp.Year()))
+	raw[18] = byte(timestamp.Month())
+	raw[19] = byte(timestamp.Day())
+	raw[20] = byte(timestamp.Hour())
+	raw[21] = byte(timestamp.Minute())
+	raw[22] = byte(timestamp.Second())
+	binary.LittleEndian.PutUint32(raw[36:40], uint32(len(encodedName)))
+	binary.LittleEndian.PutUint32(raw[40:44], uint32(len(data)))
+	copy(raw[44:60], vendor[:])
+	copy(raw[authVarHeaderSize:], encodedName)
+	copy(raw[authVarHeaderSize+len(encodedName):], data)
+	return variable{name: name, vendor: vendor, raw: raw}
+}
+
+// signatureLists encodes the certificates as EFI_SIGNATURE_LISTs, one per certificate since their sizes differ
+func signatureLists(certs []*x509.Certificate) []byte {
+	var lists []byte
+	for _, cert := range certs {
+		signatureSize := len(guid{}) + len(cert.Raw)
+		list := make([]byte, signatureListHeaderSize, signatureListHeaderSize+signatureSize)
+		copy(list[0:16], certX509GUID[:])
+		binary.LittleEndian.PutUint32(list[16:20], uint32(signatureListHeaderSize+signatureSize))
+		binary.LittleEndian.PutUint32(list[24:28], uint32(signatureSize))
+		list = append(list, secureBootKeyOwnerGUID[:]...)
+		list = append(list, cert.Raw...)
+		lists = append(lists, list...)
+	}
+	return lists
+}
+
+func encodeName(name string) []byte {
+	chars := utf16.Encode([]rune(name + "\x00"))
+	encoded := make([]byte, 2*len(chars))
+	for i, c := range chars {
+		binary.LittleEndian.PutUint16(encoded[2*i:], c)
+	}
+	return encoded
+}
+
+func decodeName(encoded []byte) string {
+	chars := make([]uint16, 0, len(encoded)/2)
+	for i := 0; i+1 < len(encoded); i += 2 {
+		c := binary.LittleEndian.Uint16(encoded[i:])
+		if c == 0 {
+			break
+		}
+		chars = append(chars, c)
+	}
+	return string(utf16.Decode(chars))
+}
+
+func alignVariable(offset int) int {
+	return (offset + 3) &^ 3
+}
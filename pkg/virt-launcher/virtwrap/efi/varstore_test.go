@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package efi
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("EFI variable store", func() {
+	const (
+		fvHeaderLength = 0x48
+		storeSize      = 0x1000
+	)
+
+	var tmpDir string
+
+	newCertificate := func(commonName string) *x509.Certificate {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: commonName},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).ToNot(HaveOccurred())
+		cert, err := x509.ParseCertificate(der)
+		Expect(err).ToNot(HaveOccurred())
+		return cert
+	}
+
+	newVariableStore := func(variables ...variable) []byte {
+		store := bytes.Repeat([]byte{0xff}, fvHeaderLength+storeSize)
+		copy(store[fvSignatureOffset:], fvSignature)
+		binary.LittleEndian.PutUint16(store[fvHeaderLengthOffset:], fvHeaderLength)
+		header := store[fvHeaderLength:]
+		copy(header[0:16], authenticatedVariableGUID[:])
+		binary.LittleEndian.PutUint32(header[16:20], storeSize)
+		header[20] = varStoreFormatted
+		header[21] = varStoreHealthy
+		offset := alignVariable(fvHeaderLength + varStoreHeaderSize)
+		for _, v := range variables {
+			copy(store[offset:], v.raw)
+			offset = alignVariable(offset + len(v.raw))
+		}
+		return store
+	}
+
+	readVariables := func(path string) []variable {
+		store, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		start, end, err := variableArea(store)
+		Expect(err).ToNot(HaveOccurred())
+		variables, err := parseVariables(store[start:end])
+		Expect(err).ToNot(HaveOccurred())
+		return variables
+	}
+
+	variableData := func(v variable) []byte {
+		nameSize := binary.LittleEndian.Uint32(v.raw[36:40])
+		return v.raw[authVarHeaderSize+nameSize:]
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "efi-varstore")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should replace the Secure Boot keys and keep the other variables", func() {
+		oldPK := newAuthenticatedVariable("PK", globalVariableGUID, signatureLists([]*x509.Certificate{newCertificate("old")}), time.Now())
+		deleted := newAuthenticatedVariable("Boot0000", globalVariableGUID, []byte{1}, time.Now())
+		deleted.raw[2] = 0x3c
+		boot := newAuthenticatedVariable("Boot0000", globalVariableGUID, []byte{2}, time.Now())
+		dbx := newAuthenticatedVariable("dbx", imageSecurityDatabaseGUID, []byte{3}, time.Now())
+
+		template := filepath.Join(tmpDir, "template.fd")
+		Expect(os.WriteFile(template, newVariableStore(oldPK, deleted, boot, dbx), 0600)).To(Succeed())
+
+		pk := newCertificate("pk")
+		db := []*x509.Certificate{newCertificate("db1"), newCertificate("db2")}
+		target := filepath.Join(tmpDir, "target.fd")
+		Expect(EnrollSecureBootKeys(template, target, &SecureBootKeys{PK: []*x509.Certificate{pk}, DB: db})).To(Succeed())
+
+		variables := readVariables(target)
+		Expect(variables).To(HaveLen(4))
+		Expect(variables[0].name).To(Equal("PK"))
+		Expect(variableData(variables[0])).To(Equal(signatureLists([]*x509.Certificate{pk})))
+		Expect(variables[1].name).To(Equal("Boot0000"))
+		Expect(variableData(variables[1])).To(Equal([]byte{2}))
+		Expect(variables[2].name).To(Equal("dbx"))
+		Expect(variables[3].name).To(Equal("db"))
+		Expect(variables[3].vendor).To(Equal(imageSecurityDatabaseGUID))
+		Expect(variableData(variables[3])).To(HaveLen(2*(signatureListHeaderSize+16) + len(db[0].Raw) + len(db[1].Raw)))
+	})
+
+	It("should reject a store without authenticated variables", func() {
+		store := newVariableStore()
+		copy(store[fvHeaderLength:], globalVariableGUID[:])
+		template := filepath.Join(tmpDir, "template.fd")
+		Expect(os.WriteFile(template, store, 0600)).To(Succeed())
+		Expect(EnrollSecureBootKeys(template, filepath.Join(tmpDir, "target.fd"), &SecureBootKeys{})).ToNot(Succeed())
+	})
+
+	It("should read the keys from the secret", func() {
+		cert := newCertificate("kek")
+		data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+		Expect(os.WriteFile(filepath.Join(tmpDir, v1.EFISecureBootKEKSecretKey), data, 0600)).To(Succeed())
+
+		keys, err := ReadSecureBootKeys(tmpDir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys.PK).To(BeNil())
+		Expect(keys.DB).To(BeNil())
+		Expect(keys.KEK).To(HaveLen(1))
+		Expect(keys.KEK[0].Raw).To(Equal(cert.Raw))
+	})
+})
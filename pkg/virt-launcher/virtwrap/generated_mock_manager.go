@@ -307,6 +307,36 @@ func (_mr *_MockDomainManagerRecorder) UpdateVCPUs(arg0, arg1 interface{}) *gomo
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateVCPUs", arg0, arg1)
 }
 
+func (_m *MockDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "UpdateGuestMemory", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) UpdateGuestMemory(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateGuestMemory", arg0)
+}
+
+func (_m *MockDomainManager) UpdateIOLimits(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "UpdateIOLimits", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) UpdateIOLimits(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateIOLimits", arg0)
+}
+
+func (_m *MockDomainManager) UpdateInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "UpdateInterfaceLinkStates", vmi)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) UpdateInterfaceLinkStates(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpdateInterfaceLinkStates", arg0)
+}
+
 func (_m *MockDomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	ret := _m.ctrl.Call(_m, "GetSEVInfo")
 	ret0, _ := ret[0].(*v1.SEVPlatformInfo)
@@ -329,6 +359,17 @@ func (_mr *_MockDomainManagerRecorder) GetLaunchMeasurement(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetLaunchMeasurement", arg0)
 }
 
+func (_m *MockDomainManager) GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error) {
+	ret := _m.ctrl.Call(_m, "GetAttestationReport", vmi, nonce)
+	ret0, _ := ret[0].(*v1.AttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GetAttestationReport(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetAttestationReport", arg0, arg1)
+}
+
 func (_m *MockDomainManager) InjectLaunchSecret(_param0 *v1.VirtualMachineInstance, _param1 *v1.SEVSecretOptions) error {
 	ret := _m.ctrl.Call(_m, "InjectLaunchSecret", _param0, _param1)
 	ret0, _ := ret[0].(error)
@@ -338,3 +379,23 @@ func (_m *MockDomainManager) InjectLaunchSecret(_param0 *v1.VirtualMachineInstan
 func (_mr *_MockDomainManagerRecorder) InjectLaunchSecret(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
+
+func (_m *MockDomainManager) BackupVMI(_param0 *v1.VirtualMachineInstance, _param1 *v1.VirtualMachineInstanceBackupOptions) error {
+	ret := _m.ctrl.Call(_m, "BackupVMI", _param0, _param1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) BackupVMI(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "BackupVMI", arg0, arg1)
+}
+
+func (_m *MockDomainManager) AbortVMIBackup(_param0 *v1.VirtualMachineInstance) error {
+	ret := _m.ctrl.Call(_m, "AbortVMIBackup", _param0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) AbortVMIBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortVMIBackup", arg0)
+}
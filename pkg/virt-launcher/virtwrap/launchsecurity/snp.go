@@ -0,0 +1,45 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package launchsecurity
+
+import (
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// SEVSNPPolicySMT allows the guest to run on hosts with SMT enabled
+	SEVSNPPolicySMT uint64 = (1 << 16)
+	// SEVSNPPolicyReserved is reserved by the SNP ABI and must always be set
+	SEVSNPPolicyReserved uint64 = (1 << 17)
+	// SEVSNPPolicyDebug allows the hypervisor to debug the guest, it is never set
+	SEVSNPPolicyDebug uint64 = (1 << 19)
+)
+
+func SEVSNPPolicyToBits(policy *v1.SEVSNPPolicy) uint64 {
+	bits := SEVSNPPolicyReserved | SEVSNPPolicySMT
+
+	if policy != nil {
+		if policy.SMT != nil && !*policy.SMT {
+			bits = bits &^ SEVSNPPolicySMT
+		}
+	}
+
+	return bits
+}
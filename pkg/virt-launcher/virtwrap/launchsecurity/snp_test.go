@@ -0,0 +1,46 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package launchsecurity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/launchsecurity"
+)
+
+var _ = Describe("LaunchSecurity: AMD SEV Secure Nested Paging (SEV-SNP)", func() {
+	Context("SEV-SNP policy conversion", func() {
+		DescribeTable("should set the policy bits", func(policy *v1.SEVSNPPolicy, expectedBits uint64) {
+			bits := launchsecurity.SEVSNPPolicyToBits(policy)
+			Expect(bits).To(Equal(expectedBits))
+			Expect(bits & launchsecurity.SEVSNPPolicyDebug).To(BeZero())
+		},
+			Entry("with SMT allowed by default", nil, launchsecurity.SEVSNPPolicyReserved|launchsecurity.SEVSNPPolicySMT),
+			Entry("with SMT allowed when unset", &v1.SEVSNPPolicy{}, launchsecurity.SEVSNPPolicyReserved|launchsecurity.SEVSNPPolicySMT),
+			Entry("with SMT explicitly allowed", &v1.SEVSNPPolicy{SMT: pointer.Bool(true)}, launchsecurity.SEVSNPPolicyReserved|launchsecurity.SEVSNPPolicySMT),
+			Entry("with SMT disallowed", &v1.SEVSNPPolicy{SMT: pointer.Bool(false)}, launchsecurity.SEVSNPPolicyReserved),
+		)
+	})
+})
@@ -21,7 +21,6 @@ package virtwrap
 
 import (
 	"bytes"
-	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -31,7 +30,6 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 
-	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
@@ -83,6 +81,7 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+	pausedToConverge         bool
 }
 
 type inflightMigrationAborted struct {
@@ -108,9 +107,12 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if migratePaused {
 		migrateFlags |= libvirt.MIGRATE_PAUSED
 	}
-	if options.ParallelMigrationThreads != nil {
+	if options.ParallelMigrationThreads != nil || options.Compression == v1.MigrationCompressionZstd {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if options.Compression != "" {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -137,45 +139,6 @@ func hotUnplugHostDevices(virConn cli.Connection, dom cli.VirDomain) error {
 	return nil
 }
 
-func generateDomainForTargetCPUSetAndTopology(vmi *v1.VirtualMachineInstance, domSpec *api.DomainSpec) (*api.Domain, error) {
-	var targetTopology cmdv1.Topology
-	targetNodeCPUSet := vmi.Status.MigrationState.TargetCPUSet
-	err := json.Unmarshal([]byte(vmi.Status.MigrationState.TargetNodeTopology), &targetTopology)
-	if err != nil {
-		return nil, err
-	}
-
-	useIOThreads := false
-	for _, diskDevice := range vmi.Spec.Domain.Devices.Disks {
-		if diskDevice.DedicatedIOThread != nil && *diskDevice.DedicatedIOThread {
-			useIOThreads = true
-			break
-		}
-	}
-	domain := api.NewMinimalDomain(vmi.Name)
-	domain.Spec = *domSpec
-	cpuTopology := vcpu.GetCPUTopology(vmi)
-	cpuCount := vcpu.CalculateRequestedVCPUs(cpuTopology)
-
-	// update cpu count to maximum hot plugable CPUs
-	vmiCPU := vmi.Spec.Domain.CPU
-	if vmiCPU != nil && vmiCPU.MaxSockets != 0 {
-		cpuTopology.Sockets = vmiCPU.MaxSockets
-		cpuCount = vcpu.CalculateRequestedVCPUs(cpuTopology)
-	}
-	domain.Spec.CPU.Topology = cpuTopology
-	domain.Spec.VCPU = &api.VCPU{
-		Placement: "static",
-		CPUs:      cpuCount,
-	}
-	err = vcpu.AdjustDomainForTopologyAndCPUSet(domain, vmi, &targetTopology, targetNodeCPUSet, useIOThreads)
-	if err != nil {
-		return nil, err
-	}
-
-	return domain, err
-}
-
 func injectNewSection(encoder *xml.Encoder, domain *api.Domain, section []string, logger *log.FilteredLogger) error {
 	// Marshalling the whole domain, even if we just need the cputune section, for indentation purposes
 	xmlstr, err := xml.MarshalIndent(domain.Spec, "", "  ")
@@ -259,6 +222,13 @@ func shouldOverrideForDedicatedCPUTarget(section []string, strict bool) bool {
 		section[2] == "numa" {
 		return true
 	}
+	if (!strict || len(section) == 3) &&
+		len(section) >= 3 &&
+		section[0] == "domain" &&
+		section[1] == "memoryBacking" &&
+		section[2] == "hugepages" {
+		return true
+	}
 
 	return false
 }
@@ -368,11 +338,22 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 		shared:    make(map[string]bool),
 		generated: make(map[string]bool),
 	}
+	// volumes of cross cluster migrations are either copied or were pre-synced to the other cluster
+	volumeMigration := migrations.CrossClusterVolumeMigration(vmi)
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
-		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil ||
-			(volSrc.HostDisk != nil && *volSrc.HostDisk.Shared) {
-			disks.shared[volume.Name] = true
+		switch volumeMigration {
+		case v1.CrossClusterVolumeMigrationBlock:
+			// every persistent volume is copied
+		case v1.CrossClusterVolumeMigrationPreSynced:
+			if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil || volSrc.HostDisk != nil {
+				disks.shared[volume.Name] = true
+			}
+		default:
+			if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil ||
+				(volSrc.HostDisk != nil && *volSrc.HostDisk.Shared) {
+				disks.shared[volume.Name] = true
+			}
 		}
 		if volSrc.ConfigMap != nil || volSrc.Secret != nil || volSrc.DownwardAPI != nil ||
 			volSrc.ServiceAccount != nil || volSrc.CloudInitNoCloud != nil ||
@@ -547,6 +528,10 @@ func (m *migrationMonitor) shouldTriggerPostCopy(elapsed int64) bool {
 	return m.shouldTriggerTimeout(elapsed) && m.options.AllowPostCopy
 }
 
+func (m *migrationMonitor) shouldTriggerPause(elapsed int64) bool {
+	return m.shouldTriggerTimeout(elapsed) && m.options.AllowWorkloadDisruption
+}
+
 func (m *migrationMonitor) isMigrationProgressing() bool {
 	logger := log.Log.Object(m.vmi)
 
@@ -638,6 +623,20 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 
 		m.l.updateVMIMigrationMode(v1.MigrationPostCopy)
 
+	case m.pausedToConverge:
+		// The VMI was paused to let the migration converge, the
+		// remaining data can only shrink so there is nothing to track.
+
+	case m.shouldTriggerPause(elapsed):
+		logger.Info("Pausing the VMI to let the migration converge")
+		// libvirt resumes the domain on the target once the migration completes
+		err := dom.Suspend()
+		if err != nil {
+			logger.Reason(err).Error("failed to pause the VMI for migration")
+			return nil
+		}
+		m.pausedToConverge = true
+
 	case !m.isMigrationProgressing():
 		// check if the migration is still progressing
 		// a stuck migration will get terminated when post copy
@@ -809,7 +808,8 @@ func (l *LibvirtDomainManager) asyncMigrationAbort(vmi *v1.VirtualMachineInstanc
 }
 
 func isBlockMigration(vmi *v1.VirtualMachineInstance) bool {
-	return vmi.Status.MigrationMethod == v1.BlockMigration
+	return vmi.Status.MigrationMethod == v1.BlockMigration ||
+		migrations.CrossClusterVolumeMigration(vmi) == v1.CrossClusterVolumeMigrationBlock
 }
 
 func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, virtShareDir string, domSpec *api.DomainSpec) (*libvirt.DomainMigrateParameters, error) {
@@ -843,6 +843,8 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		PersistXMLSet:          true,
 		ParallelConnectionsSet: parallelMigrationSet,
 		ParallelConnections:    parallelMigrationThreads,
+		CompressionSet:         options.Compression != "",
+		Compression:            string(options.Compression),
 	}
 
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
@@ -76,6 +76,7 @@ import (
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netsriov "kubevirt.io/kubevirt/pkg/network/sriov"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	accesscredentials "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/access-credentials"
@@ -135,9 +136,15 @@ type DomainManager interface {
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
+	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
+	UpdateIOLimits(vmi *v1.VirtualMachineInstance) error
+	UpdateInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
+	GetAttestationReport(vmi *v1.VirtualMachineInstance, nonce string) (*v1.AttestationReport, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	BackupVMI(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceBackupOptions) error
+	AbortVMIBackup(*v1.VirtualMachineInstance) error
 }
 
 type LibvirtDomainManager struct {
@@ -168,6 +175,11 @@ type LibvirtDomainManager struct {
 	migrateInfoStats         *stats.DomainJobInfo
 
 	metadataCache *metadata.Cache
+
+	// balloonLock protects the balloon policy of the VMI, reconciled by a loop started on first use
+	balloonLock     sync.Mutex
+	balloon         balloonPolicy
+	balloonLoopOnce sync.Once
 }
 
 type pausedVMIs struct {
@@ -449,6 +461,263 @@ func (l *LibvirtDomainManager) UpdateVCPUs(vmi *v1.VirtualMachineInstance, optio
 	return nil
 }
 
+// UpdateGuestMemory resizes the virtio-mem device of a running domain to match the guest memory of the VMI
+func (l *LibvirtDomainManager) UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to update guest memory"
+
+	if vmi.Spec.Domain.Memory == nil || vmi.Spec.Domain.Memory.Guest == nil ||
+		vmi.Status.Memory == nil || vmi.Status.Memory.GuestAtBoot == nil {
+		return fmt.Errorf("%s: guest memory is not set on the VMI", errMsgPrefix)
+	}
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	spec, err := getDomainSpec(dom)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	memoryDevice := spec.Devices.Memory
+	if memoryDevice == nil || memoryDevice.Target == nil {
+		return fmt.Errorf("%s: domain has no hotpluggable memory device", errMsgPrefix)
+	}
+
+	requested := vmi.Spec.Domain.Memory.Guest.Value() - vmi.Status.Memory.GuestAtBoot.Value()
+	size, err := memoryToBytes(memoryDevice.Target.Size)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	if requested < 0 || uint64(requested) > size {
+		return fmt.Errorf("%s: requested memory %d is out of the hotpluggable range", errMsgPrefix, requested)
+	}
+
+	memoryDevice.Target.Requested = api.Memory{Value: uint64(requested), Unit: "b"}
+	memoryDevice.Target.Current = nil
+	memoryDeviceXML, err := xml.Marshal(memoryDevice)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	if err := dom.UpdateDeviceFlags(string(memoryDeviceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	return nil
+}
+
+func memoryToBytes(memory api.Memory) (uint64, error) {
+	switch strings.ToLower(memory.Unit) {
+	case "", "b", "bytes":
+		return memory.Value, nil
+	case "k", "kib":
+		return memory.Value << 10, nil
+	case "m", "mib":
+		return memory.Value << 20, nil
+	case "g", "gib":
+		return memory.Value << 30, nil
+	}
+	return 0, fmt.Errorf("unsupported memory unit %s", memory.Unit)
+}
+
+// UpdateIOLimits applies the disk I/O throttling and interface bandwidth limits of the VMI to the running domain
+func (l *LibvirtDomainManager) UpdateIOLimits(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to update I/O limits"
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	spec, err := getDomainSpec(dom)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	disksByName := map[string]v1.Disk{}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		disksByName[disk.Name] = disk
+	}
+	for _, domainDisk := range spec.Devices.Disks {
+		if domainDisk.Alias == nil {
+			continue
+		}
+		disk, exists := disksByName[domainDisk.Alias.GetName()]
+		if !exists {
+			continue
+		}
+		current := blockIOTuneOrEmpty(domainDisk.IOTune)
+		desired := blockIOTuneOrEmpty(converter.Convert_v1_DiskIOTune_To_api_BlockIOTune(disk.IOTune))
+		if current == desired {
+			continue
+		}
+		params := &libvirt.DomainBlockIoTuneParameters{
+			TotalBytesSecSet: true,
+			TotalBytesSec:    desired.TotalBytesSec,
+			ReadBytesSecSet:  true,
+			ReadBytesSec:     desired.ReadBytesSec,
+			WriteBytesSecSet: true,
+			WriteBytesSec:    desired.WriteBytesSec,
+			TotalIopsSecSet:  true,
+			TotalIopsSec:     desired.TotalIopsSec,
+			ReadIopsSecSet:   true,
+			ReadIopsSec:      desired.ReadIopsSec,
+			WriteIopsSecSet:  true,
+			WriteIopsSec:     desired.WriteIopsSec,
+		}
+		if err := dom.SetBlockIoTune(domainDisk.Target.Device, params, affectDomainLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("%s: disk %s: %v", errMsgPrefix, disk.Name, err)
+		}
+		log.Log.Object(vmi).Infof("I/O throttling of disk %s has been updated", disk.Name)
+	}
+
+	interfacesByName := map[string]v1.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		interfacesByName[iface.Name] = iface
+	}
+	for _, domainIface := range spec.Devices.Interfaces {
+		if domainIface.Alias == nil {
+			continue
+		}
+		iface, exists := interfacesByName[domainIface.Alias.GetName()]
+		if !exists {
+			continue
+		}
+		var currentIn, currentOut api.BandWidthLimit
+		if domainIface.BandWidth != nil {
+			currentIn = bandWidthLimitOrEmpty(domainIface.BandWidth.Inbound)
+			currentOut = bandWidthLimitOrEmpty(domainIface.BandWidth.Outbound)
+		}
+		var desiredIn, desiredOut api.BandWidthLimit
+		if desired := converter.Convert_v1_InterfaceBandwidth_To_api_BandWidth(iface.Bandwidth); desired != nil {
+			desiredIn = bandWidthLimitOrEmpty(desired.Inbound)
+			desiredOut = bandWidthLimitOrEmpty(desired.Outbound)
+		}
+		if currentIn == desiredIn && currentOut == desiredOut {
+			continue
+		}
+		device := domainIfaceDevice(domainIface)
+		if device == "" {
+			return fmt.Errorf("%s: interface %s has neither a target device nor a MAC address", errMsgPrefix, iface.Name)
+		}
+		params := &libvirt.DomainInterfaceParameters{
+			BandwidthInAverageSet:  true,
+			BandwidthInAverage:     desiredIn.Average,
+			BandwidthInPeakSet:     true,
+			BandwidthInPeak:        desiredIn.Peak,
+			BandwidthInBurstSet:    true,
+			BandwidthInBurst:       desiredIn.Burst,
+			BandwidthOutAverageSet: true,
+			BandwidthOutAverage:    desiredOut.Average,
+			BandwidthOutPeakSet:    true,
+			BandwidthOutPeak:       desiredOut.Peak,
+			BandwidthOutBurstSet:   true,
+			BandwidthOutBurst:      desiredOut.Burst,
+		}
+		if err := dom.SetInterfaceParameters(device, params, affectDomainLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("%s: interface %s: %v", errMsgPrefix, iface.Name, err)
+		}
+		log.Log.Object(vmi).Infof("bandwidth of interface %s has been updated", iface.Name)
+	}
+
+	return nil
+}
+
+// UpdateInterfaceLinkStates sets the link of the running domain interfaces up or down, following the VMI interface states
+func (l *LibvirtDomainManager) UpdateInterfaceLinkStates(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to update interface link states"
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domainName)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	spec, err := getDomainSpec(dom)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	interfacesByName := map[string]v1.Interface{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		interfacesByName[iface.Name] = iface
+	}
+	for _, domainIface := range spec.Devices.Interfaces {
+		if domainIface.Alias == nil {
+			continue
+		}
+		iface, exists := interfacesByName[domainIface.Alias.GetName()]
+		if !exists {
+			continue
+		}
+		desired := converter.Convert_v1_InterfaceState_To_api_LinkState(iface.State)
+		if desired == nil || linkStateOrUp(domainIface.LinkState) == desired.State {
+			continue
+		}
+
+		domainIface.LinkState = desired
+		ifaceXML, err := xml.Marshal(domainIface)
+		if err != nil {
+			return fmt.Errorf("%s: interface %s: %v", errMsgPrefix, iface.Name, err)
+		}
+		if err := dom.UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags); err != nil {
+			return fmt.Errorf("%s: interface %s: %v", errMsgPrefix, iface.Name, err)
+		}
+		log.Log.Object(vmi).Infof("link of interface %s has been set %s", iface.Name, desired.State)
+	}
+
+	return nil
+}
+
+// linkStateOrUp returns the link state of a domain interface, which is up unless set otherwise
+func linkStateOrUp(linkState *api.LinkState) string {
+	if linkState == nil || linkState.State == "" {
+		return string(v1.InterfaceStateLinkUp)
+	}
+	return linkState.State
+}
+
+func blockIOTuneOrEmpty(ioTune *api.BlockIOTune) api.BlockIOTune {
+	if ioTune == nil {
+		return api.BlockIOTune{}
+	}
+	return *ioTune
+}
+
+func bandWidthLimitOrEmpty(limit *api.BandWidthLimit) api.BandWidthLimit {
+	if limit == nil {
+		return api.BandWidthLimit{}
+	}
+	return *limit
+}
+
+// domainIfaceDevice returns the identifier libvirt accepts to address the interface: its target device or MAC address
+func domainIfaceDevice(iface api.Interface) string {
+	if iface.Target != nil && iface.Target.Device != "" {
+		return iface.Target.Device
+	}
+	if iface.MAC != nil {
+		return iface.MAC.MAC
+	}
+	return ""
+}
+
 // HotplugHostDevices attach host-devices to running domain, currently only SRIOV host-devices are supported.
 // This operation runs in the background, only one hotplug operation can occur at a time.
 func (l *LibvirtDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
@@ -737,12 +1006,57 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("Starting qemu agent access credential propagation failed: %v", err)
 	}
 
+	if err := l.defineTPMEncryptionSecret(vmi); err != nil {
+		return domain, fmt.Errorf("defining the TPM state encryption secret failed: %v", err)
+	}
+
+	if err := enrollSecureBootKeys(vmi, domain); err != nil {
+		return domain, fmt.Errorf("enrolling the Secure Boot keys failed: %v", err)
+	}
+
 	// expand disk image files if they're too small
 	expandDiskImagesOffline(vmi, domain)
 
 	return domain, err
 }
 
+// defineTPMEncryptionSecret hands the key mounted from the VMI encryption secret to libvirt,
+// which passes it to swtpm to encrypt the persistent TPM state at rest.
+func (l *LibvirtDomainManager) defineTPMEncryptionSecret(vmi *v1.VirtualMachineInstance) error {
+	tpm := vmi.Spec.Domain.Devices.TPM
+	if tpm == nil || tpm.Persistent == nil || !*tpm.Persistent || tpm.EncryptionSecretRef == nil {
+		return nil
+	}
+	key, err := os.ReadFile(filepath.Join(config.TPMEncryptionKeyDir, v1.TPMEncryptionKeySecretKey))
+	if err != nil {
+		return err
+	}
+	secretXML := fmt.Sprintf("<secret ephemeral='yes' private='yes'><uuid>%s</uuid><usage type='vtpm'><name>%s</name></usage></secret>",
+		vmi.UID, api.VMINamespaceKeyFunc(vmi))
+	return l.virConn.DefineSecret(secretXML, key)
+}
+
+// enrollSecureBootKeys initializes the NVRAM of the domain with the Secure Boot keys of the VMI,
+// unless a previous boot of a persistent NVRAM already did.
+func enrollSecureBootKeys(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	firmware := vmi.Spec.Domain.Firmware
+	if firmware == nil || firmware.Bootloader == nil || firmware.Bootloader.EFI == nil ||
+		firmware.Bootloader.EFI.SecureBootKeysSecretRef == nil || domain.Spec.OS.NVRam == nil {
+		return nil
+	}
+	nvram := domain.Spec.OS.NVRam
+	if _, err := os.Stat(nvram.NVRam); err == nil {
+		return nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	keys, err := efi.ReadSecureBootKeys(config.SecureBootKeysDir)
+	if err != nil {
+		return err
+	}
+	return efi.EnrollSecureBootKeys(nvram.Template, nvram.NVRam, keys)
+}
+
 func expandDiskImagesOffline(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 	logger := log.Log.Object(vmi)
 	for _, disk := range domain.Spec.Devices.Disks {
@@ -869,16 +1183,17 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 	var efiConf *converter.EFIConfiguration
 	if vmi.IsBootloaderEFI() {
 		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
-		sev := kutil.IsSEVVMI(vmi)
+		// SEV, SEV-SNP and TDX guests all boot the confidential computing OVMF build
+		confidential := kutil.IsConfidentialVMI(vmi)
 
-		if !l.efiEnvironment.Bootable(secureBoot, sev) {
-			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
-			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
+		if !l.efiEnvironment.Bootable(secureBoot, confidential) {
+			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, Confidential=%v", secureBoot, confidential)
+			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, Confidential=%v", secureBoot, confidential)
 		}
 
 		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, sev),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, sev),
+			EFICode:      l.efiEnvironment.EFICode(secureBoot, confidential),
+			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, confidential),
 			SecureLoader: secureBoot,
 		}
 	}
@@ -895,7 +1210,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		UseVirtioTransitional: vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
 		PermanentVolumes:      permanentVolumes,
 		EphemeraldiskCreator:  l.ephemeralDiskCreator,
-		UseLaunchSecurity:     kutil.IsSEVVMI(vmi),
+		UseLaunchSecurity:     kutil.IsConfidentialVMI(vmi),
 		FreePageReporting:     isFreePageReportingEnabled(false, vmi),
 	}
 
@@ -910,6 +1225,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		c.MemBalloonStatsPeriod = uint(options.MemBalloonStatsPeriod)
 		// Add preallocated and thick-provisioned volumes for which we need to avoid the discard=unmap option
 		c.VolumesDiscardIgnore = options.PreallocatedVolumes
+		c.DomainAttachmentByInterfaceName = options.GetInterfaceDomainAttachment()
 
 		if len(options.DisksInfo) > 0 {
 			l.disksInfo = options.DisksInfo
@@ -1130,6 +1446,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: oldSpec}); err != nil {
 			return nil, err
 		}
+		l.syncBalloonPolicy(vmi)
 	}
 
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
@@ -1361,9 +1678,38 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	}
 
 	l.setMemoryDumpResult(failed, reason)
+	l.resetGuestAfterWatchdogDump(dom, logger)
 	return err
 }
 
+// resetGuestAfterWatchdogDump resets the guest kept paused by the watchdog dump action
+// once its memory dump completed, whether the dump succeeded or not.
+func (l *LibvirtDomainManager) resetGuestAfterWatchdogDump(dom cli.VirDomain, logger *log.FilteredLogger) {
+	memoryDumpMetadata, _ := l.metadataCache.MemoryDump.Load()
+	resetPending := false
+	l.metadataCache.Watchdog.WithSafeBlock(func(watchdogMetadata *api.WatchdogMetadata, initialized bool) {
+		if !initialized || !watchdogMetadata.DumpPending || watchdogMetadata.LastFiredTimestamp == nil ||
+			memoryDumpMetadata.StartTimestamp == nil || memoryDumpMetadata.StartTimestamp.Before(watchdogMetadata.LastFiredTimestamp) {
+			return
+		}
+		watchdogMetadata.DumpPending = false
+		resetPending = true
+	})
+	if !resetPending {
+		return
+	}
+
+	if err := dom.Reset(0); err != nil {
+		logger.Reason(err).Error("failed to reset the guest after the watchdog memory dump")
+		return
+	}
+	if err := dom.Resume(); err != nil {
+		logger.Reason(err).Error("failed to resume the guest after the watchdog memory dump")
+		return
+	}
+	logger.Info("guest reset after the watchdog memory dump")
+}
+
 func (l *LibvirtDomainManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := l.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -1709,7 +2055,11 @@ func (l *LibvirtDomainManager) DeleteVMI(vmi *v1.VirtualMachineInstance) error {
 	}
 	defer dom.Free()
 
-	err = dom.UndefineFlags(libvirt.DOMAIN_UNDEFINE_NVRAM)
+	undefineFlags := libvirt.DOMAIN_UNDEFINE_NVRAM
+	if backendstorage.HasPersistentEFI(&vmi.Spec) {
+		undefineFlags = libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM
+	}
+	err = dom.UndefineFlags(undefineFlags)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Undefining the domain failed.")
 		return err
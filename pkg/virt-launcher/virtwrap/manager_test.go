@@ -667,6 +667,32 @@ var _ = Describe("Manager", func() {
 			// not to call core dump command again
 			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
 		})
+		It("should reset the guest paused by the watchdog dump action once its memory is dumped", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+			mockDomain.EXPECT().Reset(uint32(0)).Return(nil)
+			resumed := make(chan struct{})
+			mockDomain.EXPECT().Resume().DoAndReturn(func() error {
+				close(resumed)
+				return nil
+			})
+
+			firedTimestamp := metav1.NewTime(time.Now().Add(-time.Minute))
+			metadataCache.Watchdog.Store(api.WatchdogMetadata{
+				FiredCount:         1,
+				LastFiredTimestamp: &firedTimestamp,
+				Action:             string(v1.WatchdogActionDump),
+				DumpPending:        true,
+			})
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			vmi := newVMI(testNamespace, testVmName)
+			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+
+			Eventually(resumed, 5*time.Second).Should(BeClosed())
+			watchdog, _ := metadataCache.Watchdog.Load()
+			Expect(watchdog.DumpPending).To(BeFalse())
+		})
 		It("should update domain with memory dump info if memory dump failed", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			dumpFailure := fmt.Errorf("Memory dump failed!!")
@@ -1339,6 +1365,183 @@ var _ = Describe("Manager", func() {
 			err := manager.InjectLaunchSecret(vmi, sevSecretOptions)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should query the SEV attestation report of a VirtualMachineInstance", func() {
+			const nonce = "AAECAwQFBgcICQoLDA0ODw=="
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.LaunchSecurity = &v1.LaunchSecurity{SEV: &v1.SEV{}}
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().Free()
+			mockDomain.EXPECT().QemuMonitorCommand(
+				`{"execute":"query-sev-attestation-report","arguments":{"mnonce":"`+nonce+`"}}`,
+				libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT,
+			).Return(`{"return":{"data":"cmVwb3J0"},"id":"libvirt-42"}`, nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			report, err := manager.GetAttestationReport(vmi, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(report).To(Equal(&v1.AttestationReport{Type: "sev", Nonce: nonce, Report: "cmVwb3J0"}))
+		})
+
+		DescribeTable("should refuse to query the attestation report", func(launchSecurity *v1.LaunchSecurity, nonce string) {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.LaunchSecurity = launchSecurity
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			_, err := manager.GetAttestationReport(vmi, nonce)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("of a VirtualMachineInstance without launch security", nil, "AAECAwQFBgcICQoLDA0ODw=="),
+			Entry("of a SEV-SNP VirtualMachineInstance", &v1.LaunchSecurity{SNP: &v1.SEVSNP{}}, "AAECAwQFBgcICQoLDA0ODw=="),
+			Entry("of a TDX VirtualMachineInstance", &v1.LaunchSecurity{TDX: &v1.TDX{}}, "AAECAwQFBgcICQoLDA0ODw=="),
+			Entry("with a nonce of the wrong size", &v1.LaunchSecurity{SEV: &v1.SEV{}}, "AAECAw=="),
+			Entry("with a nonce that is not base64 encoded", &v1.LaunchSecurity{SEV: &v1.SEV{}}, "not-base64"),
+		)
+	})
+	Context("on I/O limits update", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name:   "disk0",
+				IOTune: &v1.DiskIOTune{TotalBytesSec: 2048},
+			}}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:      "default",
+				Bandwidth: &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: 1000, Burst: 500}},
+			}}
+		})
+
+		expectDomainWith := func(ioTune *api.BlockIOTune, bandwidth *api.BandWidth) {
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Disks = []api.Disk{{
+				Alias:  api.NewUserDefinedAlias("disk0"),
+				Target: api.DiskTarget{Device: "vda"},
+				IOTune: ioTune,
+			}}
+			domainSpec.Devices.Interfaces = []api.Interface{{
+				Alias:     api.NewUserDefinedAlias("default"),
+				Target:    &api.InterfaceTarget{Device: "tap0"},
+				BandWidth: bandwidth,
+			}}
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+		}
+
+		It("should apply changed limits to the domain", func() {
+			expectDomainWith(&api.BlockIOTune{TotalBytesSec: 1024}, nil)
+			mockDomain.EXPECT().SetBlockIoTune("vda", &libvirt.DomainBlockIoTuneParameters{
+				TotalBytesSecSet: true,
+				TotalBytesSec:    2048,
+				ReadBytesSecSet:  true,
+				WriteBytesSecSet: true,
+				TotalIopsSecSet:  true,
+				ReadIopsSecSet:   true,
+				WriteIopsSecSet:  true,
+			}, affectDomainLiveAndConfigLibvirtFlags).Return(nil)
+			mockDomain.EXPECT().SetInterfaceParameters("tap0", &libvirt.DomainInterfaceParameters{
+				BandwidthInAverageSet:  true,
+				BandwidthInPeakSet:     true,
+				BandwidthInBurstSet:    true,
+				BandwidthOutAverageSet: true,
+				BandwidthOutAverage:    1000,
+				BandwidthOutPeakSet:    true,
+				BandwidthOutBurstSet:   true,
+				BandwidthOutBurst:      500,
+			}, affectDomainLiveAndConfigLibvirtFlags).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateIOLimits(vmi)).To(Succeed())
+		})
+
+		It("should leave matching limits alone", func() {
+			expectDomainWith(&api.BlockIOTune{TotalBytesSec: 2048}, &api.BandWidth{Outbound: &api.BandWidthLimit{Average: 1000, Burst: 500}})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateIOLimits(vmi)).To(Succeed())
+		})
+	})
+	Context("on interface link states update", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:  "default",
+				State: v1.InterfaceStateLinkDown,
+			}}
+		})
+
+		domainIfaceWith := func(linkState *api.LinkState) api.Interface {
+			return api.Interface{
+				Type:      "ethernet",
+				Alias:     api.NewUserDefinedAlias("default"),
+				Target:    &api.InterfaceTarget{Device: "tap0"},
+				LinkState: linkState,
+			}
+		}
+
+		expectDomainWith := func(linkState *api.LinkState) {
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Interfaces = []api.Interface{domainIfaceWith(linkState)}
+			xml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(xml), nil)
+		}
+
+		It("should set the link of the interface down", func() {
+			expectDomainWith(nil)
+			ifaceXML, err := xml.Marshal(domainIfaceWith(&api.LinkState{State: "down"}))
+			Expect(err).NotTo(HaveOccurred())
+			mockDomain.EXPECT().UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateInterfaceLinkStates(vmi)).To(Succeed())
+		})
+
+		It("should set the link of the interface back up", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateLinkUp
+			expectDomainWith(&api.LinkState{State: "down"})
+			ifaceXML, err := xml.Marshal(domainIfaceWith(&api.LinkState{State: "up"}))
+			Expect(err).NotTo(HaveOccurred())
+			mockDomain.EXPECT().UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateInterfaceLinkStates(vmi)).To(Succeed())
+		})
+
+		It("should leave a matching link state alone", func() {
+			expectDomainWith(&api.LinkState{State: "down"})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateInterfaceLinkStates(vmi)).To(Succeed())
+		})
+
+		It("should set the link of the interface back up when its state is unset", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].State = ""
+			expectDomainWith(&api.LinkState{State: "down"})
+			ifaceXML, err := xml.Marshal(domainIfaceWith(&api.LinkState{State: "up"}))
+			Expect(err).NotTo(HaveOccurred())
+			mockDomain.EXPECT().UpdateDeviceFlags(string(ifaceXML), affectDeviceLiveAndConfigLibvirtFlags).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateInterfaceLinkStates(vmi)).To(Succeed())
+		})
+
+		It("should not control the link of an interface being unplugged", func() {
+			vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+			expectDomainWith(&api.LinkState{State: "down"})
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.UpdateInterfaceLinkStates(vmi)).To(Succeed())
+		})
 	})
 	Context("test marking graceful shutdown", func() {
 		It("Should set metadata when calling MarkGracefulShutdown api", func() {
@@ -1929,6 +2132,12 @@ var _ = Describe("Manager", func() {
 				AllowPostCopy:            migrationType == "postCopy",
 				ParallelMigrationThreads: parallelMigrationThreads,
 			}
+			switch migrationType {
+			case "xbzrle":
+				options.Compression = v1.MigrationCompressionXBZRLE
+			case "zstd":
+				options.Compression = v1.MigrationCompressionZstd
+			}
 
 			flags := generateMigrationFlags(isBlockMigration, isVmiPaused, options)
 			expectedMigrateFlags := libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
@@ -1947,9 +2156,12 @@ var _ = Describe("Manager", func() {
 			if migrationType == "paused" {
 				expectedMigrateFlags |= libvirt.MIGRATE_PAUSED
 			}
-			if migrationType == "parallel" {
+			if migrationType == "parallel" || migrationType == "zstd" {
 				expectedMigrateFlags |= libvirt.MIGRATE_PARALLEL
 			}
+			if migrationType == "xbzrle" || migrationType == "zstd" {
+				expectedMigrateFlags |= libvirt.MIGRATE_COMPRESSED
+			}
 			Expect(flags).To(Equal(expectedMigrateFlags), "libvirt migration flags are not set as expected")
 		},
 		Entry("with block migration", "block"),
@@ -1959,6 +2171,8 @@ var _ = Describe("Manager", func() {
 		Entry("migration using postcopy", "postCopy"),
 		Entry("migration of paused vmi", "paused"),
 		Entry("migration with parallel threads", "parallel"),
+		Entry("migration with xbzrle compression", "xbzrle"),
+		Entry("migration with zstd compression", "zstd"),
 	)
 
 	DescribeTable("on successful list all domains",
@@ -2549,6 +2763,19 @@ var _ = Describe("migratableDomXML", func() {
 		By("ensuring the generated XML is accurate")
 		Expect(newXML).To(Equal(expectedXML), "the target XML is not as expected")
 	})
+	DescribeTable("should override the pinning related sections for a dedicated CPU target", func(section []string, strict, expected bool) {
+		Expect(shouldOverrideForDedicatedCPUTarget(section, strict)).To(Equal(expected))
+	},
+		Entry("cputune", []string{"domain", "cputune"}, true, true),
+		Entry("inside cputune", []string{"domain", "cputune", "vcpupin"}, false, true),
+		Entry("numatune", []string{"domain", "numatune"}, true, true),
+		Entry("the guest NUMA cells", []string{"domain", "cpu", "numa"}, true, true),
+		Entry("the hugepages mapped to the guest NUMA cells", []string{"domain", "memoryBacking", "hugepages"}, true, true),
+		Entry("inside the hugepages", []string{"domain", "memoryBacking", "hugepages", "page"}, false, true),
+		Entry("not when strictly inside the hugepages", []string{"domain", "memoryBacking", "hugepages", "page"}, true, false),
+		Entry("not the other memory backing settings", []string{"domain", "memoryBacking", "allocation"}, false, false),
+		Entry("not the vcpu count", []string{"domain", "vcpu"}, false, false),
+	)
 })
 
 var _ = Describe("Manager helper functions", func() {
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"libvirt.org/go/libvirt"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/balloon"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+const balloonReconcileInterval = 10 * time.Second
+
+// balloonPolicy is the memory balloon policy of the running VMI
+type balloonPolicy struct {
+	policy   *v1.MemoryBalloon
+	guestKiB uint64
+}
+
+// syncBalloonPolicy records the balloon policy of the VMI and starts reconciling
+// the balloon size of the domain against it once a policy is set.
+func (l *LibvirtDomainManager) syncBalloonPolicy(vmi *v1.VirtualMachineInstance) {
+	var policy *v1.MemoryBalloon
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Balloon != nil {
+		policy = vmi.Spec.Domain.Memory.Balloon.DeepCopy()
+	}
+
+	l.balloonLock.Lock()
+	l.balloon = balloonPolicy{
+		policy:   policy,
+		guestKiB: uint64(vcpu.GetVirtualMemory(vmi).Value()) / 1024,
+	}
+	l.balloonLock.Unlock()
+
+	if policy != nil {
+		l.balloonLoopOnce.Do(func() {
+			go wait.Forever(l.reconcileBalloon, balloonReconcileInterval)
+		})
+	}
+}
+
+func (l *LibvirtDomainManager) reconcileBalloon() {
+	l.balloonLock.Lock()
+	current := l.balloon
+	l.balloonLock.Unlock()
+	if current.policy == nil {
+		return
+	}
+
+	if migration, exists := l.metadataCache.Migration.Load(); exists && !migration.Completed && !migration.Failed {
+		// the balloon is left untouched while the guest memory is being migrated
+		return
+	}
+
+	domStats, err := l.virConn.GetDomainStats(libvirt.DOMAIN_STATS_BALLOON, &stats.DomainJobInfo{}, libvirt.CONNECT_GET_ALL_DOMAINS_STATS_RUNNING)
+	if err != nil {
+		log.Log.Reason(err).Warning("failed to get the balloon statistics of the domain")
+		return
+	}
+	if len(domStats) == 0 || domStats[0].Memory == nil {
+		return
+	}
+	domStat := domStats[0]
+
+	if domStat.Memory.ActualBalloonSet {
+		l.metadataCache.Balloon.WithSafeBlock(func(balloonMetadata *api.BalloonMetadata, _ bool) {
+			balloonMetadata.Actual = domStat.Memory.ActualBalloon
+			if balloonMetadata.Target == 0 {
+				balloonMetadata.Target = domStat.Memory.ActualBalloon
+			}
+		})
+	}
+
+	target, update := balloon.CalculateTarget(current.policy, current.guestKiB, domStat.Memory)
+	if !update {
+		return
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	dom, err := l.virConn.LookupDomainByName(domStat.Name)
+	if err != nil {
+		log.Log.Reason(err).Warning(failedGetDomain)
+		return
+	}
+	defer dom.Free()
+
+	if err := dom.SetMemoryFlags(target, libvirt.DOMAIN_MEM_LIVE); err != nil {
+		log.Log.Reason(err).Warningf("failed to set the balloon target of the domain to %d KiB", target)
+		return
+	}
+	log.Log.V(3).Infof("balloon target of the domain changed from %d KiB to %d KiB", domStat.Memory.ActualBalloon, target)
+
+	l.metadataCache.Balloon.WithSafeBlock(func(balloonMetadata *api.BalloonMetadata, _ bool) {
+		balloonMetadata.Target = target
+	})
+}
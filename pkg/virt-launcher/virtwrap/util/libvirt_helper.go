@@ -389,6 +389,41 @@ func StartVirtlog(stopChan chan struct{}, domainName string, nonRoot bool) {
 	go startQEMUSeaBiosLogging(stopChan)
 }
 
+// StartVirtsecretd spawns virtsecretd, which holds the libvirt secrets used to encrypt
+// the persistent TPM state, and restarts it if it exits.
+func StartVirtsecretd(stopChan chan struct{}) {
+	go func() {
+		for {
+			cmd := exec.Command("/usr/sbin/virtsecretd")
+
+			exitChan := make(chan struct{})
+
+			err := cmd.Start()
+			if err != nil {
+				log.Log.Reason(err).Error("failed to start virtsecretd")
+				panic(err)
+			}
+
+			go func() {
+				defer close(exitChan)
+				_ = cmd.Wait()
+			}()
+
+			select {
+			case <-stopChan:
+				_ = cmd.Process.Kill()
+				return
+			case <-exitChan:
+				log.Log.Errorf("virtsecretd exited, restarting")
+			}
+
+			// this sleep is to avoid consumming all resources in the
+			// event of a virtsecretd crash loop.
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
 // returns the namespace and name that is encoded in the
 // domain name.
 func SplitVMINamespaceKey(domainName string) (namespace, name string) {
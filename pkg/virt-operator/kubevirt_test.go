@@ -86,8 +86,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 75
-	patchCount    = 50
+	resourceCount = 78
+	patchCount    = 53
 	updateCount   = 26
 )
 
@@ -1217,6 +1217,9 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineInstanceCrd, components.NewPresetCrd, components.NewReplicaSetCrd,
 		components.NewVirtualMachineCrd, components.NewVirtualMachineInstanceMigrationCrd,
 		components.NewVirtualMachineSnapshotCrd, components.NewVirtualMachineSnapshotContentCrd,
+		components.NewVirtualMachineSnapshotScheduleCrd,
+		components.NewVirtualMachineSnapshotGroupCrd,
+		components.NewVirtualMachineBackupCrd,
 		components.NewVirtualMachineExportCrd,
 		components.NewVirtualMachineRestoreCrd, components.NewVirtualMachineInstancetypeCrd,
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
@@ -2430,7 +2433,7 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.controller.stores.ClusterRoleBindingCache.List()).To(HaveLen(6))
 			Expect(kvTestData.controller.stores.RoleCache.List()).To(HaveLen(5))
 			Expect(kvTestData.controller.stores.RoleBindingCache.List()).To(HaveLen(5))
-			Expect(kvTestData.controller.stores.CrdCache.List()).To(HaveLen(16))
+			Expect(kvTestData.controller.stores.CrdCache.List()).To(HaveLen(19))
 			Expect(kvTestData.controller.stores.ServiceCache.List()).To(HaveLen(4))
 			Expect(kvTestData.controller.stores.DeploymentCache.List()).To(HaveLen(1))
 			Expect(kvTestData.controller.stores.DaemonSetCache.List()).To(BeEmpty())
@@ -64,6 +64,9 @@ var (
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTGROUP      = "virtualmachinesnapshotgroups." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUP             = "virtualmachinebackups." + snapshotv1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clonev1alpha1.VirtualMachineCloneKind.Group
@@ -567,6 +570,129 @@ func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "LastSchedule", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "LastSuccess", Type: "date", JSONPath: ".status.lastSuccessTime"},
+		{Name: "Error", Type: "string", JSONPath: ".status.lastFailure.message"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotGroupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTGROUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotgroups",
+			Singular:   "virtualmachinesnapshotgroup",
+			Kind:       "VirtualMachineSnapshotGroup",
+			ShortNames: []string{"vmsnapshotgroup", "vmsnapshotgroups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: ".status.error.message"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineBackupCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUP
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackups",
+			Singular:   "virtualmachinebackup",
+			Kind:       "VirtualMachineBackup",
+			ShortNames: []string{"vmbackup", "vmbackups"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "SourceKind", Type: "string", JSONPath: ".spec.source.kind"},
+		{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+		{Name: "Mode", Type: "string", JSONPath: ".spec.mode"},
+		{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		{Name: "Checkpoint", Type: "string", JSONPath: ".status.checkpointName"},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "Error", Type: "string", JSONPath: ".status.error.message"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineExportCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -1052,6 +1052,36 @@ var CRDsValidation map[string]string = map[string]string{
                         are ANDed.
                       type: object
                   type: object
+                tuning:
+                  description: Tuning enables virt-handler to adapt the KSM scanning
+                    rate to the memory pressure of the node. Without it KSM runs with
+                    the kernel defaults.
+                  properties:
+                    freeMemoryThresholdPercent:
+                      description: FreeMemoryThresholdPercent is the percentage of
+                        available memory below which the node is considered to be
+                        under memory pressure. Defaults to 20.
+                      format: int32
+                      type: integer
+                    pagesToScanMax:
+                      description: PagesToScanMax is the maximum number of pages scanned
+                        per period while the node is under memory pressure. Defaults
+                        to 1250.
+                      format: int32
+                      type: integer
+                    pagesToScanMin:
+                      description: PagesToScanMin is the number of pages scanned per
+                        period while the node is not under memory pressure. Defaults
+                        to 64.
+                      format: int32
+                      type: integer
+                    sleepMillisecs:
+                      description: SleepMillisecs is the pause between two scan periods
+                        on a node with 16Gi of memory. It is scaled down for nodes
+                        with more memory. Defaults to 10.
+                      format: int32
+                      type: integer
+                  type: object
               type: object
             liveUpdateConfiguration:
               description: LiveUpdateConfiguration holds defaults for live update
@@ -1062,6 +1092,14 @@ var CRDsValidation map[string]string = map[string]string{
                     can be hotplugged
                   format: int32
                   type: integer
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest defines the maximum amount memory that can
+                    be allocated to the guest using hotplug.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
               type: object
             machineType:
               type: string
@@ -1133,6 +1171,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy when CompletionTimeoutPerGiB triggers. Defaults to
                     false
                   type: boolean
+                allowWorkloadDisruption:
+                  description: AllowWorkloadDisruption allows the platform to pause
+                    a VMI whose live migration did not complete within CompletionTimeoutPerGiB,
+                    so that the migration can converge. If AllowPostCopy is also true,
+                    post-copy takes precedence. Defaults to false
+                  type: boolean
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -1150,6 +1194,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the live
+                    migration stream. zstd compression always uses parallel migration
+                    connections. Defaults to no compression
+                  type: string
+                crossClusterPeerCABundle:
+                  description: CrossClusterPeerCABundle holds the PEM encoded CA certificates
+                    of the virt-handlers of the clusters VMIs are migrated from or
+                    to. The tunnels of cross cluster migrations trust them in addition
+                    to the KubeVirt CA.
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -1173,6 +1228,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationConnections:
+                  description: ParallelMigrationConnections is the number of parallel
+                    connections (multifd channels) used to transfer the state of a
+                    single VMI during a live migration. Defaults to a single connection
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -1203,8 +1264,65 @@ var CRDsValidation map[string]string = map[string]string{
             network:
               description: NetworkConfiguration holds network options
               properties:
+                binding:
+                  additionalProperties:
+                    description: InterfaceBindingPlugin describes how a network binding
+                      plugin connects an interface to the guest.
+                    properties:
+                      domainAttachmentType:
+                        description: 'DomainAttachmentType is a standard domain network
+                          attachment method KubeVirt supports. Supported values: "tap".
+                          The standard domain attachment can be used instead or in
+                          addition to the sidecarImage.'
+                        type: string
+                      networkAttachmentDefinition:
+                        description: 'NetworkAttachmentDefinition references a NetworkAttachmentDefinition
+                          which is attached to the virt-launcher pod to perform the
+                          pod-level network setup of the plugin. Format: <name> or
+                          <namespace>/<name>. If namespace is not specified, the VMI
+                          namespace is assumed.'
+                        type: string
+                      sidecarImage:
+                        description: SidecarImage references a container image that
+                          runs in the virt-launcher pod. The sidecar is called over
+                          the hooks OnDefineDomain API with the interface and the
+                          domain spec and may run additional services.
+                        type: string
+                    type: object
+                  description: Binding registers the network binding plugins, by name,
+                    which interfaces can refer to.
+                  type: object
                 defaultNetworkInterface:
                   type: string
+                macAddressPool:
+                  description: MacAddressPool configures the MAC address ranges which
+                    unique MAC addresses are allocated from to the interfaces of VirtualMachines
+                    that do not specify one, before they are started. When set, duplicate
+                    MAC addresses among VirtualMachines are rejected.
+                  properties:
+                    ranges:
+                      description: Ranges lists the MAC address ranges of the pool,
+                        allocated in order.
+                      items:
+                        description: MacAddressRange is an inclusive range of MAC
+                          addresses.
+                        properties:
+                          end:
+                            description: End is the last MAC address of the range,
+                              e.g. 02:00:00:ff:ff:ff.
+                            type: string
+                          start:
+                            description: Start is the first MAC address of the range,
+                              e.g. 02:00:00:00:00:00.
+                            type: string
+                        required:
+                        - start
+                        - end
+                        type: object
+                      type: array
+                  required:
+                  - ranges
+                  type: object
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
@@ -1409,10 +1527,15 @@ var CRDsValidation map[string]string = map[string]string{
                     in which free page reporting is always disabled.
                   type: object
               type: object
+            vmStateStorageAccessMode:
+              description: VMStateStorageAccessMode is the access mode of the PVCs
+                created to preserve VM state. With ReadWriteOnce, the state is copied
+                to a new PVC when the VM is live migrated. Defaults to ReadWriteMany
+              type: string
             vmStateStorageClass:
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM. The storage
-                class must support RWX in filesystem mode.
+                class must support the VMStateStorageAccessMode in filesystem mode.
               type: string
             webhookConfiguration:
               description: ReloadableComponentConfiguration holds all generic k8s
@@ -3491,6 +3614,8 @@ var CRDsValidation map[string]string = map[string]string{
           type: boolean
         allowPostCopy:
           type: boolean
+        allowWorkloadDisruption:
+          type: boolean
         bandwidthPerMigration:
           anyOf:
           - type: integer
@@ -3500,6 +3625,22 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression is the method used to compress the live
+            migration stream
+          type: string
+        parallelMigrationConnections:
+          format: int32
+          type: integer
+        priority:
+          description: Priority decides which policy applies when more than one policy
+            matches a VMI. The matching policy with the highest priority is chosen,
+            regardless of how many labels the other policies match. Defaults to 0
+          format: int32
+          type: integer
+        progressTimeout:
+          format: int64
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -3516,6 +3657,24 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       nullable: true
+      properties:
+        virtualMachineInstances:
+          description: VirtualMachineInstances lists the VMIs that are currently governed
+            by this policy, i.e. the VMIs for which this policy is the matching policy
+          items:
+            description: GovernedVirtualMachineInstance references a VMI that is governed
+              by a migration policy
+            properties:
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
   required:
   - spec
@@ -4263,6 +4422,21 @@ var CRDsValidation map[string]string = map[string]string{
                   format: int32
                   type: integer
               type: object
+            memory:
+              description: LiveUpdateMemory holds hotplug configuration for the memory
+                resource. Empty struct indicates that default will be used for maxGuest.
+                Default is specified on cluster level. Absence of the struct means
+                opt-out from memory hotplug functionality.
+              properties:
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest defines the maximum amount memory that can
+                    be allocated to the guest using hotplug.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
           type: object
         preference:
           description: PreferenceMatcher references a set of preference that is used
@@ -5626,6 +5800,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   should be used. Supported values are: native, default,
                                   threads.'
                                 type: string
+                              ioTune:
+                                description: IOTune specifies I/O throttling limits
+                                  for the disk. With the VMLiveUpdateFeatures feature
+                                  gate enabled the limits can be changed on a running
+                                  VMI.
+                                properties:
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIopsSec:
+                                    description: ReadIopsSec is the read I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIopsSec:
+                                    description: TotalIopsSec is the total I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIopsSec:
+                                    description: WriteIopsSec is the write I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -5775,6 +5986,73 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: Bandwidth specifies the inbound and outbound
+                                  traffic limits of the interface. With the VMLiveUpdateFeatures
+                                  feature gate enabled the limits can be changed on
+                                  a running VMI.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          in kilobytes per second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kilobytes
+                                          that can be sent in a single burst at peak
+                                          speed.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate at which
+                                          the interface can send data in kilobytes
+                                          per second.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          in kilobytes per second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kilobytes
+                                          that can be sent in a single burst at peak
+                                          speed.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate at which
+                                          the interface can send data in kilobytes
+                                          per second.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies the binding plugin
+                                  that will be used to connect the interface to the
+                                  guest. It provides an alternative to InterfaceBindingMethod.
+                                properties:
+                                  name:
+                                    description: Name references to the binding name
+                                      as defined in the KubeVirt CR network configuration.
+                                    type: string
+                                required:
+                                - name
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used
                                   to determine ordering of boot devices. Lower values
@@ -5794,6 +6072,46 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  ipv6:
+                                    description: IPv6 configures the DHCPv6 server
+                                      and the router advertisements of the interface.
+                                    properties:
+                                      dnsServers:
+                                        description: If specified will pass the configured
+                                          IPv6 DNS servers to the VM via DHCPv6 option
+                                          23.
+                                        items:
+                                          type: string
+                                        type: array
+                                      routerAdvertisements:
+                                        description: If set to true, router advertisements
+                                          are sent to the VM, providing its default
+                                          route. Supported only on masquerade interfaces.
+                                          Requires the Root feature gate, since a
+                                          non-root virt-launcher lacks the NET_RAW
+                                          capability.
+                                        type: boolean
+                                      searchDomains:
+                                        description: If specified will pass the configured
+                                          domain search list to the VM via DHCPv6
+                                          option 24. Defaults to the search domains
+                                          of the pod.
+                                        items:
+                                          type: string
+                                        type: array
+                                    type: object
+                                  ipxeBootFileName:
+                                    description: If specified will pass option 67
+                                      with this value instead of BootFileName to clients
+                                      identifying themselves as iPXE by the user class
+                                      option 77. Allows chainloading an iPXE script
+                                      after booting iPXE over the network.
+                                    type: string
+                                  leaseTime:
+                                    description: If specified will be used as the
+                                      lease time of the DHCP and DHCPv6 servers. Defaults
+                                      to an infinite lease.
+                                    type: string
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -5820,11 +6138,100 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routes:
+                                    description: If specified will pass the given
+                                      static routes to the VM via DHCP option 121,
+                                      in addition to the routes of the pod network.
+                                    items:
+                                      description: DHCPRoute defines a static route
+                                        passed to the VM via DHCP option 121.
+                                      properties:
+                                        destination:
+                                          description: Destination is the IPv4 CIDR
+                                            of the route, e.g. 10.10.0.0/16.
+                                          type: string
+                                        gateway:
+                                          description: Gateway is the IPv4 address
+                                            of the next hop of the route.
+                                          type: string
+                                      required:
+                                      - destination
+                                      - gateway
+                                      type: object
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              filter:
+                                description: Filter specifies the traffic allowed
+                                  to and from the guest on the interface. It is only
+                                  supported with the bridge binding method.
+                                properties:
+                                  antiSpoofing:
+                                    description: AntiSpoofing drops the traffic sent
+                                      by the guest with a source MAC or IPv4 address
+                                      other than the ones of the interface. Defaults
+                                      to true.
+                                    type: boolean
+                                  egress:
+                                    description: Egress lists the traffic the guest
+                                      is allowed to send. Any other traffic is dropped,
+                                      unless the list is empty.
+                                    items:
+                                      description: InterfaceFilterRule allows the
+                                        traffic matching all of its fields.
+                                      properties:
+                                        cidr:
+                                          description: 'CIDR of the remote peer. For
+                                            example: 10.0.0.0/8 or fd10::/64. Matches
+                                            any peer when empty.'
+                                          type: string
+                                        ports:
+                                          description: Destination ports of the traffic,
+                                            each 0 < x < 65536. Requires the TCP or
+                                            UDP protocol. Matches any port when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: Protocol of the traffic. Must
+                                            be TCP, UDP or ICMP. Matches any protocol
+                                            when empty.
+                                          type: string
+                                      type: object
+                                    type: array
+                                  ingress:
+                                    description: Ingress lists the traffic allowed
+                                      to reach the guest. Any other traffic is dropped,
+                                      unless the list is empty.
+                                    items:
+                                      description: InterfaceFilterRule allows the
+                                        traffic matching all of its fields.
+                                      properties:
+                                        cidr:
+                                          description: 'CIDR of the remote peer. For
+                                            example: 10.0.0.0/8 or fd10::/64. Matches
+                                            any peer when empty.'
+                                          type: string
+                                        ports:
+                                          description: Destination ports of the traffic,
+                                            each 0 < x < 65536. Requires the TCP or
+                                            UDP protocol. Matches any port when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: Protocol of the traffic. Must
+                                            be TCP, UDP or ICMP. Matches any protocol
+                                            when empty.
+                                          type: string
+                                      type: object
+                                    type: array
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -5895,9 +6302,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: object
                               state:
                                 description: State represents the requested operational
-                                  state of the interface. The (only) value supported
-                                  is 'absent', expressing a request to remove the
-                                  interface.
+                                  state of the interface. The supported values are
+                                  'absent', expressing a request to remove the interface,
+                                  'down', setting the link of the interface down without
+                                  removing it, and 'up', the default, setting the
+                                  link of the interface up.
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -5915,6 +6324,20 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        panicDevices:
+                          description: PanicDevices describe devices notifying the
+                            host when the guest kernel panics.
+                          items:
+                            description: PanicDevice notifies the host when the guest
+                              kernel panics.
+                            properties:
+                              model:
+                                description: 'Model specifies what type of panic device
+                                  is provided. One of: hyperv, isa, pvpanic. The model
+                                  used when it is missing depends on the guest architecture.'
+                                type: string
+                            type: object
+                          type: array
                         rng:
                           description: Whether to have random number generator from
                             host
@@ -5936,11 +6359,29 @@ var CRDsValidation map[string]string = map[string]string{
                         tpm:
                           description: Whether to emulate a TPM device.
                           properties:
+                            encryptionSecretRef:
+                              description: EncryptionSecretRef references a k8s secret
+                                whose "key" entry is used to encrypt the persistent
+                                TPM state.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
                             persistent:
                               description: Persistent indicates the state of the TPM
                                 device should be kept accross reboots Defaults to
                                 false
                               type: boolean
+                            stateRetentionPolicy:
+                              description: StateRetentionPolicy defines what happens
+                                to the persistent TPM state when the VM is deleted.
+                                Delete removes the state together with the VM, Retain
+                                keeps it for a later VM of the same name. Defaults
+                                to Delete
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: Fall back to legacy virtio 0.9 support if virtio
@@ -5952,14 +6393,38 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
                           properties:
+                            diag288:
+                              description: diag288 watchdog device, available on s390x.
+                              properties:
+                                action:
+                                  description: The action to take. Valid values are
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
+                                  type: string
+                              type: object
                             i6300esb:
                               description: i6300esb watchdog device.
                               properties:
                                 action:
                                   description: The action to take. Valid values are
-                                    poweroff, reset, shutdown. Defaults to reset.
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
+                                  type: string
+                              type: object
+                            itco:
+                              description: iTCO watchdog device built into the q35
+                                chipset.
+                              properties:
+                                action:
+                                  description: The action to take. Valid values are
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
                                   type: string
                               type: object
+                            memoryDumpClaimName:
+                              description: MemoryDumpClaimName is the name of the
+                                PVC the guest memory is dumped to by the dump action,
+                                before the guest is reset. Required by the dump action.
+                                The memory is only dumped for VMIs owned by a VirtualMachine,
+                                other VMIs are reset right away.
+                              type: string
                             name:
                               description: Name of the watchdog.
                               type: string
@@ -6208,12 +6673,32 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist
+                                    the EFI NVRAM across reboots and live migrations.
+                                    Defaults to false
+                                  type: boolean
                                 secureBoot:
                                   description: If set, SecureBoot will be enabled
                                     and the OVMF roms will be swapped for SecureBoot-enabled
                                     ones. Requires SMM to be enabled. Defaults to
                                     true
                                   type: boolean
+                                secureBootKeysSecretRef:
+                                  description: SecureBootKeysSecretRef references
+                                    a k8s secret whose "PK", "KEK" and "db" entries
+                                    hold PEM encoded X.509 certificates to enroll
+                                    in place of the default Secure Boot keys. The
+                                    keys are enrolled when the NVRAM is initialized.
+                                    Requires SecureBoot to be enabled.
+                                  properties:
+                                    name:
+                                      description: 'Name of the referent. More info:
+                                        https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        TODO: Add other useful fields. apiVersion,
+                                        kind, uid?'
+                                      type: string
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -6296,6 +6781,27 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Base64 encoded session blob.
                               type: string
                           type: object
+                        snp:
+                          description: AMD Secure Encrypted Virtualization with Secure
+                            Nested Paging (SEV-SNP).
+                          properties:
+                            policy:
+                              description: 'Guest policy flags as defined in AMD SEV-SNP
+                                firmware ABI specification. Note: due to security
+                                reasons it is not allowed to enable guest debugging.
+                                Therefore the debug flag is not exposed to users and
+                                is always false.'
+                              properties:
+                                smt:
+                                  description: Allow the guest to run on hosts with
+                                    simultaneous multithreading (SMT) enabled. Defaults
+                                    to true.
+                                  type: boolean
+                              type: object
+                          type: object
+                        tdx:
+                          description: Intel Trust Domain Extensions (TDX).
+                          type: object
                       type: object
                     machine:
                       description: Machine type.
@@ -6308,6 +6814,28 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: Balloon enables a free memory policy which
+                            inflates the memory balloon of idle guests and deflates
+                            it when the guest is under memory pressure. It requires
+                            the memory balloon device and its statistics to be enabled.
+                          properties:
+                            freeMemoryPercent:
+                              description: FreeMemoryPercent is the percentage of
+                                its memory the policy keeps free inside the guest.
+                                Defaults to 20.
+                              format: int32
+                              type: integer
+                            minGuest:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: MinGuest is the lowest amount of memory
+                                the balloon can leave to the guest. Defaults to half
+                                of the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          type: object
                         guest:
                           anyOf:
                           - type: integer
@@ -6328,6 +6856,32 @@ var CRDsValidation map[string]string = map[string]string{
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
                           type: object
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: MaxGuest allows to specify the maximum amount
+                            of memory which is visible inside the Guest OS. The delta
+                            between MaxGuest and Guest is the amount of memory that
+                            can be hotplugged.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
+                    onCrash:
+                      description: OnCrash defines what happens when the guest kernel
+                        panics. Requires a panic device.
+                      properties:
+                        action:
+                          description: 'Action taken when the guest kernel panics.
+                            One of: Restart, Pause, MemoryDump. MemoryDump leaves
+                            the guest paused once its memory is dumped. Defaults to
+                            Pause.'
+                          type: string
+                        memoryDumpClaimName:
+                          description: MemoryDumpClaimName is the name of the PVC
+                            the guest memory is dumped to by the MemoryDump action.
+                            The memory is only dumped for VMIs owned by a VirtualMachine.
+                          type: string
                       type: object
                     resources:
                       description: Resources describes the Compute Resources required
@@ -6350,6 +6904,11 @@ var CRDsValidation map[string]string = map[string]string{
                             the container's memory limit. This can lead to crashes
                             if all memory is in use on a node. Defaults to false.
                           type: boolean
+                        overcommitWithKSM:
+                          description: OvercommitWithKSM schedules the VMI on a KSM-enabled
+                            node and lowers its memory request by the memory overcommit
+                            these nodes report. Defaults to false.
+                          type: boolean
                         requests:
                           additionalProperties:
                             anyOf:
@@ -7593,6 +8152,42 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: IOTune specifies I/O throttling limits for the
+                          disk. With the VMLiveUpdateFeatures feature gate enabled
+                          the limits can be changed on a running VMI.
+                        properties:
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIopsSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIopsSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIopsSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -7738,8 +8333,12 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineclone": `openAPIV3Schema:
-  description: VirtualMachineClone is a CRD that clones one VM into another.
+	"virtualmachinebackup": `openAPIV3Schema:
+  description: VirtualMachineBackup defines the operation of backing up the disks
+    of a running VirtualMachine. Every backup records a checkpoint, a later backup
+    can be taken incrementally from it so that only the blocks changed since are copied.
+    Checkpoints do not survive a restart or a migration of the VM, and changed blocks
+    are only tracked for qcow2 disks; raw disks are always backed up in full.
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation
@@ -7754,32 +8353,21 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
+      description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup
+        resource
       properties:
-        annotationFilters:
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        labelFilters:
-          items:
-            type: string
-          type: array
-          x-kubernetes-list-type: atomic
-        newMacAddresses:
-          additionalProperties:
-            type: string
-          description: NewMacAddresses manually sets that target interfaces' mac addresses.
-            The key is the interface name and the value is the new mac address. If
-            this field is not specified, a new MAC address will be generated automatically,
-            as for any interface that is not included in this map.
-          type: object
-        newSMBiosSerial:
-          description: NewSMBiosSerial manually sets that target's SMbios serial.
-            If this field is not specified, a new serial will be generated automatically.
+        incrementalFrom:
+          description: IncrementalFrom is the name of the checkpoint of a previous
+            backup of the same VirtualMachine. Only the blocks changed since that
+            checkpoint are backed up. A full backup is taken when it is not set.
+          type: string
+        mode:
+          description: Mode is either Push, where the backup is written to the Target
+            volume, or Pull, where the disks are exposed through a VirtualMachineExport
+            until the VirtualMachineBackup is deleted. Defaults to Pull.
           type: string
         source:
-          description: TypedLocalObjectReference contains enough information to let
-            you locate the typed referenced object inside the same namespace.
+          description: Source is the VirtualMachine to back up
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced.
@@ -7797,32 +8385,191 @@ var CRDsValidation map[string]string = map[string]string{
           - name
           type: object
         target:
-          description: If the target is not provided, a random name would be generated
-            for the target. The target's name can be viewed by inspecting status "TargetName"
-            field below.
-          properties:
-            apiGroup:
-              description: APIGroup is the group for the resource being referenced.
-                If APIGroup is not specified, the specified Kind must be in the core
-                API group. For any other third-party types, APIGroup is required.
-              type: string
-            kind:
-              description: Kind is the type of resource being referenced
-              type: string
-            name:
-              description: Name is the name of resource being referenced
-              type: string
-          required:
-          - kind
-          - name
-          type: object
+          description: Target is the name of a volume of the VirtualMachine, backed
+            by a filesystem PersistentVolumeClaim and not attached as a disk, the
+            backup is written to in push mode
+          type: string
       required:
       - source
       type: object
     status:
+      description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup
+        resource
       properties:
-        conditions:
-          items:
+        checkpointName:
+          description: CheckpointName is the name of the checkpoint recorded by the
+            backup, it can be used as IncrementalFrom of a later backup
+          type: string
+        completionTime:
+          format: date-time
+          nullable: true
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        readyToUse:
+          description: ReadyToUse indicates that the backup can be read, either from
+            the target volume in push mode or through a VirtualMachineExport in pull
+            mode
+          type: boolean
+        startTime:
+          format: date-time
+          nullable: true
+          type: string
+        volumes:
+          description: Volumes lists the backed up volumes
+          items:
+            description: VirtualMachineInstanceBackupVolume describes a volume included
+              in a backup job
+            properties:
+              dirtyBitmap:
+                description: DirtyBitmap is the name of the bitmap exposing the changed
+                  blocks in pull mode. It is only set for incremental backups.
+                type: string
+              diskTarget:
+                description: DiskTarget is the target device of the disk in the domain
+                type: string
+              exportName:
+                description: ExportName is the NBD export name of the disk in pull
+                  mode
+                type: string
+              path:
+                description: Path is the file the disk is written to in push mode,
+                  relative to the target volume
+                type: string
+              volumeName:
+                description: VolumeName is the name of the volume
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone is a CRD that clones one VM into another.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        annotationFilters:
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: NewMacAddresses manually sets that target interfaces' mac addresses.
+            The key is the interface name and the value is the new mac address. If
+            this field is not specified, a new MAC address will be generated automatically,
+            as for any interface that is not included in this map.
+          type: object
+        newSMBiosSerial:
+          description: NewSMBiosSerial manually sets that target's SMbios serial.
+            If this field is not specified, a new serial will be generated automatically.
+          type: string
+        source:
+          description: TypedLocalObjectReference contains enough information to let
+            you locate the typed referenced object inside the same namespace.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core
+                API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        target:
+          description: If the target is not provided, a random name would be generated
+            for the target. The target's name can be viewed by inspecting status "TargetName"
+            field below.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced.
+                If APIGroup is not specified, the specified Kind must be in the core
+                API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+      required:
+      - source
+      type: object
+    status:
+      properties:
+        conditions:
+          items:
             description: Condition defines conditions
             properties:
               lastProbeTime:
@@ -8023,10 +8770,47 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Base64 encoded session blob.
                   type: string
               type: object
+            snp:
+              description: AMD Secure Encrypted Virtualization with Secure Nested
+                Paging (SEV-SNP).
+              properties:
+                policy:
+                  description: 'Guest policy flags as defined in AMD SEV-SNP firmware
+                    ABI specification. Note: due to security reasons it is not allowed
+                    to enable guest debugging. Therefore the debug flag is not exposed
+                    to users and is always false.'
+                  properties:
+                    smt:
+                      description: Allow the guest to run on hosts with simultaneous
+                        multithreading (SMT) enabled. Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+            tdx:
+              description: Intel Trust Domain Extensions (TDX).
+              type: object
           type: object
         memory:
           description: Required Memory related attributes of the instancetype.
           properties:
+            balloon:
+              description: Optionally enables the free memory policy of the memory
+                balloon for the VirtualMachineInstance.
+              properties:
+                freeMemoryPercent:
+                  description: FreeMemoryPercent is the percentage of its memory the
+                    policy keeps free inside the guest. Defaults to 20.
+                  format: int32
+                  type: integer
+                minGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MinGuest is the lowest amount of memory the balloon
+                    can leave to the guest. Defaults to half of the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
             guest:
               anyOf:
               - type: integer
@@ -8322,10 +9106,25 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredTPM optionally defines the preferred TPM device
                 to be used.
               properties:
+                encryptionSecretRef:
+                  description: EncryptionSecretRef references a k8s secret whose "key"
+                    entry is used to encrypt the persistent TPM state.
+                  properties:
+                    name:
+                      description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        TODO: Add other useful fields. apiVersion, kind, uid?'
+                      type: string
+                  type: object
                 persistent:
                   description: Persistent indicates the state of the TPM device should
                     be kept accross reboots Defaults to false
                   type: boolean
+                stateRetentionPolicy:
+                  description: StateRetentionPolicy defines what happens to the persistent
+                    TPM state when the VM is deleted. Delete removes the state together
+                    with the VM, Retain keeps it for a later VM of the same name.
+                    Defaults to Delete
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -10125,6 +10924,42 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: IOTune specifies I/O throttling limits for the
+                          disk. With the VMLiveUpdateFeatures feature gate enabled
+                          the limits can be changed on a running VMI.
+                        properties:
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIopsSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIopsSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIopsSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -10268,6 +11103,68 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: Bandwidth specifies the inbound and outbound
+                          traffic limits of the interface. With the VMLiveUpdateFeatures
+                          feature gate enabled the limits can be changed on a running
+                          VMI.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate in kilobytes
+                                  per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kilobytes that
+                                  can be sent in a single burst at peak speed.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate at which the
+                                  interface can send data in kilobytes per second.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate in kilobytes
+                                  per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kilobytes that
+                                  can be sent in a single burst at peak speed.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate at which the
+                                  interface can send data in kilobytes per second.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies the binding plugin that will
+                          be used to connect the interface to the guest. It provides
+                          an alternative to InterfaceBindingMethod.
+                        properties:
+                          name:
+                            description: Name references to the binding name as defined
+                              in the KubeVirt CR network configuration.
+                            type: string
+                        required:
+                        - name
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine
                           ordering of boot devices. Lower values take precedence.
@@ -10286,6 +11183,43 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          ipv6:
+                            description: IPv6 configures the DHCPv6 server and the
+                              router advertisements of the interface.
+                            properties:
+                              dnsServers:
+                                description: If specified will pass the configured
+                                  IPv6 DNS servers to the VM via DHCPv6 option 23.
+                                items:
+                                  type: string
+                                type: array
+                              routerAdvertisements:
+                                description: If set to true, router advertisements
+                                  are sent to the VM, providing its default route.
+                                  Supported only on masquerade interfaces. Requires
+                                  the Root feature gate, since a non-root virt-launcher
+                                  lacks the NET_RAW capability.
+                                type: boolean
+                              searchDomains:
+                                description: If specified will pass the configured
+                                  domain search list to the VM via DHCPv6 option 24.
+                                  Defaults to the search domains of the pod.
+                                items:
+                                  type: string
+                                type: array
+                            type: object
+                          ipxeBootFileName:
+                            description: If specified will pass option 67 with this
+                              value instead of BootFileName to clients identifying
+                              themselves as iPXE by the user class option 77. Allows
+                              chainloading an iPXE script after booting iPXE over
+                              the network.
+                            type: string
+                          leaseTime:
+                            description: If specified will be used as the lease time
+                              of the DHCP and DHCPv6 servers. Defaults to an infinite
+                              lease.
+                            type: string
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -10312,11 +11246,97 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will pass the given static routes
+                              to the VM via DHCP option 121, in addition to the routes
+                              of the pod network.
+                            items:
+                              description: DHCPRoute defines a static route passed
+                                to the VM via DHCP option 121.
+                              properties:
+                                destination:
+                                  description: Destination is the IPv4 CIDR of the
+                                    route, e.g. 10.10.0.0/16.
+                                  type: string
+                                gateway:
+                                  description: Gateway is the IPv4 address of the
+                                    next hop of the route.
+                                  type: string
+                              required:
+                              - destination
+                              - gateway
+                              type: object
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
                             type: string
                         type: object
+                      filter:
+                        description: Filter specifies the traffic allowed to and from
+                          the guest on the interface. It is only supported with the
+                          bridge binding method.
+                        properties:
+                          antiSpoofing:
+                            description: AntiSpoofing drops the traffic sent by the
+                              guest with a source MAC or IPv4 address other than the
+                              ones of the interface. Defaults to true.
+                            type: boolean
+                          egress:
+                            description: Egress lists the traffic the guest is allowed
+                              to send. Any other traffic is dropped, unless the list
+                              is empty.
+                            items:
+                              description: InterfaceFilterRule allows the traffic
+                                matching all of its fields.
+                              properties:
+                                cidr:
+                                  description: 'CIDR of the remote peer. For example:
+                                    10.0.0.0/8 or fd10::/64. Matches any peer when
+                                    empty.'
+                                  type: string
+                                ports:
+                                  description: Destination ports of the traffic, each
+                                    0 < x < 65536. Requires the TCP or UDP protocol.
+                                    Matches any port when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: Protocol of the traffic. Must be TCP,
+                                    UDP or ICMP. Matches any protocol when empty.
+                                  type: string
+                              type: object
+                            type: array
+                          ingress:
+                            description: Ingress lists the traffic allowed to reach
+                              the guest. Any other traffic is dropped, unless the
+                              list is empty.
+                            items:
+                              description: InterfaceFilterRule allows the traffic
+                                matching all of its fields.
+                              properties:
+                                cidr:
+                                  description: 'CIDR of the remote peer. For example:
+                                    10.0.0.0/8 or fd10::/64. Matches any peer when
+                                    empty.'
+                                  type: string
+                                ports:
+                                  description: Destination ports of the traffic, each
+                                    0 < x < 65536. Requires the TCP or UDP protocol.
+                                    Matches any port when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: Protocol of the traffic. Must be TCP,
+                                    UDP or ICMP. Matches any protocol when empty.
+                                  type: string
+                              type: object
+                            type: array
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -10384,8 +11404,10 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                       state:
                         description: State represents the requested operational state
-                          of the interface. The (only) value supported is 'absent',
-                          expressing a request to remove the interface.
+                          of the interface. The supported values are 'absent', expressing
+                          a request to remove the interface, 'down', setting the link
+                          of the interface down without removing it, and 'up', the
+                          default, setting the link of the interface up.
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -10402,6 +11424,20 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                panicDevices:
+                  description: PanicDevices describe devices notifying the host when
+                    the guest kernel panics.
+                  items:
+                    description: PanicDevice notifies the host when the guest kernel
+                      panics.
+                    properties:
+                      model:
+                        description: 'Model specifies what type of panic device is
+                          provided. One of: hyperv, isa, pvpanic. The model used when
+                          it is missing depends on the guest architecture.'
+                        type: string
+                    type: object
+                  type: array
                 rng:
                   description: Whether to have random number generator from host
                   type: object
@@ -10422,10 +11458,25 @@ var CRDsValidation map[string]string = map[string]string{
                 tpm:
                   description: Whether to emulate a TPM device.
                   properties:
+                    encryptionSecretRef:
+                      description: EncryptionSecretRef references a k8s secret whose
+                        "key" entry is used to encrypt the persistent TPM state.
+                      properties:
+                        name:
+                          description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            TODO: Add other useful fields. apiVersion, kind, uid?'
+                          type: string
+                      type: object
                     persistent:
                       description: Persistent indicates the state of the TPM device
                         should be kept accross reboots Defaults to false
                       type: boolean
+                    stateRetentionPolicy:
+                      description: StateRetentionPolicy defines what happens to the
+                        persistent TPM state when the VM is deleted. Delete removes
+                        the state together with the VM, Retain keeps it for a later
+                        VM of the same name. Defaults to Delete
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: Fall back to legacy virtio 0.9 support if virtio bus
@@ -10437,16 +11488,39 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
                   properties:
-                    i6300esb:
-                      description: i6300esb watchdog device.
+                    diag288:
+                      description: diag288 watchdog device, available on s390x.
                       properties:
                         action:
                           description: The action to take. Valid values are poweroff,
-                            reset, shutdown. Defaults to reset.
+                            reset, shutdown, dump. Defaults to reset.
                           type: string
                       type: object
-                    name:
-                      description: Name of the watchdog.
+                    i6300esb:
+                      description: i6300esb watchdog device.
+                      properties:
+                        action:
+                          description: The action to take. Valid values are poweroff,
+                            reset, shutdown, dump. Defaults to reset.
+                          type: string
+                      type: object
+                    itco:
+                      description: iTCO watchdog device built into the q35 chipset.
+                      properties:
+                        action:
+                          description: The action to take. Valid values are poweroff,
+                            reset, shutdown, dump. Defaults to reset.
+                          type: string
+                      type: object
+                    memoryDumpClaimName:
+                      description: MemoryDumpClaimName is the name of the PVC the
+                        guest memory is dumped to by the dump action, before the guest
+                        is reset. Required by the dump action. The memory is only
+                        dumped for VMIs owned by a VirtualMachine, other VMIs are
+                        reset right away.
+                      type: string
+                    name:
+                      description: Name of the watchdog.
                       type: string
                   required:
                   - name
@@ -10669,11 +11743,28 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the
+                            EFI NVRAM across reboots and live migrations. Defaults
+                            to false
+                          type: boolean
                         secureBoot:
                           description: If set, SecureBoot will be enabled and the
                             OVMF roms will be swapped for SecureBoot-enabled ones.
                             Requires SMM to be enabled. Defaults to true
                           type: boolean
+                        secureBootKeysSecretRef:
+                          description: SecureBootKeysSecretRef references a k8s secret
+                            whose "PK", "KEK" and "db" entries hold PEM encoded X.509
+                            certificates to enroll in place of the default Secure
+                            Boot keys. The keys are enrolled when the NVRAM is initialized.
+                            Requires SecureBoot to be enabled.
+                          properties:
+                            name:
+                              description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                TODO: Add other useful fields. apiVersion, kind, uid?'
+                              type: string
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -10750,6 +11841,25 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Base64 encoded session blob.
                       type: string
                   type: object
+                snp:
+                  description: AMD Secure Encrypted Virtualization with Secure Nested
+                    Paging (SEV-SNP).
+                  properties:
+                    policy:
+                      description: 'Guest policy flags as defined in AMD SEV-SNP firmware
+                        ABI specification. Note: due to security reasons it is not
+                        allowed to enable guest debugging. Therefore the debug flag
+                        is not exposed to users and is always false.'
+                      properties:
+                        smt:
+                          description: Allow the guest to run on hosts with simultaneous
+                            multithreading (SMT) enabled. Defaults to true.
+                          type: boolean
+                      type: object
+                  type: object
+                tdx:
+                  description: Intel Trust Domain Extensions (TDX).
+                  type: object
               type: object
             machine:
               description: Machine type.
@@ -10761,6 +11871,26 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                balloon:
+                  description: Balloon enables a free memory policy which inflates
+                    the memory balloon of idle guests and deflates it when the guest
+                    is under memory pressure. It requires the memory balloon device
+                    and its statistics to be enabled.
+                  properties:
+                    freeMemoryPercent:
+                      description: FreeMemoryPercent is the percentage of its memory
+                        the policy keeps free inside the guest. Defaults to 20.
+                      format: int32
+                      type: integer
+                    minGuest:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: MinGuest is the lowest amount of memory the balloon
+                        can leave to the guest. Defaults to half of the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  type: object
                 guest:
                   anyOf:
                   - type: integer
@@ -10780,6 +11910,30 @@ var CRDsValidation map[string]string = map[string]string{
                         architecture valid values are 1Gi and 2Mi.
                       type: string
                   type: object
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest allows to specify the maximum amount of memory
+                    which is visible inside the Guest OS. The delta between MaxGuest
+                    and Guest is the amount of memory that can be hotplugged.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
+            onCrash:
+              description: OnCrash defines what happens when the guest kernel panics.
+                Requires a panic device.
+              properties:
+                action:
+                  description: 'Action taken when the guest kernel panics. One of:
+                    Restart, Pause, MemoryDump. MemoryDump leaves the guest paused
+                    once its memory is dumped. Defaults to Pause.'
+                  type: string
+                memoryDumpClaimName:
+                  description: MemoryDumpClaimName is the name of the PVC the guest
+                    memory is dumped to by the MemoryDump action. The memory is only
+                    dumped for VMIs owned by a VirtualMachine.
+                  type: string
               type: object
             resources:
               description: Resources describes the Compute Resources required by this
@@ -10801,6 +11955,11 @@ var CRDsValidation map[string]string = map[string]string{
                     container's memory limit. This can lead to crashes if all memory
                     is in use on a node. Defaults to false.
                   type: boolean
+                overcommitWithKSM:
+                  description: OvercommitWithKSM schedules the VMI on a KSM-enabled
+                    node and lowers its memory request by the memory overcommit these
+                    nodes report. Defaults to false.
+                  type: boolean
                 requests:
                   additionalProperties:
                     anyOf:
@@ -11741,6 +12900,74 @@ var CRDsValidation map[string]string = map[string]string{
           description: ActivePods is a mapping of pod UID to node name. It is possible
             for multiple pods to be running for a single VMI during migration.
           type: object
+        backupStatus:
+          description: BackupStatus is the status of the last backup job started in
+            the VirtualMachineInstance
+          nullable: true
+          properties:
+            checkpoint:
+              description: Checkpoint is the name of the checkpoint created along
+                with the backup
+              type: string
+            completed:
+              description: Completed indicates that the backup job ended
+              type: boolean
+            endTimestamp:
+              description: EndTimestamp is the time the backup job ended
+              format: date-time
+              nullable: true
+              type: string
+            failed:
+              description: Failed indicates that the backup job failed
+              type: boolean
+            failureReason:
+              description: FailureReason is the reason the backup job failed
+              type: string
+            mode:
+              description: Mode is the way the disks are backed up
+              type: string
+            name:
+              description: Name identifies the backup job
+              type: string
+            startTimestamp:
+              description: StartTimestamp is the time the backup job started
+              format: date-time
+              nullable: true
+              type: string
+            volumes:
+              description: Volumes lists the volumes included in the backup
+              items:
+                description: VirtualMachineInstanceBackupVolume describes a volume
+                  included in a backup job
+                properties:
+                  dirtyBitmap:
+                    description: DirtyBitmap is the name of the bitmap exposing the
+                      changed blocks in pull mode. It is only set for incremental
+                      backups.
+                    type: string
+                  diskTarget:
+                    description: DiskTarget is the target device of the disk in the
+                      domain
+                    type: string
+                  exportName:
+                    description: ExportName is the NBD export name of the disk in
+                      pull mode
+                    type: string
+                  path:
+                    description: Path is the file the disk is written to in push mode,
+                      relative to the target volume
+                    type: string
+                  volumeName:
+                    description: VolumeName is the name of the volume
+                    type: string
+                required:
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - name
+          type: object
         conditions:
           description: Conditions are specific points in VirtualMachineInstance's
             pod runtime.
@@ -11830,6 +13057,24 @@ var CRDsValidation map[string]string = map[string]string{
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              filterDroppedPackets:
+                description: FilterDroppedPackets counts the packets dropped by the
+                  interface filter, refreshed at most once per minute
+                properties:
+                  egress:
+                    description: Egress is the number of dropped packets sent by the
+                      guest, including the spoofed ones
+                    format: int64
+                    type: integer
+                  ingress:
+                    description: Ingress is the number of dropped packets destined
+                      to the guest
+                    format: int64
+                    type: integer
+                required:
+                - ingress
+                - egress
+                type: object
               infoSource:
                 description: 'Specifies the origin of the interface data collected.
                   values: domain, guest-agent, multus-status.'
@@ -11846,6 +13091,11 @@ var CRDsValidation map[string]string = map[string]string{
                 items:
                   type: string
                 type: array
+              linkState:
+                description: LinkState is the link state of the interface in the domain,
+                  up or down. Reported when the link state is controlled by the interface
+                  state.
+                type: string
               mac:
                 description: Hardware address of a Virtual Machine interface
                 type: string
@@ -11872,6 +13122,42 @@ var CRDsValidation map[string]string = map[string]string{
               description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
               type: string
           type: object
+        memory:
+          description: Memory shows the current memory allocation of the VirtualMachineInstance.
+          properties:
+            balloonActual:
+              anyOf:
+              - type: integer
+              - type: string
+              description: BalloonActual is the amount of memory the memory balloon
+                currently leaves to the guest.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            balloonTarget:
+              anyOf:
+              - type: integer
+              - type: string
+              description: BalloonTarget is the amount of memory the memory balloon
+                policy wants to leave to the guest.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            guestAtBoot:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestAtBoot specifies the amount of memory the VirtualMachineInstance
+                booted with.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            guestCurrent:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestCurrent specifies the amount of memory currently available
+                to the guest.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+          type: object
         migrationMethod:
           description: 'Represents the method using which the vmi can be migrated:
             live migration or block migration'
@@ -11888,6 +13174,21 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            crossCluster:
+              description: Details of a migration from or to another cluster
+              properties:
+                connectURL:
+                  description: The host:port endpoint of the receiving cluster the
+                    libvirt streams are tunneled to
+                  type: string
+                migrationID:
+                  description: The ID which identifies the migration on both clusters
+                  type: string
+                volumeMigration:
+                  description: How persistent volumes are transferred to the receiving
+                    cluster
+                  type: string
+              type: object
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -11912,6 +13213,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy when CompletionTimeoutPerGiB triggers. Defaults to
                     false
                   type: boolean
+                allowWorkloadDisruption:
+                  description: AllowWorkloadDisruption allows the platform to pause
+                    a VMI whose live migration did not complete within CompletionTimeoutPerGiB,
+                    so that the migration can converge. If AllowPostCopy is also true,
+                    post-copy takes precedence. Defaults to false
+                  type: boolean
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -11929,6 +13236,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the live
+                    migration stream. zstd compression always uses parallel migration
+                    connections. Defaults to no compression
+                  type: string
+                crossClusterPeerCABundle:
+                  description: CrossClusterPeerCABundle holds the PEM encoded CA certificates
+                    of the virt-handlers of the clusters VMIs are migrated from or
+                    to. The tunnels of cross cluster migrations trust them in addition
+                    to the KubeVirt CA.
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -11952,6 +13270,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationConnections:
+                  description: ParallelMigrationConnections is the number of parallel
+                    connections (multifd channels) used to transfer the state of a
+                    single VMI during a live migration. Defaults to a single connection
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -11992,6 +13316,10 @@ var CRDsValidation map[string]string = map[string]string{
             sourceNode:
               description: The source node that the VMI originated on
               type: string
+            sourcePersistentStatePVCName:
+              description: The name of the PVC holding the persistent VM state, like
+                the TPM, used by the source pod
+              type: string
             startTimestamp:
               description: The time the migration action began
               format: date-time
@@ -12031,6 +13359,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: If the VMI requires dedicated CPUs, this field will hold
                 the numa topology on the target node
               type: string
+            targetPersistentStatePVCName:
+              description: The name of the PVC holding the persistent VM state, like
+                the TPM, used by the target pod. It differs from the source one when
+                the state storage is not shared between nodes.
+              type: string
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
@@ -12087,6 +13420,12 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         topologyHints:
           properties:
+            ksmMemoryOvercommit:
+              description: KSMMemoryOvercommit is the lowest memory overcommit percentage
+                published by the KSM-enabled nodes when the launcher pod memory request
+                was computed.
+              format: int32
+              type: integer
             tscFrequency:
               format: int64
               type: integer
@@ -12208,6 +13547,25 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        watchdogStatus:
+          description: WatchdogStatus reports the firing of the watchdog device of
+            the VirtualMachineInstance
+          nullable: true
+          properties:
+            action:
+              description: Action is the action taken the last time the watchdog fired
+              type: string
+            firedCount:
+              description: FiredCount is the number of times the watchdog fired since
+                the VirtualMachineInstance started
+              format: int64
+              type: integer
+            lastFiredTimestamp:
+              description: LastFiredTimestamp is the time the watchdog fired last
+              format: date-time
+              nullable: true
+              type: string
+          type: object
       type: object
   required:
   - spec
@@ -12231,6 +13589,42 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        receive:
+          description: Receive makes the VMI the target of a migration from another
+            cluster. The VMI has to carry the kubevirt.io/cross-cluster-migration-target
+            annotation, so that it waits for the migrated domain instead of booting.
+            It has to have the same name, namespace and spec as the source VMI.
+          properties:
+            migrationID:
+              description: MigrationID identifies the migration on both clusters and
+                has to match the one of the sending migration
+              type: string
+          required:
+          - migrationID
+          type: object
+        sendTo:
+          description: SendTo migrates the VMI to another cluster, which has to run
+            a migration with a matching Receive section. The VMI stops running in
+            this cluster once the migration succeeded. Both clusters have to trust
+            the CA of each others virt-handlers, see CrossClusterPeerCABundle in the
+            migration configuration.
+          properties:
+            connectURL:
+              description: ConnectURL is the host:port endpoint reported by the receiving
+                migration in status.migrationState.crossCluster.connectURL
+              type: string
+            migrationID:
+              description: MigrationID identifies the migration on both clusters and
+                has to match the one of the receiving migration
+              type: string
+            volumeMigration:
+              description: VolumeMigration defines how persistent volumes reach the
+                receiving cluster. Defaults to PreSynced.
+              type: string
+          required:
+          - connectURL
+          - migrationID
+          type: object
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -12276,6 +13670,21 @@ var CRDsValidation map[string]string = map[string]string{
             completed:
               description: Indicates the migration completed
               type: boolean
+            crossCluster:
+              description: Details of a migration from or to another cluster
+              properties:
+                connectURL:
+                  description: The host:port endpoint of the receiving cluster the
+                    libvirt streams are tunneled to
+                  type: string
+                migrationID:
+                  description: The ID which identifies the migration on both clusters
+                  type: string
+                volumeMigration:
+                  description: How persistent volumes are transferred to the receiving
+                    cluster
+                  type: string
+              type: object
             endTimestamp:
               description: The time the migration action ended
               format: date-time
@@ -12300,6 +13709,12 @@ var CRDsValidation map[string]string = map[string]string{
                     to post-copy when CompletionTimeoutPerGiB triggers. Defaults to
                     false
                   type: boolean
+                allowWorkloadDisruption:
+                  description: AllowWorkloadDisruption allows the platform to pause
+                    a VMI whose live migration did not complete within CompletionTimeoutPerGiB,
+                    so that the migration can converge. If AllowPostCopy is also true,
+                    post-copy takes precedence. Defaults to false
+                  type: boolean
                 bandwidthPerMigration:
                   anyOf:
                   - type: integer
@@ -12317,6 +13732,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the live
+                    migration stream. zstd compression always uses parallel migration
+                    connections. Defaults to no compression
+                  type: string
+                crossClusterPeerCABundle:
+                  description: CrossClusterPeerCABundle holds the PEM encoded CA certificates
+                    of the virt-handlers of the clusters VMIs are migrated from or
+                    to. The tunnels of cross cluster migrations trust them in addition
+                    to the KubeVirt CA.
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -12340,6 +13766,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationConnections:
+                  description: ParallelMigrationConnections is the number of parallel
+                    connections (multifd channels) used to transfer the state of a
+                    single VMI during a live migration. Defaults to a single connection
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -12380,6 +13812,10 @@ var CRDsValidation map[string]string = map[string]string{
             sourceNode:
               description: The source node that the VMI originated on
               type: string
+            sourcePersistentStatePVCName:
+              description: The name of the PVC holding the persistent VM state, like
+                the TPM, used by the source pod
+              type: string
             startTimestamp:
               description: The time the migration action began
               format: date-time
@@ -12419,6 +13855,11 @@ var CRDsValidation map[string]string = map[string]string{
               description: If the VMI requires dedicated CPUs, this field will hold
                 the numa topology on the target node
               type: string
+            targetPersistentStatePVCName:
+              description: The name of the PVC holding the persistent VM state, like
+                the TPM, used by the target pod. It differs from the source one when
+                the state storage is not shared between nodes.
+              type: string
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
@@ -12786,6 +14227,42 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: IOTune specifies I/O throttling limits for the
+                          disk. With the VMLiveUpdateFeatures feature gate enabled
+                          the limits can be changed on a running VMI.
+                        properties:
+                          readBytesSec:
+                            description: ReadBytesSec is the read throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          readIopsSec:
+                            description: ReadIopsSec is the read I/O operations per
+                              second limit.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec is the total throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          totalIopsSec:
+                            description: TotalIopsSec is the total I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec is the write throughput limit
+                              in bytes per second.
+                            format: int64
+                            type: integer
+                          writeIopsSec:
+                            description: WriteIopsSec is the write I/O operations
+                              per second limit.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12929,6 +14406,68 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: Bandwidth specifies the inbound and outbound
+                          traffic limits of the interface. With the VMLiveUpdateFeatures
+                          feature gate enabled the limits can be changed on a running
+                          VMI.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate in kilobytes
+                                  per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kilobytes that
+                                  can be sent in a single burst at peak speed.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate at which the
+                                  interface can send data in kilobytes per second.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                description: Average is the average bit rate in kilobytes
+                                  per second.
+                                format: int32
+                                type: integer
+                              burst:
+                                description: Burst is the amount of kilobytes that
+                                  can be sent in a single burst at peak speed.
+                                format: int32
+                                type: integer
+                              peak:
+                                description: Peak is the maximum rate at which the
+                                  interface can send data in kilobytes per second.
+                                format: int32
+                                type: integer
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies the binding plugin that will
+                          be used to connect the interface to the guest. It provides
+                          an alternative to InterfaceBindingMethod.
+                        properties:
+                          name:
+                            description: Name references to the binding name as defined
+                              in the KubeVirt CR network configuration.
+                            type: string
+                        required:
+                        - name
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine
                           ordering of boot devices. Lower values take precedence.
@@ -12947,6 +14486,43 @@ var CRDsValidation map[string]string = map[string]string{
                             description: If specified will pass option 67 to interface's
                               DHCP server
                             type: string
+                          ipv6:
+                            description: IPv6 configures the DHCPv6 server and the
+                              router advertisements of the interface.
+                            properties:
+                              dnsServers:
+                                description: If specified will pass the configured
+                                  IPv6 DNS servers to the VM via DHCPv6 option 23.
+                                items:
+                                  type: string
+                                type: array
+                              routerAdvertisements:
+                                description: If set to true, router advertisements
+                                  are sent to the VM, providing its default route.
+                                  Supported only on masquerade interfaces. Requires
+                                  the Root feature gate, since a non-root virt-launcher
+                                  lacks the NET_RAW capability.
+                                type: boolean
+                              searchDomains:
+                                description: If specified will pass the configured
+                                  domain search list to the VM via DHCPv6 option 24.
+                                  Defaults to the search domains of the pod.
+                                items:
+                                  type: string
+                                type: array
+                            type: object
+                          ipxeBootFileName:
+                            description: If specified will pass option 67 with this
+                              value instead of BootFileName to clients identifying
+                              themselves as iPXE by the user class option 77. Allows
+                              chainloading an iPXE script after booting iPXE over
+                              the network.
+                            type: string
+                          leaseTime:
+                            description: If specified will be used as the lease time
+                              of the DHCP and DHCPv6 servers. Defaults to an infinite
+                              lease.
+                            type: string
                           ntpServers:
                             description: If specified will pass the configured NTP
                               server to the VM via DHCP option 042.
@@ -12973,11 +14549,97 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routes:
+                            description: If specified will pass the given static routes
+                              to the VM via DHCP option 121, in addition to the routes
+                              of the pod network.
+                            items:
+                              description: DHCPRoute defines a static route passed
+                                to the VM via DHCP option 121.
+                              properties:
+                                destination:
+                                  description: Destination is the IPv4 CIDR of the
+                                    route, e.g. 10.10.0.0/16.
+                                  type: string
+                                gateway:
+                                  description: Gateway is the IPv4 address of the
+                                    next hop of the route.
+                                  type: string
+                              required:
+                              - destination
+                              - gateway
+                              type: object
+                            type: array
                           tftpServerName:
                             description: If specified will pass option 66 to interface's
                               DHCP server
                             type: string
                         type: object
+                      filter:
+                        description: Filter specifies the traffic allowed to and from
+                          the guest on the interface. It is only supported with the
+                          bridge binding method.
+                        properties:
+                          antiSpoofing:
+                            description: AntiSpoofing drops the traffic sent by the
+                              guest with a source MAC or IPv4 address other than the
+                              ones of the interface. Defaults to true.
+                            type: boolean
+                          egress:
+                            description: Egress lists the traffic the guest is allowed
+                              to send. Any other traffic is dropped, unless the list
+                              is empty.
+                            items:
+                              description: InterfaceFilterRule allows the traffic
+                                matching all of its fields.
+                              properties:
+                                cidr:
+                                  description: 'CIDR of the remote peer. For example:
+                                    10.0.0.0/8 or fd10::/64. Matches any peer when
+                                    empty.'
+                                  type: string
+                                ports:
+                                  description: Destination ports of the traffic, each
+                                    0 < x < 65536. Requires the TCP or UDP protocol.
+                                    Matches any port when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: Protocol of the traffic. Must be TCP,
+                                    UDP or ICMP. Matches any protocol when empty.
+                                  type: string
+                              type: object
+                            type: array
+                          ingress:
+                            description: Ingress lists the traffic allowed to reach
+                              the guest. Any other traffic is dropped, unless the
+                              list is empty.
+                            items:
+                              description: InterfaceFilterRule allows the traffic
+                                matching all of its fields.
+                              properties:
+                                cidr:
+                                  description: 'CIDR of the remote peer. For example:
+                                    10.0.0.0/8 or fd10::/64. Matches any peer when
+                                    empty.'
+                                  type: string
+                                ports:
+                                  description: Destination ports of the traffic, each
+                                    0 < x < 65536. Requires the TCP or UDP protocol.
+                                    Matches any port when empty.
+                                  items:
+                                    format: int32
+                                    type: integer
+                                  type: array
+                                protocol:
+                                  description: Protocol of the traffic. Must be TCP,
+                                    UDP or ICMP. Matches any protocol when empty.
+                                  type: string
+                              type: object
+                            type: array
+                        type: object
                       macAddress:
                         description: 'Interface MAC address. For example: de:ad:00:00:be:af
                           or DE-AD-00-00-BE-AF.'
@@ -13045,8 +14707,10 @@ var CRDsValidation map[string]string = map[string]string{
                         type: object
                       state:
                         description: State represents the requested operational state
-                          of the interface. The (only) value supported is 'absent',
-                          expressing a request to remove the interface.
+                          of the interface. The supported values are 'absent', expressing
+                          a request to remove the interface, 'down', setting the link
+                          of the interface down without removing it, and 'up', the
+                          default, setting the link of the interface up.
                         type: string
                       tag:
                         description: If specified, the virtual network interface address
@@ -13063,6 +14727,20 @@ var CRDsValidation map[string]string = map[string]string{
                     factors of the VirtualMachineInstance, like the number of guest
                     CPUs.
                   type: boolean
+                panicDevices:
+                  description: PanicDevices describe devices notifying the host when
+                    the guest kernel panics.
+                  items:
+                    description: PanicDevice notifies the host when the guest kernel
+                      panics.
+                    properties:
+                      model:
+                        description: 'Model specifies what type of panic device is
+                          provided. One of: hyperv, isa, pvpanic. The model used when
+                          it is missing depends on the guest architecture.'
+                        type: string
+                    type: object
+                  type: array
                 rng:
                   description: Whether to have random number generator from host
                   type: object
@@ -13083,10 +14761,25 @@ var CRDsValidation map[string]string = map[string]string{
                 tpm:
                   description: Whether to emulate a TPM device.
                   properties:
+                    encryptionSecretRef:
+                      description: EncryptionSecretRef references a k8s secret whose
+                        "key" entry is used to encrypt the persistent TPM state.
+                      properties:
+                        name:
+                          description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                            TODO: Add other useful fields. apiVersion, kind, uid?'
+                          type: string
+                      type: object
                     persistent:
                       description: Persistent indicates the state of the TPM device
                         should be kept accross reboots Defaults to false
                       type: boolean
+                    stateRetentionPolicy:
+                      description: StateRetentionPolicy defines what happens to the
+                        persistent TPM state when the VM is deleted. Delete removes
+                        the state together with the VM, Retain keeps it for a later
+                        VM of the same name. Defaults to Delete
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: Fall back to legacy virtio 0.9 support if virtio bus
@@ -13098,14 +14791,37 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
                   properties:
+                    diag288:
+                      description: diag288 watchdog device, available on s390x.
+                      properties:
+                        action:
+                          description: The action to take. Valid values are poweroff,
+                            reset, shutdown, dump. Defaults to reset.
+                          type: string
+                      type: object
                     i6300esb:
                       description: i6300esb watchdog device.
                       properties:
                         action:
                           description: The action to take. Valid values are poweroff,
-                            reset, shutdown. Defaults to reset.
+                            reset, shutdown, dump. Defaults to reset.
+                          type: string
+                      type: object
+                    itco:
+                      description: iTCO watchdog device built into the q35 chipset.
+                      properties:
+                        action:
+                          description: The action to take. Valid values are poweroff,
+                            reset, shutdown, dump. Defaults to reset.
                           type: string
                       type: object
+                    memoryDumpClaimName:
+                      description: MemoryDumpClaimName is the name of the PVC the
+                        guest memory is dumped to by the dump action, before the guest
+                        is reset. Required by the dump action. The memory is only
+                        dumped for VMIs owned by a VirtualMachine, other VMIs are
+                        reset right away.
+                      type: string
                     name:
                       description: Name of the watchdog.
                       type: string
@@ -13330,11 +15046,28 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the
+                            EFI NVRAM across reboots and live migrations. Defaults
+                            to false
+                          type: boolean
                         secureBoot:
                           description: If set, SecureBoot will be enabled and the
                             OVMF roms will be swapped for SecureBoot-enabled ones.
                             Requires SMM to be enabled. Defaults to true
                           type: boolean
+                        secureBootKeysSecretRef:
+                          description: SecureBootKeysSecretRef references a k8s secret
+                            whose "PK", "KEK" and "db" entries hold PEM encoded X.509
+                            certificates to enroll in place of the default Secure
+                            Boot keys. The keys are enrolled when the NVRAM is initialized.
+                            Requires SecureBoot to be enabled.
+                          properties:
+                            name:
+                              description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                TODO: Add other useful fields. apiVersion, kind, uid?'
+                              type: string
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -13411,6 +15144,25 @@ var CRDsValidation map[string]string = map[string]string{
                       description: Base64 encoded session blob.
                       type: string
                   type: object
+                snp:
+                  description: AMD Secure Encrypted Virtualization with Secure Nested
+                    Paging (SEV-SNP).
+                  properties:
+                    policy:
+                      description: 'Guest policy flags as defined in AMD SEV-SNP firmware
+                        ABI specification. Note: due to security reasons it is not
+                        allowed to enable guest debugging. Therefore the debug flag
+                        is not exposed to users and is always false.'
+                      properties:
+                        smt:
+                          description: Allow the guest to run on hosts with simultaneous
+                            multithreading (SMT) enabled. Defaults to true.
+                          type: boolean
+                      type: object
+                  type: object
+                tdx:
+                  description: Intel Trust Domain Extensions (TDX).
+                  type: object
               type: object
             machine:
               description: Machine type.
@@ -13422,6 +15174,26 @@ var CRDsValidation map[string]string = map[string]string{
             memory:
               description: Memory allow specifying the VMI memory features.
               properties:
+                balloon:
+                  description: Balloon enables a free memory policy which inflates
+                    the memory balloon of idle guests and deflates it when the guest
+                    is under memory pressure. It requires the memory balloon device
+                    and its statistics to be enabled.
+                  properties:
+                    freeMemoryPercent:
+                      description: FreeMemoryPercent is the percentage of its memory
+                        the policy keeps free inside the guest. Defaults to 20.
+                      format: int32
+                      type: integer
+                    minGuest:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      description: MinGuest is the lowest amount of memory the balloon
+                        can leave to the guest. Defaults to half of the guest memory.
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  type: object
                 guest:
                   anyOf:
                   - type: integer
@@ -13441,6 +15213,30 @@ var CRDsValidation map[string]string = map[string]string{
                         architecture valid values are 1Gi and 2Mi.
                       type: string
                   type: object
+                maxGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxGuest allows to specify the maximum amount of memory
+                    which is visible inside the Guest OS. The delta between MaxGuest
+                    and Guest is the amount of memory that can be hotplugged.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
+            onCrash:
+              description: OnCrash defines what happens when the guest kernel panics.
+                Requires a panic device.
+              properties:
+                action:
+                  description: 'Action taken when the guest kernel panics. One of:
+                    Restart, Pause, MemoryDump. MemoryDump leaves the guest paused
+                    once its memory is dumped. Defaults to Pause.'
+                  type: string
+                memoryDumpClaimName:
+                  description: MemoryDumpClaimName is the name of the PVC the guest
+                    memory is dumped to by the MemoryDump action. The memory is only
+                    dumped for VMIs owned by a VirtualMachine.
+                  type: string
               type: object
             resources:
               description: Resources describes the Compute Resources required by this
@@ -13462,6 +15258,11 @@ var CRDsValidation map[string]string = map[string]string{
                     container's memory limit. This can lead to crashes if all memory
                     is in use on a node. Defaults to false.
                   type: boolean
+                overcommitWithKSM:
+                  description: OvercommitWithKSM schedules the VMI on a KSM-enabled
+                    node and lowers its memory request by the memory overcommit these
+                    nodes report. Defaults to false.
+                  type: boolean
                 requests:
                   additionalProperties:
                     anyOf:
@@ -14917,6 +16718,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   should be used. Supported values are: native, default,
                                   threads.'
                                 type: string
+                              ioTune:
+                                description: IOTune specifies I/O throttling limits
+                                  for the disk. With the VMLiveUpdateFeatures feature
+                                  gate enabled the limits can be changed on a running
+                                  VMI.
+                                properties:
+                                  readBytesSec:
+                                    description: ReadBytesSec is the read throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readIopsSec:
+                                    description: ReadIopsSec is the read I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec is the total throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalIopsSec:
+                                    description: TotalIopsSec is the total I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec is the write throughput
+                                      limit in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeIopsSec:
+                                    description: WriteIopsSec is the write I/O operations
+                                      per second limit.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -15066,6 +16904,73 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: Bandwidth specifies the inbound and outbound
+                                  traffic limits of the interface. With the VMLiveUpdateFeatures
+                                  feature gate enabled the limits can be changed on
+                                  a running VMI.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          in kilobytes per second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kilobytes
+                                          that can be sent in a single burst at peak
+                                          speed.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate at which
+                                          the interface can send data in kilobytes
+                                          per second.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        description: Average is the average bit rate
+                                          in kilobytes per second.
+                                        format: int32
+                                        type: integer
+                                      burst:
+                                        description: Burst is the amount of kilobytes
+                                          that can be sent in a single burst at peak
+                                          speed.
+                                        format: int32
+                                        type: integer
+                                      peak:
+                                        description: Peak is the maximum rate at which
+                                          the interface can send data in kilobytes
+                                          per second.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies the binding plugin
+                                  that will be used to connect the interface to the
+                                  guest. It provides an alternative to InterfaceBindingMethod.
+                                properties:
+                                  name:
+                                    description: Name references to the binding name
+                                      as defined in the KubeVirt CR network configuration.
+                                    type: string
+                                required:
+                                - name
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used
                                   to determine ordering of boot devices. Lower values
@@ -15085,6 +16990,46 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: If specified will pass option 67
                                       to interface's DHCP server
                                     type: string
+                                  ipv6:
+                                    description: IPv6 configures the DHCPv6 server
+                                      and the router advertisements of the interface.
+                                    properties:
+                                      dnsServers:
+                                        description: If specified will pass the configured
+                                          IPv6 DNS servers to the VM via DHCPv6 option
+                                          23.
+                                        items:
+                                          type: string
+                                        type: array
+                                      routerAdvertisements:
+                                        description: If set to true, router advertisements
+                                          are sent to the VM, providing its default
+                                          route. Supported only on masquerade interfaces.
+                                          Requires the Root feature gate, since a
+                                          non-root virt-launcher lacks the NET_RAW
+                                          capability.
+                                        type: boolean
+                                      searchDomains:
+                                        description: If specified will pass the configured
+                                          domain search list to the VM via DHCPv6
+                                          option 24. Defaults to the search domains
+                                          of the pod.
+                                        items:
+                                          type: string
+                                        type: array
+                                    type: object
+                                  ipxeBootFileName:
+                                    description: If specified will pass option 67
+                                      with this value instead of BootFileName to clients
+                                      identifying themselves as iPXE by the user class
+                                      option 77. Allows chainloading an iPXE script
+                                      after booting iPXE over the network.
+                                    type: string
+                                  leaseTime:
+                                    description: If specified will be used as the
+                                      lease time of the DHCP and DHCPv6 servers. Defaults
+                                      to an infinite lease.
+                                    type: string
                                   ntpServers:
                                     description: If specified will pass the configured
                                       NTP server to the VM via DHCP option 042.
@@ -15111,11 +17056,100 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routes:
+                                    description: If specified will pass the given
+                                      static routes to the VM via DHCP option 121,
+                                      in addition to the routes of the pod network.
+                                    items:
+                                      description: DHCPRoute defines a static route
+                                        passed to the VM via DHCP option 121.
+                                      properties:
+                                        destination:
+                                          description: Destination is the IPv4 CIDR
+                                            of the route, e.g. 10.10.0.0/16.
+                                          type: string
+                                        gateway:
+                                          description: Gateway is the IPv4 address
+                                            of the next hop of the route.
+                                          type: string
+                                      required:
+                                      - destination
+                                      - gateway
+                                      type: object
+                                    type: array
                                   tftpServerName:
                                     description: If specified will pass option 66
                                       to interface's DHCP server
                                     type: string
                                 type: object
+                              filter:
+                                description: Filter specifies the traffic allowed
+                                  to and from the guest on the interface. It is only
+                                  supported with the bridge binding method.
+                                properties:
+                                  antiSpoofing:
+                                    description: AntiSpoofing drops the traffic sent
+                                      by the guest with a source MAC or IPv4 address
+                                      other than the ones of the interface. Defaults
+                                      to true.
+                                    type: boolean
+                                  egress:
+                                    description: Egress lists the traffic the guest
+                                      is allowed to send. Any other traffic is dropped,
+                                      unless the list is empty.
+                                    items:
+                                      description: InterfaceFilterRule allows the
+                                        traffic matching all of its fields.
+                                      properties:
+                                        cidr:
+                                          description: 'CIDR of the remote peer. For
+                                            example: 10.0.0.0/8 or fd10::/64. Matches
+                                            any peer when empty.'
+                                          type: string
+                                        ports:
+                                          description: Destination ports of the traffic,
+                                            each 0 < x < 65536. Requires the TCP or
+                                            UDP protocol. Matches any port when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: Protocol of the traffic. Must
+                                            be TCP, UDP or ICMP. Matches any protocol
+                                            when empty.
+                                          type: string
+                                      type: object
+                                    type: array
+                                  ingress:
+                                    description: Ingress lists the traffic allowed
+                                      to reach the guest. Any other traffic is dropped,
+                                      unless the list is empty.
+                                    items:
+                                      description: InterfaceFilterRule allows the
+                                        traffic matching all of its fields.
+                                      properties:
+                                        cidr:
+                                          description: 'CIDR of the remote peer. For
+                                            example: 10.0.0.0/8 or fd10::/64. Matches
+                                            any peer when empty.'
+                                          type: string
+                                        ports:
+                                          description: Destination ports of the traffic,
+                                            each 0 < x < 65536. Requires the TCP or
+                                            UDP protocol. Matches any port when empty.
+                                          items:
+                                            format: int32
+                                            type: integer
+                                          type: array
+                                        protocol:
+                                          description: Protocol of the traffic. Must
+                                            be TCP, UDP or ICMP. Matches any protocol
+                                            when empty.
+                                          type: string
+                                      type: object
+                                    type: array
+                                type: object
                               macAddress:
                                 description: 'Interface MAC address. For example:
                                   de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -15186,9 +17220,11 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: object
                               state:
                                 description: State represents the requested operational
-                                  state of the interface. The (only) value supported
-                                  is 'absent', expressing a request to remove the
-                                  interface.
+                                  state of the interface. The supported values are
+                                  'absent', expressing a request to remove the interface,
+                                  'down', setting the link of the interface down without
+                                  removing it, and 'up', the default, setting the
+                                  link of the interface up.
                                 type: string
                               tag:
                                 description: If specified, the virtual network interface
@@ -15206,6 +17242,20 @@ var CRDsValidation map[string]string = map[string]string{
                             depends on additional factors of the VirtualMachineInstance,
                             like the number of guest CPUs.
                           type: boolean
+                        panicDevices:
+                          description: PanicDevices describe devices notifying the
+                            host when the guest kernel panics.
+                          items:
+                            description: PanicDevice notifies the host when the guest
+                              kernel panics.
+                            properties:
+                              model:
+                                description: 'Model specifies what type of panic device
+                                  is provided. One of: hyperv, isa, pvpanic. The model
+                                  used when it is missing depends on the guest architecture.'
+                                type: string
+                            type: object
+                          type: array
                         rng:
                           description: Whether to have random number generator from
                             host
@@ -15227,11 +17277,29 @@ var CRDsValidation map[string]string = map[string]string{
                         tpm:
                           description: Whether to emulate a TPM device.
                           properties:
+                            encryptionSecretRef:
+                              description: EncryptionSecretRef references a k8s secret
+                                whose "key" entry is used to encrypt the persistent
+                                TPM state.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
                             persistent:
                               description: Persistent indicates the state of the TPM
                                 device should be kept accross reboots Defaults to
                                 false
                               type: boolean
+                            stateRetentionPolicy:
+                              description: StateRetentionPolicy defines what happens
+                                to the persistent TPM state when the VM is deleted.
+                                Delete removes the state together with the VM, Retain
+                                keeps it for a later VM of the same name. Defaults
+                                to Delete
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: Fall back to legacy virtio 0.9 support if virtio
@@ -15243,14 +17311,38 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
                           properties:
+                            diag288:
+                              description: diag288 watchdog device, available on s390x.
+                              properties:
+                                action:
+                                  description: The action to take. Valid values are
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
+                                  type: string
+                              type: object
                             i6300esb:
                               description: i6300esb watchdog device.
                               properties:
                                 action:
                                   description: The action to take. Valid values are
-                                    poweroff, reset, shutdown. Defaults to reset.
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
+                                  type: string
+                              type: object
+                            itco:
+                              description: iTCO watchdog device built into the q35
+                                chipset.
+                              properties:
+                                action:
+                                  description: The action to take. Valid values are
+                                    poweroff, reset, shutdown, dump. Defaults to reset.
                                   type: string
                               type: object
+                            memoryDumpClaimName:
+                              description: MemoryDumpClaimName is the name of the
+                                PVC the guest memory is dumped to by the dump action,
+                                before the guest is reset. Required by the dump action.
+                                The memory is only dumped for VMIs owned by a VirtualMachine,
+                                other VMIs are reset right away.
+                              type: string
                             name:
                               description: Name of the watchdog.
                               type: string
@@ -15499,12 +17591,32 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist
+                                    the EFI NVRAM across reboots and live migrations.
+                                    Defaults to false
+                                  type: boolean
                                 secureBoot:
                                   description: If set, SecureBoot will be enabled
                                     and the OVMF roms will be swapped for SecureBoot-enabled
                                     ones. Requires SMM to be enabled. Defaults to
                                     true
                                   type: boolean
+                                secureBootKeysSecretRef:
+                                  description: SecureBootKeysSecretRef references
+                                    a k8s secret whose "PK", "KEK" and "db" entries
+                                    hold PEM encoded X.509 certificates to enroll
+                                    in place of the default Secure Boot keys. The
+                                    keys are enrolled when the NVRAM is initialized.
+                                    Requires SecureBoot to be enabled.
+                                  properties:
+                                    name:
+                                      description: 'Name of the referent. More info:
+                                        https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                        TODO: Add other useful fields. apiVersion,
+                                        kind, uid?'
+                                      type: string
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -15587,6 +17699,27 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Base64 encoded session blob.
                               type: string
                           type: object
+                        snp:
+                          description: AMD Secure Encrypted Virtualization with Secure
+                            Nested Paging (SEV-SNP).
+                          properties:
+                            policy:
+                              description: 'Guest policy flags as defined in AMD SEV-SNP
+                                firmware ABI specification. Note: due to security
+                                reasons it is not allowed to enable guest debugging.
+                                Therefore the debug flag is not exposed to users and
+                                is always false.'
+                              properties:
+                                smt:
+                                  description: Allow the guest to run on hosts with
+                                    simultaneous multithreading (SMT) enabled. Defaults
+                                    to true.
+                                  type: boolean
+                              type: object
+                          type: object
+                        tdx:
+                          description: Intel Trust Domain Extensions (TDX).
+                          type: object
                       type: object
                     machine:
                       description: Machine type.
@@ -15599,6 +17732,28 @@ var CRDsValidation map[string]string = map[string]string{
                     memory:
                       description: Memory allow specifying the VMI memory features.
                       properties:
+                        balloon:
+                          description: Balloon enables a free memory policy which
+                            inflates the memory balloon of idle guests and deflates
+                            it when the guest is under memory pressure. It requires
+                            the memory balloon device and its statistics to be enabled.
+                          properties:
+                            freeMemoryPercent:
+                              description: FreeMemoryPercent is the percentage of
+                                its memory the policy keeps free inside the guest.
+                                Defaults to 20.
+                              format: int32
+                              type: integer
+                            minGuest:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: MinGuest is the lowest amount of memory
+                                the balloon can leave to the guest. Defaults to half
+                                of the guest memory.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          type: object
                         guest:
                           anyOf:
                           - type: integer
@@ -15619,6 +17774,32 @@ var CRDsValidation map[string]string = map[string]string{
                                 x86_64 architecture valid values are 1Gi and 2Mi.
                               type: string
                           type: object
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: MaxGuest allows to specify the maximum amount
+                            of memory which is visible inside the Guest OS. The delta
+                            between MaxGuest and Guest is the amount of memory that
+                            can be hotplugged.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
+                    onCrash:
+                      description: OnCrash defines what happens when the guest kernel
+                        panics. Requires a panic device.
+                      properties:
+                        action:
+                          description: 'Action taken when the guest kernel panics.
+                            One of: Restart, Pause, MemoryDump. MemoryDump leaves
+                            the guest paused once its memory is dumped. Defaults to
+                            Pause.'
+                          type: string
+                        memoryDumpClaimName:
+                          description: MemoryDumpClaimName is the name of the PVC
+                            the guest memory is dumped to by the MemoryDump action.
+                            The memory is only dumped for VMIs owned by a VirtualMachine.
+                          type: string
                       type: object
                     resources:
                       description: Resources describes the Compute Resources required
@@ -15641,6 +17822,11 @@ var CRDsValidation map[string]string = map[string]string{
                             the container's memory limit. This can lead to crashes
                             if all memory is in use on a node. Defaults to false.
                           type: boolean
+                        overcommitWithKSM:
+                          description: OvercommitWithKSM schedules the VMI on a KSM-enabled
+                            node and lowers its memory request by the memory overcommit
+                            these nodes report. Defaults to false.
+                          type: boolean
                         requests:
                           additionalProperties:
                             anyOf:
@@ -16825,10 +19011,47 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Base64 encoded session blob.
                   type: string
               type: object
+            snp:
+              description: AMD Secure Encrypted Virtualization with Secure Nested
+                Paging (SEV-SNP).
+              properties:
+                policy:
+                  description: 'Guest policy flags as defined in AMD SEV-SNP firmware
+                    ABI specification. Note: due to security reasons it is not allowed
+                    to enable guest debugging. Therefore the debug flag is not exposed
+                    to users and is always false.'
+                  properties:
+                    smt:
+                      description: Allow the guest to run on hosts with simultaneous
+                        multithreading (SMT) enabled. Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+            tdx:
+              description: Intel Trust Domain Extensions (TDX).
+              type: object
           type: object
         memory:
           description: Required Memory related attributes of the instancetype.
           properties:
+            balloon:
+              description: Optionally enables the free memory policy of the memory
+                balloon for the VirtualMachineInstance.
+              properties:
+                freeMemoryPercent:
+                  description: FreeMemoryPercent is the percentage of its memory the
+                    policy keeps free inside the guest. Defaults to 20.
+                  format: int32
+                  type: integer
+                minGuest:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MinGuest is the lowest amount of memory the balloon
+                    can leave to the guest. Defaults to half of the guest memory.
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+              type: object
             guest:
               anyOf:
               - type: integer
@@ -16884,53 +19107,808 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
-        paused:
-          description: Indicates that the pool is paused.
-          type: boolean
-        replicas:
-          description: Number of desired pods. This is a pointer to distinguish between
-            explicit zero and not specified. Defaults to 1.
-          format: int32
-          type: integer
-        selector:
-          description: Label selector for pods. Existing Poolss whose pods are selected
-            by this will be the ones affected by this deployment.
+        dataVolumeClaimRetentionPolicy:
+          description: DataVolumeClaimRetentionPolicy defines what happens to the
+            DataVolumes created from the DataVolumeClaimTemplates when their replica
+            is removed. They are always deleted together with the pool.
           properties:
-            matchExpressions:
-              description: matchExpressions is a list of label selector requirements.
-                The requirements are ANDed.
-              items:
-                description: A label selector requirement is a selector that contains
-                  values, a key, and an operator that relates the key and values.
+            whenScaled:
+              description: WhenScaled specifies what happens to the DataVolumes of
+                a replica which gets removed because the pool scales in. "Retain"
+                keeps them, so that they get reattached when the pool scales out again,
+                "Delete" removes them. Defaults to Retain.
+              type: string
+          type: object
+        dataVolumeClaimTemplates:
+          description: DataVolumeClaimTemplates lists DataVolumes which are created
+            once per replica and outlive the VMs. The DataVolume of a replica is named
+            <template name>-<VM name> and owned by the pool, volumes of the VM template
+            which refer to a template by name are pointed to the DataVolume of the
+            replica. A recreated VM with the same name reattaches the DataVolumes
+            of its predecessor.
+          items:
+            nullable: true
+            properties:
+              apiVersion:
+                description: 'APIVersion defines the versioned schema of this representation
+                  of an object. Servers should convert recognized schemas to the latest
+                  internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+                type: string
+              kind:
+                description: 'Kind is a string value representing the REST resource
+                  this object represents. Servers may infer this from the endpoint
+                  the client submits requests to. Cannot be updated. In CamelCase.
+                  More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+                type: string
+              metadata:
+                nullable: true
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+              spec:
+                description: DataVolumeSpec contains the DataVolume specification.
                 properties:
-                  key:
-                    description: key is the label key that the selector applies to.
-                    type: string
-                  operator:
-                    description: operator represents a key's relationship to a set
-                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
-                    type: string
-                  values:
-                    description: values is an array of string values. If the operator
-                      is In or NotIn, the values array must be non-empty. If the operator
-                      is Exists or DoesNotExist, the values array must be empty. This
-                      array is replaced during a strategic merge patch.
+                  checkpoints:
+                    description: Checkpoints is a list of DataVolumeCheckpoints, representing
+                      stages in a multistage import.
                     items:
-                      type: string
+                      description: DataVolumeCheckpoint defines a stage in a warm
+                        migration.
+                      properties:
+                        current:
+                          description: Current is the identifier of the snapshot created
+                            for this checkpoint.
+                          type: string
+                        previous:
+                          description: Previous is the identifier of the snapshot
+                            from the previous checkpoint.
+                          type: string
+                      required:
+                      - current
+                      - previous
+                      type: object
                     type: array
-                required:
-                - key
-                - operator
-                type: object
-              type: array
-            matchLabels:
-              additionalProperties:
-                type: string
-              description: matchLabels is a map of {key,value} pairs. A single {key,value}
-                in the matchLabels map is equivalent to an element of matchExpressions,
-                whose key field is "key", the operator is "In", and the values array
-                contains only "value". The requirements are ANDed.
-              type: object
+                  contentType:
+                    description: 'DataVolumeContentType options: "kubevirt", "archive"'
+                    enum:
+                    - kubevirt
+                    - archive
+                    type: string
+                  finalCheckpoint:
+                    description: FinalCheckpoint indicates whether the current DataVolumeCheckpoint
+                      is the final checkpoint.
+                    type: boolean
+                  preallocation:
+                    description: Preallocation controls whether storage for DataVolumes
+                      should be allocated in advance.
+                    type: boolean
+                  priorityClassName:
+                    description: PriorityClassName for Importer, Cloner and Uploader
+                      pod
+                    type: string
+                  pvc:
+                    description: PVC is the PVC specification
+                    properties:
+                      accessModes:
+                        description: 'accessModes contains the desired access modes
+                          the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                        items:
+                          type: string
+                        type: array
+                      dataSource:
+                        description: 'dataSource field can be used to specify either:
+                          * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                          * An existing PVC (PersistentVolumeClaim) If the provisioner
+                          or an external controller can support the specified data
+                          source, it will create a new volume based on the contents
+                          of the specified data source. When the AnyVolumeDataSource
+                          feature gate is enabled, dataSource contents will be copied
+                          to dataSourceRef, and dataSourceRef contents will be copied
+                          to dataSource when dataSourceRef.namespace is not specified.
+                          If the namespace is specified, then dataSourceRef will not
+                          be copied to dataSource.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      dataSourceRef:
+                        description: 'dataSourceRef specifies the object from which
+                          to populate the volume with data, if a non-empty volume
+                          is desired. This may be any object from a non-empty API
+                          group (non core object) or a PersistentVolumeClaim object.
+                          When this field is specified, volume binding will only succeed
+                          if the type of the specified object matches some installed
+                          volume populator or dynamic provisioner. This field will
+                          replace the functionality of the dataSource field and as
+                          such if both fields are non-empty, they must have the same
+                          value. For backwards compatibility, when namespace isn''t
+                          specified in dataSourceRef, both fields (dataSource and
+                          dataSourceRef) will be set to the same value automatically
+                          if one of them is empty and the other is non-empty. When
+                          namespace is specified in dataSourceRef, dataSource isn''t
+                          set to the same value and must be empty. There are three
+                          important differences between dataSource and dataSourceRef:
+                          * While dataSource only allows two specific types of objects,
+                          dataSourceRef   allows any non-core object, as well as PersistentVolumeClaim
+                          objects. * While dataSource ignores disallowed values (dropping
+                          them), dataSourceRef   preserves all values, and generates
+                          an error if a disallowed value is   specified. * While dataSource
+                          only allows local objects, dataSourceRef allows objects   in
+                          any namespaces. (Beta) Using this field requires the AnyVolumeDataSource
+                          feature gate to be enabled. (Alpha) Using the namespace
+                          field of dataSourceRef requires the CrossNamespaceVolumeDataSource
+                          feature gate to be enabled.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                          namespace:
+                            description: Namespace is the namespace of resource being
+                              referenced Note that when a namespace is specified,
+                              a gateway.networking.k8s.io/ReferenceGrant object is
+                              required in the referent namespace to allow that namespace's
+                              owner to accept the reference. See the ReferenceGrant
+                              documentation for details. (Alpha) This field requires
+                              the CrossNamespaceVolumeDataSource feature gate to be
+                              enabled.
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      resources:
+                        description: 'resources represents the minimum resources the
+                          volume should have. If RecoverVolumeExpansionFailure feature
+                          is enabled users are allowed to specify resource requirements
+                          that are lower than previous value but must still be higher
+                          than capacity recorded in the status field of the claim.
+                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                        properties:
+                          claims:
+                            description: "Claims lists the names of resources, defined
+                              in spec.resourceClaims, that are used by this container.
+                              \n This is an alpha field and requires enabling the
+                              DynamicResourceAllocation feature gate. \n This field
+                              is immutable. It can only be set for containers."
+                            items:
+                              description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                              properties:
+                                name:
+                                  description: Name must match the name of one entry
+                                    in pod.spec.resourceClaims of the Pod where this
+                                    field is used. It makes that resource available
+                                    inside a container.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute
+                              resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of
+                              compute resources required. If Requests is omitted for
+                              a container, it defaults to Limits if that is explicitly
+                              specified, otherwise to an implementation-defined value.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                        type: object
+                      selector:
+                        description: selector is a label query over volumes to consider
+                          for binding.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: A label selector requirement is a selector
+                                that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: operator represents a key's relationship
+                                    to a set of values. Valid operators are In, NotIn,
+                                    Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: values is an array of string values.
+                                    If the operator is In or NotIn, the values array
+                                    must be non-empty. If the operator is Exists or
+                                    DoesNotExist, the values array must be empty.
+                                    This array is replaced during a strategic merge
+                                    patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: matchLabels is a map of {key,value} pairs.
+                              A single {key,value} in the matchLabels map is equivalent
+                              to an element of matchExpressions, whose key field is
+                              "key", the operator is "In", and the values array contains
+                              only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                      storageClassName:
+                        description: 'storageClassName is the name of the StorageClass
+                          required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                        type: string
+                      volumeMode:
+                        description: volumeMode defines what type of volume is required
+                          by the claim. Value of Filesystem is implied when not included
+                          in claim spec.
+                        type: string
+                      volumeName:
+                        description: volumeName is the binding reference to the PersistentVolume
+                          backing this claim.
+                        type: string
+                    type: object
+                  source:
+                    description: Source is the src of the data for the requested DataVolume
+                    properties:
+                      blank:
+                        description: DataVolumeBlankImage provides the parameters
+                          to create a new raw blank image for the PVC
+                        type: object
+                      gcs:
+                        description: DataVolumeSourceGCS provides the parameters to
+                          create a Data Volume from an GCS source
+                        properties:
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the GCS source
+                            type: string
+                          url:
+                            description: URL is the url of the GCS source
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      http:
+                        description: DataVolumeSourceHTTP can be either an http or
+                          https endpoint, with an optional basic auth user name and
+                          password, and an optional configmap containing additional
+                          CAs
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap is a configmap reference, containing
+                              a Certificate Authority(CA) public key, and a base64
+                              encoded pem certificate
+                            type: string
+                          extraHeaders:
+                            description: ExtraHeaders is a list of strings containing
+                              extra headers to include with HTTP transfer requests
+                            items:
+                              type: string
+                            type: array
+                          secretExtraHeaders:
+                            description: SecretExtraHeaders is a list of Secret references,
+                              each containing an extra HTTP header that may include
+                              sensitive information
+                            items:
+                              type: string
+                            type: array
+                          secretRef:
+                            description: SecretRef A Secret reference, the secret
+                              should contain accessKeyId (user name) base64 encoded,
+                              and secretKey (password) also base64 encoded
+                            type: string
+                          url:
+                            description: URL is the URL of the http(s) endpoint
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      imageio:
+                        description: DataVolumeSourceImageIO provides the parameters
+                          to create a Data Volume from an imageio source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap provides a reference to the
+                              CA cert
+                            type: string
+                          diskId:
+                            description: DiskID provides id of a disk to be imported
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the ovirt-engine
+                            type: string
+                          url:
+                            description: URL is the URL of the ovirt-engine
+                            type: string
+                        required:
+                        - diskId
+                        - url
+                        type: object
+                      pvc:
+                        description: DataVolumeSourcePVC provides the parameters to
+                          create a Data Volume from an existing PVC
+                        properties:
+                          name:
+                            description: The name of the source PVC
+                            type: string
+                          namespace:
+                            description: The namespace of the source PVC
+                            type: string
+                        required:
+                        - name
+                        - namespace
+                        type: object
+                      registry:
+                        description: DataVolumeSourceRegistry provides the parameters
+                          to create a Data Volume from an registry source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap provides a reference to the
+                              Registry certs
+                            type: string
+                          imageStream:
+                            description: ImageStream is the name of image stream for
+                              import
+                            type: string
+                          pullMethod:
+                            description: PullMethod can be either "pod" (default import),
+                              or "node" (node docker cache based import)
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the Registry source
+                            type: string
+                          url:
+                            description: 'URL is the url of the registry source (starting
+                              with the scheme: docker, oci-archive)'
+                            type: string
+                        type: object
+                      s3:
+                        description: DataVolumeSourceS3 provides the parameters to
+                          create a Data Volume from an S3 source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap is a configmap reference, containing
+                              a Certificate Authority(CA) public key, and a base64
+                              encoded pem certificate
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the S3 source
+                            type: string
+                          url:
+                            description: URL is the url of the S3 source
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      snapshot:
+                        description: DataVolumeSourceSnapshot provides the parameters
+                          to create a Data Volume from an existing VolumeSnapshot
+                        properties:
+                          name:
+                            description: The name of the source VolumeSnapshot
+                            type: string
+                          namespace:
+                            description: The namespace of the source VolumeSnapshot
+                            type: string
+                        required:
+                        - name
+                        - namespace
+                        type: object
+                      upload:
+                        description: DataVolumeSourceUpload provides the parameters
+                          to create a Data Volume by uploading the source
+                        type: object
+                      vddk:
+                        description: DataVolumeSourceVDDK provides the parameters
+                          to create a Data Volume from a Vmware source
+                        properties:
+                          backingFile:
+                            description: BackingFile is the path to the virtual hard
+                              disk to migrate from vCenter/ESXi
+                            type: string
+                          initImageURL:
+                            description: InitImageURL is an optional URL to an image
+                              containing an extracted VDDK library, overrides v2v-vmware
+                              config map
+                            type: string
+                          secretRef:
+                            description: SecretRef provides a reference to a secret
+                              containing the username and password needed to access
+                              the vCenter or ESXi host
+                            type: string
+                          thumbprint:
+                            description: Thumbprint is the certificate thumbprint
+                              of the vCenter or ESXi host
+                            type: string
+                          url:
+                            description: URL is the URL of the vCenter or ESXi host
+                              with the VM to migrate
+                            type: string
+                          uuid:
+                            description: UUID is the UUID of the virtual machine that
+                              the backing file is attached to in vCenter/ESXi
+                            type: string
+                        type: object
+                    type: object
+                  sourceRef:
+                    description: SourceRef is an indirect reference to the source
+                      of data for the requested DataVolume
+                    properties:
+                      kind:
+                        description: The kind of the source reference, currently only
+                          "DataSource" is supported
+                        type: string
+                      name:
+                        description: The name of the source reference
+                        type: string
+                      namespace:
+                        description: The namespace of the source reference, defaults
+                          to the DataVolume namespace
+                        type: string
+                    required:
+                    - kind
+                    - name
+                    type: object
+                  storage:
+                    description: Storage is the requested storage specification
+                    properties:
+                      accessModes:
+                        description: 'AccessModes contains the desired access modes
+                          the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                        items:
+                          type: string
+                        type: array
+                      dataSource:
+                        description: 'This field can be used to specify either: *
+                          An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                          * An existing PVC (PersistentVolumeClaim) * An existing
+                          custom resource that implements data population (Alpha)
+                          In order to use custom resource types that implement data
+                          population, the AnyVolumeDataSource feature gate must be
+                          enabled. If the provisioner or an external controller can
+                          support the specified data source, it will create a new
+                          volume based on the contents of the specified data source.
+                          If the AnyVolumeDataSource feature gate is enabled, this
+                          field will always have the same contents as the DataSourceRef
+                          field.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      dataSourceRef:
+                        description: 'Specifies the object from which to populate
+                          the volume with data, if a non-empty volume is desired.
+                          This may be any local object from a non-empty API group
+                          (non core object) or a PersistentVolumeClaim object. When
+                          this field is specified, volume binding will only succeed
+                          if the type of the specified object matches some installed
+                          volume populator or dynamic provisioner. This field will
+                          replace the functionality of the DataSource field and as
+                          such if both fields are non-empty, they must have the same
+                          value. For backwards compatibility, both fields (DataSource
+                          and DataSourceRef) will be set to the same value automatically
+                          if one of them is empty and the other is non-empty. There
+                          are two important differences between DataSource and DataSourceRef:
+                          * While DataSource only allows two specific types of objects,
+                          DataSourceRef allows any non-core object, as well as PersistentVolumeClaim
+                          objects. * While DataSource ignores disallowed values (dropping
+                          them), DataSourceRef preserves all values, and generates
+                          an error if a disallowed value is specified. (Beta) Using
+                          this field requires the AnyVolumeDataSource feature gate
+                          to be enabled.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                          namespace:
+                            description: Namespace is the namespace of resource being
+                              referenced Note that when a namespace is specified,
+                              a gateway.networking.k8s.io/ReferenceGrant object is
+                              required in the referent namespace to allow that namespace's
+                              owner to accept the reference. See the ReferenceGrant
+                              documentation for details. (Alpha) This field requires
+                              the CrossNamespaceVolumeDataSource feature gate to be
+                              enabled.
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      resources:
+                        description: 'Resources represents the minimum resources the
+                          volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                        properties:
+                          claims:
+                            description: "Claims lists the names of resources, defined
+                              in spec.resourceClaims, that are used by this container.
+                              \n This is an alpha field and requires enabling the
+                              DynamicResourceAllocation feature gate. \n This field
+                              is immutable. It can only be set for containers."
+                            items:
+                              description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                              properties:
+                                name:
+                                  description: Name must match the name of one entry
+                                    in pod.spec.resourceClaims of the Pod where this
+                                    field is used. It makes that resource available
+                                    inside a container.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute
+                              resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of
+                              compute resources required. If Requests is omitted for
+                              a container, it defaults to Limits if that is explicitly
+                              specified, otherwise to an implementation-defined value.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                        type: object
+                      selector:
+                        description: A label query over volumes to consider for binding.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: A label selector requirement is a selector
+                                that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: operator represents a key's relationship
+                                    to a set of values. Valid operators are In, NotIn,
+                                    Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: values is an array of string values.
+                                    If the operator is In or NotIn, the values array
+                                    must be non-empty. If the operator is Exists or
+                                    DoesNotExist, the values array must be empty.
+                                    This array is replaced during a strategic merge
+                                    patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: matchLabels is a map of {key,value} pairs.
+                              A single {key,value} in the matchLabels map is equivalent
+                              to an element of matchExpressions, whose key field is
+                              "key", the operator is "In", and the values array contains
+                              only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                      storageClassName:
+                        description: 'Name of the StorageClass required by the claim.
+                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                        type: string
+                      volumeMode:
+                        description: volumeMode defines what type of volume is required
+                          by the claim. Value of Filesystem is implied when not included
+                          in claim spec.
+                        type: string
+                      volumeName:
+                        description: VolumeName is the binding reference to the PersistentVolume
+                          backing this claim.
+                        type: string
+                    type: object
+                type: object
+              status:
+                description: DataVolumeTemplateDummyStatus is here simply for backwards
+                  compatibility with a previous API.
+                nullable: true
+                type: object
+            required:
+            - spec
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        namingPolicy:
+          description: NamingPolicy defines how the VMs of the pool are named. Can
+            be "Indexed" or "Ordinal". The Ordinal policy can not be combined with
+            a scale in policy. Defaults to Indexed.
+          type: string
+        paused:
+          description: Indicates that the pool is paused.
+          type: boolean
+        replicas:
+          description: Number of desired pods. This is a pointer to distinguish between
+            explicit zero and not specified. Defaults to 1.
+          format: int32
+          type: integer
+        scaleInPolicy:
+          description: The policy used to pick the VMs which get removed when the
+            pool scales in. VMs annotated with pool.kubevirt.io/scale-in-protection=true
+            are never picked.
+          properties:
+            order:
+              description: Order lists the criteria used to pick the VMs which get
+                removed when the pool scales in. Each criterion only breaks the ties
+                left by the previous ones, remaining ties are broken randomly. Can
+                contain "NotReadyFirst", "NewestFirst", "OldestFirst" and "LeastLoaded".
+                If empty, VMs are picked randomly.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        selector:
+          description: Label selector for pods. Existing Poolss whose pods are selected
+            by this will be the ones affected by this deployment.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        updateStrategy:
+          description: The strategy used to roll out template changes to existing
+            VMs. If not set, all outdated VMs are updated and restarted at once.
+          properties:
+            rollingUpdate:
+              description: Rolling update config params. Present only if Type = RollingUpdate.
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: 'The maximum number of VMs that can be created over
+                    the desired number of replicas while outdated VMs are updated.
+                    Value can be an absolute number (ex: 5) or a percentage of desired
+                    replicas (ex: 10%). Absolute number is calculated from percentage
+                    by rounding up. Defaults to 0.'
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: 'The maximum number of VMs that can be unavailable
+                    during the update. Value can be an absolute number (ex: 5) or
+                    a percentage of desired replicas (ex: 10%). Absolute number is
+                    calculated from percentage by rounding down. This can not be 0
+                    if MaxSurge is 0. Defaults to 1.'
+                  x-kubernetes-int-or-string: true
+                mode:
+                  description: Mode defines whether running VMs are restarted to pick
+                    up a template change. Can be "Proactive" or "Opportunistic". Defaults
+                    to Proactive.
+                  type: string
+              type: object
+            type:
+              description: Type of the update strategy. Can be "RollingUpdate". Defaults
+                to RollingUpdate.
+              type: string
           type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
@@ -17708,6 +20686,21 @@ var CRDsValidation map[string]string = map[string]string{
                           format: int32
                           type: integer
                       type: object
+                    memory:
+                      description: LiveUpdateMemory holds hotplug configuration for
+                        the memory resource. Empty struct indicates that default will
+                        be used for maxGuest. Default is specified on cluster level.
+                        Absence of the struct means opt-out from memory hotplug functionality.
+                      properties:
+                        maxGuest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: MaxGuest defines the maximum amount memory
+                            that can be allocated to the guest using hotplug.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                      type: object
                   type: object
                 preference:
                   description: PreferenceMatcher references a set of preference that
@@ -19189,6 +22182,43 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO mode should be used. Supported values
                                           are: native, default, threads.'
                                         type: string
+                                      ioTune:
+                                        description: IOTune specifies I/O throttling
+                                          limits for the disk. With the VMLiveUpdateFeatures
+                                          feature gate enabled the limits can be changed
+                                          on a running VMI.
+                                        properties:
+                                          readBytesSec:
+                                            description: ReadBytesSec is the read
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          readIopsSec:
+                                            description: ReadIopsSec is the read I/O
+                                              operations per second limit.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec is the total
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          totalIopsSec:
+                                            description: TotalIopsSec is the total
+                                              I/O operations per second limit.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec is the write
+                                              throughput limit in bytes per second.
+                                            format: int64
+                                            type: integer
+                                          writeIopsSec:
+                                            description: WriteIopsSec is the write
+                                              I/O operations per second limit.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -19347,6 +22377,76 @@ var CRDsValidation map[string]string = map[string]string{
                                           value is required to be unique across all
                                           devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: Bandwidth specifies the inbound
+                                          and outbound traffic limits of the interface.
+                                          With the VMLiveUpdateFeatures feature gate
+                                          enabled the limits can be changed on a running
+                                          VMI.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate in kilobytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kilobytes that can be sent in a
+                                                  single burst at peak speed.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: Peak is the maximum rate
+                                                  at which the interface can send
+                                                  data in kilobytes per second.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                description: Average is the average
+                                                  bit rate in kilobytes per second.
+                                                format: int32
+                                                type: integer
+                                              burst:
+                                                description: Burst is the amount of
+                                                  kilobytes that can be sent in a
+                                                  single burst at peak speed.
+                                                format: int32
+                                                type: integer
+                                              peak:
+                                                description: Peak is the maximum rate
+                                                  at which the interface can send
+                                                  data in kilobytes per second.
+                                                format: int32
+                                                type: integer
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
+                                      binding:
+                                        description: Binding specifies the binding
+                                          plugin that will be used to connect the
+                                          interface to the guest. It provides an alternative
+                                          to InterfaceBindingMethod.
+                                        properties:
+                                          name:
+                                            description: Name references to the binding
+                                              name as defined in the KubeVirt CR network
+                                              configuration.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
                                       bootOrder:
                                         description: BootOrder is an integer value
                                           > 0, used to determine ordering of boot
@@ -19368,6 +22468,50 @@ var CRDsValidation map[string]string = map[string]string{
                                             description: If specified will pass option
                                               67 to interface's DHCP server
                                             type: string
+                                          ipv6:
+                                            description: IPv6 configures the DHCPv6
+                                              server and the router advertisements
+                                              of the interface.
+                                            properties:
+                                              dnsServers:
+                                                description: If specified will pass
+                                                  the configured IPv6 DNS servers
+                                                  to the VM via DHCPv6 option 23.
+                                                items:
+                                                  type: string
+                                                type: array
+                                              routerAdvertisements:
+                                                description: If set to true, router
+                                                  advertisements are sent to the VM,
+                                                  providing its default route. Supported
+                                                  only on masquerade interfaces. Requires
+                                                  the Root feature gate, since a non-root
+                                                  virt-launcher lacks the NET_RAW
+                                                  capability.
+                                                type: boolean
+                                              searchDomains:
+                                                description: If specified will pass
+                                                  the configured domain search list
+                                                  to the VM via DHCPv6 option 24.
+                                                  Defaults to the search domains of
+                                                  the pod.
+                                                items:
+                                                  type: string
+                                                type: array
+                                            type: object
+                                          ipxeBootFileName:
+                                            description: If specified will pass option
+                                              67 with this value instead of BootFileName
+                                              to clients identifying themselves as
+                                              iPXE by the user class option 77. Allows
+                                              chainloading an iPXE script after booting
+                                              iPXE over the network.
+                                            type: string
+                                          leaseTime:
+                                            description: If specified will be used
+                                              as the lease time of the DHCP and DHCPv6
+                                              servers. Defaults to an infinite lease.
+                                            type: string
                                           ntpServers:
                                             description: If specified will pass the
                                               configured NTP server to the VM via
@@ -19396,11 +22540,110 @@ var CRDsValidation map[string]string = map[string]string{
                                               - value
                                               type: object
                                             type: array
+                                          routes:
+                                            description: If specified will pass the
+                                              given static routes to the VM via DHCP
+                                              option 121, in addition to the routes
+                                              of the pod network.
+                                            items:
+                                              description: DHCPRoute defines a static
+                                                route passed to the VM via DHCP option
+                                                121.
+                                              properties:
+                                                destination:
+                                                  description: Destination is the
+                                                    IPv4 CIDR of the route, e.g. 10.10.0.0/16.
+                                                  type: string
+                                                gateway:
+                                                  description: Gateway is the IPv4
+                                                    address of the next hop of the
+                                                    route.
+                                                  type: string
+                                              required:
+                                              - destination
+                                              - gateway
+                                              type: object
+                                            type: array
                                           tftpServerName:
                                             description: If specified will pass option
                                               66 to interface's DHCP server
                                             type: string
                                         type: object
+                                      filter:
+                                        description: Filter specifies the traffic
+                                          allowed to and from the guest on the interface.
+                                          It is only supported with the bridge binding
+                                          method.
+                                        properties:
+                                          antiSpoofing:
+                                            description: AntiSpoofing drops the traffic
+                                              sent by the guest with a source MAC
+                                              or IPv4 address other than the ones
+                                              of the interface. Defaults to true.
+                                            type: boolean
+                                          egress:
+                                            description: Egress lists the traffic
+                                              the guest is allowed to send. Any other
+                                              traffic is dropped, unless the list
+                                              is empty.
+                                            items:
+                                              description: InterfaceFilterRule allows
+                                                the traffic matching all of its fields.
+                                              properties:
+                                                cidr:
+                                                  description: 'CIDR of the remote
+                                                    peer. For example: 10.0.0.0/8
+                                                    or fd10::/64. Matches any peer
+                                                    when empty.'
+                                                  type: string
+                                                ports:
+                                                  description: Destination ports of
+                                                    the traffic, each 0 < x < 65536.
+                                                    Requires the TCP or UDP protocol.
+                                                    Matches any port when empty.
+                                                  items:
+                                                    format: int32
+                                                    type: integer
+                                                  type: array
+                                                protocol:
+                                                  description: Protocol of the traffic.
+                                                    Must be TCP, UDP or ICMP. Matches
+                                                    any protocol when empty.
+                                                  type: string
+                                              type: object
+                                            type: array
+                                          ingress:
+                                            description: Ingress lists the traffic
+                                              allowed to reach the guest. Any other
+                                              traffic is dropped, unless the list
+                                              is empty.
+                                            items:
+                                              description: InterfaceFilterRule allows
+                                                the traffic matching all of its fields.
+                                              properties:
+                                                cidr:
+                                                  description: 'CIDR of the remote
+                                                    peer. For example: 10.0.0.0/8
+                                                    or fd10::/64. Matches any peer
+                                                    when empty.'
+                                                  type: string
+                                                ports:
+                                                  description: Destination ports of
+                                                    the traffic, each 0 < x < 65536.
+                                                    Requires the TCP or UDP protocol.
+                                                    Matches any port when empty.
+                                                  items:
+                                                    format: int32
+                                                    type: integer
+                                                  type: array
+                                                protocol:
+                                                  description: Protocol of the traffic.
+                                                    Must be TCP, UDP or ICMP. Matches
+                                                    any protocol when empty.
+                                                  type: string
+                                              type: object
+                                            type: array
+                                        type: object
                                       macAddress:
                                         description: 'Interface MAC address. For example:
                                           de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -19500,6 +22743,21 @@ var CRDsValidation map[string]string = map[string]string{
                                     factors of the VirtualMachineInstance, like the
                                     number of guest CPUs.
                                   type: boolean
+                                panicDevices:
+                                  description: PanicDevices describe devices notifying
+                                    the host when the guest kernel panics.
+                                  items:
+                                    description: PanicDevice notifies the host when
+                                      the guest kernel panics.
+                                    properties:
+                                      model:
+                                        description: 'Model specifies what type of
+                                          panic device is provided. One of: hyperv,
+                                          isa, pvpanic. The model used when it is
+                                          missing depends on the guest architecture.'
+                                        type: string
+                                    type: object
+                                  type: array
                                 rng:
                                   description: Whether to have random number generator
                                     from host
@@ -19523,11 +22781,30 @@ var CRDsValidation map[string]string = map[string]string{
                                 tpm:
                                   description: Whether to emulate a TPM device.
                                   properties:
+                                    encryptionSecretRef:
+                                      description: EncryptionSecretRef references
+                                        a k8s secret whose "key" entry is used to
+                                        encrypt the persistent TPM state.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
                                     persistent:
                                       description: Persistent indicates the state
                                         of the TPM device should be kept accross reboots
                                         Defaults to false
                                       type: boolean
+                                    stateRetentionPolicy:
+                                      description: StateRetentionPolicy defines what
+                                        happens to the persistent TPM state when the
+                                        VM is deleted. Delete removes the state together
+                                        with the VM, Retain keeps it for a later VM
+                                        of the same name. Defaults to Delete
+                                      type: string
                                   type: object
                                 useVirtioTransitional:
                                   description: Fall back to legacy virtio 0.9 support
@@ -19540,15 +22817,43 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Watchdog describes a watchdog device
                                     which can be added to the vmi.
                                   properties:
+                                    diag288:
+                                      description: diag288 watchdog device, available
+                                        on s390x.
+                                      properties:
+                                        action:
+                                          description: The action to take. Valid values
+                                            are poweroff, reset, shutdown, dump. Defaults
+                                            to reset.
+                                          type: string
+                                      type: object
                                     i6300esb:
                                       description: i6300esb watchdog device.
                                       properties:
                                         action:
                                           description: The action to take. Valid values
-                                            are poweroff, reset, shutdown. Defaults
+                                            are poweroff, reset, shutdown, dump. Defaults
+                                            to reset.
+                                          type: string
+                                      type: object
+                                    itco:
+                                      description: iTCO watchdog device built into
+                                        the q35 chipset.
+                                      properties:
+                                        action:
+                                          description: The action to take. Valid values
+                                            are poweroff, reset, shutdown, dump. Defaults
                                             to reset.
                                           type: string
                                       type: object
+                                    memoryDumpClaimName:
+                                      description: MemoryDumpClaimName is the name
+                                        of the PVC the guest memory is dumped to by
+                                        the dump action, before the guest is reset.
+                                        Required by the dump action. The memory is
+                                        only dumped for VMIs owned by a VirtualMachine,
+                                        other VMIs are reset right away.
+                                      type: string
                                     name:
                                       description: Name of the watchdog.
                                       type: string
@@ -19807,12 +23112,33 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: If set, EFI will be used instead
                                         of BIOS.
                                       properties:
+                                        persistent:
+                                          description: If set to true, Persistent
+                                            will persist the EFI NVRAM across reboots
+                                            and live migrations. Defaults to false
+                                          type: boolean
                                         secureBoot:
                                           description: If set, SecureBoot will be
                                             enabled and the OVMF roms will be swapped
                                             for SecureBoot-enabled ones. Requires
                                             SMM to be enabled. Defaults to true
                                           type: boolean
+                                        secureBootKeysSecretRef:
+                                          description: SecureBootKeysSecretRef references
+                                            a k8s secret whose "PK", "KEK" and "db"
+                                            entries hold PEM encoded X.509 certificates
+                                            to enroll in place of the default Secure
+                                            Boot keys. The keys are enrolled when
+                                            the NVRAM is initialized. Requires SecureBoot
+                                            to be enabled.
+                                          properties:
+                                            name:
+                                              description: 'Name of the referent.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                TODO: Add other useful fields. apiVersion,
+                                                kind, uid?'
+                                              type: string
+                                          type: object
                                       type: object
                                   type: object
                                 kernelBoot:
@@ -19899,6 +23225,28 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Base64 encoded session blob.
                                       type: string
                                   type: object
+                                snp:
+                                  description: AMD Secure Encrypted Virtualization
+                                    with Secure Nested Paging (SEV-SNP).
+                                  properties:
+                                    policy:
+                                      description: 'Guest policy flags as defined
+                                        in AMD SEV-SNP firmware ABI specification.
+                                        Note: due to security reasons it is not allowed
+                                        to enable guest debugging. Therefore the debug
+                                        flag is not exposed to users and is always
+                                        false.'
+                                      properties:
+                                        smt:
+                                          description: Allow the guest to run on hosts
+                                            with simultaneous multithreading (SMT)
+                                            enabled. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                  type: object
+                                tdx:
+                                  description: Intel Trust Domain Extensions (TDX).
+                                  type: object
                               type: object
                             machine:
                               description: Machine type.
@@ -19912,6 +23260,29 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Memory allow specifying the VMI memory
                                 features.
                               properties:
+                                balloon:
+                                  description: Balloon enables a free memory policy
+                                    which inflates the memory balloon of idle guests
+                                    and deflates it when the guest is under memory
+                                    pressure. It requires the memory balloon device
+                                    and its statistics to be enabled.
+                                  properties:
+                                    freeMemoryPercent:
+                                      description: FreeMemoryPercent is the percentage
+                                        of its memory the policy keeps free inside
+                                        the guest. Defaults to 20.
+                                      format: int32
+                                      type: integer
+                                    minGuest:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: MinGuest is the lowest amount of
+                                        memory the balloon can leave to the guest.
+                                        Defaults to half of the guest memory.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  type: object
                                 guest:
                                   anyOf:
                                   - type: integer
@@ -19934,6 +23305,33 @@ var CRDsValidation map[string]string = map[string]string{
                                         are 1Gi and 2Mi.
                                       type: string
                                   type: object
+                                maxGuest:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: MaxGuest allows to specify the maximum
+                                    amount of memory which is visible inside the Guest
+                                    OS. The delta between MaxGuest and Guest is the
+                                    amount of memory that can be hotplugged.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                              type: object
+                            onCrash:
+                              description: OnCrash defines what happens when the guest
+                                kernel panics. Requires a panic device.
+                              properties:
+                                action:
+                                  description: 'Action taken when the guest kernel
+                                    panics. One of: Restart, Pause, MemoryDump. MemoryDump
+                                    leaves the guest paused once its memory is dumped.
+                                    Defaults to Pause.'
+                                  type: string
+                                memoryDumpClaimName:
+                                  description: MemoryDumpClaimName is the name of
+                                    the PVC the guest memory is dumped to by the MemoryDump
+                                    action. The memory is only dumped for VMIs owned
+                                    by a VirtualMachine.
+                                  type: string
                               type: object
                             resources:
                               description: Resources describes the Compute Resources
@@ -19957,6 +23355,12 @@ var CRDsValidation map[string]string = map[string]string{
                                     limit. This can lead to crashes if all memory
                                     is in use on a node. Defaults to false.
                                   type: boolean
+                                overcommitWithKSM:
+                                  description: OvercommitWithKSM schedules the VMI
+                                    on a KSM-enabled node and lowers its memory request
+                                    by the memory overcommit these nodes report. Defaults
+                                    to false.
+                                  type: boolean
                                 requests:
                                   additionalProperties:
                                     anyOf:
@@ -21038,12 +24442,20 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes
             it through the scale subresource.
           type: string
+        outdatedReplicas:
+          description: Number of VMs which still run an older pool template.
+          format: int32
+          type: integer
         readyReplicas:
           format: int32
           type: integer
         replicas:
           format: int32
           type: integer
+        updatedReplicas:
+          description: Number of VMs which run the current pool template.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -21307,10 +24719,25 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredTPM optionally defines the preferred TPM device
                 to be used.
               properties:
+                encryptionSecretRef:
+                  description: EncryptionSecretRef references a k8s secret whose "key"
+                    entry is used to encrypt the persistent TPM state.
+                  properties:
+                    name:
+                      description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                        TODO: Add other useful fields. apiVersion, kind, uid?'
+                      type: string
+                  type: object
                 persistent:
                   description: Persistent indicates the state of the TPM device should
                     be kept accross reboots Defaults to false
                   type: boolean
+                stateRetentionPolicy:
+                  description: StateRetentionPolicy defines what happens to the persistent
+                    TPM state when the VM is deleted. Delete removes the state together
+                    with the VM, Retain keeps it for a later VM of the same name.
+                    Defaults to Delete
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -21632,6 +25059,19 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: NewMacAddresses manually sets the target interfaces' mac addresses
+            when restoring into a new VM. The key is the interface name and the value
+            is the new mac address. Interfaces that are not included in this map get
+            a new MAC address generated automatically.
+          type: object
+        newSMBiosSerial:
+          description: NewSMBiosSerial manually sets the target's SMbios serial when
+            restoring into a new VM. If this field is not specified, a new serial
+            will be generated automatically.
+          type: string
         patches:
           description: "If the target for the restore does not exist, it will be created.
             Patches holds JSON patches that would be applied to the target manifest
@@ -21660,6 +25100,13 @@ var CRDsValidation map[string]string = map[string]string{
           - kind
           - name
           type: object
+        targetNamespace:
+          description: TargetNamespace is the namespace the target is restored into.
+            Defaults to the namespace of the VirtualMachineRestore. When set to another
+            namespace the target must not exist, and the restored PVCs reference the
+            VolumeSnapshots across namespaces, which requires the CrossNamespaceVolumeDataSource
+            feature and a ReferenceGrant in the namespace of the VirtualMachineRestore.
+          type: string
         virtualMachineSnapshotName:
           type: string
       required:
@@ -22694,6 +26141,22 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
+                        memory:
+                          description: LiveUpdateMemory holds hotplug configuration
+                            for the memory resource. Empty struct indicates that default
+                            will be used for maxGuest. Default is specified on cluster
+                            level. Absence of the struct means opt-out from memory
+                            hotplug functionality.
+                          properties:
+                            maxGuest:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              description: MaxGuest defines the maximum amount memory
+                                that can be allocated to the guest using hotplug.
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                          type: object
                       type: object
                     preference:
                       description: PreferenceMatcher references a set of preference
@@ -24256,6 +27719,45 @@ var CRDsValidation map[string]string = map[string]string{
                                               disk IO mode should be used. Supported
                                               values are: native, default, threads.'
                                             type: string
+                                          ioTune:
+                                            description: IOTune specifies I/O throttling
+                                              limits for the disk. With the VMLiveUpdateFeatures
+                                              feature gate enabled the limits can
+                                              be changed on a running VMI.
+                                            properties:
+                                              readBytesSec:
+                                                description: ReadBytesSec is the read
+                                                  throughput limit in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              readIopsSec:
+                                                description: ReadIopsSec is the read
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec is the
+                                                  total throughput limit in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              totalIopsSec:
+                                                description: TotalIopsSec is the total
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec is the
+                                                  write throughput limit in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeIopsSec:
+                                                description: WriteIopsSec is the write
+                                                  I/O operations per second limit.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -24422,6 +27924,78 @@ var CRDsValidation map[string]string = map[string]string{
                                               be unique across all devices and be
                                               between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: Bandwidth specifies the inbound
+                                              and outbound traffic limits of the interface.
+                                              With the VMLiveUpdateFeatures feature
+                                              gate enabled the limits can be changed
+                                              on a running VMI.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate in kilobytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kilobytes that can be sent
+                                                      in a single burst at peak speed.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: Peak is the maximum
+                                                      rate at which the interface
+                                                      can send data in kilobytes per
+                                                      second.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    description: Average is the average
+                                                      bit rate in kilobytes per second.
+                                                    format: int32
+                                                    type: integer
+                                                  burst:
+                                                    description: Burst is the amount
+                                                      of kilobytes that can be sent
+                                                      in a single burst at peak speed.
+                                                    format: int32
+                                                    type: integer
+                                                  peak:
+                                                    description: Peak is the maximum
+                                                      rate at which the interface
+                                                      can send data in kilobytes per
+                                                      second.
+                                                    format: int32
+                                                    type: integer
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
+                                          binding:
+                                            description: Binding specifies the binding
+                                              plugin that will be used to connect
+                                              the interface to the guest. It provides
+                                              an alternative to InterfaceBindingMethod.
+                                            properties:
+                                              name:
+                                                description: Name references to the
+                                                  binding name as defined in the KubeVirt
+                                                  CR network configuration.
+                                                type: string
+                                            required:
+                                            - name
+                                            type: object
                                           bootOrder:
                                             description: BootOrder is an integer value
                                               > 0, used to determine ordering of boot
@@ -24443,6 +28017,54 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: If specified will pass
                                                   option 67 to interface's DHCP server
                                                 type: string
+                                              ipv6:
+                                                description: IPv6 configures the DHCPv6
+                                                  server and the router advertisements
+                                                  of the interface.
+                                                properties:
+                                                  dnsServers:
+                                                    description: If specified will
+                                                      pass the configured IPv6 DNS
+                                                      servers to the VM via DHCPv6
+                                                      option 23.
+                                                    items:
+                                                      type: string
+                                                    type: array
+                                                  routerAdvertisements:
+                                                    description: If set to true, router
+                                                      advertisements are sent to the
+                                                      VM, providing its default route.
+                                                      Supported only on masquerade
+                                                      interfaces. Requires the Root
+                                                      feature gate, since a non-root
+                                                      virt-launcher lacks the NET_RAW
+                                                      capability.
+                                                    type: boolean
+                                                  searchDomains:
+                                                    description: If specified will
+                                                      pass the configured domain search
+                                                      list to the VM via DHCPv6 option
+                                                      24. Defaults to the search domains
+                                                      of the pod.
+                                                    items:
+                                                      type: string
+                                                    type: array
+                                                type: object
+                                              ipxeBootFileName:
+                                                description: If specified will pass
+                                                  option 67 with this value instead
+                                                  of BootFileName to clients identifying
+                                                  themselves as iPXE by the user class
+                                                  option 77. Allows chainloading an
+                                                  iPXE script after booting iPXE over
+                                                  the network.
+                                                type: string
+                                              leaseTime:
+                                                description: If specified will be
+                                                  used as the lease time of the DHCP
+                                                  and DHCPv6 servers. Defaults to
+                                                  an infinite lease.
+                                                type: string
                                               ntpServers:
                                                 description: If specified will pass
                                                   the configured NTP server to the
@@ -24472,11 +28094,118 @@ var CRDsValidation map[string]string = map[string]string{
                                                   - value
                                                   type: object
                                                 type: array
+                                              routes:
+                                                description: If specified will pass
+                                                  the given static routes to the VM
+                                                  via DHCP option 121, in addition
+                                                  to the routes of the pod network.
+                                                items:
+                                                  description: DHCPRoute defines a
+                                                    static route passed to the VM
+                                                    via DHCP option 121.
+                                                  properties:
+                                                    destination:
+                                                      description: Destination is
+                                                        the IPv4 CIDR of the route,
+                                                        e.g. 10.10.0.0/16.
+                                                      type: string
+                                                    gateway:
+                                                      description: Gateway is the
+                                                        IPv4 address of the next hop
+                                                        of the route.
+                                                      type: string
+                                                  required:
+                                                  - destination
+                                                  - gateway
+                                                  type: object
+                                                type: array
                                               tftpServerName:
                                                 description: If specified will pass
                                                   option 66 to interface's DHCP server
                                                 type: string
                                             type: object
+                                          filter:
+                                            description: Filter specifies the traffic
+                                              allowed to and from the guest on the
+                                              interface. It is only supported with
+                                              the bridge binding method.
+                                            properties:
+                                              antiSpoofing:
+                                                description: AntiSpoofing drops the
+                                                  traffic sent by the guest with a
+                                                  source MAC or IPv4 address other
+                                                  than the ones of the interface.
+                                                  Defaults to true.
+                                                type: boolean
+                                              egress:
+                                                description: Egress lists the traffic
+                                                  the guest is allowed to send. Any
+                                                  other traffic is dropped, unless
+                                                  the list is empty.
+                                                items:
+                                                  description: InterfaceFilterRule
+                                                    allows the traffic matching all
+                                                    of its fields.
+                                                  properties:
+                                                    cidr:
+                                                      description: 'CIDR of the remote
+                                                        peer. For example: 10.0.0.0/8
+                                                        or fd10::/64. Matches any
+                                                        peer when empty.'
+                                                      type: string
+                                                    ports:
+                                                      description: Destination ports
+                                                        of the traffic, each 0 < x
+                                                        < 65536. Requires the TCP
+                                                        or UDP protocol. Matches any
+                                                        port when empty.
+                                                      items:
+                                                        format: int32
+                                                        type: integer
+                                                      type: array
+                                                    protocol:
+                                                      description: Protocol of the
+                                                        traffic. Must be TCP, UDP
+                                                        or ICMP. Matches any protocol
+                                                        when empty.
+                                                      type: string
+                                                  type: object
+                                                type: array
+                                              ingress:
+                                                description: Ingress lists the traffic
+                                                  allowed to reach the guest. Any
+                                                  other traffic is dropped, unless
+                                                  the list is empty.
+                                                items:
+                                                  description: InterfaceFilterRule
+                                                    allows the traffic matching all
+                                                    of its fields.
+                                                  properties:
+                                                    cidr:
+                                                      description: 'CIDR of the remote
+                                                        peer. For example: 10.0.0.0/8
+                                                        or fd10::/64. Matches any
+                                                        peer when empty.'
+                                                      type: string
+                                                    ports:
+                                                      description: Destination ports
+                                                        of the traffic, each 0 < x
+                                                        < 65536. Requires the TCP
+                                                        or UDP protocol. Matches any
+                                                        port when empty.
+                                                      items:
+                                                        format: int32
+                                                        type: integer
+                                                      type: array
+                                                    protocol:
+                                                      description: Protocol of the
+                                                        traffic. Must be TCP, UDP
+                                                        or ICMP. Matches any protocol
+                                                        when empty.
+                                                      type: string
+                                                  type: object
+                                                type: array
+                                            type: object
                                           macAddress:
                                             description: 'Interface MAC address. For
                                               example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
@@ -24581,6 +28310,22 @@ var CRDsValidation map[string]string = map[string]string{
                                         factors of the VirtualMachineInstance, like
                                         the number of guest CPUs.
                                       type: boolean
+                                    panicDevices:
+                                      description: PanicDevices describe devices notifying
+                                        the host when the guest kernel panics.
+                                      items:
+                                        description: PanicDevice notifies the host
+                                          when the guest kernel panics.
+                                        properties:
+                                          model:
+                                            description: 'Model specifies what type
+                                              of panic device is provided. One of:
+                                              hyperv, isa, pvpanic. The model used
+                                              when it is missing depends on the guest
+                                              architecture.'
+                                            type: string
+                                        type: object
+                                      type: array
                                     rng:
                                       description: Whether to have random number generator
                                         from host
@@ -24604,11 +28349,31 @@ var CRDsValidation map[string]string = map[string]string{
                                     tpm:
                                       description: Whether to emulate a TPM device.
                                       properties:
+                                        encryptionSecretRef:
+                                          description: EncryptionSecretRef references
+                                            a k8s secret whose "key" entry is used
+                                            to encrypt the persistent TPM state.
+                                          properties:
+                                            name:
+                                              description: 'Name of the referent.
+                                                More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                TODO: Add other useful fields. apiVersion,
+                                                kind, uid?'
+                                              type: string
+                                          type: object
                                         persistent:
                                           description: Persistent indicates the state
                                             of the TPM device should be kept accross
                                             reboots Defaults to false
                                           type: boolean
+                                        stateRetentionPolicy:
+                                          description: StateRetentionPolicy defines
+                                            what happens to the persistent TPM state
+                                            when the VM is deleted. Delete removes
+                                            the state together with the VM, Retain
+                                            keeps it for a later VM of the same name.
+                                            Defaults to Delete
+                                          type: string
                                       type: object
                                     useVirtioTransitional:
                                       description: Fall back to legacy virtio 0.9
@@ -24621,6 +28386,16 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Watchdog describes a watchdog device
                                         which can be added to the vmi.
                                       properties:
+                                        diag288:
+                                          description: diag288 watchdog device, available
+                                            on s390x.
+                                          properties:
+                                            action:
+                                              description: The action to take. Valid
+                                                values are poweroff, reset, shutdown,
+                                                dump. Defaults to reset.
+                                              type: string
+                                          type: object
                                         i6300esb:
                                           description: i6300esb watchdog device.
                                           properties:
@@ -24630,6 +28405,25 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Defaults to reset.
                                               type: string
                                           type: object
+                                        itco:
+                                          description: iTCO watchdog device built
+                                            into the q35 chipset.
+                                          properties:
+                                            action:
+                                              description: The action to take. Valid
+                                                values are poweroff, reset, shutdown,
+                                                dump. Defaults to reset.
+                                              type: string
+                                          type: object
+                                        memoryDumpClaimName:
+                                          description: MemoryDumpClaimName is the
+                                            name of the PVC the guest memory is dumped
+                                            to by the dump action, before the guest
+                                            is reset. Required by the dump action.
+                                            The memory is only dumped for VMIs owned
+                                            by a VirtualMachine, other VMIs are reset
+                                            right away.
+                                          type: string
                                         name:
                                           description: Name of the watchdog.
                                           type: string
@@ -24897,6 +28691,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: If set, EFI will be used instead
                                             of BIOS.
                                           properties:
+                                            persistent:
+                                              description: If set to true, Persistent
+                                                will persist the EFI NVRAM across
+                                                reboots and live migrations. Defaults
+                                                to false
+                                              type: boolean
                                             secureBoot:
                                               description: If set, SecureBoot will
                                                 be enabled and the OVMF roms will
@@ -24904,6 +28704,23 @@ var CRDsValidation map[string]string = map[string]string{
                                                 ones. Requires SMM to be enabled.
                                                 Defaults to true
                                               type: boolean
+                                            secureBootKeysSecretRef:
+                                              description: SecureBootKeysSecretRef
+                                                references a k8s secret whose "PK",
+                                                "KEK" and "db" entries hold PEM encoded
+                                                X.509 certificates to enroll in place
+                                                of the default Secure Boot keys. The
+                                                keys are enrolled when the NVRAM is
+                                                initialized. Requires SecureBoot to
+                                                be enabled.
+                                              properties:
+                                                name:
+                                                  description: 'Name of the referent.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                    TODO: Add other useful fields.
+                                                    apiVersion, kind, uid?'
+                                                  type: string
+                                              type: object
                                           type: object
                                       type: object
                                     kernelBoot:
@@ -24992,6 +28809,28 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: Base64 encoded session blob.
                                           type: string
                                       type: object
+                                    snp:
+                                      description: AMD Secure Encrypted Virtualization
+                                        with Secure Nested Paging (SEV-SNP).
+                                      properties:
+                                        policy:
+                                          description: 'Guest policy flags as defined
+                                            in AMD SEV-SNP firmware ABI specification.
+                                            Note: due to security reasons it is not
+                                            allowed to enable guest debugging. Therefore
+                                            the debug flag is not exposed to users
+                                            and is always false.'
+                                          properties:
+                                            smt:
+                                              description: Allow the guest to run
+                                                on hosts with simultaneous multithreading
+                                                (SMT) enabled. Defaults to true.
+                                              type: boolean
+                                          type: object
+                                      type: object
+                                    tdx:
+                                      description: Intel Trust Domain Extensions (TDX).
+                                      type: object
                                   type: object
                                 machine:
                                   description: Machine type.
@@ -25005,6 +28844,29 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Memory allow specifying the VMI memory
                                     features.
                                   properties:
+                                    balloon:
+                                      description: Balloon enables a free memory policy
+                                        which inflates the memory balloon of idle
+                                        guests and deflates it when the guest is under
+                                        memory pressure. It requires the memory balloon
+                                        device and its statistics to be enabled.
+                                      properties:
+                                        freeMemoryPercent:
+                                          description: FreeMemoryPercent is the percentage
+                                            of its memory the policy keeps free inside
+                                            the guest. Defaults to 20.
+                                          format: int32
+                                          type: integer
+                                        minGuest:
+                                          anyOf:
+                                          - type: integer
+                                          - type: string
+                                          description: MinGuest is the lowest amount
+                                            of memory the balloon can leave to the
+                                            guest. Defaults to half of the guest memory.
+                                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                          x-kubernetes-int-or-string: true
+                                      type: object
                                     guest:
                                       anyOf:
                                       - type: integer
@@ -25028,6 +28890,34 @@ var CRDsValidation map[string]string = map[string]string{
                                             are 1Gi and 2Mi.
                                           type: string
                                       type: object
+                                    maxGuest:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: MaxGuest allows to specify the
+                                        maximum amount of memory which is visible
+                                        inside the Guest OS. The delta between MaxGuest
+                                        and Guest is the amount of memory that can
+                                        be hotplugged.
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                  type: object
+                                onCrash:
+                                  description: OnCrash defines what happens when the
+                                    guest kernel panics. Requires a panic device.
+                                  properties:
+                                    action:
+                                      description: 'Action taken when the guest kernel
+                                        panics. One of: Restart, Pause, MemoryDump.
+                                        MemoryDump leaves the guest paused once its
+                                        memory is dumped. Defaults to Pause.'
+                                      type: string
+                                    memoryDumpClaimName:
+                                      description: MemoryDumpClaimName is the name
+                                        of the PVC the guest memory is dumped to by
+                                        the MemoryDump action. The memory is only
+                                        dumped for VMIs owned by a VirtualMachine.
+                                      type: string
                                   type: object
                                 resources:
                                   description: Resources describes the Compute Resources
@@ -25052,6 +28942,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         all memory is in use on a node. Defaults to
                                         false.
                                       type: boolean
+                                    overcommitWithKSM:
+                                      description: OvercommitWithKSM schedules the
+                                        VMI on a KSM-enabled node and lowers its memory
+                                        request by the memory overcommit these nodes
+                                        report. Defaults to false.
+                                      type: boolean
                                     requests:
                                       additionalProperties:
                                         anyOf:
@@ -26430,6 +30326,43 @@ var CRDsValidation map[string]string = map[string]string{
                                       mode should be used. Supported values are: native,
                                       default, threads.'
                                     type: string
+                                  ioTune:
+                                    description: IOTune specifies I/O throttling limits
+                                      for the disk. With the VMLiveUpdateFeatures
+                                      feature gate enabled the limits can be changed
+                                      on a running VMI.
+                                    properties:
+                                      readBytesSec:
+                                        description: ReadBytesSec is the read throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readIopsSec:
+                                        description: ReadIopsSec is the read I/O operations
+                                          per second limit.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec is the total throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalIopsSec:
+                                        description: TotalIopsSec is the total I/O
+                                          operations per second limit.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec is the write throughput
+                                          limit in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeIopsSec:
+                                        description: WriteIopsSec is the write I/O
+                                          operations per second limit.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -26860,5 +30793,298 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotgroup": `openAPIV3Schema:
+  description: VirtualMachineSnapshotGroup defines the operation of snapshotting several
+    VMs at the same instant
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is passed on to every VirtualMachineSnapshot
+            of the group
+          type: string
+        failureDeadline:
+          description: This time represents the number of seconds we permit the group
+            snapshot to take, it is passed on to every VirtualMachineSnapshot of the
+            group. In case we pass this deadline the whole group is rolled back and
+            marked as failed.
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines in the namespace of the
+            group that are snapshotted together
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        freezeTime:
+          description: FreezeTime is the time at which all the members of the group
+            were frozen
+          format: date-time
+          nullable: true
+          type: string
+        members:
+          description: Members lists the VirtualMachines of the group and their VirtualMachineSnapshots
+          items:
+            description: VirtualMachineSnapshotGroupMember links a VirtualMachine
+              of the group to its VirtualMachineSnapshot
+            properties:
+              virtualMachineName:
+                type: string
+              virtualMachineSnapshotName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        readyToUse:
+          type: boolean
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule defines a recurring snapshot of the
+    VMs matching a label selector
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        disabled:
+          description: Disabled suspends the creation of new snapshots, retention
+            is still applied
+          type: boolean
+        failureDeadline:
+          description: FailureDeadline is passed on to every VirtualMachineSnapshot
+            created by the schedule
+          type: string
+        retention:
+          description: Retention defines which snapshots created by the schedule are
+            kept
+          properties:
+            expires:
+              description: Expires is the duration after which a snapshot is deleted
+              type: string
+            maxCount:
+              description: MaxCount is the number of ready snapshots kept per VirtualMachine,
+                older ones are deleted, failed snapshots do not count towards it
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: Schedule is a cron expression (e.g. "0 2 * * *") describing
+            when snapshots are taken
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines in the namespace of the
+            schedule that are snapshotted
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        lastFailure:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+        lastSuccessTime:
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
@@ -41,11 +41,14 @@ const (
 	VMInstancesGuestOSInfo = "virtualmachineinstances/guestosinfo"
 	VMInstancesFileSysList = "virtualmachineinstances/filesystemlist"
 	VMInstancesUserList    = "virtualmachineinstances/userlist"
+	VMInstancesCPULoad     = "virtualmachineinstances/cpuload"
 
 	VMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	VMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	VMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	VMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
+
+	VMInstancesAttestationReport = "virtualmachineinstances/attestationreport"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -152,8 +155,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesCPULoad,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
+					VMInstancesAttestationReport,
 				},
 				Verbs: []string{
 					"get",
@@ -242,6 +247,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinebackups",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -289,6 +297,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinepools/scale",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -335,8 +344,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesCPULoad,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
+					VMInstancesAttestationReport,
 				},
 				Verbs: []string{
 					"get",
@@ -425,6 +436,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinebackups",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -472,6 +486,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinepools/scale",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -526,8 +541,10 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesCPULoad,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
+					VMInstancesAttestationReport,
 				},
 				Verbs: []string{
 					"get",
@@ -567,6 +584,9 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
 					"virtualmachinerestores",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinesnapshotgroups",
+					"virtualmachinebackups",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -614,6 +634,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinepools/scale",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
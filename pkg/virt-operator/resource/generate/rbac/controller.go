@@ -375,11 +375,24 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/sev/setupsession",
 					"virtualmachineinstances/sev/injectlaunchsecret",
+					"virtualmachineinstances/backup",
+					"virtualmachineinstances/abortbackup",
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					"subresources.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachineinstances/cpuload",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"cdi.kubevirt.io",
@@ -493,6 +506,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceMigrationPolicies + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
@@ -43,6 +43,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/network/macpool"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -110,6 +111,21 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if ksmConfig := newKV.Spec.Configuration.KSMConfiguration; ksmConfig != nil {
+		results = append(results,
+			validateKSMTuning(field.NewPath("spec").Child("configuration", "ksmConfiguration", "tuning"), ksmConfig.Tuning)...)
+	}
+
+	results = append(results,
+		validateVMStateStorageAccessMode(field.NewPath("spec").Child("configuration", "vmStateStorageAccessMode"), newKV.Spec.Configuration.VMStateStorageAccessMode)...)
+
+	if networkConfig := newKV.Spec.Configuration.NetworkConfiguration; networkConfig != nil {
+		results = append(results,
+			validateNetworkBindings(field.NewPath("spec").Child("configuration", "network", "binding"), networkConfig.Binding)...)
+		results = append(results,
+			validateMacAddressPool(field.NewPath("spec").Child("configuration", "network", "macAddressPool"), networkConfig.MacAddressPool)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -431,6 +447,93 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateKSMTuning(field *field.Path, tuning *v1.KSMTuning) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	if tuning == nil {
+		return statuses
+	}
+
+	if tuning.PagesToScanMin != nil && tuning.PagesToScanMax != nil && *tuning.PagesToScanMin > *tuning.PagesToScanMax {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("pagesToScanMin").String(),
+			Message: fmt.Sprintf("%s cannot be greater than %s", field.Child("pagesToScanMin").String(), field.Child("pagesToScanMax").String()),
+		})
+	}
+
+	if tuning.FreeMemoryThresholdPercent != nil && *tuning.FreeMemoryThresholdPercent > 100 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("freeMemoryThresholdPercent").String(),
+			Message: fmt.Sprintf("%s must be between 0 and 100", field.Child("freeMemoryThresholdPercent").String()),
+		})
+	}
+
+	return statuses
+}
+
+func validateVMStateStorageAccessMode(field *field.Path, accessMode corev1.PersistentVolumeAccessMode) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	switch accessMode {
+	case "", corev1.ReadWriteMany, corev1.ReadWriteOnce:
+	default:
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s must be either %s or %s", field.String(), corev1.ReadWriteMany, corev1.ReadWriteOnce),
+		})
+	}
+
+	return statuses
+}
+
+func validateNetworkBindings(field *field.Path, bindings map[string]v1.InterfaceBindingPlugin) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	for name, binding := range bindings {
+		bindingField := field.Key(name)
+		switch binding.DomainAttachmentType {
+		case "", v1.Tap:
+		default:
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   bindingField.Child("domainAttachmentType").String(),
+				Message: fmt.Sprintf("%s must be %s", bindingField.Child("domainAttachmentType").String(), v1.Tap),
+			})
+		}
+		if binding.SidecarImage == "" && binding.DomainAttachmentType == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   bindingField.String(),
+				Message: fmt.Sprintf("%s must specify a sidecarImage or a domainAttachmentType", bindingField.String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateMacAddressPool(field *field.Path, pool *v1.MacAddressPool) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	if pool == nil {
+		return statuses
+	}
+
+	for idx, macRange := range pool.Ranges {
+		rangeField := field.Child("ranges").Index(idx)
+		if _, _, err := macpool.ParseRange(macRange); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   rangeField.String(),
+				Message: fmt.Sprintf("%s is invalid: %v", rangeField.String(), err),
+			})
+		}
+	}
+
+	return statuses
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
@@ -27,6 +27,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -136,6 +137,59 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	DescribeTable("validateKSMTuning", func(tuning *v1.KSMTuning, expectedFields []string) {
+		causes := validateKSMTuning(test, tuning)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without tuning", nil, nil),
+		Entry("with defaults", &v1.KSMTuning{}, nil),
+		Entry("with a valid scanning range", &v1.KSMTuning{
+			PagesToScanMin:             pointer.Uint32(100),
+			PagesToScanMax:             pointer.Uint32(100),
+			FreeMemoryThresholdPercent: pointer.Uint32(100),
+		}, nil),
+		Entry("with a minimum greater than the maximum", &v1.KSMTuning{
+			PagesToScanMin: pointer.Uint32(200),
+			PagesToScanMax: pointer.Uint32(100),
+		}, []string{test.Child("pagesToScanMin").String()}),
+		Entry("with a threshold above 100 percent", &v1.KSMTuning{
+			FreeMemoryThresholdPercent: pointer.Uint32(101),
+		}, []string{test.Child("freeMemoryThresholdPercent").String()}),
+	)
+
+	DescribeTable("validateVMStateStorageAccessMode", func(accessMode corev1.PersistentVolumeAccessMode, expectedCauses int) {
+		causes := validateVMStateStorageAccessMode(test, accessMode)
+		Expect(causes).To(HaveLen(expectedCauses))
+	},
+		Entry("without an access mode", corev1.PersistentVolumeAccessMode(""), 0),
+		Entry("with ReadWriteMany", corev1.ReadWriteMany, 0),
+		Entry("with ReadWriteOnce", corev1.ReadWriteOnce, 0),
+		Entry("with ReadOnlyMany", corev1.ReadOnlyMany, 1),
+	)
+
+	DescribeTable("validateNetworkBindings", func(binding v1.InterfaceBindingPlugin, expectedCauses int) {
+		causes := validateNetworkBindings(test, map[string]v1.InterfaceBindingPlugin{"myplugin": binding})
+		Expect(causes).To(HaveLen(expectedCauses))
+	},
+		Entry("with a sidecar image", v1.InterfaceBindingPlugin{SidecarImage: "registry:5000/plugin:devel"}, 0),
+		Entry("with a tap domain attachment", v1.InterfaceBindingPlugin{DomainAttachmentType: v1.Tap}, 0),
+		Entry("without a sidecar image nor a domain attachment", v1.InterfaceBindingPlugin{NetworkAttachmentDefinition: "plugin-nad"}, 1),
+		Entry("with an unsupported domain attachment", v1.InterfaceBindingPlugin{DomainAttachmentType: "vhostuser"}, 1),
+	)
+
+	DescribeTable("validateMacAddressPool", func(macRange v1.MacAddressRange, expectedCauses int) {
+		causes := validateMacAddressPool(test, &v1.MacAddressPool{Ranges: []v1.MacAddressRange{macRange}})
+		Expect(causes).To(HaveLen(expectedCauses))
+	},
+		Entry("with a valid range", v1.MacAddressRange{Start: "02:00:00:00:00:00", End: "02:00:00:ff:ff:ff"}, 0),
+		Entry("with a single MAC address", v1.MacAddressRange{Start: "02:00:00:00:00:00", End: "02:00:00:00:00:00"}, 0),
+		Entry("with an invalid MAC address", v1.MacAddressRange{Start: "02:00:00:00:00:00", End: "02:00:00"}, 1),
+		Entry("with a start after the end", v1.MacAddressRange{Start: "02:00:00:ff:ff:ff", End: "02:00:00:00:00:00"}, 1),
+	)
+
 	Context("with AdditionalGuestMemoryOverheadRatio", func() {
 		DescribeTable("the ratio must be parsable to float", func(unparsableRatio string) {
 			causes := validateGuestToRequestHeadroom(&unparsableRatio)
@@ -0,0 +1,143 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	createclone "kubevirt.io/kubevirt/pkg/virtctl/create/clone"
+	"kubevirt.io/kubevirt/pkg/virtctl/progress"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_CLONE = "clone"
+
+	cloneKind = "VirtualMachineClone"
+)
+
+type runClone struct {
+	clientConfig clientcmd.ClientConfig
+	newClone     func(cmd *cobra.Command) (*clonev1alpha1.VirtualMachineClone, error)
+	wait         bool
+	timeout      time.Duration
+	output       string
+}
+
+func NewCloneCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := runClone{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   COMMAND_CLONE,
+		Short: "Clone a virtual machine",
+		Long: `Clones a virtual machine or a snapshot into a new virtual machine by creating a VirtualMachineClone.
+The flags are the same as for "create clone", which only prints the manifest.`,
+		Args:    templates.ExactArgs(COMMAND_CLONE, 0),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return c.run(cmd)
+		},
+	}
+	c.newClone = createclone.AddFlags(cmd)
+	cmd.Flags().BoolVar(&c.wait, progress.WaitFlag, false, "Wait until the clone finished and report the changes of its phase and conditions.")
+	cmd.Flags().DurationVar(&c.timeout, progress.TimeoutFlag, progress.DefaultTimeout, "The maximum time to wait for the clone to finish, only used together with --wait.")
+	cmd.Flags().StringVarP(&c.output, progress.OutputFlag, "o", "", "Print the resulting object in the given format. Supported formats: yaml, json.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Clone the virtualmachine 'myvm' into a virtualmachine with a random name:
+  {{ProgramName}} clone --source-name myvm
+
+  # Clone the virtualmachine 'myvm' into the virtualmachine 'myclone' and wait until it is created:
+  {{ProgramName}} clone --source-name myvm --target-name myclone --wait --timeout 10m
+
+  # Clone the snapshot 'mysnapshot' into the virtualmachine 'myclone' and print the resulting VirtualMachineClone:
+  {{ProgramName}} clone --source-name mysnapshot --source-type snapshot --target-name myclone --wait -o yaml`
+}
+
+func (c *runClone) run(cmd *cobra.Command) error {
+	clone, err := c.newClone(cmd)
+	if err != nil {
+		return err
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	clone, err = virtClient.VirtualMachineClone(namespace).Create(context.Background(), clone, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineClone: %v", err)
+	}
+	cmd.Printf("%s %s/%s created\n", cloneKind, namespace, clone.Name)
+
+	if c.wait {
+		err = progress.Wait(cmd.OutOrStdout(), cloneKind, clone.Name, c.timeout, func() (*progress.Status, error) {
+			clone, err = virtClient.VirtualMachineClone(namespace).Get(context.Background(), clone.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return cloneStatus(clone), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return progress.PrintResult(cmd.OutOrStdout(), c.output, clone, cloneSummary(clone))
+}
+
+func cloneStatus(clone *clonev1alpha1.VirtualMachineClone) *progress.Status {
+	status := &progress.Status{
+		Phase:  string(clone.Status.Phase),
+		Done:   clone.Status.Phase == clonev1alpha1.Succeeded,
+		Failed: clone.Status.Phase == clonev1alpha1.Failed,
+	}
+	for _, condition := range clone.Status.Conditions {
+		status.Conditions = append(status.Conditions,
+			progress.FormatCondition(string(condition.Type), string(condition.Status), condition.Reason, condition.Message))
+	}
+	return status
+}
+
+func cloneSummary(clone *clonev1alpha1.VirtualMachineClone) string {
+	summary := fmt.Sprintf("%s %s/%s of %s %s, phase: %s", cloneKind, clone.Namespace, clone.Name,
+		clone.Spec.Source.Kind, clone.Spec.Source.Name, clone.Status.Phase)
+	if clone.Status.TargetName != nil {
+		summary += fmt.Sprintf(", target: %s", *clone.Status.TargetName)
+	}
+	return summary
+}
@@ -0,0 +1,11 @@
+package clone_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestClone(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,115 @@
+package clone_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clone"
+	createclone "kubevirt.io/kubevirt/pkg/virtctl/create/clone"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Clone", func() {
+
+	const (
+		cloneName  = "testclone"
+		sourceName = "testvm"
+		targetName = "targetvm"
+	)
+
+	var kubevirtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClone(k8smetav1.NamespaceDefault).
+			Return(kubevirtClient.CloneV1alpha1().VirtualMachineClones(k8smetav1.NamespaceDefault)).AnyTimes()
+	})
+
+	newCommand := func(args ...string) func() ([]byte, error) {
+		return clientcmd.NewRepeatableVirtctlCommandWithOut(append([]string{clone.COMMAND_CLONE,
+			"--" + createclone.NameFlag, cloneName,
+			"--" + createclone.SourceNameFlag, sourceName,
+			"--" + createclone.TargetNameFlag, targetName,
+		}, args...)...)
+	}
+
+	It("should fail without the name of the source", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(clone.COMMAND_CLONE)
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should create the clone", func() {
+		out, err := newCommand("--"+createclone.SourceTypeFlag, "snapshot")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("VirtualMachineClone default/testclone created"))
+
+		vmClone, err := kubevirtClient.CloneV1alpha1().VirtualMachineClones(k8smetav1.NamespaceDefault).Get(context.Background(), cloneName, k8smetav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmClone.Spec.Source.Kind).To(Equal("VirtualMachineSnapshot"))
+		Expect(vmClone.Spec.Source.Name).To(Equal(sourceName))
+		Expect(vmClone.Spec.Target.Kind).To(Equal("VirtualMachine"))
+		Expect(vmClone.Spec.Target.Name).To(Equal(targetName))
+	})
+
+	Context("with --wait", func() {
+		reportStatus := func(status clonev1alpha1.VirtualMachineCloneStatus) {
+			kubevirtClient.Fake.PrependReactor("get", "virtualmachineclones", func(action testing.Action) (bool, runtime.Object, error) {
+				obj, err := kubevirtClient.Tracker().Get(action.GetResource(), action.GetNamespace(), action.(testing.GetAction).GetName())
+				if err != nil {
+					return true, nil, err
+				}
+				obj.(*clonev1alpha1.VirtualMachineClone).Status = status
+				return true, obj, nil
+			})
+		}
+
+		It("should wait until the clone succeeded and report its progress", func() {
+			reportStatus(clonev1alpha1.VirtualMachineCloneStatus{
+				Phase:      clonev1alpha1.Succeeded,
+				TargetName: pointer.String(targetName),
+				Conditions: []clonev1alpha1.Condition{
+					{Type: clonev1alpha1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "Succeeded", Message: "Clone succeeded"},
+				},
+			})
+
+			out, err := newCommand("--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineClone testclone phase: Succeeded"))
+			Expect(string(out)).To(ContainSubstring("VirtualMachineClone testclone condition: Ready=True (Succeeded: Clone succeeded)"))
+			Expect(string(out)).To(ContainSubstring("VirtualMachineClone default/testclone of VirtualMachine testvm, phase: Succeeded, target: targetvm"))
+		})
+
+		It("should fail if the clone failed", func() {
+			reportStatus(clonev1alpha1.VirtualMachineCloneStatus{Phase: clonev1alpha1.Failed})
+
+			_, err := newCommand("--wait")()
+			Expect(err).To(MatchError("VirtualMachineClone testclone failed"))
+		})
+
+		It("should print the resulting clone", func() {
+			reportStatus(clonev1alpha1.VirtualMachineCloneStatus{Phase: clonev1alpha1.Succeeded})
+
+			out, err := newCommand("--wait", "-o", "yaml")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("phase: Succeeded"))
+			Expect(string(out)).To(ContainSubstring("name: testclone"))
+		})
+	})
+})
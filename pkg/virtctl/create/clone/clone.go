@@ -70,16 +70,34 @@ var optFns = map[string]optionFn{
 }
 
 func NewCommand() *cobra.Command {
-	c := createClone{}
-
 	cmd := &cobra.Command{
 		Use:     Clone,
 		Short:   "Create a clone object manifest",
-		Example: c.usage(),
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			return c.run(cmd)
-		},
+		Example: usage(),
 	}
+	newClone := AddFlags(cmd)
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		clone, err := newClone(cmd)
+		if err != nil {
+			return err
+		}
+
+		cloneBytes, err := yaml.Marshal(clone)
+		if err != nil {
+			return err
+		}
+
+		cmd.Print(string(cloneBytes))
+		return nil
+	}
+
+	return cmd
+}
+
+// AddFlags registers the flags describing a VirtualMachineClone on the given command.
+// The returned function builds the VirtualMachineClone once the flags were parsed.
+func AddFlags(cmd *cobra.Command) func(cmd *cobra.Command) (*clonev1alpha1.VirtualMachineClone, error) {
+	c := createClone{}
 
 	const emptyValue = ""
 	const supportsMultipleFlags = "Can be provided multiple times."
@@ -98,7 +116,7 @@ func NewCommand() *cobra.Command {
 		panic(err)
 	}
 
-	return cmd
+	return c.build
 }
 
 func withNewMacAddresses(c *createClone, cloneSpec *cloneSpec) error {
@@ -126,7 +144,7 @@ func withNewMacAddresses(c *createClone, cloneSpec *cloneSpec) error {
 	return nil
 }
 
-func (c *createClone) usage() string {
+func usage() string {
 	return `  # Create a manifest for a clone with a random name:
   {{ProgramName}} create clone --source-name sourceVM --target-name targetVM
   
@@ -197,34 +215,28 @@ func (c *createClone) applyFlags(cmd *cobra.Command, spec *clonev1alpha1.Virtual
 	return nil
 }
 
-func (c *createClone) run(cmd *cobra.Command) error {
+func (c *createClone) build(cmd *cobra.Command) (*clonev1alpha1.VirtualMachineClone, error) {
 	c.setDefaults()
 	err := c.validateFlags()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	clone, err := c.newClone()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = c.applyFlags(cmd, &clone.Spec)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if clone.Name == "" {
 		clone.Name = "clone-" + rand.String(5)
 	}
 
-	cloneBytes, err := yaml.Marshal(clone)
-	if err != nil {
-		return err
-	}
-
-	cmd.Print(string(cloneBytes))
-	return nil
+	return clone, nil
 }
 
 func (c *createClone) typeToTypedLocalObjectReference(sourceOrTargetType, sourceOrTargetName string, isSource bool) (*v1.TypedLocalObjectReference, error) {
@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package progress
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	WaitFlag    = "wait"
+	TimeoutFlag = "timeout"
+	OutputFlag  = "output"
+
+	OutputYAML = "yaml"
+	OutputJSON = "json"
+
+	DefaultTimeout = 5 * time.Minute
+
+	pollInterval = time.Second
+)
+
+// Status is a point in time view on an asynchronous operation like a snapshot, a restore or a clone
+type Status struct {
+	Phase string
+	// Conditions are formatted as "Type=Status" optionally followed by the reason and the message
+	Conditions []string
+	Done       bool
+	Failed     bool
+}
+
+// Wait polls getStatus until the operation is done, failed or the timeout expired.
+// Every change of the phase and the conditions is reported to out.
+func Wait(out io.Writer, kind, name string, timeout time.Duration, getStatus func() (*Status, error)) error {
+	last := &Status{}
+	err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		status, err := getStatus()
+		if err != nil {
+			return false, err
+		}
+		reportChanges(out, kind, name, last, status)
+		last = status
+
+		if status.Failed {
+			return false, fmt.Errorf("%s %s failed", kind, name)
+		}
+		return status.Done, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out after %s waiting for %s %s", timeout, kind, name)
+	}
+	return err
+}
+
+func reportChanges(out io.Writer, kind, name string, last, current *Status) {
+	if current.Phase != last.Phase && current.Phase != "" {
+		fmt.Fprintf(out, "%s %s phase: %s\n", kind, name, current.Phase)
+	}
+	known := make(map[string]bool, len(last.Conditions))
+	for _, condition := range last.Conditions {
+		known[condition] = true
+	}
+	for _, condition := range current.Conditions {
+		if !known[condition] {
+			fmt.Fprintf(out, "%s %s condition: %s\n", kind, name, condition)
+		}
+	}
+}
+
+// FormatCondition formats a condition for the Conditions of a Status
+func FormatCondition(conditionType, status, reason, message string) string {
+	condition := fmt.Sprintf("%s=%s", conditionType, status)
+	details := []string{}
+	for _, detail := range []string{reason, message} {
+		if detail != "" {
+			details = append(details, detail)
+		}
+	}
+	if len(details) > 0 {
+		condition += " (" + strings.Join(details, ": ") + ")"
+	}
+	return condition
+}
+
+// PrintResult prints the resulting object of an operation in the requested output format,
+// or the given summary if no format was requested.
+func PrintResult(out io.Writer, format string, obj interface{}, summary string) error {
+	var bytes []byte
+	var err error
+	switch format {
+	case "":
+		_, err = fmt.Fprintln(out, summary)
+		return err
+	case OutputYAML:
+		bytes, err = yaml.Marshal(obj)
+	case OutputJSON:
+		bytes, err = json.MarshalIndent(obj, "", "  ")
+		bytes = append(bytes, '\n')
+	default:
+		return fmt.Errorf("unsupported output format %s, supported formats: %s, %s", format, OutputYAML, OutputJSON)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(bytes)
+	return err
+}
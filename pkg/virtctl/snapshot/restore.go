@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/progress"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESTORE = "restore"
+
+	restoreKind = "VirtualMachineRestore"
+)
+
+type restoreSnapshot struct {
+	clientConfig clientcmd.ClientConfig
+	name         string
+	wait         bool
+	timeout      time.Duration
+	output       string
+}
+
+func NewRestoreCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := restoreSnapshot{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "restore (VM) (SNAPSHOT)",
+		Short: "Restore a virtual machine from a snapshot",
+		Long: `Restores a virtual machine from a VirtualMachineSnapshot by creating a VirtualMachineRestore.
+First argument is the name of the virtual machine to restore, it has to be stopped.
+Second argument is the name of the snapshot.`,
+		Args:    templates.ExactArgs(COMMAND_RESTORE, 2),
+		Example: usageRestore(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, args[0], args[1])
+		},
+	}
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Specify the name of the restore. If not specified, the name is generated from the name of the virtual machine.")
+	addWaitFlags(cmd, &c.wait, &c.timeout, &c.output)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageRestore() string {
+	return `  # Restore the virtualmachine 'myvm' from the snapshot 'mysnapshot':
+  {{ProgramName}} restore myvm mysnapshot
+
+  # Restore and wait until the restore completed:
+  {{ProgramName}} restore myvm mysnapshot --wait --timeout 10m`
+}
+
+func (c *restoreSnapshot) run(cmd *cobra.Command, vmName, snapshotName string) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	restore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.name,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P(virtv1.SchemeGroupVersion.Group),
+				Kind:     "VirtualMachine",
+				Name:     vmName,
+			},
+			VirtualMachineSnapshotName: snapshotName,
+		},
+	}
+	if c.name == "" {
+		restore.GenerateName = vmName + "-restore-"
+	}
+
+	restore, err = virtClient.VirtualMachineRestore(namespace).Create(context.Background(), restore, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error restoring VirtualMachine %s from VirtualMachineSnapshot %s: %v", vmName, snapshotName, err)
+	}
+	cmd.Printf("%s %s/%s created\n", restoreKind, namespace, restore.Name)
+
+	if c.wait {
+		err = progress.Wait(cmd.OutOrStdout(), restoreKind, restore.Name, c.timeout, func() (*progress.Status, error) {
+			restore, err = virtClient.VirtualMachineRestore(namespace).Get(context.Background(), restore.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return restoreStatus(restore), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return progress.PrintResult(cmd.OutOrStdout(), c.output, restore, restoreSummary(restore))
+}
+
+func restoreStatus(restore *snapshotv1.VirtualMachineRestore) *progress.Status {
+	status := &progress.Status{}
+	if restore.Status == nil {
+		return status
+	}
+	status.Conditions = formatConditions(restore.Status.Conditions)
+	status.Done = restore.Status.Complete != nil && *restore.Status.Complete
+	for _, condition := range restore.Status.Conditions {
+		if condition.Type == snapshotv1.ConditionFailure && condition.Status == k8sv1.ConditionTrue {
+			status.Failed = true
+		}
+	}
+	return status
+}
+
+func restoreSummary(restore *snapshotv1.VirtualMachineRestore) string {
+	complete := restore.Status != nil && restore.Status.Complete != nil && *restore.Status.Complete
+	return fmt.Sprintf("%s %s/%s of %s %s from %s, complete: %t", restoreKind, restore.Namespace, restore.Name,
+		restore.Spec.Target.Kind, restore.Spec.Target.Name, restore.Spec.VirtualMachineSnapshotName, complete)
+}
@@ -0,0 +1,254 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/progress"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SNAPSHOT = "snapshot"
+	COMMAND_CREATE   = "create"
+	COMMAND_LIST     = "list"
+
+	NameFlag = "name"
+
+	snapshotKind = "VirtualMachineSnapshot"
+)
+
+type createSnapshot struct {
+	clientConfig clientcmd.ClientConfig
+	name         string
+	wait         bool
+	timeout      time.Duration
+	output       string
+}
+
+func NewSnapshotCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_SNAPSHOT,
+		Short: "Create and list snapshots of virtual machines",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(newCreateCommand(clientConfig))
+	cmd.AddCommand(newListCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newCreateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := createSnapshot{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "create (VM)",
+		Short: "Create a snapshot of a virtual machine",
+		Long: `Creates a VirtualMachineSnapshot of a virtual machine.
+The first argument is the name of the virtual machine.`,
+		Args:    templates.ExactArgs(COMMAND_CREATE, 1),
+		Example: usageCreate(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&c.name, NameFlag, "", "Specify the name of the snapshot. If not specified, the name is generated from the name of the virtual machine.")
+	addWaitFlags(cmd, &c.wait, &c.timeout, &c.output)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func addWaitFlags(cmd *cobra.Command, wait *bool, timeout *time.Duration, output *string) {
+	cmd.Flags().BoolVar(wait, progress.WaitFlag, false, "Wait until the operation finished and report the changes of its phase and conditions.")
+	cmd.Flags().DurationVar(timeout, progress.TimeoutFlag, progress.DefaultTimeout, "The maximum time to wait for the operation to finish, only used together with --wait.")
+	cmd.Flags().StringVarP(output, progress.OutputFlag, "o", "", "Print the resulting object in the given format. Supported formats: yaml, json.")
+}
+
+func usageCreate() string {
+	return `  # Create a snapshot of the virtualmachine 'myvm':
+  {{ProgramName}} snapshot create myvm
+
+  # Create a snapshot called 'mysnapshot' and wait until it is ready to use:
+  {{ProgramName}} snapshot create myvm --name mysnapshot --wait --timeout 10m
+
+  # Create a snapshot and print the resulting VirtualMachineSnapshot:
+  {{ProgramName}} snapshot create myvm --wait -o yaml`
+}
+
+func (c *createSnapshot) run(cmd *cobra.Command, vmName string) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.name,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.P(virtv1.SchemeGroupVersion.Group),
+				Kind:     "VirtualMachine",
+				Name:     vmName,
+			},
+		},
+	}
+	if c.name == "" {
+		snapshot.GenerateName = vmName + "-snapshot-"
+	}
+
+	snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Create(context.Background(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineSnapshot of VirtualMachine %s: %v", vmName, err)
+	}
+	cmd.Printf("%s %s/%s created\n", snapshotKind, namespace, snapshot.Name)
+
+	if c.wait {
+		err = progress.Wait(cmd.OutOrStdout(), snapshotKind, snapshot.Name, c.timeout, func() (*progress.Status, error) {
+			snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Get(context.Background(), snapshot.Name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return snapshotStatus(snapshot), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return progress.PrintResult(cmd.OutOrStdout(), c.output, snapshot, snapshotSummary(snapshot))
+}
+
+func snapshotStatus(snapshot *snapshotv1.VirtualMachineSnapshot) *progress.Status {
+	status := &progress.Status{}
+	if snapshot.Status == nil {
+		return status
+	}
+	status.Phase = string(snapshot.Status.Phase)
+	status.Conditions = formatConditions(snapshot.Status.Conditions)
+	status.Done = snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse
+	status.Failed = snapshot.Status.Phase == snapshotv1.Failed
+	return status
+}
+
+func snapshotSummary(snapshot *snapshotv1.VirtualMachineSnapshot) string {
+	phase := snapshotv1.PhaseUnset
+	readyToUse := false
+	if snapshot.Status != nil {
+		phase = snapshot.Status.Phase
+		readyToUse = snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse
+	}
+	return fmt.Sprintf("%s %s/%s of %s %s, phase: %s, ready to use: %t", snapshotKind, snapshot.Namespace, snapshot.Name,
+		snapshot.Spec.Source.Kind, snapshot.Spec.Source.Name, phase, readyToUse)
+}
+
+func formatConditions(conditions []snapshotv1.Condition) []string {
+	formatted := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		formatted = append(formatted, progress.FormatCondition(string(condition.Type), string(condition.Status), condition.Reason, condition.Message))
+	}
+	return formatted
+}
+
+func newListCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [VM]",
+		Short: "List the snapshots of virtual machines",
+		Long: `Lists the VirtualMachineSnapshots in the namespace.
+The optional first argument is the name of a virtual machine to list the snapshots of.`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `  # List all snapshots in the namespace:
+  {{ProgramName}} snapshot list
+
+  # List the snapshots of the virtualmachine 'myvm':
+  {{ProgramName}} snapshot list myvm`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vmName := ""
+			if len(args) > 0 {
+				vmName = args[0]
+			}
+			return listSnapshots(cmd, clientConfig, vmName)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func listSnapshots(cmd *cobra.Command, clientConfig clientcmd.ClientConfig, vmName string) error {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	snapshots, err := virtClient.VirtualMachineSnapshot(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing VirtualMachineSnapshots: %v", err)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCEKIND\tSOURCENAME\tPHASE\tREADYTOUSE\tAGE")
+	for _, snapshot := range snapshots.Items {
+		if vmName != "" && snapshot.Spec.Source.Name != vmName {
+			continue
+		}
+		phase := snapshotv1.PhaseUnset
+		readyToUse := false
+		if snapshot.Status != nil {
+			phase = snapshot.Status.Phase
+			readyToUse = snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\n", snapshot.Name, snapshot.Spec.Source.Kind, snapshot.Spec.Source.Name,
+			phase, readyToUse, age(snapshot.CreationTimestamp))
+	}
+	return w.Flush()
+}
+
+func age(timestamp metav1.Time) string {
+	if timestamp.IsZero() {
+		return "<unknown>"
+	}
+	return time.Since(timestamp.Time).Round(time.Second).String()
+}
@@ -0,0 +1,223 @@
+package snapshot_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Snapshot", func() {
+
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+		restoreName  = "testrestore"
+	)
+
+	var kubevirtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(k8smetav1.NamespaceDefault).
+			Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(k8smetav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(k8smetav1.NamespaceDefault).
+			Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineRestores(k8smetav1.NamespaceDefault)).AnyTimes()
+	})
+
+	getSnapshot := func(name string) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(k8smetav1.NamespaceDefault).Get(context.Background(), name, k8smetav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmSnapshot
+	}
+
+	// reportStatus lets every get of the given resource return the object with the given status
+	reportStatus := func(resource string, setStatus func(obj runtime.Object)) {
+		kubevirtClient.Fake.PrependReactor("get", resource, func(action testing.Action) (bool, runtime.Object, error) {
+			obj, err := kubevirtClient.Tracker().Get(action.GetResource(), action.GetNamespace(), action.(testing.GetAction).GetName())
+			if err != nil {
+				return true, nil, err
+			}
+			setStatus(obj)
+			return true, obj, nil
+		})
+	}
+
+	Context("create", func() {
+		It("should fail without the name of the VM", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE)
+			Expect(cmd()).To(MatchError(ContainSubstring("argument validation failed")))
+		})
+
+		It("should create a snapshot of the VM", func() {
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot default/testsnapshot created"))
+
+			vmSnapshot := getSnapshot(snapshotName)
+			Expect(vmSnapshot.Spec.Source.Kind).To(Equal("VirtualMachine"))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+			Expect(*vmSnapshot.Spec.Source.APIGroup).To(Equal("kubevirt.io"))
+		})
+
+		It("should generate the name of the snapshot if not specified", func() {
+			kubevirtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				Expect(vmSnapshot.Name).To(BeEmpty())
+				Expect(vmSnapshot.GenerateName).To(Equal(vmName + "-snapshot-"))
+				vmSnapshot.Name = vmSnapshot.GenerateName + "abcde"
+				return true, vmSnapshot, nil
+			})
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot default/testvm-snapshot-abcde created"))
+		})
+
+		It("should wait until the snapshot is ready to use and report its progress", func() {
+			reportStatus("virtualmachinesnapshots", func(obj runtime.Object) {
+				obj.(*snapshotv1.VirtualMachineSnapshot).Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					Phase:      snapshotv1.Succeeded,
+					ReadyToUse: pointer.Bool(true),
+					Conditions: []snapshotv1.Condition{
+						{Type: snapshotv1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "Operation complete"},
+					},
+				}
+			})
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName, "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot testsnapshot phase: Succeeded"))
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot testsnapshot condition: Ready=True (Operation complete)"))
+			Expect(string(out)).To(ContainSubstring("VirtualMachineSnapshot default/testsnapshot of VirtualMachine testvm, phase: Succeeded, ready to use: true"))
+		})
+
+		It("should fail if the snapshot failed", func() {
+			reportStatus("virtualmachinesnapshots", func(obj runtime.Object) {
+				obj.(*snapshotv1.VirtualMachineSnapshot).Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					Phase: snapshotv1.Failed,
+				}
+			})
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName, "--wait")
+			Expect(cmd()).To(MatchError("VirtualMachineSnapshot testsnapshot failed"))
+		})
+
+		It("should time out if the snapshot does not become ready to use", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName, "--wait", "--timeout", "1ms")
+			Expect(cmd()).To(MatchError(ContainSubstring("timed out after 1ms waiting for VirtualMachineSnapshot testsnapshot")))
+		})
+
+		DescribeTable("should print the resulting snapshot", func(format, expected string) {
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName, "-o", format)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring(expected))
+		},
+			Entry("as yaml", "yaml", "name: testsnapshot"),
+			Entry("as json", "json", `"name": "testsnapshot"`),
+		)
+
+		It("should reject an unknown output format", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_CREATE, vmName, "--name", snapshotName, "-o", "xml")
+			Expect(cmd()).To(MatchError(ContainSubstring("unsupported output format xml")))
+		})
+	})
+
+	Context("list", func() {
+		BeforeEach(func() {
+			for _, s := range []struct{ name, vm string }{{"snapshot1", vmName}, {"snapshot2", "othervm"}} {
+				_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(k8smetav1.NamespaceDefault).Create(context.Background(), &snapshotv1.VirtualMachineSnapshot{
+					ObjectMeta: k8smetav1.ObjectMeta{Name: s.name, Namespace: k8smetav1.NamespaceDefault},
+					Spec: snapshotv1.VirtualMachineSnapshotSpec{
+						Source: k8sv1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: s.vm},
+					},
+					Status: &snapshotv1.VirtualMachineSnapshotStatus{
+						Phase:      snapshotv1.Succeeded,
+						ReadyToUse: pointer.Bool(true),
+					},
+				}, k8smetav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("should list all snapshots", func() {
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_LIST)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`snapshot1\s+VirtualMachine\s+testvm\s+Succeeded\s+true`))
+			Expect(string(out)).To(MatchRegexp(`snapshot2\s+VirtualMachine\s+othervm\s+Succeeded\s+true`))
+		})
+
+		It("should only list the snapshots of the given VM", func() {
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_SNAPSHOT, snapshot.COMMAND_LIST, vmName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("snapshot1"))
+			Expect(string(out)).ToNot(ContainSubstring("snapshot2"))
+		})
+	})
+
+	Context("restore", func() {
+		It("should fail without the name of the snapshot", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_RESTORE, vmName)
+			Expect(cmd()).To(MatchError(ContainSubstring("argument validation failed")))
+		})
+
+		It("should restore the VM from the snapshot", func() {
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_RESTORE, vmName, snapshotName, "--name", restoreName)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineRestore default/testrestore created"))
+
+			restore, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineRestores(k8smetav1.NamespaceDefault).Get(context.Background(), restoreName, k8smetav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restore.Spec.Target.Kind).To(Equal("VirtualMachine"))
+			Expect(restore.Spec.Target.Name).To(Equal(vmName))
+			Expect(restore.Spec.VirtualMachineSnapshotName).To(Equal(snapshotName))
+		})
+
+		It("should wait until the restore is complete", func() {
+			reportStatus("virtualmachinerestores", func(obj runtime.Object) {
+				obj.(*snapshotv1.VirtualMachineRestore).Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.Bool(true),
+					Conditions: []snapshotv1.Condition{
+						{Type: snapshotv1.ConditionProgressing, Status: k8sv1.ConditionFalse},
+					},
+				}
+			})
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(snapshot.COMMAND_RESTORE, vmName, snapshotName, "--name", restoreName, "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("VirtualMachineRestore testrestore condition: Progressing=False"))
+			Expect(string(out)).To(ContainSubstring("VirtualMachineRestore default/testrestore of VirtualMachine testvm from testsnapshot, complete: true"))
+		})
+
+		It("should fail if the restore reports a failure", func() {
+			reportStatus("virtualmachinerestores", func(obj runtime.Object) {
+				obj.(*snapshotv1.VirtualMachineRestore).Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Conditions: []snapshotv1.Condition{
+						{Type: snapshotv1.ConditionFailure, Status: k8sv1.ConditionTrue},
+					},
+				}
+			})
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(snapshot.COMMAND_RESTORE, vmName, snapshotName, "--name", restoreName, "--wait")
+			Expect(cmd()).To(MatchError("VirtualMachineRestore testrestore failed"))
+		})
+	})
+})
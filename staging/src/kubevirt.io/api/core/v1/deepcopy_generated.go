@@ -170,6 +170,31 @@ func (in *ArchSpecificConfiguration) DeepCopy() *ArchSpecificConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AttestationReport) DeepCopyInto(out *AttestationReport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AttestationReport.
+func (in *AttestationReport) DeepCopy() *AttestationReport {
+	if in == nil {
+		return nil
+	}
+	out := new(AttestationReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AttestationReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizedKeysFile) DeepCopyInto(out *AuthorizedKeysFile) {
 	*out = *in
@@ -207,6 +232,22 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -641,6 +682,22 @@ func (in *ContainerDiskSource) DeepCopy() *ContainerDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrossClusterMigrationState) DeepCopyInto(out *CrossClusterMigrationState) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrossClusterMigrationState.
+func (in *CrossClusterMigrationState) DeepCopy() *CrossClusterMigrationState {
+	if in == nil {
+		return nil
+	}
+	out := new(CrossClusterMigrationState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomBlockSize) DeepCopyInto(out *CustomBlockSize) {
 	*out = *in
@@ -733,6 +790,21 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]DHCPRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.LeaseTime != nil {
+		in, out := &in.LeaseTime, &out.LeaseTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(DHCPv6Options)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -762,6 +834,53 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRoute) DeepCopyInto(out *DHCPRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRoute.
+func (in *DHCPRoute) DeepCopy() *DHCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPv6Options) DeepCopyInto(out *DHCPv6Options) {
+	*out = *in
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouterAdvertisements != nil {
+		in, out := &in.RouterAdvertisements, &out.RouterAdvertisements
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPv6Options.
+func (in *DHCPv6Options) DeepCopy() *DHCPv6Options {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPv6Options)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -881,6 +1000,13 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(Watchdog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PanicDevices != nil {
+		in, out := &in.PanicDevices, &out.PanicDevices
+		*out = make([]PanicDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Interfaces != nil {
 		in, out := &in.Interfaces, &out.Interfaces
 		*out = make([]Interface, len(*in))
@@ -985,6 +1111,22 @@ func (in *Devices) DeepCopy() *Devices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Diag288Watchdog) DeepCopyInto(out *Diag288Watchdog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Diag288Watchdog.
+func (in *Diag288Watchdog) DeepCopy() *Diag288Watchdog {
+	if in == nil {
+		return nil
+	}
+	out := new(Diag288Watchdog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DisableFreePageReporting) DeepCopyInto(out *DisableFreePageReporting) {
 	*out = *in
@@ -1025,6 +1167,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1069,6 +1216,22 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1180,6 +1343,11 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 		*out = new(LaunchSecurity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OnCrash != nil {
+		in, out := &in.OnCrash, &out.OnCrash
+		*out = new(OnCrash)
+		**out = **in
+	}
 	return
 }
 
@@ -1240,6 +1408,16 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecureBootKeysSecretRef != nil {
+		in, out := &in.SecureBootKeysSecretRef, &out.SecureBootKeysSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -1904,6 +2082,22 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ITCOWatchdog) DeepCopyInto(out *ITCOWatchdog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ITCOWatchdog.
+func (in *ITCOWatchdog) DeepCopy() *ITCOWatchdog {
+	if in == nil {
+		return nil
+	}
+	out := new(ITCOWatchdog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Input) DeepCopyInto(out *Input) {
 	*out = *in
@@ -1940,6 +2134,11 @@ func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
 	in.InterfaceBindingMethod.DeepCopyInto(&out.InterfaceBindingMethod)
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(PluginBinding)
+		**out = **in
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]Port, len(*in))
@@ -1955,6 +2154,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(InterfaceFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1968,6 +2177,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimit)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimit)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2014,6 +2249,22 @@ func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPlugin.
+func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
 	*out = *in
@@ -2030,6 +2281,78 @@ func (in *InterfaceBridge) DeepCopy() *InterfaceBridge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFilter) DeepCopyInto(out *InterfaceFilter) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]InterfaceFilterRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]InterfaceFilterRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AntiSpoofing != nil {
+		in, out := &in.AntiSpoofing, &out.AntiSpoofing
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFilter.
+func (in *InterfaceFilter) DeepCopy() *InterfaceFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFilterDroppedPackets) DeepCopyInto(out *InterfaceFilterDroppedPackets) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFilterDroppedPackets.
+func (in *InterfaceFilterDroppedPackets) DeepCopy() *InterfaceFilterDroppedPackets {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFilterDroppedPackets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceFilterRule) DeepCopyInto(out *InterfaceFilterRule) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceFilterRule.
+func (in *InterfaceFilterRule) DeepCopy() *InterfaceFilterRule {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceFilterRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMacvtap) DeepCopyInto(out *InterfaceMacvtap) {
 	*out = *in
@@ -2118,6 +2441,11 @@ func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(KSMTuning)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2131,6 +2459,42 @@ func (in *KSMConfiguration) DeepCopy() *KSMConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KSMTuning) DeepCopyInto(out *KSMTuning) {
+	*out = *in
+	if in.PagesToScanMin != nil {
+		in, out := &in.PagesToScanMin, &out.PagesToScanMin
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PagesToScanMax != nil {
+		in, out := &in.PagesToScanMax, &out.PagesToScanMax
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SleepMillisecs != nil {
+		in, out := &in.SleepMillisecs, &out.SleepMillisecs
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.FreeMemoryThresholdPercent != nil {
+		in, out := &in.FreeMemoryThresholdPercent, &out.FreeMemoryThresholdPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KSMTuning.
+func (in *KSMTuning) DeepCopy() *KSMTuning {
+	if in == nil {
+		return nil
+	}
+	out := new(KSMTuning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KVMTimer) DeepCopyInto(out *KVMTimer) {
 	*out = *in
@@ -2592,6 +2956,16 @@ func (in *LaunchSecurity) DeepCopyInto(out *LaunchSecurity) {
 		*out = new(SEV)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SNP != nil {
+		in, out := &in.SNP, &out.SNP
+		*out = new(SEVSNP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TDX != nil {
+		in, out := &in.TDX, &out.TDX
+		*out = new(TDX)
+		**out = **in
+	}
 	return
 }
 
@@ -2634,6 +3008,11 @@ func (in *LiveUpdateConfiguration) DeepCopyInto(out *LiveUpdateConfiguration) {
 		*out = new(uint32)
 		**out = **in
 	}
+	if in.MaxGuest != nil {
+		in, out := &in.MaxGuest, &out.MaxGuest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
 	return
 }
 
@@ -2655,6 +3034,11 @@ func (in *LiveUpdateFeatures) DeepCopyInto(out *LiveUpdateFeatures) {
 		*out = new(LiveUpdateCPU)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(LiveUpdateMemory)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2668,6 +3052,27 @@ func (in *LiveUpdateFeatures) DeepCopy() *LiveUpdateFeatures {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LiveUpdateMemory) DeepCopyInto(out *LiveUpdateMemory) {
+	*out = *in
+	if in.MaxGuest != nil {
+		in, out := &in.MaxGuest, &out.MaxGuest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LiveUpdateMemory.
+func (in *LiveUpdateMemory) DeepCopy() *LiveUpdateMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(LiveUpdateMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LogVerbosity) DeepCopyInto(out *LogVerbosity) {
 	*out = *in
@@ -2707,6 +3112,43 @@ func (in *LunTarget) DeepCopy() *LunTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacAddressPool) DeepCopyInto(out *MacAddressPool) {
+	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MacAddressRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressPool.
+func (in *MacAddressPool) DeepCopy() *MacAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacAddressRange) DeepCopyInto(out *MacAddressRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacAddressRange.
+func (in *MacAddressRange) DeepCopy() *MacAddressRange {
+	if in == nil {
+		return nil
+	}
+	out := new(MacAddressRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Machine) DeepCopyInto(out *Machine) {
 	*out = *in
@@ -2785,6 +3227,16 @@ func (in *Memory) DeepCopyInto(out *Memory) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MaxGuest != nil {
+		in, out := &in.MaxGuest, &out.MaxGuest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(MemoryBalloon)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2793,7 +3245,33 @@ func (in *Memory) DeepCopy() *Memory {
 	if in == nil {
 		return nil
 	}
-	out := new(Memory)
+	out := new(Memory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryBalloon) DeepCopyInto(out *MemoryBalloon) {
+	*out = *in
+	if in.FreeMemoryPercent != nil {
+		in, out := &in.FreeMemoryPercent, &out.FreeMemoryPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinGuest != nil {
+		in, out := &in.MinGuest, &out.MinGuest
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryBalloon.
+func (in *MemoryBalloon) DeepCopy() *MemoryBalloon {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryBalloon)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2815,6 +3293,42 @@ func (in *MemoryDumpVolumeSource) DeepCopy() *MemoryDumpVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MemoryStatus) DeepCopyInto(out *MemoryStatus) {
+	*out = *in
+	if in.GuestAtBoot != nil {
+		in, out := &in.GuestAtBoot, &out.GuestAtBoot
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.GuestCurrent != nil {
+		in, out := &in.GuestCurrent, &out.GuestCurrent
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BalloonTarget != nil {
+		in, out := &in.BalloonTarget, &out.BalloonTarget
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.BalloonActual != nil {
+		in, out := &in.BalloonActual, &out.BalloonActual
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemoryStatus.
+func (in *MemoryStatus) DeepCopy() *MemoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MemoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -2900,6 +3414,21 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationConnections != nil {
+		in, out := &in.ParallelMigrationConnections, &out.ParallelMigrationConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		**out = **in
+	}
+	if in.AllowWorkloadDisruption != nil {
+		in, out := &in.AllowWorkloadDisruption, &out.AllowWorkloadDisruption
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2996,6 +3525,18 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = make(map[string]InterfaceBindingPlugin, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MacAddressPool != nil {
+		in, out := &in.MacAddressPool, &out.MacAddressPool
+		*out = new(MacAddressPool)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3103,6 +3644,22 @@ func (in *NodePlacement) DeepCopy() *NodePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCrash) DeepCopyInto(out *OnCrash) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCrash.
+func (in *OnCrash) DeepCopy() *OnCrash {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCrash)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PITTimer) DeepCopyInto(out *PITTimer) {
 	*out = *in
@@ -3124,6 +3681,27 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
+	*out = *in
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(PanicDeviceModel)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicDevice.
+func (in *PanicDevice) DeepCopy() *PanicDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 	*out = *in
@@ -3250,6 +3828,22 @@ func (in *PersistentVolumeClaimVolumeSource) DeepCopy() *PersistentVolumeClaimVo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginBinding) DeepCopyInto(out *PluginBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginBinding.
+func (in *PluginBinding) DeepCopy() *PluginBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodNetwork) DeepCopyInto(out *PodNetwork) {
 	*out = *in
@@ -3706,6 +4300,48 @@ func (in *SEVPolicy) DeepCopy() *SEVPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNP) DeepCopyInto(out *SEVSNP) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(SEVSNPPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNP.
+func (in *SEVSNP) DeepCopy() *SEVSNP {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SEVSNPPolicy) DeepCopyInto(out *SEVSNPPolicy) {
+	*out = *in
+	if in.SMT != nil {
+		in, out := &in.SMT, &out.SMT
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SEVSNPPolicy.
+func (in *SEVSNPPolicy) DeepCopy() *SEVSNPPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SEVSNPPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SEVSecretOptions) DeepCopyInto(out *SEVSecretOptions) {
 	*out = *in
@@ -4027,6 +4663,22 @@ func (in *SysprepSource) DeepCopy() *SysprepSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TDX) DeepCopyInto(out *TDX) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TDX.
+func (in *TDX) DeepCopy() *TDX {
+	if in == nil {
+		return nil
+	}
+	out := new(TDX)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSConfiguration) DeepCopyInto(out *TLSConfiguration) {
 	*out = *in
@@ -4056,6 +4708,11 @@ func (in *TPMDevice) DeepCopyInto(out *TPMDevice) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EncryptionSecretRef != nil {
+		in, out := &in.EncryptionSecretRef, &out.EncryptionSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 	return
 }
 
@@ -4134,6 +4791,11 @@ func (in *TopologyHints) DeepCopyInto(out *TopologyHints) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.KSMMemoryOvercommit != nil {
+		in, out := &in.KSMMemoryOvercommit, &out.KSMMemoryOvercommit
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -4387,6 +5049,98 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupOptions) DeepCopyInto(out *VirtualMachineInstanceBackupOptions) {
+	*out = *in
+	if in.IncrementalFrom != nil {
+		in, out := &in.IncrementalFrom, &out.IncrementalFrom
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupOptions.
+func (in *VirtualMachineInstanceBackupOptions) DeepCopy() *VirtualMachineInstanceBackupOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupStatus) DeepCopyInto(out *VirtualMachineInstanceBackupStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VirtualMachineInstanceBackupVolume, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupStatus.
+func (in *VirtualMachineInstanceBackupStatus) DeepCopy() *VirtualMachineInstanceBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupVolume) DeepCopyInto(out *VirtualMachineInstanceBackupVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupVolume.
+func (in *VirtualMachineInstanceBackupVolume) DeepCopy() *VirtualMachineInstanceBackupVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCPULoad) DeepCopyInto(out *VirtualMachineInstanceCPULoad) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Window = in.Window
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCPULoad.
+func (in *VirtualMachineInstanceCPULoad) DeepCopy() *VirtualMachineInstanceCPULoad {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCPULoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceCPULoad) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCondition) DeepCopyInto(out *VirtualMachineInstanceCondition) {
 	*out = *in
@@ -4611,7 +5365,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -4702,9 +5456,51 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopyInto(out *VirtualMachineInstanceMigrationReceive) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationReceive.
+func (in *VirtualMachineInstanceMigrationReceive) DeepCopy() *VirtualMachineInstanceMigrationReceive {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationReceive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopyInto(out *VirtualMachineInstanceMigrationSendTo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMigrationSendTo.
+func (in *VirtualMachineInstanceMigrationSendTo) DeepCopy() *VirtualMachineInstanceMigrationSendTo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMigrationSendTo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.SendTo != nil {
+		in, out := &in.SendTo, &out.SendTo
+		*out = new(VirtualMachineInstanceMigrationSendTo)
+		**out = **in
+	}
+	if in.Receive != nil {
+		in, out := &in.Receive, &out.Receive
+		*out = new(VirtualMachineInstanceMigrationReceive)
+		**out = **in
+	}
 	return
 }
 
@@ -4755,6 +5551,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.CrossCluster != nil {
+		in, out := &in.CrossCluster, &out.CrossCluster
+		*out = new(CrossClusterMigrationState)
+		**out = **in
+	}
 	return
 }
 
@@ -4811,6 +5612,11 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FilterDroppedPackets != nil {
+		in, out := &in.FilterDroppedPackets, &out.FilterDroppedPackets
+		*out = new(InterfaceFilterDroppedPackets)
+		**out = **in
+	}
 	return
 }
 
@@ -5240,6 +6046,21 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(CPUTopology)
 		**out = **in
 	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(MemoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupStatus != nil {
+		in, out := &in.BackupStatus, &out.BackupStatus
+		*out = new(VirtualMachineInstanceBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WatchdogStatus != nil {
+		in, out := &in.WatchdogStatus, &out.WatchdogStatus
+		*out = new(VirtualMachineInstanceWatchdogStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5271,6 +6092,26 @@ func (in *VirtualMachineInstanceTemplateSpec) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceWatchdogStatus) DeepCopyInto(out *VirtualMachineInstanceWatchdogStatus) {
+	*out = *in
+	if in.LastFiredTimestamp != nil {
+		in, out := &in.LastFiredTimestamp, &out.LastFiredTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceWatchdogStatus.
+func (in *VirtualMachineInstanceWatchdogStatus) DeepCopy() *VirtualMachineInstanceWatchdogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceWatchdogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInterfaceRequest) DeepCopyInto(out *VirtualMachineInterfaceRequest) {
 	*out = *in
@@ -5756,6 +6597,16 @@ func (in *WatchdogDevice) DeepCopyInto(out *WatchdogDevice) {
 		*out = new(I6300ESBWatchdog)
 		**out = **in
 	}
+	if in.Diag288 != nil {
+		in, out := &in.Diag288, &out.Diag288
+		*out = new(Diag288Watchdog)
+		**out = **in
+	}
+	if in.ITCO != nil {
+		in, out := &in.ITCO, &out.ITCO
+		*out = new(ITCOWatchdog)
+		**out = **in
+	}
 	return
 }
 
@@ -24,6 +24,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -200,8 +201,37 @@ type DomainSpec struct {
 	// Launch Security setting of the vmi.
 	// +optional
 	LaunchSecurity *LaunchSecurity `json:"launchSecurity,omitempty"`
+	// OnCrash defines what happens when the guest kernel panics.
+	// Requires a panic device.
+	// +optional
+	OnCrash *OnCrash `json:"onCrash,omitempty"`
 }
 
+// OnCrash defines what happens when the guest kernel panics.
+type OnCrash struct {
+	// Action taken when the guest kernel panics.
+	// One of: Restart, Pause, MemoryDump.
+	// MemoryDump leaves the guest paused once its memory is dumped.
+	// Defaults to Pause.
+	// +optional
+	Action CrashAction `json:"action,omitempty"`
+	// MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the MemoryDump action.
+	// The memory is only dumped for VMIs owned by a VirtualMachine.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
+}
+
+type CrashAction string
+
+const (
+	// CrashActionRestart resets the guest
+	CrashActionRestart CrashAction = "Restart"
+	// CrashActionPause leaves the guest paused
+	CrashActionPause CrashAction = "Pause"
+	// CrashActionMemoryDump leaves the guest paused and dumps its memory to a PVC
+	CrashActionMemoryDump CrashAction = "MemoryDump"
+)
+
 // Chassis specifies the chassis info passed to the domain.
 type Chassis struct {
 	Manufacturer string `json:"manufacturer,omitempty"`
@@ -237,8 +267,27 @@ type EFI struct {
 	// Defaults to true
 	// +optional
 	SecureBoot *bool `json:"secureBoot,omitempty"`
+	// If set to true, Persistent will persist the EFI NVRAM across reboots and live migrations.
+	// Defaults to false
+	// +optional
+	Persistent *bool `json:"persistent,omitempty"`
+	// SecureBootKeysSecretRef references a k8s secret whose "PK", "KEK" and "db" entries hold
+	// PEM encoded X.509 certificates to enroll in place of the default Secure Boot keys.
+	// The keys are enrolled when the NVRAM is initialized.
+	// Requires SecureBoot to be enabled.
+	// +optional
+	SecureBootKeysSecretRef *v1.LocalObjectReference `json:"secureBootKeysSecretRef,omitempty"`
 }
 
+const (
+	// EFISecureBootPKSecretKey is the key of the Secure Boot platform key certificates in the secret referenced by SecureBootKeysSecretRef
+	EFISecureBootPKSecretKey = "PK"
+	// EFISecureBootKEKSecretKey is the key of the Secure Boot key exchange key certificates in the secret referenced by SecureBootKeysSecretRef
+	EFISecureBootKEKSecretKey = "KEK"
+	// EFISecureBootDBSecretKey is the key of the Secure Boot signature database certificates in the secret referenced by SecureBootKeysSecretRef
+	EFISecureBootDBSecretKey = "db"
+)
+
 // If set, the VM will be booted from the defined kernel / initrd.
 type KernelBootContainer struct {
 	// Image that contains initrd / kernel files.
@@ -283,6 +332,10 @@ type ResourceRequirements struct {
 	// put the overhead only into the container's memory limit. This can lead to crashes if
 	// all memory is in use on a node. Defaults to false.
 	OvercommitGuestOverhead bool `json:"overcommitGuestOverhead,omitempty"`
+	// OvercommitWithKSM schedules the VMI on a KSM-enabled node and lowers its memory request
+	// by the memory overcommit these nodes report. Defaults to false.
+	// +optional
+	OvercommitWithKSM bool `json:"overcommitWithKSM,omitempty"`
 }
 
 // CPU allows specifying the CPU topology.
@@ -373,6 +426,26 @@ type Memory struct {
 	// Defaults to the requested memory in the resources section if not specified.
 	// + optional
 	Guest *resource.Quantity `json:"guest,omitempty"`
+	// MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.
+	// The delta between MaxGuest and Guest is the amount of memory that can be hotplugged.
+	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
+	// Balloon enables a free memory policy which inflates the memory balloon of idle guests
+	// and deflates it when the guest is under memory pressure.
+	// It requires the memory balloon device and its statistics to be enabled.
+	// +optional
+	Balloon *MemoryBalloon `json:"balloon,omitempty"`
+}
+
+// MemoryBalloon configures the free memory policy of the memory balloon.
+type MemoryBalloon struct {
+	// FreeMemoryPercent is the percentage of its memory the policy keeps free inside the guest.
+	// Defaults to 20.
+	// +optional
+	FreeMemoryPercent *uint32 `json:"freeMemoryPercent,omitempty"`
+	// MinGuest is the lowest amount of memory the balloon can leave to the guest.
+	// Defaults to half of the guest memory.
+	// +optional
+	MinGuest *resource.Quantity `json:"minGuest,omitempty"`
 }
 
 // Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
@@ -412,6 +485,9 @@ type Devices struct {
 	Disks []Disk `json:"disks,omitempty"`
 	// Watchdog describes a watchdog device which can be added to the vmi.
 	Watchdog *Watchdog `json:"watchdog,omitempty"`
+	// PanicDevices describe devices notifying the host when the guest kernel panics.
+	// +optional
+	PanicDevices []PanicDevice `json:"panicDevices,omitempty"`
 	// Interfaces describe network interfaces which are added to the vmi.
 	Interfaces []Interface `json:"interfaces,omitempty"`
 	// Inputs describe input devices
@@ -499,8 +575,28 @@ type TPMDevice struct {
 	// Persistent indicates the state of the TPM device should be kept accross reboots
 	// Defaults to false
 	Persistent *bool `json:"persistent,omitempty"`
+	// StateRetentionPolicy defines what happens to the persistent TPM state when the VM is deleted.
+	// Delete removes the state together with the VM, Retain keeps it for a later VM of the same name.
+	// Defaults to Delete
+	// +optional
+	StateRetentionPolicy TPMStateRetentionPolicy `json:"stateRetentionPolicy,omitempty"`
+	// EncryptionSecretRef references a k8s secret whose "key" entry is used to encrypt the persistent TPM state.
+	// +optional
+	EncryptionSecretRef *v1.LocalObjectReference `json:"encryptionSecretRef,omitempty"`
 }
 
+type TPMStateRetentionPolicy string
+
+const (
+	// TPMStateRetentionPolicyDelete removes the persistent TPM state when the VM is deleted
+	TPMStateRetentionPolicyDelete TPMStateRetentionPolicy = "Delete"
+	// TPMStateRetentionPolicyRetain keeps the persistent TPM state when the VM is deleted
+	TPMStateRetentionPolicyRetain TPMStateRetentionPolicy = "Retain"
+)
+
+// TPMEncryptionKeySecretKey is the entry of the encryption secret holding the TPM state key
+const TPMEncryptionKeySecretKey = "key"
+
 type InputBus string
 
 const (
@@ -606,6 +702,34 @@ type Disk struct {
 	// If specified the disk is made sharable and multiple write from different VMs are permitted
 	// +optional
 	Shareable *bool `json:"shareable,omitempty"`
+	// IOTune specifies I/O throttling limits for the disk.
+	// With the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune represents the I/O throttling limits of a disk.
+// A value of 0 means that the corresponding limit is not set.
+// Total limits are mutually exclusive with the read and write limits of the same kind.
+type DiskIOTune struct {
+	// TotalBytesSec is the total throughput limit in bytes per second.
+	// +optional
+	TotalBytesSec uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the read throughput limit in bytes per second.
+	// +optional
+	ReadBytesSec uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the write throughput limit in bytes per second.
+	// +optional
+	WriteBytesSec uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIopsSec is the total I/O operations per second limit.
+	// +optional
+	TotalIopsSec uint64 `json:"totalIopsSec,omitempty"`
+	// ReadIopsSec is the read I/O operations per second limit.
+	// +optional
+	ReadIopsSec uint64 `json:"readIopsSec,omitempty"`
+	// WriteIopsSec is the write I/O operations per second limit.
+	// +optional
+	WriteIopsSec uint64 `json:"writeIopsSec,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -656,6 +780,10 @@ type DiskTarget struct {
 type LaunchSecurity struct {
 	// AMD Secure Encrypted Virtualization (SEV).
 	SEV *SEV `json:"sev,omitempty"`
+	// AMD Secure Encrypted Virtualization with Secure Nested Paging (SEV-SNP).
+	SNP *SEVSNP `json:"snp,omitempty"`
+	// Intel Trust Domain Extensions (TDX).
+	TDX *TDX `json:"tdx,omitempty"`
 }
 
 type SEV struct {
@@ -681,6 +809,22 @@ type SEVPolicy struct {
 type SEVAttestation struct {
 }
 
+type SEVSNP struct {
+	// Guest policy flags as defined in AMD SEV-SNP firmware ABI specification.
+	// Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.
+	Policy *SEVSNPPolicy `json:"policy,omitempty"`
+}
+
+type SEVSNPPolicy struct {
+	// Allow the guest to run on hosts with simultaneous multithreading (SMT) enabled.
+	// Defaults to true.
+	// +optional
+	SMT *bool `json:"smt,omitempty"`
+}
+
+type TDX struct {
+}
+
 type LunTarget struct {
 	// Bus indicates the type of disk device to emulate.
 	// supported values: virtio, sata, scsi.
@@ -1147,6 +1291,8 @@ const (
 	WatchdogActionReset WatchdogAction = "reset"
 	// WatchdogActionShutdown will shutdown the vmi if the watchdog gets triggered.
 	WatchdogActionShutdown WatchdogAction = "shutdown"
+	// WatchdogActionDump will dump the vmi memory to a PVC and reset the vmi if the watchdog gets triggered.
+	WatchdogActionDump WatchdogAction = "dump"
 )
 
 // Named watchdog device.
@@ -1156,23 +1302,66 @@ type Watchdog struct {
 	// WatchdogDevice contains the watchdog type and actions.
 	// Defaults to i6300esb.
 	WatchdogDevice `json:",inline"`
+	// MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the dump action,
+	// before the guest is reset. Required by the dump action.
+	// The memory is only dumped for VMIs owned by a VirtualMachine, other VMIs are reset right away.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
 }
 
 // Hardware watchdog device.
 // Exactly one of its members must be set.
+// There is no virtio watchdog model, since QEMU and libvirt don't provide one.
 type WatchdogDevice struct {
 	// i6300esb watchdog device.
 	// +optional
 	I6300ESB *I6300ESBWatchdog `json:"i6300esb,omitempty"`
+	// diag288 watchdog device, available on s390x.
+	// +optional
+	Diag288 *Diag288Watchdog `json:"diag288,omitempty"`
+	// iTCO watchdog device built into the q35 chipset.
+	// +optional
+	ITCO *ITCOWatchdog `json:"itco,omitempty"`
 }
 
 // i6300esb watchdog device.
 type I6300ESBWatchdog struct {
-	// The action to take. Valid values are poweroff, reset, shutdown.
+	// The action to take. Valid values are poweroff, reset, shutdown, dump.
+	// Defaults to reset.
+	Action WatchdogAction `json:"action,omitempty"`
+}
+
+// diag288 watchdog device.
+type Diag288Watchdog struct {
+	// The action to take. Valid values are poweroff, reset, shutdown, dump.
+	// Defaults to reset.
+	Action WatchdogAction `json:"action,omitempty"`
+}
+
+// iTCO watchdog device.
+type ITCOWatchdog struct {
+	// The action to take. Valid values are poweroff, reset, shutdown, dump.
 	// Defaults to reset.
 	Action WatchdogAction `json:"action,omitempty"`
 }
 
+// PanicDevice notifies the host when the guest kernel panics.
+type PanicDevice struct {
+	// Model specifies what type of panic device is provided.
+	// One of: hyperv, isa, pvpanic.
+	// The model used when it is missing depends on the guest architecture.
+	// +optional
+	Model *PanicDeviceModel `json:"model,omitempty"`
+}
+
+type PanicDeviceModel string
+
+const (
+	PanicDeviceModelHyperv  PanicDeviceModel = "hyperv"
+	PanicDeviceModelIsa     PanicDeviceModel = "isa"
+	PanicDeviceModelPvpanic PanicDeviceModel = "pvpanic"
+)
+
 type Interface struct {
 	// Logical name of the interface as well as a reference to the associated networks.
 	// Must match the Name of a Network.
@@ -1185,6 +1374,10 @@ type Interface struct {
 	// BindingMethod specifies the method which will be used to connect the interface to the guest.
 	// Defaults to Bridge.
 	InterfaceBindingMethod `json:",inline"`
+	// Binding specifies the binding plugin that will be used to connect the interface to the guest.
+	// It provides an alternative to InterfaceBindingMethod.
+	// +optional
+	Binding *PluginBinding `json:"binding,omitempty"`
 	// List of ports to be forwarded to the virtual machine.
 	Ports []Port `json:"ports,omitempty"`
 	// Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.
@@ -1210,15 +1403,49 @@ type Interface struct {
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
 	// State represents the requested operational state of the interface.
-	// The (only) value supported is `absent`, expressing a request to remove the interface.
+	// The supported values are `absent`, expressing a request to remove the interface,
+	// `down`, setting the link of the interface down without removing it,
+	// and `up`, the default, setting the link of the interface up.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth specifies the inbound and outbound traffic limits of the interface.
+	// With the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+	// Filter specifies the traffic allowed to and from the guest on the interface.
+	// It is only supported with the bridge binding method.
+	// +optional
+	Filter *InterfaceFilter `json:"filter,omitempty"`
+}
+
+// InterfaceBandwidth represents the traffic limits of an interface, as seen from the guest.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit represents the shaping parameters of one traffic direction.
+type BandwidthLimit struct {
+	// Average is the average bit rate in kilobytes per second.
+	Average uint32 `json:"average"`
+	// Peak is the maximum rate at which the interface can send data in kilobytes per second.
+	// +optional
+	Peak uint32 `json:"peak,omitempty"`
+	// Burst is the amount of kilobytes that can be sent in a single burst at peak speed.
+	// +optional
+	Burst uint32 `json:"burst,omitempty"`
 }
 
 type InterfaceState string
 
 const (
-	InterfaceStateAbsent InterfaceState = "absent"
+	InterfaceStateAbsent   InterfaceState = "absent"
+	InterfaceStateLinkUp   InterfaceState = "up"
+	InterfaceStateLinkDown InterfaceState = "down"
 )
 
 // Extra DHCP options to use in the interface.
@@ -1235,6 +1462,46 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass the given static routes to the VM via DHCP option 121,
+	// in addition to the routes of the pod network.
+	// +optional
+	Routes []DHCPRoute `json:"routes,omitempty"`
+	// If specified will be used as the lease time of the DHCP and DHCPv6 servers.
+	// Defaults to an infinite lease.
+	// +optional
+	LeaseTime *metav1.Duration `json:"leaseTime,omitempty"`
+	// If specified will pass option 67 with this value instead of BootFileName to
+	// clients identifying themselves as iPXE by the user class option 77.
+	// Allows chainloading an iPXE script after booting iPXE over the network.
+	// +optional
+	IPXEBootFileName string `json:"ipxeBootFileName,omitempty"`
+	// IPv6 configures the DHCPv6 server and the router advertisements of the interface.
+	// +optional
+	IPv6 *DHCPv6Options `json:"ipv6,omitempty"`
+}
+
+// DHCPRoute defines a static route passed to the VM via DHCP option 121.
+type DHCPRoute struct {
+	// Destination is the IPv4 CIDR of the route, e.g. 10.10.0.0/16.
+	Destination string `json:"destination"`
+	// Gateway is the IPv4 address of the next hop of the route.
+	Gateway string `json:"gateway"`
+}
+
+// DHCPv6Options defines the IPv6 options passed to the VM.
+type DHCPv6Options struct {
+	// If specified will pass the configured IPv6 DNS servers to the VM via DHCPv6 option 23.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// If specified will pass the configured domain search list to the VM via DHCPv6 option 24.
+	// Defaults to the search domains of the pod.
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
+	// If set to true, router advertisements are sent to the VM, providing its default route.
+	// Supported only on masquerade interfaces.
+	// Requires the Root feature gate, since a non-root virt-launcher lacks the NET_RAW capability.
+	// +optional
+	RouterAdvertisements *bool `json:"routerAdvertisements,omitempty"`
 }
 
 func (d *DHCPOptions) UnmarshalJSON(data []byte) error {
@@ -1294,6 +1561,45 @@ type InterfaceMacvtap struct{}
 // InterfacePasst connects to a given network.
 type InterfacePasst struct{}
 
+// PluginBinding represents a binding implemented in a plugin.
+type PluginBinding struct {
+	// Name references to the binding name as defined in the KubeVirt CR network configuration.
+	Name string `json:"name"`
+}
+
+// InterfaceFilter represents the traffic filtering of an interface, as seen from the guest.
+type InterfaceFilter struct {
+	// Ingress lists the traffic allowed to reach the guest.
+	// Any other traffic is dropped, unless the list is empty.
+	// +optional
+	Ingress []InterfaceFilterRule `json:"ingress,omitempty"`
+	// Egress lists the traffic the guest is allowed to send.
+	// Any other traffic is dropped, unless the list is empty.
+	// +optional
+	Egress []InterfaceFilterRule `json:"egress,omitempty"`
+	// AntiSpoofing drops the traffic sent by the guest with a source MAC or IPv4 address
+	// other than the ones of the interface.
+	// Defaults to true.
+	// +optional
+	AntiSpoofing *bool `json:"antiSpoofing,omitempty"`
+}
+
+// InterfaceFilterRule allows the traffic matching all of its fields.
+type InterfaceFilterRule struct {
+	// CIDR of the remote peer. For example: 10.0.0.0/8 or fd10::/64.
+	// Matches any peer when empty.
+	// +optional
+	CIDR string `json:"cidr,omitempty"`
+	// Protocol of the traffic. Must be TCP, UDP or ICMP.
+	// Matches any protocol when empty.
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// Destination ports of the traffic, each 0 < x < 65536.
+	// Requires the TCP or UDP protocol. Matches any port when empty.
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
+}
+
 // Port represents a port to expose from the virtual machine.
 // Default protocol TCP.
 // The port field is mandatory
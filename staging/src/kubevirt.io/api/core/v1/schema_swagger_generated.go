@@ -95,6 +95,15 @@ func (DomainSpec) SwaggerDoc() map[string]string {
 		"ioThreadsPolicy": "Controls whether or not disks will share IOThreads.\nOmitting IOThreadsPolicy disables use of IOThreads.\nOne of: shared, auto\n+optional",
 		"chassis":         "Chassis specifies the chassis info passed to the domain.\n+optional",
 		"launchSecurity":  "Launch Security setting of the vmi.\n+optional",
+		"onCrash":         "OnCrash defines what happens when the guest kernel panics.\nRequires a panic device.\n+optional",
+	}
+}
+
+func (OnCrash) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "OnCrash defines what happens when the guest kernel panics.",
+		"action":              "Action taken when the guest kernel panics.\nOne of: Restart, Pause, MemoryDump.\nMemoryDump leaves the guest paused once its memory is dumped.\nDefaults to Pause.\n+optional",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the MemoryDump action.\nThe memory is only dumped for VMIs owned by a VirtualMachine.\n+optional",
 	}
 }
 
@@ -121,8 +130,10 @@ func (BIOS) SwaggerDoc() map[string]string {
 
 func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "If set, EFI will be used instead of BIOS.",
-		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"":                        "If set, EFI will be used instead of BIOS.",
+		"secureBoot":              "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent":              "If set to true, Persistent will persist the EFI NVRAM across reboots and live migrations.\nDefaults to false\n+optional",
+		"secureBootKeysSecretRef": "SecureBootKeysSecretRef references a k8s secret whose \"PK\", \"KEK\" and \"db\" entries hold\nPEM encoded X.509 certificates to enroll in place of the default Secure Boot keys.\nThe keys are enrolled when the NVRAM is initialized.\nRequires SecureBoot to be enabled.\n+optional",
 	}
 }
 
@@ -150,6 +161,7 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 		"requests":                "Requests is a description of the initial vmi resources.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"limits":                  "Limits describes the maximum amount of compute resources allowed.\nValid resource keys are \"memory\" and \"cpu\".\n+optional",
 		"overcommitGuestOverhead": "Don't ask the scheduler to take the guest-management overhead into account. Instead\nput the overhead only into the container's memory limit. This can lead to crashes if\nall memory is in use on a node. Defaults to false.",
+		"overcommitWithKSM":       "OvercommitWithKSM schedules the VMI on a KSM-enabled node and lowers its memory request\nby the memory overcommit these nodes report. Defaults to false.\n+optional",
 	}
 }
 
@@ -201,6 +213,16 @@ func (Memory) SwaggerDoc() map[string]string {
 		"":          "Memory allows specifying the VirtualMachineInstance memory features.",
 		"hugepages": "Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
 		"guest":     "Guest allows to specifying the amount of memory which is visible inside the Guest OS.\nThe Guest must lie between Requests and Limits from the resources section.\nDefaults to the requested memory in the resources section if not specified.\n+ optional",
+		"maxGuest":  "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS.\nThe delta between MaxGuest and Guest is the amount of memory that can be hotplugged.",
+		"balloon":   "Balloon enables a free memory policy which inflates the memory balloon of idle guests\nand deflates it when the guest is under memory pressure.\nIt requires the memory balloon device and its statistics to be enabled.\n+optional",
+	}
+}
+
+func (MemoryBalloon) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "MemoryBalloon configures the free memory policy of the memory balloon.",
+		"freeMemoryPercent": "FreeMemoryPercent is the percentage of its memory the policy keeps free inside the guest.\nDefaults to 20.\n+optional",
+		"minGuest":          "MinGuest is the lowest amount of memory the balloon can leave to the guest.\nDefaults to half of the guest memory.\n+optional",
 	}
 }
 
@@ -232,6 +254,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
 		"disks":                      "Disks describes disks, cdroms and luns which are connected to the vmi.",
 		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
+		"panicDevices":               "PanicDevices describe devices notifying the host when the guest kernel panics.\n+optional",
 		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.",
 		"inputs":                     "Inputs describe input devices",
 		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
@@ -268,7 +291,9 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"persistent": "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"persistent":           "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"stateRetentionPolicy": "StateRetentionPolicy defines what happens to the persistent TPM state when the VM is deleted.\nDelete removes the state together with the VM, Retain keeps it for a later VM of the same name.\nDefaults to Delete\n+optional",
+		"encryptionSecretRef":  "EncryptionSecretRef references a k8s secret whose \"key\" entry is used to encrypt the persistent TPM state.\n+optional",
 	}
 }
 
@@ -327,6 +352,19 @@ func (Disk) SwaggerDoc() map[string]string {
 		"tag":               "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
+		"ioTune":            "IOTune specifies I/O throttling limits for the disk.\nWith the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DiskIOTune represents the I/O throttling limits of a disk.\nA value of 0 means that the corresponding limit is not set.\nTotal limits are mutually exclusive with the read and write limits of the same kind.",
+		"totalBytesSec": "TotalBytesSec is the total throughput limit in bytes per second.\n+optional",
+		"readBytesSec":  "ReadBytesSec is the read throughput limit in bytes per second.\n+optional",
+		"writeBytesSec": "WriteBytesSec is the write throughput limit in bytes per second.\n+optional",
+		"totalIopsSec":  "TotalIopsSec is the total I/O operations per second limit.\n+optional",
+		"readIopsSec":   "ReadIopsSec is the read I/O operations per second limit.\n+optional",
+		"writeIopsSec":  "WriteIopsSec is the write I/O operations per second limit.\n+optional",
 	}
 }
 
@@ -362,6 +400,8 @@ func (DiskTarget) SwaggerDoc() map[string]string {
 func (LaunchSecurity) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sev": "AMD Secure Encrypted Virtualization (SEV).",
+		"snp": "AMD Secure Encrypted Virtualization with Secure Nested Paging (SEV-SNP).",
+		"tdx": "Intel Trust Domain Extensions (TDX).",
 	}
 }
 
@@ -384,6 +424,22 @@ func (SEVAttestation) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (SEVSNP) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"policy": "Guest policy flags as defined in AMD SEV-SNP firmware ABI specification.\nNote: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.",
+	}
+}
+
+func (SEVSNPPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"smt": "Allow the guest to run on hosts with simultaneous multithreading (SMT) enabled.\nDefaults to true.\n+optional",
+	}
+}
+
+func (TDX) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
 func (LunTarget) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"bus":         "Bus indicates the type of disk device to emulate.\nsupported values: virtio, sata, scsi.",
@@ -615,22 +671,46 @@ func (FeatureKVM) SwaggerDoc() map[string]string {
 
 func (Watchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":     "Named watchdog device.",
-		"name": "Name of the watchdog.",
+		"":                    "Named watchdog device.",
+		"name":                "Name of the watchdog.",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the dump action,\nbefore the guest is reset. Required by the dump action.\nThe memory is only dumped for VMIs owned by a VirtualMachine, other VMIs are reset right away.\n+optional",
 	}
 }
 
 func (WatchdogDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":         "Hardware watchdog device.\nExactly one of its members must be set.",
+		"":         "Hardware watchdog device.\nExactly one of its members must be set.\nThere is no virtio watchdog model, since QEMU and libvirt don't provide one.",
 		"i6300esb": "i6300esb watchdog device.\n+optional",
+		"diag288":  "diag288 watchdog device, available on s390x.\n+optional",
+		"itco":     "iTCO watchdog device built into the q35 chipset.\n+optional",
 	}
 }
 
 func (I6300ESBWatchdog) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "i6300esb watchdog device.",
-		"action": "The action to take. Valid values are poweroff, reset, shutdown.\nDefaults to reset.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, dump.\nDefaults to reset.",
+	}
+}
+
+func (Diag288Watchdog) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "diag288 watchdog device.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, dump.\nDefaults to reset.",
+	}
+}
+
+func (ITCOWatchdog) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "iTCO watchdog device.",
+		"action": "The action to take. Valid values are poweroff, reset, shutdown, dump.\nDefaults to reset.",
+	}
+}
+
+func (PanicDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "PanicDevice notifies the host when the guest kernel panics.",
+		"model": "Model specifies what type of panic device is provided.\nOne of: hyperv, isa, pvpanic.\nThe model used when it is missing depends on the guest architecture.\n+optional",
 	}
 }
 
@@ -638,6 +718,7 @@ func (Interface) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
 		"model":       "Interface model.\nOne of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
+		"binding":     "Binding specifies the binding plugin that will be used to connect the interface to the guest.\nIt provides an alternative to InterfaceBindingMethod.\n+optional",
 		"ports":       "List of ports to be forwarded to the virtual machine.",
 		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
 		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
@@ -645,17 +726,57 @@ func (Interface) SwaggerDoc() map[string]string {
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
-		"state":       "State represents the requested operational state of the interface.\nThe (only) value supported is `absent`, expressing a request to remove the interface.\n+optional",
+		"state":       "State represents the requested operational state of the interface.\nThe supported values are `absent`, expressing a request to remove the interface,\n`down`, setting the link of the interface down without removing it,\nand `up`, the default, setting the link of the interface up.\n+optional",
+		"bandwidth":   "Bandwidth specifies the inbound and outbound traffic limits of the interface.\nWith the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.\n+optional",
+		"filter":      "Filter specifies the traffic allowed to and from the guest on the interface.\nIt is only supported with the bridge binding method.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth represents the traffic limits of an interface, as seen from the guest.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit represents the shaping parameters of one traffic direction.",
+		"average": "Average is the average bit rate in kilobytes per second.",
+		"peak":    "Peak is the maximum rate at which the interface can send data in kilobytes per second.\n+optional",
+		"burst":   "Burst is the amount of kilobytes that can be sent in a single burst at peak speed.\n+optional",
 	}
 }
 
 func (DHCPOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "Extra DHCP options to use in the interface.",
-		"bootFileName":   "If specified will pass option 67 to interface's DHCP server\n+optional",
-		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
-		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
-		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"":                 "Extra DHCP options to use in the interface.",
+		"bootFileName":     "If specified will pass option 67 to interface's DHCP server\n+optional",
+		"tftpServerName":   "If specified will pass option 66 to interface's DHCP server\n+optional",
+		"ntpServers":       "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
+		"privateOptions":   "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"routes":           "If specified will pass the given static routes to the VM via DHCP option 121,\nin addition to the routes of the pod network.\n+optional",
+		"leaseTime":        "If specified will be used as the lease time of the DHCP and DHCPv6 servers.\nDefaults to an infinite lease.\n+optional",
+		"ipxeBootFileName": "If specified will pass option 67 with this value instead of BootFileName to\nclients identifying themselves as iPXE by the user class option 77.\nAllows chainloading an iPXE script after booting iPXE over the network.\n+optional",
+		"ipv6":             "IPv6 configures the DHCPv6 server and the router advertisements of the interface.\n+optional",
+	}
+}
+
+func (DHCPRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DHCPRoute defines a static route passed to the VM via DHCP option 121.",
+		"destination": "Destination is the IPv4 CIDR of the route, e.g. 10.10.0.0/16.",
+		"gateway":     "Gateway is the IPv4 address of the next hop of the route.",
+	}
+}
+
+func (DHCPv6Options) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "DHCPv6Options defines the IPv6 options passed to the VM.",
+		"dnsServers":           "If specified will pass the configured IPv6 DNS servers to the VM via DHCPv6 option 23.\n+optional",
+		"searchDomains":        "If specified will pass the configured domain search list to the VM via DHCPv6 option 24.\nDefaults to the search domains of the pod.\n+optional",
+		"routerAdvertisements": "If set to true, router advertisements are sent to the VM, providing its default route.\nSupported only on masquerade interfaces.\nRequires the Root feature gate, since a non-root virt-launcher lacks the NET_RAW capability.\n+optional",
 	}
 }
 
@@ -709,6 +830,31 @@ func (InterfacePasst) SwaggerDoc() map[string]string {
 	}
 }
 
+func (PluginBinding) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "PluginBinding represents a binding implemented in a plugin.",
+		"name": "Name references to the binding name as defined in the KubeVirt CR network configuration.",
+	}
+}
+
+func (InterfaceFilter) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InterfaceFilter represents the traffic filtering of an interface, as seen from the guest.",
+		"ingress":      "Ingress lists the traffic allowed to reach the guest.\nAny other traffic is dropped, unless the list is empty.\n+optional",
+		"egress":       "Egress lists the traffic the guest is allowed to send.\nAny other traffic is dropped, unless the list is empty.\n+optional",
+		"antiSpoofing": "AntiSpoofing drops the traffic sent by the guest with a source MAC or IPv4 address\nother than the ones of the interface.\nDefaults to true.\n+optional",
+	}
+}
+
+func (InterfaceFilterRule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceFilterRule allows the traffic matching all of its fields.",
+		"cidr":     "CIDR of the remote peer. For example: 10.0.0.0/8 or fd10::/64.\nMatches any peer when empty.\n+optional",
+		"protocol": "Protocol of the traffic. Must be TCP, UDP or ICMP.\nMatches any protocol when empty.\n+optional",
+		"ports":    "Destination ports of the traffic, each 0 < x < 65536.\nRequires the TCP or UDP protocol. Matches any port when empty.\n+optional",
+	}
+}
+
 func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port represents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory",
@@ -196,6 +196,9 @@ type VirtualMachineInstancePhaseTransitionTimestamp struct {
 
 type TopologyHints struct {
 	TSCFrequency *int64 `json:"tscFrequency,omitempty"`
+	// KSMMemoryOvercommit is the lowest memory overcommit percentage published by the KSM-enabled nodes
+	// when the launcher pod memory request was computed.
+	KSMMemoryOvercommit *int32 `json:"ksmMemoryOvercommit,omitempty"`
 }
 
 // VirtualMachineInstanceStatus represents information about the status of a VirtualMachineInstance. Status may trail the actual
@@ -280,6 +283,36 @@ type VirtualMachineInstanceStatus struct {
 	// Current topology may differ from the desired topology in the spec while CPU hotplug
 	// takes place.
 	CurrentCPUTopology *CPUTopology `json:"currentCPUTopology,omitempty"`
+
+	// Memory shows the current memory allocation of the VirtualMachineInstance.
+	// +optional
+	Memory *MemoryStatus `json:"memory,omitempty"`
+
+	// BackupStatus is the status of the last backup job started in the VirtualMachineInstance
+	// +optional
+	// +nullable
+	BackupStatus *VirtualMachineInstanceBackupStatus `json:"backupStatus,omitempty"`
+
+	// WatchdogStatus reports the firing of the watchdog device of the VirtualMachineInstance
+	// +optional
+	// +nullable
+	WatchdogStatus *VirtualMachineInstanceWatchdogStatus `json:"watchdogStatus,omitempty"`
+}
+
+// MemoryStatus shows the amount of memory used by the guest.
+type MemoryStatus struct {
+	// GuestAtBoot specifies the amount of memory the VirtualMachineInstance booted with.
+	// +optional
+	GuestAtBoot *resource.Quantity `json:"guestAtBoot,omitempty"`
+	// GuestCurrent specifies the amount of memory currently available to the guest.
+	// +optional
+	GuestCurrent *resource.Quantity `json:"guestCurrent,omitempty"`
+	// BalloonTarget is the amount of memory the memory balloon policy wants to leave to the guest.
+	// +optional
+	BalloonTarget *resource.Quantity `json:"balloonTarget,omitempty"`
+	// BalloonActual is the amount of memory the memory balloon currently leaves to the guest.
+	// +optional
+	BalloonActual *resource.Quantity `json:"balloonActual,omitempty"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
@@ -486,6 +519,9 @@ const (
 	// If the VMI was paused by the user, this is reported as true.
 	VirtualMachineInstancePaused VirtualMachineInstanceConditionType = "Paused"
 
+	// Reflects whether the guest kernel panicked and the guest was left paused
+	VirtualMachineInstanceGuestPanicked VirtualMachineInstanceConditionType = "GuestPanicked"
+
 	// Reflects whether the QEMU guest agent is connected through the channel
 	VirtualMachineInstanceAgentConnected VirtualMachineInstanceConditionType = "AgentConnected"
 
@@ -511,12 +547,16 @@ const (
 	VirtualMachineInstanceReasonHostDeviceNotMigratable = "HostDeviceNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses Secure Encrypted Virtualization (SEV)
 	VirtualMachineInstanceReasonSEVNotMigratable = "SEVNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses Trust Domain Extensions (TDX)
+	VirtualMachineInstanceReasonTDXNotMigratable = "TDXNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
 	VirtualMachineInstanceReasonNoTSCFrequencyMigratable = "NoTSCFrequencyNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it requested SCSI persitent reservation
 	VirtualMachineInstanceReasonPRNotMigratable = "PersistentReservationNotLiveMigratable"
 	// Indicates that the VMI is in progress of Hot vCPU Plug/UnPlug
 	VirtualMachineInstanceVCPUChange = "HotVCPUChange"
+	// Indicates that the VMI is in progress of Hot memory plug
+	VirtualMachineInstanceMemoryChange = "HotMemoryChange"
 )
 
 const (
@@ -577,6 +617,16 @@ func (m *VirtualMachineInstanceMigration) IsRunning() bool {
 	return true
 }
 
+// The migration sends the VMI to another cluster
+func (m *VirtualMachineInstanceMigration) IsCrossClusterSource() bool {
+	return m.Spec.SendTo != nil
+}
+
+// The migration receives the VMI from another cluster
+func (m *VirtualMachineInstanceMigration) IsCrossClusterTarget() bool {
+	return m.Spec.Receive != nil
+}
+
 // The migration phase indicates that the target pod should have already been created
 func (m *VirtualMachineInstanceMigration) TargetIsCreated() bool {
 	return m.Status.Phase != MigrationPhaseUnset &&
@@ -607,6 +657,21 @@ type VirtualMachineInstanceNetworkInterface struct {
 	InfoSource string `json:"infoSource,omitempty"`
 	// Specifies how many queues are allocated by MultiQueue
 	QueueCount int32 `json:"queueCount,omitempty"`
+	// FilterDroppedPackets counts the packets dropped by the interface filter, refreshed at most once per minute
+	// +optional
+	FilterDroppedPackets *InterfaceFilterDroppedPackets `json:"filterDroppedPackets,omitempty"`
+	// LinkState is the link state of the interface in the domain, up or down.
+	// Reported when the link state is controlled by the interface state.
+	// +optional
+	LinkState string `json:"linkState,omitempty"`
+}
+
+// InterfaceFilterDroppedPackets holds the number of packets dropped by an interface filter, per direction
+type InterfaceFilterDroppedPackets struct {
+	// Ingress is the number of dropped packets destined to the guest
+	Ingress int64 `json:"ingress"`
+	// Egress is the number of dropped packets sent by the guest, including the spoofed ones
+	Egress int64 `json:"egress"`
 }
 
 type VirtualMachineInstanceGuestOSInfo struct {
@@ -681,8 +746,36 @@ type VirtualMachineInstanceMigrationState struct {
 	// If the VMI requires dedicated CPUs, this field will
 	// hold the numa topology on the target node
 	TargetNodeTopology string `json:"targetNodeTopology,omitempty"`
+	// Details of a migration from or to another cluster
+	CrossCluster *CrossClusterMigrationState `json:"crossCluster,omitempty"`
+	// The name of the PVC holding the persistent VM state, like the TPM, used by the source pod
+	SourcePersistentStatePVCName string `json:"sourcePersistentStatePVCName,omitempty"`
+	// The name of the PVC holding the persistent VM state, like the TPM, used by the target pod.
+	// It differs from the source one when the state storage is not shared between nodes.
+	TargetPersistentStatePVCName string `json:"targetPersistentStatePVCName,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type CrossClusterMigrationState struct {
+	// The ID which identifies the migration on both clusters
+	MigrationID string `json:"migrationID,omitempty"`
+	// The host:port endpoint of the receiving cluster the libvirt streams are tunneled to
+	ConnectURL string `json:"connectURL,omitempty"`
+	// How persistent volumes are transferred to the receiving cluster
+	VolumeMigration CrossClusterVolumeMigration `json:"volumeMigration,omitempty"`
 }
 
+type CrossClusterVolumeMigration string
+
+const (
+	// CrossClusterVolumeMigrationPreSynced means that the receiving VMI references volumes whose content
+	// is kept in sync with the source volumes by the storage, so only the guest state is transferred
+	CrossClusterVolumeMigrationPreSynced CrossClusterVolumeMigration = "PreSynced"
+	// CrossClusterVolumeMigrationBlock means that the content of the persistent volumes is copied
+	// to the, possibly blank, volumes of the receiving VMI during the migration
+	CrossClusterVolumeMigrationBlock CrossClusterVolumeMigration = "BlockMigration"
+)
+
 type MigrationAbortStatus string
 
 const (
@@ -788,6 +881,11 @@ const (
 	// Machine Instance migration job. Needed because with CRDs we can't use field
 	// selectors. Used on VirtualMachineInstance.
 	MigrationTargetNodeNameLabel string = "kubevirt.io/migrationTargetNodeName"
+	// This annotation marks a VMI which is the target of a cross cluster
+	// migration. Such a VMI waits for the migrated domain instead of booting.
+	// The value is the ID of the cross cluster migration. Used on
+	// VirtualMachineInstance.
+	CrossClusterMigrationTargetAnnotation string = "kubevirt.io/cross-cluster-migration-target"
 	// This annotation indicates that a migration is the result of an
 	// automated evacuation
 	EvacuationMigrationAnnotation string = "kubevirt.io/evacuationMigration"
@@ -917,12 +1015,28 @@ const (
 	// SEVESLabel marks the node as capable of running workloads with SEV-ES
 	SEVESLabel string = "kubevirt.io/sev-es"
 
+	// SEVSNPLabel marks the node as capable of running workloads with SEV-SNP
+	SEVSNPLabel string = "kubevirt.io/sev-snp"
+
+	// TDXLabel marks the node as capable of running workloads with TDX
+	TDXLabel string = "kubevirt.io/tdx"
+
 	// KSMEnabledLabel marks the node as KSM enabled
 	KSMEnabledLabel string = "kubevirt.io/ksm-enabled"
 
 	// KSMHandlerManagedAnnotation is an annotation used to mark the nodes where the virt-handler has enabled the ksm
 	KSMHandlerManagedAnnotation string = "kubevirt.io/ksm-handler-managed"
 
+	// KSMPagesSharedAnnotation reports the number of shared pages KSM is maintaining on the node
+	KSMPagesSharedAnnotation string = "kubevirt.io/ksm-pages-shared"
+
+	// KSMPagesSharingAnnotation reports the number of pages KSM has merged into the shared pages of the node
+	KSMPagesSharingAnnotation string = "kubevirt.io/ksm-pages-sharing"
+
+	// KSMMemoryOvercommitAnnotation reports, in percent of the node memory, how much memory can be
+	// handed out to guests on the node thanks to the pages merged by KSM
+	KSMMemoryOvercommitAnnotation string = "kubevirt.io/ksm-memory-overcommit"
+
 	// InstancetypeAnnotation is the name of a VirtualMachineInstancetype
 	InstancetypeAnnotation string = "kubevirt.io/instancetype-name"
 
@@ -1226,6 +1340,33 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+	// SendTo migrates the VMI to another cluster, which has to run a migration with a
+	// matching Receive section. The VMI stops running in this cluster once the migration succeeded.
+	// Both clusters have to trust the CA of each others virt-handlers, see CrossClusterPeerCABundle
+	// in the migration configuration.
+	// +optional
+	SendTo *VirtualMachineInstanceMigrationSendTo `json:"sendTo,omitempty"`
+	// Receive makes the VMI the target of a migration from another cluster. The VMI has to carry the
+	// kubevirt.io/cross-cluster-migration-target annotation, so that it waits for the migrated domain
+	// instead of booting. It has to have the same name, namespace and spec as the source VMI.
+	// +optional
+	Receive *VirtualMachineInstanceMigrationReceive `json:"receive,omitempty"`
+}
+
+type VirtualMachineInstanceMigrationSendTo struct {
+	// MigrationID identifies the migration on both clusters and has to match the one of the receiving migration
+	MigrationID string `json:"migrationID"`
+	// ConnectURL is the host:port endpoint reported by the receiving migration in
+	// status.migrationState.crossCluster.connectURL
+	ConnectURL string `json:"connectURL"`
+	// VolumeMigration defines how persistent volumes reach the receiving cluster. Defaults to PreSynced.
+	// +optional
+	VolumeMigration CrossClusterVolumeMigration `json:"volumeMigration,omitempty"`
+}
+
+type VirtualMachineInstanceMigrationReceive struct {
+	// MigrationID identifies the migration on both clusters and has to match the one of the sending migration
+	MigrationID string `json:"migrationID"`
 }
 
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
@@ -1600,6 +1741,10 @@ const (
 	// VirtualMachinePaused is added in a virtual machine when its vmi
 	// signals with its own condition that it is paused.
 	VirtualMachinePaused VirtualMachineConditionType = "Paused"
+
+	// VirtualMachineRestartRequired is added in a virtual machine when its spec has changed
+	// in a way that cannot be applied to the running vmi and requires a restart.
+	VirtualMachineRestartRequired VirtualMachineConditionType = "RestartRequired"
 )
 
 type HostDiskType string
@@ -2152,6 +2297,21 @@ type VirtualMachineInstanceFileSystemInfo struct {
 	Filesystems []VirtualMachineInstanceFileSystem `json:"disks"`
 }
 
+// VirtualMachineInstanceCPULoad represents the vCPU utilization of a running VMI,
+// measured from the domain statistics over a short sampling window
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceCPULoad struct {
+	metav1.TypeMeta `json:",inline"`
+	// VCPUs is the number of vCPUs the utilization is averaged over
+	VCPUs int32 `json:"vCPUs"`
+	// UtilizationPercent is the average utilization of all vCPUs during the sampling window,
+	// 100 means that all vCPUs were busy for the whole window
+	UtilizationPercent int32 `json:"utilizationPercent"`
+	// Window is the duration of the sampling window
+	Window metav1.Duration `json:"window"`
+}
+
 // VirtualMachineInstanceFileSystemList comprises the list of all filesystems on guest machine
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -2175,6 +2335,88 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// VirtualMachineInstanceBackupMode is the way the disks of a VirtualMachineInstance are backed up
+type VirtualMachineInstanceBackupMode string
+
+const (
+	// BackupModePush makes QEMU write the backup of the disks to a volume of the VirtualMachineInstance
+	BackupModePush VirtualMachineInstanceBackupMode = "Push"
+	// BackupModePull exposes the disks as NBD exports until the backup job is aborted
+	BackupModePull VirtualMachineInstanceBackupMode = "Pull"
+)
+
+// VirtualMachineInstanceBackupOptions are the options of a backup job started in a VirtualMachineInstance
+type VirtualMachineInstanceBackupOptions struct {
+	// Name identifies the backup job
+	Name string `json:"name"`
+	// Mode is the way the disks are backed up
+	Mode VirtualMachineInstanceBackupMode `json:"mode"`
+	// Checkpoint is the name of the checkpoint created along with the backup
+	Checkpoint string `json:"checkpoint"`
+	// IncrementalFrom is the name of a previous checkpoint, only the blocks changed since
+	// that checkpoint are backed up. A full backup is taken when it is not set.
+	// +optional
+	IncrementalFrom *string `json:"incrementalFrom,omitempty"`
+	// Target is the name of the volume the backup is written to in push mode
+	// +optional
+	Target string `json:"target,omitempty"`
+}
+
+// VirtualMachineInstanceBackupStatus is the status of a backup job of a VirtualMachineInstance
+type VirtualMachineInstanceBackupStatus struct {
+	// Name identifies the backup job
+	Name string `json:"name"`
+	// Mode is the way the disks are backed up
+	Mode VirtualMachineInstanceBackupMode `json:"mode,omitempty"`
+	// Checkpoint is the name of the checkpoint created along with the backup
+	Checkpoint string `json:"checkpoint,omitempty"`
+	// StartTimestamp is the time the backup job started
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// EndTimestamp is the time the backup job ended
+	// +nullable
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Completed indicates that the backup job ended
+	Completed bool `json:"completed,omitempty"`
+	// Failed indicates that the backup job failed
+	Failed bool `json:"failed,omitempty"`
+	// FailureReason is the reason the backup job failed
+	FailureReason string `json:"failureReason,omitempty"`
+	// Volumes lists the volumes included in the backup
+	// +optional
+	// +listType=atomic
+	Volumes []VirtualMachineInstanceBackupVolume `json:"volumes,omitempty"`
+}
+
+// VirtualMachineInstanceWatchdogStatus reports the firing of the watchdog device
+type VirtualMachineInstanceWatchdogStatus struct {
+	// FiredCount is the number of times the watchdog fired since the VirtualMachineInstance started
+	FiredCount int64 `json:"firedCount,omitempty"`
+	// LastFiredTimestamp is the time the watchdog fired last
+	// +nullable
+	LastFiredTimestamp *metav1.Time `json:"lastFiredTimestamp,omitempty"`
+	// Action is the action taken the last time the watchdog fired
+	Action WatchdogAction `json:"action,omitempty"`
+}
+
+// VirtualMachineInstanceBackupVolume describes a volume included in a backup job
+type VirtualMachineInstanceBackupVolume struct {
+	// VolumeName is the name of the volume
+	VolumeName string `json:"volumeName"`
+	// DiskTarget is the target device of the disk in the domain
+	DiskTarget string `json:"diskTarget,omitempty"`
+	// ExportName is the NBD export name of the disk in pull mode
+	// +optional
+	ExportName string `json:"exportName,omitempty"`
+	// DirtyBitmap is the name of the bitmap exposing the changed blocks in pull mode.
+	// It is only set for incremental backups.
+	// +optional
+	DirtyBitmap string `json:"dirtyBitmap,omitempty"`
+	// Path is the file the disk is written to in push mode, relative to the target volume
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2352,9 +2594,14 @@ type KubeVirtConfiguration struct {
 	SeccompConfiguration           *SeccompConfiguration             `json:"seccompConfiguration,omitempty"`
 
 	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
-	// The storage class must support RWX in filesystem mode.
-	VMStateStorageClass   string                 `json:"vmStateStorageClass,omitempty"`
-	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
+	// The storage class must support the VMStateStorageAccessMode in filesystem mode.
+	VMStateStorageClass string `json:"vmStateStorageClass,omitempty"`
+	// VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state.
+	// With ReadWriteOnce, the state is copied to a new PVC when the VM is live migrated.
+	// Defaults to ReadWriteMany
+	// +optional
+	VMStateStorageAccessMode k8sv1.PersistentVolumeAccessMode `json:"vmStateStorageAccessMode,omitempty"`
+	VirtualMachineOptions    *VirtualMachineOptions           `json:"virtualMachineOptions,omitempty"`
 
 	// KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).
 	KSMConfiguration *KSMConfiguration `json:"ksmConfiguration,omitempty"`
@@ -2510,7 +2757,30 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
-}
+	// ParallelMigrationConnections is the number of parallel connections (multifd channels) used to transfer
+	// the state of a single VMI during a live migration. Defaults to a single connection
+	ParallelMigrationConnections *uint32 `json:"parallelMigrationConnections,omitempty"`
+	// Compression is the method used to compress the live migration stream. zstd compression always
+	// uses parallel migration connections. Defaults to no compression
+	Compression *MigrationCompression `json:"compression,omitempty"`
+	// AllowWorkloadDisruption allows the platform to pause a VMI whose live migration did not complete within
+	// CompletionTimeoutPerGiB, so that the migration can converge. If AllowPostCopy is also true, post-copy
+	// takes precedence. Defaults to false
+	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	// CrossClusterPeerCABundle holds the PEM encoded CA certificates of the virt-handlers of the clusters VMIs
+	// are migrated from or to. The tunnels of cross cluster migrations trust them in addition to the KubeVirt CA.
+	CrossClusterPeerCABundle string `json:"crossClusterPeerCABundle,omitempty"`
+}
+
+// MigrationCompression is the method used to compress the live migration stream
+type MigrationCompression string
+
+const (
+	// MigrationCompressionXBZRLE compresses the memory pages that were modified since they were last sent
+	MigrationCompressionXBZRLE MigrationCompression = "xbzrle"
+	// MigrationCompressionZstd compresses the migration stream with zstd, using parallel migration connections
+	MigrationCompressionZstd MigrationCompression = "zstd"
+)
 
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
@@ -2638,6 +2908,32 @@ type KSMConfiguration struct {
 	// Empty NodeLabelSelector will enable ksm for every node.
 	// +optional
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
+	// Tuning enables virt-handler to adapt the KSM scanning rate to the memory pressure of the node.
+	// Without it KSM runs with the kernel defaults.
+	// +optional
+	Tuning *KSMTuning `json:"tuning,omitempty"`
+}
+
+// KSMTuning configures how aggressively KSM scans the memory of a node.
+// The number of pages to scan grows while the node is under memory pressure and decays otherwise.
+// +k8s:openapi-gen=true
+type KSMTuning struct {
+	// PagesToScanMin is the number of pages scanned per period while the node is not under memory pressure.
+	// Defaults to 64.
+	// +optional
+	PagesToScanMin *uint32 `json:"pagesToScanMin,omitempty"`
+	// PagesToScanMax is the maximum number of pages scanned per period while the node is under memory pressure.
+	// Defaults to 1250.
+	// +optional
+	PagesToScanMax *uint32 `json:"pagesToScanMax,omitempty"`
+	// SleepMillisecs is the pause between two scan periods on a node with 16Gi of memory.
+	// It is scaled down for nodes with more memory. Defaults to 10.
+	// +optional
+	SleepMillisecs *uint32 `json:"sleepMillisecs,omitempty"`
+	// FreeMemoryThresholdPercent is the percentage of available memory below which the node is considered to be under memory pressure.
+	// Defaults to 20.
+	// +optional
+	FreeMemoryThresholdPercent *uint32 `json:"freeMemoryThresholdPercent,omitempty"`
 }
 
 // NetworkConfiguration holds network options
@@ -2645,8 +2941,56 @@ type NetworkConfiguration struct {
 	NetworkInterface                  string `json:"defaultNetworkInterface,omitempty"`
 	PermitSlirpInterface              *bool  `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool  `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
+	// Binding registers the network binding plugins, by name, which interfaces can refer to.
+	// +optional
+	Binding map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// MacAddressPool configures the MAC address ranges which unique MAC addresses are allocated from
+	// to the interfaces of VirtualMachines that do not specify one, before they are started.
+	// When set, duplicate MAC addresses among VirtualMachines are rejected.
+	// +optional
+	MacAddressPool *MacAddressPool `json:"macAddressPool,omitempty"`
+}
+
+// MacAddressPool holds the MAC address ranges VirtualMachine interfaces are allocated from.
+type MacAddressPool struct {
+	// Ranges lists the MAC address ranges of the pool, allocated in order.
+	Ranges []MacAddressRange `json:"ranges"`
+}
+
+// MacAddressRange is an inclusive range of MAC addresses.
+type MacAddressRange struct {
+	// Start is the first MAC address of the range, e.g. 02:00:00:00:00:00.
+	Start string `json:"start"`
+	// End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff.
+	End string `json:"end"`
+}
+
+// InterfaceBindingPlugin describes how a network binding plugin connects an interface to the guest.
+type InterfaceBindingPlugin struct {
+	// SidecarImage references a container image that runs in the virt-launcher pod.
+	// The sidecar is called over the hooks OnDefineDomain API with the interface and the domain spec
+	// and may run additional services.
+	// +optional
+	SidecarImage string `json:"sidecarImage,omitempty"`
+	// NetworkAttachmentDefinition references a NetworkAttachmentDefinition which is attached to the
+	// virt-launcher pod to perform the pod-level network setup of the plugin.
+	// Format: <name> or <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.
+	// +optional
+	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
+	// DomainAttachmentType is a standard domain network attachment method KubeVirt supports.
+	// Supported values: "tap".
+	// The standard domain attachment can be used instead or in addition to the sidecarImage.
+	// +optional
+	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
 }
 
+type DomainAttachmentType string
+
+const (
+	// Tap attaches the interface to the domain through a tap device, which the plugin creates in the pod.
+	Tap DomainAttachmentType = "tap"
+)
+
 // GuestAgentPing configures the guest-agent based ping probe
 type GuestAgentPing struct {
 }
@@ -2751,6 +3095,11 @@ type LiveUpdateFeatures struct {
 	// Default is specified on cluster level.
 	// Absence of the struct means opt-out from CPU hotplug functionality.
 	CPU *LiveUpdateCPU `json:"cpu,omitempty" optional:"true"`
+	// LiveUpdateMemory holds hotplug configuration for the memory resource.
+	// Empty struct indicates that default will be used for maxGuest.
+	// Default is specified on cluster level.
+	// Absence of the struct means opt-out from memory hotplug functionality.
+	Memory *LiveUpdateMemory `json:"memory,omitempty" optional:"true"`
 }
 
 type LiveUpdateCPU struct {
@@ -2758,9 +3107,18 @@ type LiveUpdateCPU struct {
 	MaxSockets *uint32 `json:"maxSockets,omitempty" optional:"true"`
 }
 
+type LiveUpdateMemory struct {
+	// MaxGuest defines the maximum amount memory that can be allocated
+	// to the guest using hotplug.
+	MaxGuest *resource.Quantity `json:"maxGuest,omitempty" optional:"true"`
+}
+
 type LiveUpdateConfiguration struct {
 	// MaxCpuSockets holds the maximum amount of sockets that can be hotplugged
 	MaxCpuSockets *uint32 `json:"maxCpuSockets,omitempty"`
+	// MaxGuest defines the maximum amount memory that can be allocated
+	// to the guest using hotplug.
+	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
 }
 
 // SEVPlatformInfo contains information about the AMD SEV features for the node.
@@ -2808,3 +3166,17 @@ type SEVSecretOptions struct {
 	// Base64 encoded encrypted launch secret.
 	Secret string `json:"secret,omitempty"`
 }
+
+// AttestationReport contains an attestation report of a confidential guest.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AttestationReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// Launch security technology the guest is running with. Only sev is supported,
+	// SEV-SNP and TDX guests request their reports from within the guest.
+	Type string `json:"type,omitempty"`
+	// Base64 encoded nonce the report was requested with.
+	Nonce string `json:"nonce,omitempty"`
+	// Base64 encoded attestation report of the guest.
+	Report string `json:"report,omitempty"`
+}
@@ -51,7 +51,9 @@ func (VirtualMachineInstancePhaseTransitionTimestamp) SwaggerDoc() map[string]st
 }
 
 func (TopologyHints) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"ksmMemoryOvercommit": "KSMMemoryOvercommit is the lowest memory overcommit percentage published by the KSM-enabled nodes\nwhen the launcher pod memory request was computed.",
+	}
 }
 
 func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
@@ -80,6 +82,19 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"selinuxContext":                "SELinuxContext is the actual SELinux context of the virt-launcher pod\n+optional",
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
+		"memory":                        "Memory shows the current memory allocation of the VirtualMachineInstance.\n+optional",
+		"backupStatus":                  "BackupStatus is the status of the last backup job started in the VirtualMachineInstance\n+optional\n+nullable",
+		"watchdogStatus":                "WatchdogStatus reports the firing of the watchdog device of the VirtualMachineInstance\n+optional\n+nullable",
+	}
+}
+
+func (MemoryStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "MemoryStatus shows the amount of memory used by the guest.",
+		"guestAtBoot":   "GuestAtBoot specifies the amount of memory the VirtualMachineInstance booted with.\n+optional",
+		"guestCurrent":  "GuestCurrent specifies the amount of memory currently available to the guest.\n+optional",
+		"balloonTarget": "BalloonTarget is the amount of memory the memory balloon policy wants to leave to the guest.\n+optional",
+		"balloonActual": "BalloonActual is the amount of memory the memory balloon currently leaves to the guest.\n+optional",
 	}
 }
 
@@ -144,13 +159,23 @@ func (VirtualMachineInstanceMigrationCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"ipAddress":     "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
-		"mac":           "Hardware address of a Virtual Machine interface",
-		"name":          "Name of the interface, corresponds to name of the network assigned to the interface",
-		"ipAddresses":   "List of all IP addresses of a Virtual Machine interface",
-		"interfaceName": "The interface name inside the Virtual Machine",
-		"infoSource":    "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
-		"queueCount":    "Specifies how many queues are allocated by MultiQueue",
+		"ipAddress":            "IP address of a Virtual Machine interface. It is always the first item of\nIPs",
+		"mac":                  "Hardware address of a Virtual Machine interface",
+		"name":                 "Name of the interface, corresponds to name of the network assigned to the interface",
+		"ipAddresses":          "List of all IP addresses of a Virtual Machine interface",
+		"interfaceName":        "The interface name inside the Virtual Machine",
+		"infoSource":           "Specifies the origin of the interface data collected. values: domain, guest-agent, multus-status.",
+		"queueCount":           "Specifies how many queues are allocated by MultiQueue",
+		"filterDroppedPackets": "FilterDroppedPackets counts the packets dropped by the interface filter, refreshed at most once per minute\n+optional",
+		"linkState":            "LinkState is the link state of the interface in the domain, up or down.\nReported when the link state is controlled by the interface state.\n+optional",
+	}
+}
+
+func (InterfaceFilterDroppedPackets) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "InterfaceFilterDroppedPackets holds the number of packets dropped by an interface filter, per direction",
+		"ingress": "Ingress is the number of dropped packets destined to the guest",
+		"egress":  "Egress is the number of dropped packets sent by the guest, including the spoofed ones",
 	}
 }
 
@@ -190,6 +215,18 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"migrationConfiguration":         "Migration configurations to apply",
 		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
+		"crossCluster":                   "Details of a migration from or to another cluster",
+		"sourcePersistentStatePVCName":   "The name of the PVC holding the persistent VM state, like the TPM, used by the source pod",
+		"targetPersistentStatePVCName":   "The name of the PVC holding the persistent VM state, like the TPM, used by the target pod.\nIt differs from the source one when the state storage is not shared between nodes.",
+	}
+}
+
+func (CrossClusterMigrationState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "+k8s:openapi-gen=true",
+		"migrationID":     "The ID which identifies the migration on both clusters",
+		"connectURL":      "The host:port endpoint of the receiving cluster the libvirt streams are tunneled to",
+		"volumeMigration": "How persistent volumes are transferred to the receiving cluster",
 	}
 }
 
@@ -270,6 +307,22 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"sendTo":  "SendTo migrates the VMI to another cluster, which has to run a migration with a\nmatching Receive section. The VMI stops running in this cluster once the migration succeeded.\nBoth clusters have to trust the CA of each others virt-handlers, see CrossClusterPeerCABundle\nin the migration configuration.\n+optional",
+		"receive": "Receive makes the VMI the target of a migration from another cluster. The VMI has to carry the\nkubevirt.io/cross-cluster-migration-target annotation, so that it waits for the migrated domain\ninstead of booting. It has to have the same name, namespace and spec as the source VMI.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationSendTo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"migrationID":     "MigrationID identifies the migration on both clusters and has to match the one of the receiving migration",
+		"connectURL":      "ConnectURL is the host:port endpoint reported by the receiving migration in\nstatus.migrationState.crossCluster.connectURL",
+		"volumeMigration": "VolumeMigration defines how persistent volumes reach the receiving cluster. Defaults to PreSynced.\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMigrationReceive) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"migrationID": "MigrationID identifies the migration on both clusters and has to match the one of the sending migration",
 	}
 }
 
@@ -614,6 +667,15 @@ func (VirtualMachineInstanceFileSystemInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceCPULoad) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceCPULoad represents the vCPU utilization of a running VMI,\nmeasured from the domain statistics over a short sampling window\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"vCPUs":              "VCPUs is the number of vCPUs the utilization is averaged over",
+		"utilizationPercent": "UtilizationPercent is the average utilization of all vCPUs during the sampling window,\n100 means that all vCPUs were busy for the whole window",
+		"window":             "Window is the duration of the sampling window",
+	}
+}
+
 func (VirtualMachineInstanceFileSystemList) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "VirtualMachineInstanceFileSystemList comprises the list of all filesystems on guest machine\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
@@ -632,6 +694,52 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceBackupOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineInstanceBackupOptions are the options of a backup job started in a VirtualMachineInstance",
+		"name":            "Name identifies the backup job",
+		"mode":            "Mode is the way the disks are backed up",
+		"checkpoint":      "Checkpoint is the name of the checkpoint created along with the backup",
+		"incrementalFrom": "IncrementalFrom is the name of a previous checkpoint, only the blocks changed since\nthat checkpoint are backed up. A full backup is taken when it is not set.\n+optional",
+		"target":          "Target is the name of the volume the backup is written to in push mode\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceBackupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceBackupStatus is the status of a backup job of a VirtualMachineInstance",
+		"name":           "Name identifies the backup job",
+		"mode":           "Mode is the way the disks are backed up",
+		"checkpoint":     "Checkpoint is the name of the checkpoint created along with the backup",
+		"startTimestamp": "StartTimestamp is the time the backup job started\n+nullable",
+		"endTimestamp":   "EndTimestamp is the time the backup job ended\n+nullable",
+		"completed":      "Completed indicates that the backup job ended",
+		"failed":         "Failed indicates that the backup job failed",
+		"failureReason":  "FailureReason is the reason the backup job failed",
+		"volumes":        "Volumes lists the volumes included in the backup\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceWatchdogStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceWatchdogStatus reports the firing of the watchdog device",
+		"firedCount":         "FiredCount is the number of times the watchdog fired since the VirtualMachineInstance started",
+		"lastFiredTimestamp": "LastFiredTimestamp is the time the watchdog fired last\n+nullable",
+		"action":             "Action is the action taken the last time the watchdog fired",
+	}
+}
+
+func (VirtualMachineInstanceBackupVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceBackupVolume describes a volume included in a backup job",
+		"volumeName":  "VolumeName is the name of the volume",
+		"diskTarget":  "DiskTarget is the target device of the disk in the domain",
+		"exportName":  "ExportName is the NBD export name of the disk in pull mode\n+optional",
+		"dirtyBitmap": "DirtyBitmap is the name of the bitmap exposing the changed blocks in pull mode.\nIt is only set for incremental backups.\n+optional",
+		"path":        "Path is the file the disk is written to in push mode, relative to the target volume\n+optional",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -718,7 +826,8 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"additionalGuestMemoryOverheadRatio": "AdditionalGuestMemoryOverheadRatio can be used to increase the virtualization infrastructure\noverhead. This is useful, since the calculation of this overhead is not accurate and cannot\nbe entirely known in advance. The ratio that is being set determines by which factor to increase\nthe overhead calculated by Kubevirt. A higher ratio means that the VMs would be less compromised\nby node pressures, but would mean that fewer VMs could be scheduled to a node.\nIf not set, the default is 1.",
 		"supportContainerResources":          "+listType=map\n+listMapKey=type\nSupportContainerResources specifies the resource requirements for various types of supporting containers such as container disks/virtiofs/sidecars and hotplug attachment pods. If omitted a sensible default will be supplied.",
 		"supportedGuestAgentVersions":        "deprecated",
-		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.\nThe storage class must support RWX in filesystem mode.",
+		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.\nThe storage class must support the VMStateStorageAccessMode in filesystem mode.",
+		"vmStateStorageAccessMode":           "VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state.\nWith ReadWriteOnce, the state is copied to a new PVC when the VM is live migrated.\nDefaults to ReadWriteMany\n+optional",
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
@@ -796,6 +905,10 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"parallelMigrationConnections":      "ParallelMigrationConnections is the number of parallel connections (multifd channels) used to transfer\nthe state of a single VMI during a live migration. Defaults to a single connection",
+		"compression":                       "Compression is the method used to compress the live migration stream. zstd compression always\nuses parallel migration connections. Defaults to no compression",
+		"allowWorkloadDisruption":           "AllowWorkloadDisruption allows the platform to pause a VMI whose live migration did not complete within\nCompletionTimeoutPerGiB, so that the migration can converge. If AllowPostCopy is also true, post-copy\ntakes precedence. Defaults to false",
+		"crossClusterPeerCABundle":          "CrossClusterPeerCABundle holds the PEM encoded CA certificates of the virt-handlers of the clusters VMIs\nare migrated from or to. The tunnels of cross cluster migrations trust them in addition to the KubeVirt CA.",
 	}
 }
 
@@ -871,12 +984,49 @@ func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
 		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"tuning":            "Tuning enables virt-handler to adapt the KSM scanning rate to the memory pressure of the node.\nWithout it KSM runs with the kernel defaults.\n+optional",
+	}
+}
+
+func (KSMTuning) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                           "KSMTuning configures how aggressively KSM scans the memory of a node.\nThe number of pages to scan grows while the node is under memory pressure and decays otherwise.\n+k8s:openapi-gen=true",
+		"pagesToScanMin":             "PagesToScanMin is the number of pages scanned per period while the node is not under memory pressure.\nDefaults to 64.\n+optional",
+		"pagesToScanMax":             "PagesToScanMax is the maximum number of pages scanned per period while the node is under memory pressure.\nDefaults to 1250.\n+optional",
+		"sleepMillisecs":             "SleepMillisecs is the pause between two scan periods on a node with 16Gi of memory.\nIt is scaled down for nodes with more memory. Defaults to 10.\n+optional",
+		"freeMemoryThresholdPercent": "FreeMemoryThresholdPercent is the percentage of available memory below which the node is considered to be under memory pressure.\nDefaults to 20.\n+optional",
 	}
 }
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options",
+		"":               "NetworkConfiguration holds network options",
+		"binding":        "Binding registers the network binding plugins, by name, which interfaces can refer to.\n+optional",
+		"macAddressPool": "MacAddressPool configures the MAC address ranges which unique MAC addresses are allocated from\nto the interfaces of VirtualMachines that do not specify one, before they are started.\nWhen set, duplicate MAC addresses among VirtualMachines are rejected.\n+optional",
+	}
+}
+
+func (MacAddressPool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "MacAddressPool holds the MAC address ranges VirtualMachine interfaces are allocated from.",
+		"ranges": "Ranges lists the MAC address ranges of the pool, allocated in order.",
+	}
+}
+
+func (MacAddressRange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "MacAddressRange is an inclusive range of MAC addresses.",
+		"start": "Start is the first MAC address of the range, e.g. 02:00:00:00:00:00.",
+		"end":   "End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff.",
+	}
+}
+
+func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "InterfaceBindingPlugin describes how a network binding plugin connects an interface to the guest.",
+		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar is called over the hooks OnDefineDomain API with the interface and the domain spec\nand may run additional services.\n+optional",
+		"networkAttachmentDefinition": "NetworkAttachmentDefinition references a NetworkAttachmentDefinition which is attached to the\nvirt-launcher pod to perform the pod-level network setup of the plugin.\nFormat: <name> or <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.\n+optional",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method KubeVirt supports.\nSupported values: \"tap\".\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\n+optional",
 	}
 }
 
@@ -920,7 +1070,8 @@ func (PreferenceMatcher) SwaggerDoc() map[string]string {
 
 func (LiveUpdateFeatures) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"cpu": "LiveUpdateCPU holds hotplug configuration for the CPU resource.\nEmpty struct indicates that default will be used for maxSockets.\nDefault is specified on cluster level.\nAbsence of the struct means opt-out from CPU hotplug functionality.",
+		"cpu":    "LiveUpdateCPU holds hotplug configuration for the CPU resource.\nEmpty struct indicates that default will be used for maxSockets.\nDefault is specified on cluster level.\nAbsence of the struct means opt-out from CPU hotplug functionality.",
+		"memory": "LiveUpdateMemory holds hotplug configuration for the memory resource.\nEmpty struct indicates that default will be used for maxGuest.\nDefault is specified on cluster level.\nAbsence of the struct means opt-out from memory hotplug functionality.",
 	}
 }
 
@@ -930,9 +1081,16 @@ func (LiveUpdateCPU) SwaggerDoc() map[string]string {
 	}
 }
 
+func (LiveUpdateMemory) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"maxGuest": "MaxGuest defines the maximum amount memory that can be allocated\nto the guest using hotplug.",
+	}
+}
+
 func (LiveUpdateConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"maxCpuSockets": "MaxCpuSockets holds the maximum amount of sockets that can be hotplugged",
+		"maxGuest":      "MaxGuest defines the maximum amount memory that can be allocated\nto the guest using hotplug.",
 	}
 }
 
@@ -971,3 +1129,12 @@ func (SEVSecretOptions) SwaggerDoc() map[string]string {
 		"secret": "Base64 encoded encrypted launch secret.",
 	}
 }
+
+func (AttestationReport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "AttestationReport contains an attestation report of a confidential guest.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"type":   "Launch security technology the guest is running with. Only sev is supported,\nSEV-SNP and TDX guests request their reports from within the guest.",
+		"nonce":  "Base64 encoded nonce the report was requested with.",
+		"report": "Base64 encoded attestation report of the guest.",
+	}
+}
@@ -180,6 +180,9 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// Extents is a JSON list of the allocated extents of a volume exported from a VirtualMachineBackup,
+	// or of the extents changed since the previous checkpoint when the backup is incremental
+	Extents ExportVolumeFormat = "extents"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
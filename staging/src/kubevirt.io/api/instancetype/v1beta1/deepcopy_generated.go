@@ -333,6 +333,11 @@ func (in *MemoryInstancetype) DeepCopyInto(out *MemoryInstancetype) {
 		*out = new(v1.Hugepages)
 		**out = **in
 	}
+	if in.Balloon != nil {
+		in, out := &in.Balloon, &out.Balloon
+		*out = new(v1.MemoryBalloon)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -161,6 +161,10 @@ type MemoryInstancetype struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:validation:Minimum=0
 	OvercommitPercent int `json:"overcommitPercent,omitempty"`
+
+	// Optionally enables the free memory policy of the memory balloon for the VirtualMachineInstance.
+	// +optional
+	Balloon *v1.MemoryBalloon `json:"balloon,omitempty"`
 }
 
 // VirtualMachinePreference resource contains optional preferences related to the VirtualMachine.
@@ -58,6 +58,7 @@ func (MemoryInstancetype) SwaggerDoc() map[string]string {
 		"guest":             "Required amount of memory which is visible inside the guest OS.",
 		"hugepages":         "Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.\n+optional",
 		"overcommitPercent": "OvercommitPercent is the percentage of the guest memory which will be overcommitted.\nThis means that the VMIs parent pod (virt-launcher) will request less\nphysical memory by a factor specified by the OvercommitPercent.\nOvercommits can lead to memory exhaustion, which in turn can lead to crashes. Use carefully.\nDefaults to 0\n+optional\n+kubebuilder:validation:Maximum=100\n+kubebuilder:validation:Minimum=0",
+		"balloon":           "Optionally enables the free memory policy of the memory balloon for the VirtualMachineInstance.\n+optional",
 	}
 }
 
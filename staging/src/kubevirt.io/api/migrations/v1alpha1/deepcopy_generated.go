@@ -23,8 +23,25 @@ package v1alpha1
 
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	v1 "kubevirt.io/api/core/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GovernedVirtualMachineInstance) DeepCopyInto(out *GovernedVirtualMachineInstance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GovernedVirtualMachineInstance.
+func (in *GovernedVirtualMachineInstance) DeepCopy() *GovernedVirtualMachineInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(GovernedVirtualMachineInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in LabelSelector) DeepCopyInto(out *LabelSelector) {
 	{
@@ -53,7 +70,7 @@ func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -116,6 +133,11 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(Selectors)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	if in.AllowAutoConverge != nil {
 		in, out := &in.AllowAutoConverge, &out.AllowAutoConverge
 		*out = new(bool)
@@ -136,6 +158,26 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationConnections != nil {
+		in, out := &in.ParallelMigrationConnections, &out.ParallelMigrationConnections
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(v1.MigrationCompression)
+		**out = **in
+	}
+	if in.ProgressTimeout != nil {
+		in, out := &in.ProgressTimeout, &out.ProgressTimeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AllowWorkloadDisruption != nil {
+		in, out := &in.AllowWorkloadDisruption, &out.AllowWorkloadDisruption
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -152,6 +194,11 @@ func (in *MigrationPolicySpec) DeepCopy() *MigrationPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
+	if in.VirtualMachineInstances != nil {
+		in, out := &in.VirtualMachineInstances, &out.VirtualMachineInstances
+		*out = make([]GovernedVirtualMachineInstance, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -43,6 +43,12 @@ type MigrationPolicy struct {
 type MigrationPolicySpec struct {
 	Selectors *Selectors `json:"selectors"`
 
+	// Priority decides which policy applies when more than one policy matches a VMI.
+	// The matching policy with the highest priority is chosen, regardless of how many labels
+	// the other policies match. Defaults to 0
+	//+optional
+	Priority *int32 `json:"priority,omitempty"`
+
 	//+optional
 	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
 	//+optional
@@ -51,6 +57,14 @@ type MigrationPolicySpec struct {
 	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
 	//+optional
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	//+optional
+	ParallelMigrationConnections *uint32 `json:"parallelMigrationConnections,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	//+optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+	//+optional
+	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -63,6 +77,17 @@ type Selectors struct {
 }
 
 type MigrationPolicyStatus struct {
+	// VirtualMachineInstances lists the VMIs that are currently governed by this policy,
+	// i.e. the VMIs for which this policy is the matching policy
+	//+optional
+	//+listType=atomic
+	VirtualMachineInstances []GovernedVirtualMachineInstance `json:"virtualMachineInstances,omitempty"`
+}
+
+// GovernedVirtualMachineInstance references a VMI that is governed by a migration policy
+type GovernedVirtualMachineInstance struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -99,6 +124,26 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		changed = true
 		*clusterMigrationConfigurations.AllowPostCopy = *policySpec.AllowPostCopy
 	}
+	if policySpec.ParallelMigrationConnections != nil {
+		changed = true
+		parallelMigrationConnections := *policySpec.ParallelMigrationConnections
+		clusterMigrationConfigurations.ParallelMigrationConnections = &parallelMigrationConnections
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		compression := *policySpec.Compression
+		clusterMigrationConfigurations.Compression = &compression
+	}
+	if policySpec.ProgressTimeout != nil {
+		changed = true
+		progressTimeout := *policySpec.ProgressTimeout
+		clusterMigrationConfigurations.ProgressTimeout = &progressTimeout
+	}
+	if policySpec.AllowWorkloadDisruption != nil {
+		changed = true
+		allowWorkloadDisruption := *policySpec.AllowWorkloadDisruption
+		clusterMigrationConfigurations.AllowWorkloadDisruption = &allowWorkloadDisruption
+	}
 
 	return changed, nil
 }
@@ -11,10 +11,15 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
+		"priority":                     "Priority decides which policy applies when more than one policy matches a VMI.\nThe matching policy with the highest priority is chosen, regardless of how many labels\nthe other policies match. Defaults to 0\n+optional",
+		"allowAutoConverge":            "+optional",
+		"bandwidthPerMigration":        "+optional",
+		"completionTimeoutPerGiB":      "+optional",
+		"allowPostCopy":                "+optional",
+		"parallelMigrationConnections": "+optional",
+		"compression":                  "+optional",
+		"progressTimeout":              "+optional",
+		"allowWorkloadDisruption":      "+optional",
 	}
 }
 
@@ -26,7 +31,15 @@ func (Selectors) SwaggerDoc() map[string]string {
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"virtualMachineInstances": "VirtualMachineInstances lists the VMIs that are currently governed by this policy,\ni.e. the VMIs for which this policy is the matching policy\n+optional\n+listType=atomic",
+	}
+}
+
+func (GovernedVirtualMachineInstance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "GovernedVirtualMachineInstance references a VMI that is governed by a migration policy",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
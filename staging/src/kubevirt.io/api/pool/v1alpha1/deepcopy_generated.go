@@ -24,6 +24,8 @@ package v1alpha1
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+	corev1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -72,6 +74,22 @@ func (in *VirtualMachinePoolCondition) DeepCopy() *VirtualMachinePoolCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolDataVolumeClaimRetentionPolicy) DeepCopyInto(out *VirtualMachinePoolDataVolumeClaimRetentionPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolDataVolumeClaimRetentionPolicy.
+func (in *VirtualMachinePoolDataVolumeClaimRetentionPolicy) DeepCopy() *VirtualMachinePoolDataVolumeClaimRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolDataVolumeClaimRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolList) DeepCopyInto(out *VirtualMachinePoolList) {
 	*out = *in
@@ -105,6 +123,53 @@ func (in *VirtualMachinePoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopyInto(out *VirtualMachinePoolRollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolRollingUpdate.
+func (in *VirtualMachinePoolRollingUpdate) DeepCopy() *VirtualMachinePoolRollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolRollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolScaleInPolicy) DeepCopyInto(out *VirtualMachinePoolScaleInPolicy) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = make([]VirtualMachinePoolScaleInOrder, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolScaleInPolicy.
+func (in *VirtualMachinePoolScaleInPolicy) DeepCopy() *VirtualMachinePoolScaleInPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolScaleInPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 	*out = *in
@@ -123,6 +188,28 @@ func (in *VirtualMachinePoolSpec) DeepCopyInto(out *VirtualMachinePoolSpec) {
 		*out = new(VirtualMachineTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(VirtualMachinePoolUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleInPolicy != nil {
+		in, out := &in.ScaleInPolicy, &out.ScaleInPolicy
+		*out = new(VirtualMachinePoolScaleInPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataVolumeClaimTemplates != nil {
+		in, out := &in.DataVolumeClaimTemplates, &out.DataVolumeClaimTemplates
+		*out = make([]corev1.DataVolumeTemplateSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataVolumeClaimRetentionPolicy != nil {
+		in, out := &in.DataVolumeClaimRetentionPolicy, &out.DataVolumeClaimRetentionPolicy
+		*out = new(VirtualMachinePoolDataVolumeClaimRetentionPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -159,6 +246,27 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopyInto(out *VirtualMachinePoolUpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(VirtualMachinePoolRollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePoolUpdateStrategy.
+func (in *VirtualMachinePoolUpdateStrategy) DeepCopy() *VirtualMachinePoolUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePoolUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSpec) {
 	*out = *in
@@ -22,12 +22,17 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
 
 const (
 	VirtualMachinePoolKind = "VirtualMachinePool"
+
+	// VirtualMachinePoolScaleInProtectionAnnotation set to "true" on a VirtualMachine of a pool
+	// prevents the pool controller from removing the VM when the pool scales in.
+	VirtualMachinePoolScaleInProtectionAnnotation = "pool.kubevirt.io/scale-in-protection"
 )
 
 // VirtualMachinePool resource contains a VirtualMachine configuration
@@ -36,6 +41,8 @@ const (
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +k8s:openapi-gen=true
 // +genclient
+// +genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale
+// +genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale
 type VirtualMachinePool struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -89,6 +96,131 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// Number of VMs which run the current pool template.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" optional:"true"`
+
+	// Number of VMs which still run an older pool template.
+	OutdatedReplicas int32 `json:"outdatedReplicas,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// VirtualMachinePoolRollingUpdateStrategyType replaces outdated VMs in batches
+	VirtualMachinePoolRollingUpdateStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateMode string
+
+const (
+	// VirtualMachinePoolProactiveUpdateMode restarts running VMs which run an outdated template
+	VirtualMachinePoolProactiveUpdateMode VirtualMachinePoolUpdateMode = "Proactive"
+
+	// VirtualMachinePoolOpportunisticUpdateMode only updates the VM templates, running VMs
+	// pick up the change the next time they get restarted
+	VirtualMachinePoolOpportunisticUpdateMode VirtualMachinePoolUpdateMode = "Opportunistic"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy. Can be "RollingUpdate".
+	// Defaults to RollingUpdate.
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// Rolling update config params. Present only if Type = RollingUpdate.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// Mode defines whether running VMs are restarted to pick up a template change.
+	// Can be "Proactive" or "Opportunistic". Defaults to Proactive.
+	// +optional
+	Mode VirtualMachinePoolUpdateMode `json:"mode,omitempty"`
+
+	// The maximum number of VMs that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// The maximum number of VMs that can be created over the desired number of replicas
+	// while outdated VMs are updated.
+	// Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInOrder string
+
+const (
+	// VirtualMachinePoolNotReadyFirstScaleInOrder removes VMs which are not ready before ready ones
+	VirtualMachinePoolNotReadyFirstScaleInOrder VirtualMachinePoolScaleInOrder = "NotReadyFirst"
+
+	// VirtualMachinePoolNewestFirstScaleInOrder removes the most recently created VMs first
+	VirtualMachinePoolNewestFirstScaleInOrder VirtualMachinePoolScaleInOrder = "NewestFirst"
+
+	// VirtualMachinePoolOldestFirstScaleInOrder removes the least recently created VMs first
+	VirtualMachinePoolOldestFirstScaleInOrder VirtualMachinePoolScaleInOrder = "OldestFirst"
+
+	// VirtualMachinePoolLeastLoadedScaleInOrder removes the VMs with the lowest vCPU utilization first,
+	// as reported by the domain statistics of their running VMIs
+	VirtualMachinePoolLeastLoadedScaleInOrder VirtualMachinePoolScaleInOrder = "LeastLoaded"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInPolicy struct {
+	// Order lists the criteria used to pick the VMs which get removed when the pool scales in.
+	// Each criterion only breaks the ties left by the previous ones, remaining ties are broken randomly.
+	// Can contain "NotReadyFirst", "NewestFirst", "OldestFirst" and "LeastLoaded".
+	// If empty, VMs are picked randomly.
+	// +optional
+	// +listType=atomic
+	Order []VirtualMachinePoolScaleInOrder `json:"order,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolNamingPolicy string
+
+const (
+	// VirtualMachinePoolIndexedNamingPolicy names new VMs <pool name>-<index> using the lowest free index,
+	// the VMs removed on scale in are picked by the scale in policy
+	VirtualMachinePoolIndexedNamingPolicy VirtualMachinePoolNamingPolicy = "Indexed"
+
+	// VirtualMachinePoolOrdinalNamingPolicy gives the VMs a stable identity like the pods of a StatefulSet.
+	// VMs are named <pool name>-<ordinal> with ordinals from 0 to replicas-1, scale in removes the VMs
+	// with the highest ordinals first and a deleted VM is recreated with the same name
+	VirtualMachinePoolOrdinalNamingPolicy VirtualMachinePoolNamingPolicy = "Ordinal"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolDataVolumeClaimRetentionPolicyType string
+
+const (
+	// VirtualMachinePoolRetainDataVolumeClaimRetentionPolicyType keeps the DataVolumes of a replica
+	VirtualMachinePoolRetainDataVolumeClaimRetentionPolicyType VirtualMachinePoolDataVolumeClaimRetentionPolicyType = "Retain"
+
+	// VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType deletes the DataVolumes of a replica
+	VirtualMachinePoolDeleteDataVolumeClaimRetentionPolicyType VirtualMachinePoolDataVolumeClaimRetentionPolicyType = "Delete"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachinePoolDataVolumeClaimRetentionPolicy struct {
+	// WhenScaled specifies what happens to the DataVolumes of a replica which gets removed because the pool scales in.
+	// "Retain" keeps them, so that they get reattached when the pool scales out again, "Delete" removes them.
+	// Defaults to Retain.
+	// +optional
+	WhenScaled VirtualMachinePoolDataVolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +240,34 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// The strategy used to roll out template changes to existing VMs.
+	// If not set, all outdated VMs are updated and restarted at once.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// The policy used to pick the VMs which get removed when the pool scales in.
+	// VMs annotated with pool.kubevirt.io/scale-in-protection=true are never picked.
+	// +optional
+	ScaleInPolicy *VirtualMachinePoolScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
+	// NamingPolicy defines how the VMs of the pool are named. Can be "Indexed" or "Ordinal".
+	// The Ordinal policy can not be combined with a scale in policy. Defaults to Indexed.
+	// +optional
+	NamingPolicy VirtualMachinePoolNamingPolicy `json:"namingPolicy,omitempty"`
+
+	// DataVolumeClaimTemplates lists DataVolumes which are created once per replica and outlive the VMs.
+	// The DataVolume of a replica is named <template name>-<VM name> and owned by the pool,
+	// volumes of the VM template which refer to a template by name are pointed to the DataVolume of the replica.
+	// A recreated VM with the same name reattaches the DataVolumes of its predecessor.
+	// +optional
+	// +listType=atomic
+	DataVolumeClaimTemplates []virtv1.DataVolumeTemplateSpec `json:"dataVolumeClaimTemplates,omitempty"`
+
+	// DataVolumeClaimRetentionPolicy defines what happens to the DataVolumes created from the
+	// DataVolumeClaimTemplates when their replica is removed. They are always deleted together with the pool.
+	// +optional
+	DataVolumeClaimRetentionPolicy *VirtualMachinePoolDataVolumeClaimRetentionPolicy `json:"dataVolumeClaimRetentionPolicy,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
@@ -4,7 +4,7 @@ package v1alpha1
 
 func (VirtualMachinePool) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "VirtualMachinePool resource contains a VirtualMachine configuration\nthat can be used to replicate multiple VirtualMachine resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+		"": "VirtualMachinePool resource contains a VirtualMachine configuration\nthat can be used to replicate multiple VirtualMachine resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient\n+genclient:method=GetScale,verb=get,subresource=scale,result=k8s.io/api/autoscaling/v1.Scale\n+genclient:method=UpdateScale,verb=update,subresource=scale,input=k8s.io/api/autoscaling/v1.Scale,result=k8s.io/api/autoscaling/v1.Scale",
 	}
 }
 
@@ -25,20 +25,58 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 }
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "+k8s:openapi-gen=true",
+		"conditions":       "+listType=atomic",
+		"labelSelector":    "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updatedReplicas":  "Number of VMs which run the current pool template.",
+		"outdatedReplicas": "Number of VMs which still run an older pool template.",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"type":          "Type of the update strategy. Can be \"RollingUpdate\".\nDefaults to RollingUpdate.\n+optional",
+		"rollingUpdate": "Rolling update config params. Present only if Type = RollingUpdate.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "+k8s:openapi-gen=true",
+		"mode":           "Mode defines whether running VMs are restarted to pick up a template change.\nCan be \"Proactive\" or \"Opportunistic\". Defaults to Proactive.\n+optional",
+		"maxUnavailable": "The maximum number of VMs that can be unavailable during the update.\nValue can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding down.\nThis can not be 0 if MaxSurge is 0.\nDefaults to 1.\n+optional",
+		"maxSurge":       "The maximum number of VMs that can be created over the desired number of replicas\nwhile outdated VMs are updated.\nValue can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%).\nAbsolute number is calculated from percentage by rounding up.\nDefaults to 0.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolScaleInPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "+k8s:openapi-gen=true",
+		"order": "Order lists the criteria used to pick the VMs which get removed when the pool scales in.\nEach criterion only breaks the ties left by the previous ones, remaining ties are broken randomly.\nCan contain \"NotReadyFirst\", \"NewestFirst\", \"OldestFirst\" and \"LeastLoaded\".\nIf empty, VMs are picked randomly.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachinePoolDataVolumeClaimRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "+k8s:openapi-gen=true",
+		"whenScaled": "WhenScaled specifies what happens to the DataVolumes of a replica which gets removed because the pool scales in.\n\"Retain\" keeps them, so that they get reattached when the pool scales out again, \"Delete\" removes them.\nDefaults to Retain.\n+optional",
 	}
 }
 
 func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "+k8s:openapi-gen=true",
-		"replicas":               "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
-		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
-		"virtualMachineTemplate": "Template describes the VM that will be created.",
-		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"":                               "+k8s:openapi-gen=true",
+		"replicas":                       "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
+		"selector":                       "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
+		"virtualMachineTemplate":         "Template describes the VM that will be created.",
+		"paused":                         "Indicates that the pool is paused.\n+optional",
+		"updateStrategy":                 "The strategy used to roll out template changes to existing VMs.\nIf not set, all outdated VMs are updated and restarted at once.\n+optional",
+		"scaleInPolicy":                  "The policy used to pick the VMs which get removed when the pool scales in.\nVMs annotated with pool.kubevirt.io/scale-in-protection=true are never picked.\n+optional",
+		"namingPolicy":                   "NamingPolicy defines how the VMs of the pool are named. Can be \"Indexed\" or \"Ordinal\".\nThe Ordinal policy can not be combined with a scale in policy. Defaults to Indexed.\n+optional",
+		"dataVolumeClaimTemplates":       "DataVolumeClaimTemplates lists DataVolumes which are created once per replica and outlive the VMs.\nThe DataVolume of a replica is named <template name>-<VM name> and owned by the pool,\nvolumes of the VM template which refer to a template by name are pointed to the DataVolume of the replica.\nA recreated VM with the same name reattaches the DataVolumes of its predecessor.\n+optional\n+listType=atomic",
+		"dataVolumeClaimRetentionPolicy": "DataVolumeClaimRetentionPolicy defines what happens to the DataVolumes created from the\nDataVolumeClaimTemplates when their replica is removed. They are always deleted together with the pool.\n+optional",
 	}
 }
 
@@ -56,6 +56,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineSnapshotContentList{},
 		&VirtualMachineRestore{},
 		&VirtualMachineRestoreList{},
+		&VirtualMachineSnapshotSchedule{},
+		&VirtualMachineSnapshotScheduleList{},
+		&VirtualMachineSnapshotGroup{},
+		&VirtualMachineSnapshotGroupList{},
+		&VirtualMachineBackup{},
+		&VirtualMachineBackupList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -31,6 +31,12 @@ import (
 
 const DefaultFailureDeadline = 5 * time.Minute
 
+// SnapshotScheduleLabel is set on every VirtualMachineSnapshot created by a VirtualMachineSnapshotSchedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// SnapshotGroupLabel is set on every VirtualMachineSnapshot created by a VirtualMachineSnapshotGroup
+const SnapshotGroupLabel = "snapshot.kubevirt.io/group"
+
 // VirtualMachineSnapshot defines the operation of snapshotting a VM
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -321,6 +327,24 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// TargetNamespace is the namespace the target is restored into. Defaults to the namespace of the
+	// VirtualMachineRestore. When set to another namespace the target must not exist, and the restored
+	// PVCs reference the VolumeSnapshots across namespaces, which requires the CrossNamespaceVolumeDataSource
+	// feature and a ReferenceGrant in the namespace of the VirtualMachineRestore.
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
+	// NewMacAddresses manually sets the target interfaces' mac addresses when restoring into a new VM. The key is
+	// the interface name and the value is the new mac address. Interfaces that are not included in this map get
+	// a new MAC address generated automatically.
+	// +optional
+	NewMacAddresses map[string]string `json:"newMacAddresses,omitempty"`
+
+	// NewSMBiosSerial manually sets the target's SMbios serial when restoring into a new VM. If this field is not
+	// specified, a new serial will be generated automatically.
+	// +optional
+	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -361,3 +385,234 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule defines a recurring snapshot of the VMs matching a label selector
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is a cron expression (e.g. "0 2 * * *") describing when snapshots are taken
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines in the namespace of the schedule that are snapshotted
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Retention defines which snapshots created by the schedule are kept
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+
+	// Disabled suspends the creation of new snapshots, retention is still applied
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// FailureDeadline is passed on to every VirtualMachineSnapshot created by the schedule
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule expire
+type VirtualMachineSnapshotScheduleRetention struct {
+	// MaxCount is the number of ready snapshots kept per VirtualMachine, older ones are deleted, failed snapshots do not count towards it
+	// +optional
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// Expires is the duration after which a snapshot is deleted
+	// +optional
+	Expires *metav1.Duration `json:"expires,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// +optional
+	LastFailure *Error `json:"lastFailure,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VirtualMachines in the namespace of the group that are snapshotted together
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is passed on to every VirtualMachineSnapshot of the group
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take, it is passed on to every VirtualMachineSnapshot of the group.
+	// In case we pass this deadline the whole group is rolled back and marked as failed.
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// Members lists the VirtualMachines of the group and their VirtualMachineSnapshots
+	// +optional
+	// +listType=atomic
+	Members []VirtualMachineSnapshotGroupMember `json:"members,omitempty"`
+
+	// FreezeTime is the time at which all the members of the group were frozen
+	// +optional
+	// +nullable
+	FreezeTime *metav1.Time `json:"freezeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot
+type VirtualMachineSnapshotGroupMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
+
+// VirtualMachineBackup defines the operation of backing up the disks of a running VirtualMachine.
+// Every backup records a checkpoint, a later backup can be taken incrementally from it so that only
+// the blocks changed since are copied. Checkpoints do not survive a restart or a migration of the VM,
+// and changed blocks are only tracked for qcow2 disks; raw disks are always backed up in full.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
+type VirtualMachineBackupSpec struct {
+	// Source is the VirtualMachine to back up
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Mode is either Push, where the backup is written to the Target volume,
+	// or Pull, where the disks are exposed through a VirtualMachineExport
+	// until the VirtualMachineBackup is deleted. Defaults to Pull.
+	// +optional
+	Mode *v1.VirtualMachineInstanceBackupMode `json:"mode,omitempty"`
+
+	// IncrementalFrom is the name of the checkpoint of a previous backup of the same VirtualMachine.
+	// Only the blocks changed since that checkpoint are backed up. A full backup is taken when it is not set.
+	// +optional
+	IncrementalFrom *string `json:"incrementalFrom,omitempty"`
+
+	// Target is the name of a volume of the VirtualMachine, backed by a filesystem
+	// PersistentVolumeClaim and not attached as a disk, the backup is written to in push mode
+	// +optional
+	Target *string `json:"target,omitempty"`
+}
+
+// VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+type VirtualMachineBackupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// CheckpointName is the name of the checkpoint recorded by the backup,
+	// it can be used as IncrementalFrom of a later backup
+	// +optional
+	CheckpointName *string `json:"checkpointName,omitempty"`
+
+	// Volumes lists the backed up volumes
+	// +optional
+	// +listType=atomic
+	Volumes []v1.VirtualMachineInstanceBackupVolume `json:"volumes,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// ReadyToUse indicates that the backup can be read, either from the target volume in push mode
+	// or through a VirtualMachineExport in pull mode
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineBackupList is a list of VirtualMachineBackup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackup `json:"items"`
+}
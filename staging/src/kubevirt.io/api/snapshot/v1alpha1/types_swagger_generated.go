@@ -139,9 +139,12 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":        "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":  "initially only VirtualMachine type supported",
-		"patches": "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":          "initially only VirtualMachine type supported",
+		"patches":         "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"targetNamespace": "TargetNamespace is the namespace the target is restored into. Defaults to the namespace of the\nVirtualMachineRestore. When set to another namespace the target must not exist, and the restored\nPVCs reference the VolumeSnapshots across namespaces, which requires the CrossNamespaceVolumeDataSource\nfeature and a ReferenceGrant in the namespace of the VirtualMachineRestore.\n+optional",
+		"newMacAddresses": "NewMacAddresses manually sets the target interfaces' mac addresses when restoring into a new VM. The key is\nthe interface name and the value is the new mac address. Interfaces that are not included in this map get\na new MAC address generated automatically.\n+optional",
+		"newSMBiosSerial": "NewSMBiosSerial manually sets the target's SMbios serial when restoring into a new VM. If this field is not\nspecified, a new serial will be generated automatically.\n+optional",
 	}
 }
 
@@ -168,3 +171,124 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule defines a recurring snapshot of the VMs matching a label selector\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a cron expression (e.g. \"0 2 * * *\") describing when snapshots are taken",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the schedule that are snapshotted",
+		"retention":       "Retention defines which snapshots created by the schedule are kept\n+optional",
+		"disabled":        "Disabled suspends the creation of new snapshots, retention is still applied\n+optional",
+		"failureDeadline": "FailureDeadline is passed on to every VirtualMachineSnapshot created by the schedule\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule expire",
+		"maxCount": "MaxCount is the number of ready snapshots kept per VirtualMachine, older ones are deleted, failed snapshots do not count towards it\n+optional",
+		"expires":  "Expires is the duration after which a snapshot is deleted\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "+optional\n+nullable",
+		"nextScheduleTime": "+optional\n+nullable",
+		"lastSuccessTime":  "+optional\n+nullable",
+		"lastFailure":      "+optional",
+		"conditions":       "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the group that are snapshotted together",
+		"deletionPolicy":  "DeletionPolicy is passed on to every VirtualMachineSnapshot of the group\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take, it is passed on to every VirtualMachineSnapshot of the group.\nIn case we pass this deadline the whole group is rolled back and marked as failed.\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"phase":        "+optional",
+		"members":      "Members lists the VirtualMachines of the group and their VirtualMachineSnapshots\n+optional\n+listType=atomic",
+		"freezeTime":   "FreezeTime is the time at which all the members of the group were frozen\n+optional\n+nullable",
+		"creationTime": "+optional\n+nullable",
+		"readyToUse":   "+optional",
+		"error":        "+optional",
+		"conditions":   "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineBackup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackup defines the operation of backing up the disks of a running VirtualMachine.\nEvery backup records a checkpoint, a later backup can be taken incrementally from it so that only\nthe blocks changed since are copied. Checkpoints do not survive a restart or a migration of the VM,\nand changed blocks are only tracked for qcow2 disks; raw disks are always backed up in full.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource",
+		"source":          "Source is the VirtualMachine to back up",
+		"mode":            "Mode is either Push, where the backup is written to the Target volume,\nor Pull, where the disks are exposed through a VirtualMachineExport\nuntil the VirtualMachineBackup is deleted. Defaults to Pull.\n+optional",
+		"incrementalFrom": "IncrementalFrom is the name of the checkpoint of a previous backup of the same VirtualMachine.\nOnly the blocks changed since that checkpoint are backed up. A full backup is taken when it is not set.\n+optional",
+		"target":          "Target is the name of a volume of the VirtualMachine, backed by a filesystem\nPersistentVolumeClaim and not attached as a disk, the backup is written to in push mode\n+optional",
+	}
+}
+
+func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
+		"phase":          "+optional",
+		"checkpointName": "CheckpointName is the name of the checkpoint recorded by the backup,\nit can be used as IncrementalFrom of a later backup\n+optional",
+		"volumes":        "Volumes lists the backed up volumes\n+optional\n+listType=atomic",
+		"startTime":      "+optional\n+nullable",
+		"completionTime": "+optional\n+nullable",
+		"readyToUse":     "ReadyToUse indicates that the backup can be read, either from the target volume in push mode\nor through a VirtualMachineExport in pull mode\n+optional",
+		"error":          "+optional",
+		"conditions":     "+optional",
+	}
+}
+
+func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineBackupList is a list of VirtualMachineBackup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -312,8 +312,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.AddVolumeOptions":                                                   schema_kubevirtio_api_core_v1_AddVolumeOptions(ref),
 		"kubevirt.io/api/core/v1.ArchConfiguration":                                                  schema_kubevirtio_api_core_v1_ArchConfiguration(ref),
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                          schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
+		"kubevirt.io/api/core/v1.AttestationReport":                                                  schema_kubevirtio_api_core_v1_AttestationReport(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                 schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                               schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BandwidthLimit":                                                     schema_kubevirtio_api_core_v1_BandwidthLimit(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                          schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                         schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                        schema_kubevirtio_api_core_v1_CDRomTarget(ref),
@@ -334,20 +336,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.ConfigDriveSSHPublicKeyAccessCredentialPropagation":                 schema_kubevirtio_api_core_v1_ConfigDriveSSHPublicKeyAccessCredentialPropagation(ref),
 		"kubevirt.io/api/core/v1.ConfigMapVolumeSource":                                              schema_kubevirtio_api_core_v1_ConfigMapVolumeSource(ref),
 		"kubevirt.io/api/core/v1.ContainerDiskSource":                                                schema_kubevirtio_api_core_v1_ContainerDiskSource(ref),
+		"kubevirt.io/api/core/v1.CrossClusterMigrationState":                                         schema_kubevirtio_api_core_v1_CrossClusterMigrationState(ref),
 		"kubevirt.io/api/core/v1.CustomBlockSize":                                                    schema_kubevirtio_api_core_v1_CustomBlockSize(ref),
 		"kubevirt.io/api/core/v1.CustomProfile":                                                      schema_kubevirtio_api_core_v1_CustomProfile(ref),
 		"kubevirt.io/api/core/v1.CustomizeComponents":                                                schema_kubevirtio_api_core_v1_CustomizeComponents(ref),
 		"kubevirt.io/api/core/v1.CustomizeComponentsPatch":                                           schema_kubevirtio_api_core_v1_CustomizeComponentsPatch(ref),
 		"kubevirt.io/api/core/v1.DHCPOptions":                                                        schema_kubevirtio_api_core_v1_DHCPOptions(ref),
 		"kubevirt.io/api/core/v1.DHCPPrivateOptions":                                                 schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref),
+		"kubevirt.io/api/core/v1.DHCPRoute":                                                          schema_kubevirtio_api_core_v1_DHCPRoute(ref),
+		"kubevirt.io/api/core/v1.DHCPv6Options":                                                      schema_kubevirtio_api_core_v1_DHCPv6Options(ref),
 		"kubevirt.io/api/core/v1.DataVolumeSource":                                                   schema_kubevirtio_api_core_v1_DataVolumeSource(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateDummyStatus":                                      schema_kubevirtio_api_core_v1_DataVolumeTemplateDummyStatus(ref),
 		"kubevirt.io/api/core/v1.DataVolumeTemplateSpec":                                             schema_kubevirtio_api_core_v1_DataVolumeTemplateSpec(ref),
 		"kubevirt.io/api/core/v1.DeveloperConfiguration":                                             schema_kubevirtio_api_core_v1_DeveloperConfiguration(ref),
 		"kubevirt.io/api/core/v1.Devices":                                                            schema_kubevirtio_api_core_v1_Devices(ref),
+		"kubevirt.io/api/core/v1.Diag288Watchdog":                                                    schema_kubevirtio_api_core_v1_Diag288Watchdog(ref),
 		"kubevirt.io/api/core/v1.DisableFreePageReporting":                                           schema_kubevirtio_api_core_v1_DisableFreePageReporting(ref),
 		"kubevirt.io/api/core/v1.Disk":                                                               schema_kubevirtio_api_core_v1_Disk(ref),
 		"kubevirt.io/api/core/v1.DiskDevice":                                                         schema_kubevirtio_api_core_v1_DiskDevice(ref),
+		"kubevirt.io/api/core/v1.DiskIOTune":                                                         schema_kubevirtio_api_core_v1_DiskIOTune(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                         schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                   schema_kubevirtio_api_core_v1_DiskVerification(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                               schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
@@ -382,17 +389,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Hugepages":                                                          schema_kubevirtio_api_core_v1_Hugepages(ref),
 		"kubevirt.io/api/core/v1.HypervTimer":                                                        schema_kubevirtio_api_core_v1_HypervTimer(ref),
 		"kubevirt.io/api/core/v1.I6300ESBWatchdog":                                                   schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref),
+		"kubevirt.io/api/core/v1.ITCOWatchdog":                                                       schema_kubevirtio_api_core_v1_ITCOWatchdog(ref),
 		"kubevirt.io/api/core/v1.Input":                                                              schema_kubevirtio_api_core_v1_Input(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.Interface":                                                          schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBandwidth":                                                 schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                             schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
+		"kubevirt.io/api/core/v1.InterfaceBindingPlugin":                                             schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                    schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
+		"kubevirt.io/api/core/v1.InterfaceFilter":                                                    schema_kubevirtio_api_core_v1_InterfaceFilter(ref),
+		"kubevirt.io/api/core/v1.InterfaceFilterDroppedPackets":                                      schema_kubevirtio_api_core_v1_InterfaceFilterDroppedPackets(ref),
+		"kubevirt.io/api/core/v1.InterfaceFilterRule":                                                schema_kubevirtio_api_core_v1_InterfaceFilterRule(ref),
 		"kubevirt.io/api/core/v1.InterfaceMacvtap":                                                   schema_kubevirtio_api_core_v1_InterfaceMacvtap(ref),
 		"kubevirt.io/api/core/v1.InterfaceMasquerade":                                                schema_kubevirtio_api_core_v1_InterfaceMasquerade(ref),
 		"kubevirt.io/api/core/v1.InterfacePasst":                                                     schema_kubevirtio_api_core_v1_InterfacePasst(ref),
 		"kubevirt.io/api/core/v1.InterfaceSRIOV":                                                     schema_kubevirtio_api_core_v1_InterfaceSRIOV(ref),
 		"kubevirt.io/api/core/v1.InterfaceSlirp":                                                     schema_kubevirtio_api_core_v1_InterfaceSlirp(ref),
 		"kubevirt.io/api/core/v1.KSMConfiguration":                                                   schema_kubevirtio_api_core_v1_KSMConfiguration(ref),
+		"kubevirt.io/api/core/v1.KSMTuning":                                                          schema_kubevirtio_api_core_v1_KSMTuning(ref),
 		"kubevirt.io/api/core/v1.KVMTimer":                                                           schema_kubevirtio_api_core_v1_KVMTimer(ref),
 		"kubevirt.io/api/core/v1.KernelBoot":                                                         schema_kubevirtio_api_core_v1_KernelBoot(ref),
 		"kubevirt.io/api/core/v1.KernelBootContainer":                                                schema_kubevirtio_api_core_v1_KernelBootContainer(ref),
@@ -409,13 +423,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.LiveUpdateCPU":                                                      schema_kubevirtio_api_core_v1_LiveUpdateCPU(ref),
 		"kubevirt.io/api/core/v1.LiveUpdateConfiguration":                                            schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref),
 		"kubevirt.io/api/core/v1.LiveUpdateFeatures":                                                 schema_kubevirtio_api_core_v1_LiveUpdateFeatures(ref),
+		"kubevirt.io/api/core/v1.LiveUpdateMemory":                                                   schema_kubevirtio_api_core_v1_LiveUpdateMemory(ref),
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                       schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                          schema_kubevirtio_api_core_v1_LunTarget(ref),
+		"kubevirt.io/api/core/v1.MacAddressPool":                                                     schema_kubevirtio_api_core_v1_MacAddressPool(ref),
+		"kubevirt.io/api/core/v1.MacAddressRange":                                                    schema_kubevirtio_api_core_v1_MacAddressRange(ref),
 		"kubevirt.io/api/core/v1.Machine":                                                            schema_kubevirtio_api_core_v1_Machine(ref),
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                       schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                 schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                             schema_kubevirtio_api_core_v1_Memory(ref),
+		"kubevirt.io/api/core/v1.MemoryBalloon":                                                      schema_kubevirtio_api_core_v1_MemoryBalloon(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                             schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
+		"kubevirt.io/api/core/v1.MemoryStatus":                                                       schema_kubevirtio_api_core_v1_MemoryStatus(ref),
 		"kubevirt.io/api/core/v1.MigrateOptions":                                                     schema_kubevirtio_api_core_v1_MigrateOptions(ref),
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                             schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                      schema_kubevirtio_api_core_v1_MultusNetwork(ref),
@@ -426,12 +445,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.NetworkSource":                                                      schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                      schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
 		"kubevirt.io/api/core/v1.NodePlacement":                                                      schema_kubevirtio_api_core_v1_NodePlacement(ref),
+		"kubevirt.io/api/core/v1.OnCrash":                                                            schema_kubevirtio_api_core_v1_OnCrash(ref),
 		"kubevirt.io/api/core/v1.PITTimer":                                                           schema_kubevirtio_api_core_v1_PITTimer(ref),
+		"kubevirt.io/api/core/v1.PanicDevice":                                                        schema_kubevirtio_api_core_v1_PanicDevice(ref),
 		"kubevirt.io/api/core/v1.PauseOptions":                                                       schema_kubevirtio_api_core_v1_PauseOptions(ref),
 		"kubevirt.io/api/core/v1.PciHostDevice":                                                      schema_kubevirtio_api_core_v1_PciHostDevice(ref),
 		"kubevirt.io/api/core/v1.PermittedHostDevices":                                               schema_kubevirtio_api_core_v1_PermittedHostDevices(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimInfo":                                          schema_kubevirtio_api_core_v1_PersistentVolumeClaimInfo(ref),
 		"kubevirt.io/api/core/v1.PersistentVolumeClaimVolumeSource":                                  schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref),
+		"kubevirt.io/api/core/v1.PluginBinding":                                                      schema_kubevirtio_api_core_v1_PluginBinding(ref),
 		"kubevirt.io/api/core/v1.PodNetwork":                                                         schema_kubevirtio_api_core_v1_PodNetwork(ref),
 		"kubevirt.io/api/core/v1.Port":                                                               schema_kubevirtio_api_core_v1_Port(ref),
 		"kubevirt.io/api/core/v1.PreferenceMatcher":                                                  schema_kubevirtio_api_core_v1_PreferenceMatcher(ref),
@@ -454,6 +476,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SEVMeasurementInfo":                                                 schema_kubevirtio_api_core_v1_SEVMeasurementInfo(ref),
 		"kubevirt.io/api/core/v1.SEVPlatformInfo":                                                    schema_kubevirtio_api_core_v1_SEVPlatformInfo(ref),
 		"kubevirt.io/api/core/v1.SEVPolicy":                                                          schema_kubevirtio_api_core_v1_SEVPolicy(ref),
+		"kubevirt.io/api/core/v1.SEVSNP":                                                             schema_kubevirtio_api_core_v1_SEVSNP(ref),
+		"kubevirt.io/api/core/v1.SEVSNPPolicy":                                                       schema_kubevirtio_api_core_v1_SEVSNPPolicy(ref),
 		"kubevirt.io/api/core/v1.SEVSecretOptions":                                                   schema_kubevirtio_api_core_v1_SEVSecretOptions(ref),
 		"kubevirt.io/api/core/v1.SEVSessionOptions":                                                  schema_kubevirtio_api_core_v1_SEVSessionOptions(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
@@ -470,6 +494,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.SupportContainerResources":                                          schema_kubevirtio_api_core_v1_SupportContainerResources(ref),
 		"kubevirt.io/api/core/v1.SyNICTimer":                                                         schema_kubevirtio_api_core_v1_SyNICTimer(ref),
 		"kubevirt.io/api/core/v1.SysprepSource":                                                      schema_kubevirtio_api_core_v1_SysprepSource(ref),
+		"kubevirt.io/api/core/v1.TDX":                                                                schema_kubevirtio_api_core_v1_TDX(ref),
 		"kubevirt.io/api/core/v1.TLSConfiguration":                                                   schema_kubevirtio_api_core_v1_TLSConfiguration(ref),
 		"kubevirt.io/api/core/v1.TPMDevice":                                                          schema_kubevirtio_api_core_v1_TPMDevice(ref),
 		"kubevirt.io/api/core/v1.Timer":                                                              schema_kubevirtio_api_core_v1_Timer(ref),
@@ -486,6 +511,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                     schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                            schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupOptions":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupOptions(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus":                                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceBackupVolume":                                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupVolume(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceCPULoad":                                      schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPULoad(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
@@ -499,6 +528,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationCondition":                           schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationList":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationPhaseTransitionTimestamp":            schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitionTimestamp(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive":                             schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationReceive(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSendTo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSpec":                                schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationStatus":                              schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationStatus(ref),
@@ -516,6 +547,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceSpec":                                         schema_kubevirtio_api_core_v1_VirtualMachineInstanceSpec(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceStatus":                                       schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceTemplateSpec":                                 schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceWatchdogStatus":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceWatchdogStatus(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInterfaceRequest":                                     schema_kubevirtio_api_core_v1_VirtualMachineInterfaceRequest(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineList":                                                 schema_kubevirtio_api_core_v1_VirtualMachineList(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineMemoryDumpRequest":                                    schema_kubevirtio_api_core_v1_VirtualMachineMemoryDumpRequest(ref),
@@ -602,6 +634,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceList":                          schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceList(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VirtualMachinePreferenceSpec":                          schema_kubevirtio_api_instancetype_v1beta1_VirtualMachinePreferenceSpec(ref),
 		"kubevirt.io/api/instancetype/v1beta1.VolumePreferences":                                     schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref),
+		"kubevirt.io/api/migrations/v1alpha1.GovernedVirtualMachineInstance":                         schema_kubevirtio_api_migrations_v1alpha1_GovernedVirtualMachineInstance(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicy":                                        schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyList":                                    schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyList(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                    schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
@@ -609,9 +642,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/migrations/v1alpha1.Selectors":                                              schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                           schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolDataVolumeClaimRetentionPolicy":             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolDataVolumeClaimRetentionPolicy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolList":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate":                              schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolRollingUpdate(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInPolicy":                              schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolScaleInPolicy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolSpec":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolStatus":                                     schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref),
+		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy":                             schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec":                                   schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Condition":                                                schema_kubevirtio_api_snapshot_v1alpha1_Condition(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.Error":                                                    schema_kubevirtio_api_snapshot_v1alpha1_Error(ref),
@@ -619,6 +656,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1alpha1.SnapshotVolumesLists":                                     schema_kubevirtio_api_snapshot_v1alpha1_SnapshotVolumesLists(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.SourceSpec":                                               schema_kubevirtio_api_snapshot_v1alpha1_SourceSpec(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachine":                                           schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachine(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackup":                                     schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackup(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupList":                                 schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupList(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupSpec":                                 schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupSpec(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupStatus":                               schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestore":                                    schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestore(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreList":                                schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreList(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreSpec":                                schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreSpec(ref),
@@ -628,7 +669,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentList":                        schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContentList(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentSpec":                        schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContentSpec(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentStatus":                      schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContentStatus(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroup":                              schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroup(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupList":                          schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupList(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupMember":                        schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupMember(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupSpec":                          schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupSpec(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupStatus":                        schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotList":                               schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotList(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSchedule":                           schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotSchedule(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleList":                       schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleList(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleRetention":                  schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleRetention(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleSpec":                       schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleSpec(ref),
+		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleStatus":                     schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSpec":                               schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotSpec(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotStatus":                             schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotStatus(ref),
 		"kubevirt.io/api/snapshot/v1alpha1.VolumeBackup":                                             schema_kubevirtio_api_snapshot_v1alpha1_VolumeBackup(ref),
@@ -15666,6 +15717,54 @@ func schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref common.Referenc
 	}
 }
 
+func schema_kubevirtio_api_core_v1_AttestationReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AttestationReport contains an attestation report of a confidential guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Launch security technology the guest is running with. Only sev is supported, SEV-SNP and TDX guests request their reports from within the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nonce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded nonce the report was requested with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"report": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded attestation report of the guest.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15708,6 +15807,42 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BandwidthLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BandwidthLimit represents the shaping parameters of one traffic direction.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"average": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Average is the average bit rate in kilobytes per second.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"peak": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Peak is the maximum rate at which the interface can send data in kilobytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the amount of kilobytes that can be sent in a single burst at peak speed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"average"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16401,6 +16536,39 @@ func schema_kubevirtio_api_core_v1_ContainerDiskSource(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CrossClusterMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"migrationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ID which identifies the migration on both clusters",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"connectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The host:port endpoint of the receiving cluster the libvirt streams are tunneled to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How persistent volumes are transferred to the receiving cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CustomBlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16582,11 +16750,44 @@ func schema_kubevirtio_api_core_v1_DHCPOptions(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the given static routes to the VM via DHCP option 121, in addition to the routes of the pod network.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DHCPRoute"),
+									},
+								},
+							},
+						},
+					},
+					"leaseTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will be used as the lease time of the DHCP and DHCPv6 servers. Defaults to an infinite lease.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"ipxeBootFileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass option 67 with this value instead of BootFileName to clients identifying themselves as iPXE by the user class option 77. Allows chainloading an iPXE script after booting iPXE over the network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6 configures the DHCPv6 server and the router advertisements of the interface.",
+							Ref:         ref("kubevirt.io/api/core/v1.DHCPv6Options"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPPrivateOptions"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "kubevirt.io/api/core/v1.DHCPPrivateOptions", "kubevirt.io/api/core/v1.DHCPRoute", "kubevirt.io/api/core/v1.DHCPv6Options"},
 	}
 }
 
@@ -16620,6 +16821,86 @@ func schema_kubevirtio_api_core_v1_DHCPPrivateOptions(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DHCPRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DHCPRoute defines a static route passed to the VM via DHCP option 121.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination is the IPv4 CIDR of the route, e.g. 10.10.0.0/16.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gateway is the IPv4 address of the next hop of the route.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"destination", "gateway"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DHCPv6Options(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DHCPv6Options defines the IPv6 options passed to the VM.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the configured IPv6 DNS servers to the VM via DHCPv6 option 23.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"searchDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified will pass the configured domain search list to the VM via DHCPv6 option 24. Defaults to the search domains of the pod.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"routerAdvertisements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set to true, router advertisements are sent to the VM, providing its default route. Supported only on masquerade interfaces. Requires the Root feature gate, since a non-root virt-launcher lacks the NET_RAW capability.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DataVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -16844,6 +17125,20 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.Watchdog"),
 						},
 					},
+					"panicDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PanicDevices describe devices notifying the host when the guest kernel panics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.PanicDevice"),
+									},
+								},
+							},
+						},
+					},
 					"interfaces": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Interfaces describe network interfaces which are added to the vmi.",
@@ -17013,7 +17308,27 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.PanicDevice", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.Watchdog"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_Diag288Watchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "diag288 watchdog device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The action to take. Valid values are poweroff, reset, shutdown, dump. Defaults to reset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -17114,12 +17429,18 @@ func schema_kubevirtio_api_core_v1_Disk(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"ioTune": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOTune specifies I/O throttling limits for the disk. With the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.",
+							Ref:         ref("kubevirt.io/api/core/v1.DiskIOTune"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
+			"kubevirt.io/api/core/v1.BlockSize", "kubevirt.io/api/core/v1.CDRomTarget", "kubevirt.io/api/core/v1.DiskIOTune", "kubevirt.io/api/core/v1.DiskTarget", "kubevirt.io/api/core/v1.LunTarget"},
 	}
 }
 
@@ -17156,6 +17477,61 @@ func schema_kubevirtio_api_core_v1_DiskDevice(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_kubevirtio_api_core_v1_DiskIOTune(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DiskIOTune represents the I/O throttling limits of a disk. A value of 0 means that the corresponding limit is not set. Total limits are mutually exclusive with the read and write limits of the same kind.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalBytesSec is the total throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadBytesSec is the read throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeBytesSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBytesSec is the write throughput limit in bytes per second.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalIopsSec is the total I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"readIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadIopsSec is the read I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"writeIopsSec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteIopsSec is the write I/O operations per second limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_DiskTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17326,12 +17702,18 @@ func schema_kubevirtio_api_core_v1_DomainSpec(ref common.ReferenceCallback) comm
 							Ref:         ref("kubevirt.io/api/core/v1.LaunchSecurity"),
 						},
 					},
+					"onCrash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnCrash defines what happens when the guest kernel panics. Requires a panic device.",
+							Ref:         ref("kubevirt.io/api/core/v1.OnCrash"),
+						},
+					},
 				},
 				Required: []string{"devices"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPU", "kubevirt.io/api/core/v1.Chassis", "kubevirt.io/api/core/v1.Clock", "kubevirt.io/api/core/v1.Devices", "kubevirt.io/api/core/v1.Features", "kubevirt.io/api/core/v1.Firmware", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.Memory", "kubevirt.io/api/core/v1.ResourceRequirements"},
+			"kubevirt.io/api/core/v1.CPU", "kubevirt.io/api/core/v1.Chassis", "kubevirt.io/api/core/v1.Clock", "kubevirt.io/api/core/v1.Devices", "kubevirt.io/api/core/v1.Features", "kubevirt.io/api/core/v1.Firmware", "kubevirt.io/api/core/v1.LaunchSecurity", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.Memory", "kubevirt.io/api/core/v1.OnCrash", "kubevirt.io/api/core/v1.ResourceRequirements"},
 	}
 }
 
@@ -17396,9 +17778,24 @@ func schema_kubevirtio_api_core_v1_EFI(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
+					"persistent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set to true, Persistent will persist the EFI NVRAM across reboots and live migrations. Defaults to false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"secureBootKeysSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecureBootKeysSecretRef references a k8s secret whose \"PK\", \"KEK\" and \"db\" entries hold PEM encoded X.509 certificates to enroll in place of the default Secure Boot keys. The keys are enrolled when the NVRAM is initialized. Requires SecureBoot to be enabled.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -18258,7 +18655,27 @@ func schema_kubevirtio_api_core_v1_I6300ESBWatchdog(ref common.ReferenceCallback
 				Properties: map[string]spec.Schema{
 					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.",
+							Description: "The action to take. Valid values are poweroff, reset, shutdown, dump. Defaults to reset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ITCOWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "iTCO watchdog device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The action to take. Valid values are poweroff, reset, shutdown, dump. Defaults to reset.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -18397,6 +18814,12 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Ref: ref("kubevirt.io/api/core/v1.InterfacePasst"),
 						},
 					},
+					"binding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Binding specifies the binding plugin that will be used to connect the interface to the guest. It provides an alternative to InterfaceBindingMethod.",
+							Ref:         ref("kubevirt.io/api/core/v1.PluginBinding"),
+						},
+					},
 					"ports": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of ports to be forwarded to the virtual machine.",
@@ -18454,17 +18877,56 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 					},
 					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "State represents the requested operational state of the interface. The (only) value supported is `absent`, expressing a request to remove the interface.",
+							Description: "State represents the requested operational state of the interface. The supported values are `absent`, expressing a request to remove the interface, `down`, setting the link of the interface down without removing it, and `up`, the default, setting the link of the interface up.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bandwidth specifies the inbound and outbound traffic limits of the interface. With the VMLiveUpdateFeatures feature gate enabled the limits can be changed on a running VMI.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter specifies the traffic allowed to and from the guest on the interface. It is only supported with the bridge binding method.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceFilter"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceFilter", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.PluginBinding", "kubevirt.io/api/core/v1.Port"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBandwidth represents the traffic limits of an interface, as seen from the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"inbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inbound limits the traffic received by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimit"),
+						},
+					},
+					"outbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Outbound limits the traffic sent by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimit"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.BandwidthLimit"},
 	}
 }
 
@@ -18513,6 +18975,40 @@ func schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceBindingPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBindingPlugin describes how a network binding plugin connects an interface to the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sidecarImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SidecarImage references a container image that runs in the virt-launcher pod. The sidecar is called over the hooks OnDefineDomain API with the interface and the domain spec and may run additional services.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkAttachmentDefinition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkAttachmentDefinition references a NetworkAttachmentDefinition which is attached to the virt-launcher pod to perform the pod-level network setup of the plugin. Format: <name> or <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"domainAttachmentType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DomainAttachmentType is a standard domain network attachment method KubeVirt supports. Supported values: \"tap\". The standard domain attachment can be used instead or in addition to the sidecarImage.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18524,6 +19020,128 @@ func schema_kubevirtio_api_core_v1_InterfaceBridge(ref common.ReferenceCallback)
 	}
 }
 
+func schema_kubevirtio_api_core_v1_InterfaceFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFilter represents the traffic filtering of an interface, as seen from the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress lists the traffic allowed to reach the guest. Any other traffic is dropped, unless the list is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.InterfaceFilterRule"),
+									},
+								},
+							},
+						},
+					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress lists the traffic the guest is allowed to send. Any other traffic is dropped, unless the list is empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.InterfaceFilterRule"),
+									},
+								},
+							},
+						},
+					},
+					"antiSpoofing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AntiSpoofing drops the traffic sent by the guest with a source MAC or IPv4 address other than the ones of the interface. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceFilterRule"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceFilterDroppedPackets(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFilterDroppedPackets holds the number of packets dropped by an interface filter, per direction",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress is the number of dropped packets destined to the guest",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress is the number of dropped packets sent by the guest, including the spoofed ones",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"ingress", "egress"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceFilterRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceFilterRule allows the traffic matching all of its fields.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR of the remote peer. For example: 10.0.0.0/8 or fd10::/64. Matches any peer when empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol of the traffic. Must be TCP, UDP or ICMP. Matches any protocol when empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination ports of the traffic, each 0 < x < 65536. Requires the TCP or UDP protocol. Matches any port when empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_InterfaceMacvtap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18592,11 +19210,58 @@ func schema_kubevirtio_api_core_v1_KSMConfiguration(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"tuning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tuning enables virt-handler to adapt the KSM scanning rate to the memory pressure of the node. Without it KSM runs with the kernel defaults.",
+							Ref:         ref("kubevirt.io/api/core/v1.KSMTuning"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.KSMTuning"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_KSMTuning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KSMTuning configures how aggressively KSM scans the memory of a node. The number of pages to scan grows while the node is under memory pressure and decays otherwise.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pagesToScanMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesToScanMin is the number of pages scanned per period while the node is not under memory pressure. Defaults to 64.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"pagesToScanMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PagesToScanMax is the maximum number of pages scanned per period while the node is under memory pressure. Defaults to 1250.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sleepMillisecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SleepMillisecs is the pause between two scan periods on a node with 16Gi of memory. It is scaled down for nodes with more memory. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"freeMemoryThresholdPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreeMemoryThresholdPercent is the percentage of available memory below which the node is considered to be under memory pressure. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -19034,7 +19699,14 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 					},
 					"vmStateStorageClass": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM. The storage class must support RWX in filesystem mode.",
+							Description: "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM. The storage class must support the VMStateStorageAccessMode in filesystem mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vmStateStorageAccessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state. With ReadWriteOnce, the state is copied to a new PVC when the VM is live migrated. Defaults to ReadWriteMany",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -19489,11 +20161,23 @@ func schema_kubevirtio_api_core_v1_LaunchSecurity(ref common.ReferenceCallback)
 							Ref:         ref("kubevirt.io/api/core/v1.SEV"),
 						},
 					},
+					"snp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AMD Secure Encrypted Virtualization with Secure Nested Paging (SEV-SNP).",
+							Ref:         ref("kubevirt.io/api/core/v1.SEVSNP"),
+						},
+					},
+					"tdx": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Intel Trust Domain Extensions (TDX).",
+							Ref:         ref("kubevirt.io/api/core/v1.TDX"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.SEV"},
+			"kubevirt.io/api/core/v1.SEV", "kubevirt.io/api/core/v1.SEVSNP", "kubevirt.io/api/core/v1.TDX"},
 	}
 }
 
@@ -19529,9 +20213,17 @@ func schema_kubevirtio_api_core_v1_LiveUpdateConfiguration(ref common.ReferenceC
 							Format:      "int64",
 						},
 					},
+					"maxGuest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxGuest defines the maximum amount memory that can be allocated to the guest using hotplug.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -19547,34 +20239,60 @@ func schema_kubevirtio_api_core_v1_LiveUpdateFeatures(ref common.ReferenceCallba
 							Ref:         ref("kubevirt.io/api/core/v1.LiveUpdateCPU"),
 						},
 					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LiveUpdateMemory holds hotplug configuration for the memory resource. Empty struct indicates that default will be used for maxGuest. Default is specified on cluster level. Absence of the struct means opt-out from memory hotplug functionality.",
+							Ref:         ref("kubevirt.io/api/core/v1.LiveUpdateMemory"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.LiveUpdateCPU"},
+			"kubevirt.io/api/core/v1.LiveUpdateCPU", "kubevirt.io/api/core/v1.LiveUpdateMemory"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_LogVerbosity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_LiveUpdateMemory(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogVerbosity sets log verbosity level of  various components",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"virtAPI": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"virtController": {
+					"maxGuest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "MaxGuest defines the maximum amount memory that can be allocated to the guest using hotplug.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"virtHandler": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_LogVerbosity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LogVerbosity sets log verbosity level of  various components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtAPI": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"virtController": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"virtHandler": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int32",
@@ -19647,6 +20365,66 @@ func schema_kubevirtio_api_core_v1_LunTarget(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MacAddressPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacAddressPool holds the MAC address ranges VirtualMachine interfaces are allocated from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ranges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ranges lists the MAC address ranges of the pool, allocated in order.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.MacAddressRange"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ranges"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MacAddressRange"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MacAddressRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MacAddressRange is an inclusive range of MAC addresses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the first MAC address of the range, e.g. 02:00:00:00:00:00.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the last MAC address of the range, e.g. 02:00:00:ff:ff:ff.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19792,11 +20570,51 @@ func schema_kubevirtio_api_core_v1_Memory(ref common.ReferenceCallback) common.O
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"maxGuest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxGuest allows to specify the maximum amount of memory which is visible inside the Guest OS. The delta between MaxGuest and Guest is the amount of memory that can be hotplugged.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"balloon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Balloon enables a free memory policy which inflates the memory balloon of idle guests and deflates it when the guest is under memory pressure. It requires the memory balloon device and its statistics to be enabled.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryBalloon"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.MemoryBalloon"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MemoryBalloon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryBalloon configures the free memory policy of the memory balloon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"freeMemoryPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreeMemoryPercent is the percentage of its memory the policy keeps free inside the guest. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minGuest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinGuest is the lowest amount of memory the balloon can leave to the guest. Defaults to half of the guest memory.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -19835,6 +20653,45 @@ func schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref common.ReferenceCa
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MemoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MemoryStatus shows the amount of memory used by the guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"guestAtBoot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAtBoot specifies the amount of memory the VirtualMachineInstance booted with.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"guestCurrent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestCurrent specifies the amount of memory currently available to the guest.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"balloonTarget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BalloonTarget is the amount of memory the memory balloon policy wants to leave to the guest.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"balloonActual": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BalloonActual is the amount of memory the memory balloon currently leaves to the guest.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MigrateOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19972,6 +20829,34 @@ func schema_kubevirtio_api_core_v1_MigrationConfiguration(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"parallelMigrationConnections": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelMigrationConnections is the number of parallel connections (multifd channels) used to transfer the state of a single VMI during a live migration. Defaults to a single connection",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compression is the method used to compress the live migration stream. zstd compression always uses parallel migration connections. Defaults to no compression",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowWorkloadDisruption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowWorkloadDisruption allows the platform to pause a VMI whose live migration did not complete within CompletionTimeoutPerGiB, so that the migration can converge. If AllowPostCopy is also true, post-copy takes precedence. Defaults to false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"crossClusterPeerCABundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CrossClusterPeerCABundle holds the PEM encoded CA certificates of the virt-handlers of the clusters VMIs are migrated from or to. The tunnels of cross cluster migrations trust them in addition to the KubeVirt CA.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -20099,9 +20984,32 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"binding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Binding registers the network binding plugins, by name, which interfaces can refer to.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.InterfaceBindingPlugin"),
+									},
+								},
+							},
+						},
+					},
+					"macAddressPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MacAddressPool configures the MAC address ranges which unique MAC addresses are allocated from to the interfaces of VirtualMachines that do not specify one, before they are started. When set, duplicate MAC addresses among VirtualMachines are rejected.",
+							Ref:         ref("kubevirt.io/api/core/v1.MacAddressPool"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceBindingPlugin", "kubevirt.io/api/core/v1.MacAddressPool"},
 	}
 }
 
@@ -20250,6 +21158,33 @@ func schema_kubevirtio_api_core_v1_NodePlacement(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_OnCrash(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCrash defines what happens when the guest kernel panics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action taken when the guest kernel panics. One of: Restart, Pause, MemoryDump. MemoryDump leaves the guest paused once its memory is dumped. Defaults to Pause.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memoryDumpClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the MemoryDump action. The memory is only dumped for VMIs owned by a VirtualMachine.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PITTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20276,6 +21211,26 @@ func schema_kubevirtio_api_core_v1_PITTimer(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PanicDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PanicDevice notifies the host when the guest kernel panics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model specifies what type of panic device is provided. One of: hyperv, isa, pvpanic. The model used when it is missing depends on the guest architecture.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PauseOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20534,6 +21489,28 @@ func schema_kubevirtio_api_core_v1_PersistentVolumeClaimVolumeSource(ref common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_PluginBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PluginBinding represents a binding implemented in a plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name references to the binding name as defined in the KubeVirt CR network configuration.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_PodNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21002,6 +21979,13 @@ func schema_kubevirtio_api_core_v1_ResourceRequirements(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"overcommitWithKSM": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OvercommitWithKSM schedules the VMI on a KSM-enabled node and lowers its memory request by the memory overcommit these nodes report. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -21254,44 +22238,83 @@ func schema_kubevirtio_api_core_v1_SEVPolicy(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_kubevirtio_api_core_v1_SEVSecretOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_SEVSNP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SEVSecretOptions is used to provide a secret for a running guest.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"header": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Base64 encoded header needed to decrypt the secret.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secret": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Base64 encoded encrypted launch secret.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Guest policy flags as defined in AMD SEV-SNP firmware ABI specification. Note: due to security reasons it is not allowed to enable guest debugging. Therefore the debug flag is not exposed to users and is always false.",
+							Ref:         ref("kubevirt.io/api/core/v1.SEVSNPPolicy"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.SEVSNPPolicy"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_SEVSessionOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_SEVSNPPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SEVSessionOptions is used to provide SEV session parameters.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"session": {
+					"smt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Base64 encoded session blob.",
-							Type:        []string{"string"},
+							Description: "Allow the guest to run on hosts with simultaneous multithreading (SMT) enabled. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SEVSecretOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SEVSecretOptions is used to provide a secret for a running guest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded header needed to decrypt the secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded encrypted launch secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_SEVSessionOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SEVSessionOptions is used to provide SEV session parameters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"session": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Base64 encoded session blob.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -21741,6 +22764,16 @@ func schema_kubevirtio_api_core_v1_SysprepSource(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_TDX(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_TLSConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -21793,9 +22826,24 @@ func schema_kubevirtio_api_core_v1_TPMDevice(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"stateRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateRetentionPolicy defines what happens to the persistent TPM state when the VM is deleted. Delete removes the state together with the VM, Retain keeps it for a later VM of the same name. Defaults to Delete",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encryptionSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EncryptionSecretRef references a k8s secret whose \"key\" entry is used to encrypt the persistent TPM state.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
@@ -21885,6 +22933,13 @@ func schema_kubevirtio_api_core_v1_TopologyHints(ref common.ReferenceCallback) c
 							Format: "int64",
 						},
 					},
+					"ksmMemoryOvercommit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KSMMemoryOvercommit is the lowest memory overcommit percentage published by the KSM-enabled nodes when the launcher pod memory request was computed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -22257,191 +23312,203 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref common.ReferenceCa
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VirtualMachineInstanceBackupOptions are the options of a backup job started in a VirtualMachineInstance",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"status": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name identifies the backup job",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastProbeTime": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Mode is the way the disks are backed up",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"checkpoint": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Checkpoint is the name of the checkpoint created along with the backup",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"reason": {
+					"incrementalFrom": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "IncrementalFrom is the name of a previous checkpoint, only the blocks changed since that checkpoint are backed up. A full backup is taken when it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"message": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Target is the name of the volume the backup is written to in push mode",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"name", "mode", "checkpoint"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstanceFileSystem represents guest os disk",
+				Description: "VirtualMachineInstanceBackupStatus is the status of a backup job of a VirtualMachineInstance",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"diskName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name identifies the backup job",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"mountPoint": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Mode is the way the disks are backed up",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"fileSystemType": {
+					"checkpoint": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Checkpoint is the name of the checkpoint created along with the backup",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"usedBytes": {
+					"startTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "StartTimestamp is the time the backup job started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"totalBytes": {
+					"endTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "EndTimestamp is the time the backup job ended",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-				Required: []string{"diskName", "mountPoint", "fileSystemType", "usedBytes", "totalBytes"},
-			},
-		},
-	}
-}
-
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstanceFileSystemInfo represents information regarding single guest os filesystem",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"disks": {
+					"completed": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Completed indicates that the backup job ended",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed indicates that the backup job failed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason is the reason the backup job failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the volumes included in the backup",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"),
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceBackupVolume"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"disks"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineInstanceBackupVolume"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceBackupVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstanceFileSystemList comprises the list of all filesystems on guest machine",
+				Description: "VirtualMachineInstanceBackupVolume describes a volume included in a backup job",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"volumeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "VolumeName is the name of the volume",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"diskTarget": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "DiskTarget is the target device of the disk in the domain",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"exportName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ExportName is the NBD export name of the disk in pull mode",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"dirtyBitmap": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"),
-									},
-								},
-							},
+							Description: "DirtyBitmap is the name of the bitmap exposing the changed blocks in pull mode. It is only set for incremental backups.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the file the disk is written to in push mode, relative to the target volume",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"volumeName"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCPULoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent",
+				Description: "VirtualMachineInstanceCPULoad represents the vCPU utilization of a running VMI, measured from the domain statistics over a short sampling window",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -22458,26 +23525,259 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref comm
 							Format:      "",
 						},
 					},
-					"guestAgentVersion": {
+					"vCPUs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GAVersion is a version of currently installed guest agent",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VCPUs is the number of vCPUs the utilization is averaged over",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"supportedCommands": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"utilizationPercent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UtilizationPercent is the average utilization of all vCPUs during the sampling window, 100 means that all vCPUs were busy for the whole window",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
+					},
+					"window": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Return command list the guest agent supports",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
+							Description: "Window is the duration of the sampling window",
+							Default:     0,
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"vCPUs", "utilizationPercent", "window"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceFileSystem represents guest os disk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"diskName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"mountPoint": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"fileSystemType": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"usedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"totalBytes": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"diskName", "mountPoint", "fileSystemType", "usedBytes", "totalBytes"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceFileSystemInfo represents information regarding single guest os filesystem",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"disks"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceFileSystemList comprises the list of all filesystems on guest machine",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceGuestAgentInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"guestAgentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GAVersion is a version of currently installed guest agent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supportedCommands": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Return command list the guest agent supports",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
 										Ref:     ref("kubevirt.io/api/core/v1.GuestAgentCommandInfo"),
 									},
 								},
@@ -22919,50 +24219,121 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationPhaseTransitio
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationReceive(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vmiName": {
+					"migrationID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+							Description: "MigrationID identifies the migration on both clusters and has to match the one of the sending migration",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"migrationID"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSendTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"startTimestamp": {
+					"migrationID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The time the migration action began",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "MigrationID identifies the migration on both clusters and has to match the one of the receiving migration",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"endTimestamp": {
+					"connectURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The time the migration action ended",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "ConnectURL is the host:port endpoint reported by the receiving migration in status.migrationState.crossCluster.connectURL",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetNodeDomainReadyTimestamp": {
+					"volumeMigration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The timestamp at which the target node detects the domain is active",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "VolumeMigration defines how persistent volumes reach the receiving cluster. Defaults to PreSynced.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"targetNodeDomainDetected": {
+				},
+				Required: []string{"migrationID", "connectURL"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vmiName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sendTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SendTo migrates the VMI to another cluster, which has to run a migration with a matching Receive section. The VMI stops running in this cluster once the migration succeeded. Both clusters have to trust the CA of each others virt-handlers, see CrossClusterPeerCABundle in the migration configuration.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo"),
+						},
+					},
+					"receive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receive makes the VMI the target of a migration from another cluster. The VMI has to carry the kubevirt.io/cross-cluster-migration-target annotation, so that it waits for the migrated domain instead of booting. It has to have the same name, namespace and spec as the source VMI.",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationReceive", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationSendTo"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time the migration action began",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time the migration action ended",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"targetNodeDomainReadyTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The timestamp at which the target node detects the domain is active",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"targetNodeDomainDetected": {
 						SchemaProps: spec.SchemaProps{
 							Description: "The Target Node has seen the Domain Start Event",
 							Type:        []string{"boolean"},
@@ -23102,11 +24473,31 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceMigrationState(ref comm
 							Format:      "",
 						},
 					},
+					"crossCluster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Details of a migration from or to another cluster",
+							Ref:         ref("kubevirt.io/api/core/v1.CrossClusterMigrationState"),
+						},
+					},
+					"sourcePersistentStatePVCName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the PVC holding the persistent VM state, like the TPM, used by the source pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetPersistentStatePVCName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the PVC holding the persistent VM state, like the TPM, used by the target pod. It differs from the source one when the state storage is not shared between nodes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.MigrationConfiguration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.CrossClusterMigrationState", "kubevirt.io/api/core/v1.MigrationConfiguration"},
 	}
 }
 
@@ -23232,9 +24623,24 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceNetworkInterface(ref co
 							Format:      "int32",
 						},
 					},
+					"filterDroppedPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FilterDroppedPackets counts the packets dropped by the interface filter, refreshed at most once per minute",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceFilterDroppedPackets"),
+						},
+					},
+					"linkState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LinkState is the link state of the interface in the domain, up or down. Reported when the link state is controlled by the interface state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.InterfaceFilterDroppedPackets"},
 	}
 }
 
@@ -24072,11 +25478,29 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.CPUTopology"),
 						},
 					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory shows the current memory allocation of the VirtualMachineInstance.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryStatus"),
+						},
+					},
+					"backupStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupStatus is the status of the last backup job started in the VirtualMachineInstance",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus"),
+						},
+					},
+					"watchdogStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WatchdogStatus reports the firing of the watchdog device of the VirtualMachineInstance",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineInstanceWatchdogStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.CPUTopology", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.MemoryStatus", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceBackupStatus", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VirtualMachineInstanceWatchdogStatus", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -24107,6 +25531,41 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceTemplateSpec(ref common
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceWatchdogStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceWatchdogStatus reports the firing of the watchdog device",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"firedCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FiredCount is the number of times the watchdog fired since the VirtualMachineInstance started",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastFiredTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastFiredTimestamp is the time the watchdog fired last",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the action taken the last time the watchdog fired",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInterfaceRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -24948,12 +26407,31 @@ func schema_kubevirtio_api_core_v1_Watchdog(ref common.ReferenceCallback) common
 							Ref:         ref("kubevirt.io/api/core/v1.I6300ESBWatchdog"),
 						},
 					},
+					"diag288": {
+						SchemaProps: spec.SchemaProps{
+							Description: "diag288 watchdog device, available on s390x.",
+							Ref:         ref("kubevirt.io/api/core/v1.Diag288Watchdog"),
+						},
+					},
+					"itco": {
+						SchemaProps: spec.SchemaProps{
+							Description: "iTCO watchdog device built into the q35 chipset.",
+							Ref:         ref("kubevirt.io/api/core/v1.ITCOWatchdog"),
+						},
+					},
+					"memoryDumpClaimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryDumpClaimName is the name of the PVC the guest memory is dumped to by the dump action, before the guest is reset. Required by the dump action. The memory is only dumped for VMIs owned by a VirtualMachine, other VMIs are reset right away.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.I6300ESBWatchdog"},
+			"kubevirt.io/api/core/v1.Diag288Watchdog", "kubevirt.io/api/core/v1.I6300ESBWatchdog", "kubevirt.io/api/core/v1.ITCOWatchdog"},
 	}
 }
 
@@ -24961,7 +26439,7 @@ func schema_kubevirtio_api_core_v1_WatchdogDevice(ref common.ReferenceCallback)
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Hardware watchdog device. Exactly one of its members must be set.",
+				Description: "Hardware watchdog device. Exactly one of its members must be set. There is no virtio watchdog model, since QEMU and libvirt don't provide one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"i6300esb": {
@@ -27842,12 +29320,18 @@ func schema_kubevirtio_api_instancetype_v1beta1_MemoryInstancetype(ref common.Re
 							Format:      "int32",
 						},
 					},
+					"balloon": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optionally enables the free memory policy of the memory balloon for the VirtualMachineInstance.",
+							Ref:         ref("kubevirt.io/api/core/v1.MemoryBalloon"),
+						},
+					},
 				},
 				Required: []string{"guest"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.Hugepages", "kubevirt.io/api/core/v1.MemoryBalloon"},
 	}
 }
 
@@ -28454,6 +29938,34 @@ func schema_kubevirtio_api_instancetype_v1beta1_VolumePreferences(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_migrations_v1alpha1_GovernedVirtualMachineInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GovernedVirtualMachineInstance references a VMI that is governed by a migration policy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -28567,6 +30079,13 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.Re
 							Ref: ref("kubevirt.io/api/migrations/v1alpha1.Selectors"),
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority decides which policy applies when more than one policy matches a VMI. The matching policy with the highest priority is chosen, regardless of how many labels the other policies match. Defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"allowAutoConverge": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
@@ -28590,6 +30109,30 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref common.Re
 							Format: "",
 						},
 					},
+					"parallelMigrationConnections": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"compression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"progressTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"allowWorkloadDisruption": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
 				},
 				Required: []string{"selectors"},
 			},
@@ -28604,8 +30147,31 @@ func schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref common.
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineInstances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstances lists the VMIs that are currently governed by this policy, i.e. the VMIs for which this policy is the matching policy",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/migrations/v1alpha1.GovernedVirtualMachineInstance"),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"kubevirt.io/api/migrations/v1alpha1.GovernedVirtualMachineInstance"},
 	}
 }
 
@@ -28752,6 +30318,25 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref common.
 	}
 }
 
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolDataVolumeClaimRetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"whenScaled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WhenScaled specifies what happens to the DataVolumes of a replica which gets removed because the pool scales in. \"Retain\" keeps them, so that they get reattached when the pool scales out again, \"Delete\" removes them. Defaults to Retain.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -28801,59 +30386,168 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref common.Refer
 	}
 }
 
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolRollingUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"selector": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Label selector for pods. Existing Poolss whose pods are selected by this will be the ones affected by this deployment.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Mode defines whether running VMs are restarted to pick up a template change. Can be \"Proactive\" or \"Opportunistic\". Defaults to Proactive.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"virtualMachineTemplate": {
+					"maxUnavailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Template describes the VM that will be created.",
-							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"),
+							Description: "The maximum number of VMs that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"paused": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that the pool is paused.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "The maximum number of VMs that can be created over the desired number of replicas while outdated VMs are updated. Value can be an absolute number (ex: 5) or a percentage of desired replicas (ex: 10%). Absolute number is calculated from percentage by rounding up. Defaults to 0.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
 				},
-				Required: []string{"selector", "virtualMachineTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolScaleInPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+					"order": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
+						SchemaProps: spec.SchemaProps{
+							Description: "Order lists the criteria used to pick the VMs which get removed when the pool scales in. Each criterion only breaks the ties left by the previous ones, remaining ties are broken randomly. Can contain \"NotReadyFirst\", \"NewestFirst\", \"OldestFirst\" and \"LeastLoaded\". If empty, VMs are picked randomly.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of desired pods. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label selector for pods. Existing Poolss whose pods are selected by this will be the ones affected by this deployment.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"virtualMachineTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template describes the VM that will be created.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"),
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the pool is paused.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The strategy used to roll out template changes to existing VMs. If not set, all outdated VMs are updated and restarted at once.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy"),
+						},
+					},
+					"scaleInPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The policy used to pick the VMs which get removed when the pool scales in. VMs annotated with pool.kubevirt.io/scale-in-protection=true are never picked.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInPolicy"),
+						},
+					},
+					"namingPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamingPolicy defines how the VMs of the pool are named. Can be \"Indexed\" or \"Ordinal\". The Ordinal policy can not be combined with a scale in policy. Defaults to Indexed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataVolumeClaimTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeClaimTemplates lists DataVolumes which are created once per replica and outlive the VMs. The DataVolume of a replica is named <template name>-<VM name> and owned by the pool, volumes of the VM template which refer to a template by name are pointed to the DataVolume of the replica. A recreated VM with the same name reattaches the DataVolumes of its predecessor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/core/v1.DataVolumeTemplateSpec"),
+									},
+								},
+							},
+						},
+					},
+					"dataVolumeClaimRetentionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataVolumeClaimRetentionPolicy defines what happens to the DataVolumes created from the DataVolumeClaimTemplates when their replica is removed. They are always deleted together with the pool.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolDataVolumeClaimRetentionPolicy"),
+						},
+					},
+				},
+				Required: []string{"selector", "virtualMachineTemplate"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/core/v1.DataVolumeTemplateSpec", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolDataVolumeClaimRetentionPolicy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolScaleInPolicy", "kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolUpdateStrategy", "kubevirt.io/api/pool/v1alpha1.VirtualMachineTemplateSpec"},
+	}
+}
+
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 					"readyReplicas": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
@@ -28885,6 +30579,20 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 							Format:      "",
 						},
 					},
+					"updatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of VMs which run the current pool template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"outdatedReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of VMs which still run an older pool template.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -28893,6 +30601,33 @@ func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolStatus(ref common.Ref
 	}
 }
 
+func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the update strategy. Can be \"RollingUpdate\". Defaults to RollingUpdate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rolling update config params. Present only if Type = RollingUpdate.",
+							Ref:         ref("kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolRollingUpdate"},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachineTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -29133,11 +30868,11 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachine(ref common.Reference
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestore defines the operation of restoring a VM",
+				Description: "VirtualMachineBackup defines the operation of backing up the disks of a running VirtualMachine. Every backup records a checkpoint, a later backup can be taken incrementally from it so that only the blocks changed since are copied. Checkpoints do not survive a restart or a migration of the VM, and changed blocks are only tracked for qcow2 disks; raw disks are always backed up in full.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -29163,12 +30898,12 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestore(ref common.Re
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreSpec"),
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreStatus"),
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupStatus"),
 						},
 					},
 				},
@@ -29176,15 +30911,15 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestore(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackupStatus"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestoreList is a list of VirtualMachineRestore resources",
+				Description: "VirtualMachineBackupList is a list of VirtualMachineBackup resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -29214,7 +30949,7 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreList(ref commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestore"),
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackup"),
 									},
 								},
 							},
@@ -29225,53 +30960,47 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreList(ref commo
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestore"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineBackup"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+				Description: "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"target": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Description: "initially only VirtualMachine type supported",
+							Description: "Source is the VirtualMachine to back up",
 							Default:     map[string]interface{}{},
 							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
 						},
 					},
-					"virtualMachineSnapshotName": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Mode is either Push, where the backup is written to the Target volume, or Pull, where the disks are exposed through a VirtualMachineExport until the VirtualMachineBackup is deleted. Defaults to Pull.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"patches": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"incrementalFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IncrementalFrom is the name of the checkpoint of a previous backup of the same VirtualMachine. Only the blocks changed since that checkpoint are backed up. A full backup is taken when it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be applied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Target is the name of a volume of the VirtualMachine, backed by a filesystem PersistentVolumeClaim and not attached as a disk, the backup is written to in push mode",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"target", "virtualMachineSnapshotName"},
+				Required: []string{"source"},
 			},
 		},
 		Dependencies: []string{
@@ -29279,49 +31008,65 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreSpec(ref commo
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineBackupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource",
+				Description: "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"restores": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"checkpointName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CheckpointName is the name of the checkpoint recorded by the backup, it can be used as IncrementalFrom of a later backup",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes lists the backed up volumes",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VolumeRestore"),
+										Ref:     ref("kubevirt.io/api/core/v1.VirtualMachineInstanceBackupVolume"),
 									},
 								},
 							},
 						},
 					},
-					"restoreTime": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"deletedDataVolumes": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"complete": {
+					"readyToUse": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "ReadyToUse indicates that the backup can be read, either from the target volume in push mode or through a VirtualMachineExport in pull mode",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.Error"),
 						},
 					},
 					"conditions": {
@@ -29341,15 +31086,15 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreStatus(ref com
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1alpha1.Condition", "kubevirt.io/api/snapshot/v1alpha1.VolumeRestore"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/core/v1.VirtualMachineInstanceBackupVolume", "kubevirt.io/api/snapshot/v1alpha1.Condition", "kubevirt.io/api/snapshot/v1alpha1.Error"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestore(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshot defines the operation of snapshotting a VM",
+				Description: "VirtualMachineRestore defines the operation of restoring a VM",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -29375,12 +31120,12 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshot(ref common.R
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSpec"),
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotStatus"),
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreStatus"),
 						},
 					},
 				},
@@ -29388,15 +31133,15 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshot(ref common.R
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestoreStatus"},
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotContent contains the snapshot data",
+				Description: "VirtualMachineRestoreList is a list of VirtualMachineRestore resources",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -29416,21 +31161,263 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContent(ref c
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentStatus"),
-						},
-					},
-				},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestore"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineRestore"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initially only VirtualMachine type supported",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.TypedLocalObjectReference"),
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"patches": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be applied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"targetNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNamespace is the namespace the target is restored into. Defaults to the namespace of the VirtualMachineRestore. When set to another namespace the target must not exist, and the restored PVCs reference the VolumeSnapshots across namespaces, which requires the CrossNamespaceVolumeDataSource feature and a ReferenceGrant in the namespace of the VirtualMachineRestore.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newMacAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewMacAddresses manually sets the target interfaces' mac addresses when restoring into a new VM. The key is the interface name and the value is the new mac address. Interfaces that are not included in this map get a new MAC address generated automatically.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"newSMBiosSerial": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewSMBiosSerial manually sets the target's SMbios serial when restoring into a new VM. If this field is not specified, a new serial will be generated automatically.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"target", "virtualMachineSnapshotName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.TypedLocalObjectReference"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineRestoreStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"restores": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VolumeRestore"),
+									},
+								},
+							},
+						},
+					},
+					"restoreTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"deletedDataVolumes": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"complete": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1alpha1.Condition", "kubevirt.io/api/snapshot/v1alpha1.VolumeRestore"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshot defines the operation of snapshotting a VM",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotContent contains the snapshot data",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotContentStatus"),
+						},
+					},
+				},
 				Required: []string{"spec"},
 			},
 		},
@@ -29573,11 +31560,11 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotContentStatus
 	}
 }
 
-func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineSnapshotList is a list of VirtualMachineSnapshot resources",
+				Description: "VirtualMachineSnapshotGroup defines the operation of snapshotting several VMs at the same instant",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -29597,13 +31584,248 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotList(ref comm
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroup"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupMember(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupMember links a VirtualMachine of the group to its VirtualMachineSnapshot",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualMachineName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"virtualMachineSnapshotName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"virtualMachineName", "virtualMachineSnapshotName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VirtualMachines in the namespace of the group that are snapshotted together",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"deletionPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletionPolicy is passed on to every VirtualMachineSnapshot of the group",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failureDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This time represents the number of seconds we permit the group snapshot to take, it is passed on to every VirtualMachineSnapshot of the group. In case we pass this deadline the whole group is rolled back and marked as failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"selector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"members": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Members lists the VirtualMachines of the group and their VirtualMachineSnapshots",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupMember"),
+									},
+								},
+							},
+						},
+					},
+					"freezeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreezeTime is the time at which all the members of the group were frozen",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"readyToUse": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.Error"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1alpha1.Condition", "kubevirt.io/api/snapshot/v1alpha1.Error", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotGroupMember"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotList is a list of VirtualMachineSnapshot resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
@@ -29622,6 +31844,228 @@ func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotList(ref comm
 	}
 }
 
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotSchedule defines a recurring snapshot of the VMs matching a label selector",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleSpec", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleStatus"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotSchedule"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleRetention(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleRetention defines when snapshots created by a schedule expire",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxCount is the number of ready snapshots kept per VirtualMachine, older ones are deleted, failed snapshots do not count towards it",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"expires": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expires is the duration after which a snapshot is deleted",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is a cron expression (e.g. \"0 2 * * *\") describing when snapshots are taken",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the VirtualMachines in the namespace of the schedule that are snapshotted",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention defines which snapshots created by the schedule are kept",
+							Ref:         ref("kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleRetention"),
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled suspends the creation of new snapshots, retention is still applied",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"failureDeadline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureDeadline is passed on to every VirtualMachineSnapshot created by the schedule",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"schedule", "selector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "kubevirt.io/api/snapshot/v1alpha1.VirtualMachineSnapshotScheduleRetention"},
+	}
+}
+
+func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotScheduleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextScheduleTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSuccessTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastFailure": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/snapshot/v1alpha1.Error"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("kubevirt.io/api/snapshot/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "kubevirt.io/api/snapshot/v1alpha1.Condition", "kubevirt.io/api/snapshot/v1alpha1.Error"},
+	}
+}
+
 func schema_kubevirtio_api_snapshot_v1alpha1_VirtualMachineSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
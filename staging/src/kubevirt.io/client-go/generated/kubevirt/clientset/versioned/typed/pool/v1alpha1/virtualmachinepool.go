@@ -22,6 +22,7 @@ import (
 	"context"
 	"time"
 
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -47,6 +48,9 @@ type VirtualMachinePoolInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachinePoolList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachinePool, err error)
+	GetScale(ctx context.Context, virtualMachinePoolName string, options v1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, virtualMachinePoolName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (*autoscalingv1.Scale, error)
+
 	VirtualMachinePoolExpansion
 }
 
@@ -193,3 +197,32 @@ func (c *virtualMachinePools) Patch(ctx context.Context, name string, pt types.P
 		Into(result)
 	return
 }
+
+// GetScale takes name of the virtualMachinePool, and returns the corresponding autoscalingv1.Scale object, and an error if there is any.
+func (c *virtualMachinePools) GetScale(ctx context.Context, virtualMachinePoolName string, options v1.GetOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinepools").
+		Name(virtualMachinePoolName).
+		SubResource("scale").
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateScale takes the top resource name and the representation of a scale and updates it. Returns the server's representation of the scale, and an error, if there is any.
+func (c *virtualMachinePools) UpdateScale(ctx context.Context, virtualMachinePoolName string, scale *autoscalingv1.Scale, opts v1.UpdateOptions) (result *autoscalingv1.Scale, err error) {
+	result = &autoscalingv1.Scale{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinepools").
+		Name(virtualMachinePoolName).
+		SubResource("scale").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(scale).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -28,6 +28,10 @@ type FakeSnapshotV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineBackups(namespace string) v1alpha1.VirtualMachineBackupInterface {
+	return &FakeVirtualMachineBackups{c, namespace}
+}
+
 func (c *FakeSnapshotV1alpha1) VirtualMachineRestores(namespace string) v1alpha1.VirtualMachineRestoreInterface {
 	return &FakeVirtualMachineRestores{c, namespace}
 }
@@ -40,6 +44,14 @@ func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotContents(namespace string)
 	return &FakeVirtualMachineSnapshotContents{c, namespace}
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotGroups(namespace string) v1alpha1.VirtualMachineSnapshotGroupInterface {
+	return &FakeVirtualMachineSnapshotGroups{c, namespace}
+}
+
+func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotSchedules(namespace string) v1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return &FakeVirtualMachineSnapshotSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1alpha1) RESTClient() rest.Interface {
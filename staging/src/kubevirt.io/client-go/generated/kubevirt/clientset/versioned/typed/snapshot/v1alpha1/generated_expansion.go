@@ -18,8 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+type VirtualMachineBackupExpansion interface{}
+
 type VirtualMachineRestoreExpansion interface{}
 
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotGroupExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
@@ -26,9 +26,12 @@ import (
 
 type SnapshotV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineBackupsGetter
 	VirtualMachineRestoresGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotGroupsGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1alpha1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -36,6 +39,10 @@ type SnapshotV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineBackups(namespace string) VirtualMachineBackupInterface {
+	return newVirtualMachineBackups(c, namespace)
+}
+
 func (c *SnapshotV1alpha1Client) VirtualMachineRestores(namespace string) VirtualMachineRestoreInterface {
 	return newVirtualMachineRestores(c, namespace)
 }
@@ -48,6 +55,14 @@ func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotContents(namespace string
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotGroups(namespace string) VirtualMachineSnapshotGroupInterface {
+	return newVirtualMachineSnapshotGroups(c, namespace)
+}
+
+func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*SnapshotV1alpha1Client, error) {
 	config := *c
@@ -205,6 +205,36 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1alpha113.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1alpha113.VirtualMachineSnapshotGroupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotGroup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotGroup", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineBackup(namespace string) v1alpha113.VirtualMachineBackupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineBackupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1alpha110.VirtualMachineExportInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
 	ret0, _ := ret[0].(v1alpha110.VirtualMachineExportInterface)
@@ -1203,6 +1233,26 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SoftReboot(arg0, arg1 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SoftReboot", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) Backup(ctx context.Context, name string, backupOptions *v120.VirtualMachineInstanceBackupOptions) error {
+	ret := _m.ctrl.Call(_m, "Backup", ctx, name, backupOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Backup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Backup", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) AbortBackup(ctx context.Context, name string) error {
+	ret := _m.ctrl.Call(_m, "AbortBackup", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AbortBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortBackup", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) GuestOsInfo(ctx context.Context, name string) (v120.VirtualMachineInstanceGuestAgentInfo, error) {
 	ret := _m.ctrl.Call(_m, "GuestOsInfo", ctx, name)
 	ret0, _ := ret[0].(v120.VirtualMachineInstanceGuestAgentInfo)
@@ -1236,6 +1286,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) CPULoad(ctx context.Context, name string) (v120.VirtualMachineInstanceCPULoad, error) {
+	ret := _m.ctrl.Call(_m, "CPULoad", ctx, name)
+	ret0, _ := ret[0].(v120.VirtualMachineInstanceCPULoad)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) CPULoad(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "CPULoad", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v120.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1309,6 +1370,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SEVInjectLaunchSecret(a
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "SEVInjectLaunchSecret", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) AttestationReport(name string, nonce string) (v120.AttestationReport, error) {
+	ret := _m.ctrl.Call(_m, "AttestationReport", name, nonce)
+	ret0, _ := ret[0].(v120.AttestationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AttestationReport(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AttestationReport", arg0, arg1)
+}
+
 // Mock of ReplicaSetInterface interface
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -27,13 +28,17 @@ const (
 	freezeTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
 	unfreezeTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
 	softRebootTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/softreboot"
+	backupTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup"
+	abortBackupTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/abortbackup"
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	cpuLoadTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/cpuload"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
 	sevInjectLaunchSecretTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/injectlaunchsecret"
+	attestationReportTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/attestationreport"
 )
 
 func NewVirtHandlerClient(virtCli KubevirtClient, httpCli *http.Client) VirtHandlerClient {
@@ -62,15 +67,19 @@ type VirtHandlerConn interface {
 	FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	AbortBackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	AttestationReportURI(vmi *virtv1.VirtualMachineInstance, nonce string) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
 	Get(url string) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	CPULoadURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -201,6 +210,14 @@ func (v *virtHandlerConn) UnfreezeURI(vmi *virtv1.VirtualMachineInstance) (strin
 	return v.formatURI(unfreezeTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(backupTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) AbortBackupURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(abortBackupTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(softRebootTemplateURI, vmi)
 }
@@ -288,6 +305,10 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) CPULoadURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(cpuLoadTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
@@ -299,3 +320,11 @@ func (v *virtHandlerConn) SEVQueryLaunchMeasurementURI(vmi *virtv1.VirtualMachin
 func (v *virtHandlerConn) SEVInjectLaunchSecretURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevInjectLaunchSecretTemplateURI, vmi)
 }
+
+func (v *virtHandlerConn) AttestationReportURI(vmi *virtv1.VirtualMachineInstance, nonce string) (string, error) {
+	baseURI, err := v.formatURI(attestationReportTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?nonce=%s", baseURI, url.QueryEscape(nonce)), nil
+}
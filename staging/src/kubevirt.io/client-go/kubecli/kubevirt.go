@@ -71,6 +71,9 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineSnapshotGroup(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotGroupInterface
+	VirtualMachineBackup(namespace string) vmsnapshotv1alpha1.VirtualMachineBackupInterface
 	VirtualMachineExport(namespace string) vmexportv1alpha1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
@@ -174,6 +177,18 @@ func (k kubevirt) VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.Vir
 	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirt) VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirt) VirtualMachineSnapshotGroup(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineSnapshotGroups(namespace)
+}
+
+func (k kubevirt) VirtualMachineBackup(namespace string) vmsnapshotv1alpha1.VirtualMachineBackupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineBackups(namespace)
+}
+
 func (k kubevirt) VirtualMachineExport(namespace string) vmexportv1alpha1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1alpha1().VirtualMachineExports(namespace)
 }
@@ -246,9 +261,12 @@ type VirtualMachineInstanceInterface interface {
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
 	Unfreeze(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
+	Backup(ctx context.Context, name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error
+	AbortBackup(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	CPULoad(ctx context.Context, name string) (v1.VirtualMachineInstanceCPULoad, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
@@ -256,6 +274,7 @@ type VirtualMachineInstanceInterface interface {
 	SEVQueryLaunchMeasurement(name string) (v1.SEVMeasurementInfo, error)
 	SEVSetupSession(name string, sevSessionOptions *v1.SEVSessionOptions) error
 	SEVInjectLaunchSecret(name string, sevSecretOptions *v1.SEVSecretOptions) error
+	AttestationReport(name string, nonce string) (v1.AttestationReport, error)
 }
 
 type ReplicaSetInterface interface {
@@ -264,6 +264,24 @@ func (v *vmis) Unfreeze(ctx context.Context, name string) error {
 	return v.restClient.Put().AbsPath(uri).Do(ctx).Error()
 }
 
+func (v *vmis) Backup(ctx context.Context, name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error {
+	log.Log.Infof("Backup VMI %s", name)
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "backup")
+
+	JSON, err := json.Marshal(backupOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body([]byte(JSON)).Do(ctx).Error()
+}
+
+func (v *vmis) AbortBackup(ctx context.Context, name string) error {
+	log.Log.Infof("Abort backup of VMI %s", name)
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "abortbackup")
+	return v.restClient.Put().AbsPath(uri).Do(ctx).Error()
+}
+
 func (v *vmis) SoftReboot(ctx context.Context, name string) error {
 	log.Log.Infof("SoftReboot VMI")
 	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "softreboot")
@@ -454,6 +472,18 @@ func (v *vmis) FilesystemList(ctx context.Context, name string) (v1.VirtualMachi
 	return fsList, err
 }
 
+func (v *vmis) CPULoad(ctx context.Context, name string) (v1.VirtualMachineInstanceCPULoad, error) {
+	cpuLoad := v1.VirtualMachineInstanceCPULoad{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "cpuload")
+	rawLoad, err := v.restClient.Get().AbsPath(uri).Do(ctx).Raw()
+	if err != nil {
+		return cpuLoad, err
+	}
+
+	err = json.Unmarshal(rawLoad, &cpuLoad)
+	return cpuLoad, err
+}
+
 func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if screenshotOptions.MoveCursor == true {
@@ -538,3 +568,10 @@ func (v *vmis) SEVInjectLaunchSecret(name string, sevSecretOptions *v1.SEVSecret
 	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "sev/injectlaunchsecret")
 	return v.restClient.Put().RequestURI(uri).Body(body).Do(context.Background()).Error()
 }
+
+func (v *vmis) AttestationReport(name string, nonce string) (v1.AttestationReport, error) {
+	attestationReport := v1.AttestationReport{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "attestationreport")
+	err := v.restClient.Get().RequestURI(uri).Param("nonce", nonce).Do(context.Background()).Into(&attestationReport)
+	return attestationReport, err
+}
@@ -469,6 +469,26 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(fetchedInfo).To(Equal(sevMeasurementInfo), "fetched info should be the same as passed in")
 	})
 
+	It("should fetch an attestation report via subresource", func() {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		attestationReport := v1.AttestationReport{
+			Type:   "sev",
+			Nonce:  "AAECAwQFBgcICQoLDA0ODw==",
+			Report: "cmVwb3J0",
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(subVMIPath, "attestationreport"), "nonce=AAECAwQFBgcICQoLDA0ODw%3D%3D"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, attestationReport),
+		))
+		fetchedReport, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).AttestationReport("testvm", attestationReport.Nonce)
+
+		Expect(err).ToNot(HaveOccurred(), "should fetch the report normally")
+		Expect(fetchedReport).To(Equal(attestationReport), "fetched report should be the same as passed in")
+	})
+
 	It("should setup SEV session for a VirtualMachineInstance", func() {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
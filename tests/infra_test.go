@@ -257,7 +257,7 @@ var _ = Describe("[Serial][sig-compute]Infrastructure", Serial, decorators.SigCo
 		It("[test_id:5177]Should have structural schema", func() {
 			ourCRDs := []string{crds.VIRTUALMACHINE, crds.VIRTUALMACHINEINSTANCE, crds.VIRTUALMACHINEINSTANCEPRESET,
 				crds.VIRTUALMACHINEINSTANCEREPLICASET, crds.VIRTUALMACHINEINSTANCEMIGRATION, crds.KUBEVIRT,
-				crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT,
+				crds.VIRTUALMACHINESNAPSHOT, crds.VIRTUALMACHINESNAPSHOTCONTENT, crds.VIRTUALMACHINESNAPSHOTSCHEDULE, crds.VIRTUALMACHINESNAPSHOTGROUP, crds.VIRTUALMACHINEBACKUP,
 			}
 
 			for _, name := range ourCRDs {
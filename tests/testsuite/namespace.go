@@ -253,6 +253,15 @@ func CleanNamespaces() {
 			util.PanicOnError(virtCli.VirtualMachineClone(namespace).Delete(context.Background(), clone.Name, metav1.DeleteOptions{}))
 		}
 
+		// Remove vm snapshot schedules
+		util.PanicOnError(virtCli.VirtualMachineSnapshotSchedule(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{}))
+
+		// Remove vm snapshot groups
+		util.PanicOnError(virtCli.VirtualMachineSnapshotGroup(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{}))
+
+		// Remove vm backups
+		util.PanicOnError(virtCli.VirtualMachineBackup(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{}))
+
 		// Remove vm snapshots
 		util.PanicOnError(virtCli.VirtualMachineSnapshot(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{}))
 		snapshots, err := virtCli.VirtualMachineSnapshot(namespace).List(context.Background(), metav1.ListOptions{})
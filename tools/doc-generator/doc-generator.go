@@ -154,6 +154,16 @@ func getMetricsNotIncludeInEndpointByDefault() metricList {
 			description: "Indication for a VirtualMachine that its eviction strategy is set to Live Migration but is not migratable.",
 			mType:       "Gauge",
 		},
+		{
+			name:        "kubevirt_vmi_watchdog_fired_total",
+			description: "Total number of times the guest watchdog of a VirtualMachineInstance fired.",
+			mType:       "Counter",
+		},
+		{
+			name:        "kubevirt_vmi_network_filter_dropped_packets_total",
+			description: "Total number of packets dropped by the filter of a VirtualMachineInstance interface. Where `direction` is either `ingress` or `egress`.",
+			mType:       "Counter",
+		},
 	}
 
 	for _, rule := range components.GetRecordingRules("") {